@@ -60,6 +60,7 @@ fi
 # OVNKUBE_LOGFILE_MAXAGE - log file max age in days (default 5 days)
 # OVNKUBE_LIBOVSDB_CLIENT_LOGFILE - separate log file for libovsdb client (default: do not separate from logfile)
 # OVN_ACL_LOGGING_RATE_LIMIT - specify default ACL logging rate limit in messages per second (default: 20)
+# OVN_ACL_LOG_SINK - write ACL logs of ovn-controller to per-namespace files ("file") or events ("event") (default: disabled)
 # OVN_NB_PORT - ovn north db port (default 6641)
 # OVN_SB_PORT - ovn south db port (default 6642)
 # OVN_NB_RAFT_PORT - ovn north db raft port (default 6643)
@@ -83,6 +84,7 @@ fi
 # OVNKUBE_NODE_MODE - ovnkube node mode of operation, one of: full, dpu, dpu-host (default: full)
 # OVNKUBE_NODE_MGMT_PORT_NETDEV - ovnkube node management port netdev.
 # OVNKUBE_NODE_MGMT_PORT_DP_RESOURCE_NAME - ovnkube node management port device plugin resource
+# OVNKUBE_NODE_MGMT_PORT_NETNS - ovnkube node management port network namespace name or path
 # OVN_ENCAP_IP - encap IP to be used for OVN traffic on the node. mandatory in case ovnkube-node-mode=="dpu"
 # OVN_HOST_NETWORK_NAMESPACE - namespace to classify host network traffic for applying network policies
 # OVN_DISABLE_FORWARDING - disable forwarding on OVNK controlled interfaces
@@ -96,6 +98,7 @@ fi
 # OVN_NORTHD_BACKOFF_INTERVAL - ovn northd backoff interval in ms (default 300)
 # OVN_ENABLE_SVC_TEMPLATE_SUPPORT - enable svc template support
 # OVN_ENABLE_DNSNAMERESOLVER - enable dns name resolver support
+# OVN_ENABLE_DNS_SNOOPING - enable dns snooping support for egress firewall
 # OVN_OBSERV_ENABLE - enable observability for ovnkube
 
 # The argument to the command is the operation to be performed
@@ -226,6 +229,8 @@ ovn_sb_raft_election_timer=${OVN_SB_RAFT_ELECTION_TIMER:-1000}
 
 ovn_hybrid_overlay_enable=${OVN_HYBRID_OVERLAY_ENABLE:-}
 ovn_hybrid_overlay_net_cidr=${OVN_HYBRID_OVERLAY_NET_CIDR:-}
+# OVN_HYBRID_OVERLAY_EXTERNAL_PEERS - program VXLAN tunnels to ExternalOverlayPeers (default false)
+ovn_hybrid_overlay_external_peers=${OVN_HYBRID_OVERLAY_EXTERNAL_PEERS:-false}
 ovn_disable_snat_multiple_gws=${OVN_DISABLE_SNAT_MULTIPLE_GWS:-}
 ovn_disable_forwarding=${OVN_DISABLE_FORWARDING:-}
 ovn_disable_pkt_mtu_check=${OVN_DISABLE_PKT_MTU_CHECK:-}
@@ -272,6 +277,7 @@ ovn_network_segmentation_enable=${OVN_NETWORK_SEGMENTATION_ENABLE:=false}
 #OVN_NROUTE_ADVERTISEMENTS_ENABLE - enable route advertisements for ovn-kubernetes
 ovn_route_advertisements_enable=${OVN_ROUTE_ADVERTISEMENTS_ENABLE:=false}
 ovn_acl_logging_rate_limit=${OVN_ACL_LOGGING_RATE_LIMIT:-"20"}
+ovn_acl_log_sink=${OVN_ACL_LOG_SINK:-}
 ovn_netflow_targets=${OVN_NETFLOW_TARGETS:-}
 ovn_sflow_targets=${OVN_SFLOW_TARGETS:-}
 ovn_ipfix_targets=${OVN_IPFIX_TARGETS:-}
@@ -280,6 +286,8 @@ ovn_ipfix_cache_max_flows=${OVN_IPFIX_CACHE_MAX_FLOWS:-} \
 ovn_ipfix_cache_active_timeout=${OVN_IPFIX_CACHE_ACTIVE_TIMEOUT:-} \
 #OVN_STATELESS_NETPOL_ENABLE - enable stateless network policy for ovn-kubernetes
 ovn_stateless_netpol_enable=${OVN_STATELESS_NETPOL_ENABLE:-false}
+#OVN_STATELESS_NETPOL_RETURN_ACLS_ENABLE - allow the return traffic of stateless network policies
+ovn_stateless_netpol_return_acls_enable=${OVN_STATELESS_NETPOL_RETURN_ACLS_ENABLE:-false}
 #OVN_ENABLE_INTERCONNECT - enable interconnect with multiple zones
 ovn_enable_interconnect=${OVN_ENABLE_INTERCONNECT:-false}
 #OVN_ENABLE_MULTI_EXTERNAL_GATEWAY - enable multi external gateway
@@ -293,6 +301,9 @@ ovn_enable_persistent_ips=${OVN_ENABLE_PERSISTENT_IPS:-false}
 ovnkube_node_mode=${OVNKUBE_NODE_MODE:-"full"}
 # OVNKUBE_NODE_MGMT_PORT_NETDEV - is the net device to be used for management port
 ovnkube_node_mgmt_port_netdev=${OVNKUBE_NODE_MGMT_PORT_NETDEV:-}
+# OVNKUBE_NODE_MGMT_PORT_NETNS - is the name or path of the network namespace the management
+# port is isolated in, away from host processes
+ovnkube_node_mgmt_port_netns=${OVNKUBE_NODE_MGMT_PORT_NETNS:-}
 # OVNKUBE_NODE_MGMT_PORT_DP_RESOURCE_NAME - is the device plugin resource name that has
 # allocated interfaces to be used for the management port
 ovnkube_node_mgmt_port_dp_resource_name=${OVNKUBE_NODE_MGMT_PORT_DP_RESOURCE_NAME:-}
@@ -316,6 +327,8 @@ ovn_enable_svc_template_support=${OVN_ENABLE_SVC_TEMPLATE_SUPPORT:-true}
 ovn_network_qos_enable=${OVN_NETWORK_QOS_ENABLE:-false}
 # OVN_ENABLE_DNSNAMERESOLVER - enable dns name resolver support
 ovn_enable_dnsnameresolver=${OVN_ENABLE_DNSNAMERESOLVER:-false}
+# OVN_ENABLE_DNS_SNOOPING - enable dns snooping support for egress firewall
+ovn_enable_dns_snooping=${OVN_ENABLE_DNS_SNOOPING:-false}
 # OVN_OBSERV_ENABLE - enable observability for ovnkube
 ovn_observ_enable=${OVN_OBSERV_ENABLE:-false}
 # OVN_NOHOSTSUBNET_LABEL - node label indicating nodes managing their own network
@@ -1266,6 +1279,12 @@ ovn-master() {
   fi
   echo "ovn_stateless_netpol_enable_flag: ${ovn_stateless_netpol_enable_flag}"
 
+  ovn_stateless_netpol_return_acls_enable_flag=
+  if [[ ${ovn_stateless_netpol_return_acls_enable} == "true" ]]; then
+          ovn_stateless_netpol_return_acls_enable_flag="--enable-stateless-netpol-return-acls"
+  fi
+  echo "ovn_stateless_netpol_return_acls_enable_flag: ${ovn_stateless_netpol_return_acls_enable_flag}"
+
   ovnkube_enable_multi_external_gateway_flag=
   if [[ ${ovn_enable_multi_external_gateway} == "true" ]]; then
 	  ovnkube_enable_multi_external_gateway_flag="--enable-multi-external-gateway"
@@ -1348,6 +1367,7 @@ ovn-master() {
     ${ovnkube_metrics_tls_opts} \
     ${ovn_master_ssl_opts} \
     ${ovn_stateless_netpol_enable_flag} \
+    ${ovn_stateless_netpol_return_acls_enable_flag} \
     ${ovn_v4_join_subnet_opt} \
     ${ovn_v4_masquerade_subnet_opt} \
     ${ovn_v6_join_subnet_opt} \
@@ -1367,6 +1387,7 @@ ovn-master() {
     --loglevel=${ovnkube_loglevel} \
     --metrics-bind-address ${ovnkube_master_metrics_bind_address} \
     --metrics-enable-pprof \
+    --metrics-enable-network-status \
     --nb-address=${ovn_nbdb} --sb-address=${ovn_sbdb} \
     --pidfile ${OVN_RUNDIR}/ovnkube-master.pid &
 
@@ -1620,6 +1641,12 @@ ovnkube-controller() {
   fi
   echo "ovn_enable_dnsnameresolver_flag=${ovn_enable_dnsnameresolver_flag}"
 
+  ovn_enable_dns_snooping_flag=
+  if [[ ${ovn_enable_dns_snooping} == "true" ]]; then
+	  ovn_enable_dns_snooping_flag="--enable-dns-snooping"
+  fi
+  echo "ovn_enable_dns_snooping_flag=${ovn_enable_dns_snooping_flag}"
+
   ovn_observ_enable_flag=
   if [[ ${ovn_observ_enable} == "true" ]]; then
     ovn_observ_enable_flag="--enable-observability"
@@ -1660,6 +1687,7 @@ ovnkube-controller() {
     ${ovn_v6_masquerade_subnet_opt} \
     ${network_qos_enabled_flag} \
     ${ovn_enable_dnsnameresolver_flag} \
+    ${ovn_enable_dns_snooping_flag} \
     --cluster-subnets ${net_cidr} --k8s-service-cidr=${svc_cidr} \
     --gateway-mode=${ovn_gateway_mode} \
     --host-network-namespace ${ovn_host_network_namespace} \
@@ -1670,6 +1698,7 @@ ovnkube-controller() {
     --loglevel=${ovnkube_loglevel} \
     --metrics-bind-address ${ovnkube_master_metrics_bind_address} \
     --metrics-enable-pprof \
+    --metrics-enable-network-status \
     --pidfile ${OVN_RUNDIR}/ovnkube-controller.pid \
     --zone ${ovn_zone} &
 
@@ -1716,6 +1745,9 @@ ovnkube-controller-with-node() {
     hybrid_overlay_flags="--enable-hybrid-overlay"
     if [[ -n "${ovn_hybrid_overlay_net_cidr}" ]]; then
       hybrid_overlay_flags="${hybrid_overlay_flags} --hybrid-overlay-cluster-subnets=${ovn_hybrid_overlay_net_cidr}"
+      if [[ ${ovn_hybrid_overlay_external_peers} == "true" ]]; then
+        hybrid_overlay_flags="${hybrid_overlay_flags} --enable-hybrid-overlay-external-peers"
+      fi
     fi
   fi
   echo "hybrid_overlay_flags=${hybrid_overlay_flags}"
@@ -1938,6 +1970,10 @@ ovnkube-controller-with-node() {
   if [[ -n "${ovnkube_node_mgmt_port_dp_resource_name}" ]] ; then
     node_mgmt_port_netdev_flags="$node_mgmt_port_netdev_flags --ovnkube-node-mgmt-port-dp-resource-name ${ovnkube_node_mgmt_port_dp_resource_name}"
   fi
+  ovnkube_node_mgmt_port_netns_flag=
+  if [[ -n "${ovnkube_node_mgmt_port_netns}" ]]; then
+    ovnkube_node_mgmt_port_netns_flag="--ovnkube-node-mgmt-port-netns=${ovnkube_node_mgmt_port_netns}"
+  fi
 
   ovn_unprivileged_flag="--unprivileged-mode"
   if test -z "${OVN_UNPRIVILEGED_MODE+x}" -o "x${OVN_UNPRIVILEGED_MODE}" = xno; then
@@ -2042,12 +2078,24 @@ ovnkube-controller-with-node() {
   fi
   echo "network_qos_enabled_flag=${network_qos_enabled_flag}"
 
+  ovn_acl_log_sink_flag=
+  if [[ -n ${ovn_acl_log_sink} ]]; then
+      ovn_acl_log_sink_flag="--acl-log-sink=${ovn_acl_log_sink}"
+  fi
+  echo "ovn_acl_log_sink_flag=${ovn_acl_log_sink_flag}"
+
   ovn_enable_dnsnameresolver_flag=
   if [[ ${ovn_enable_dnsnameresolver} == "true" ]]; then
 	  ovn_enable_dnsnameresolver_flag="--enable-dns-name-resolver"
   fi
   echo "ovn_enable_dnsnameresolver_flag=${ovn_enable_dnsnameresolver_flag}"
 
+  ovn_enable_dns_snooping_flag=
+  if [[ ${ovn_enable_dns_snooping} == "true" ]]; then
+	  ovn_enable_dns_snooping_flag="--enable-dns-snooping"
+  fi
+  echo "ovn_enable_dns_snooping_flag=${ovn_enable_dns_snooping_flag}"
+
   ovn_observ_enable_flag=
   if [[ ${ovn_observ_enable} == "true" ]]; then
     ovn_observ_enable_flag="--enable-observability"
@@ -2095,6 +2143,7 @@ ovnkube-controller-with-node() {
     ${ovnkube_metrics_scale_enable_flag} \
     ${ovnkube_metrics_tls_opts} \
     ${ovnkube_node_mgmt_port_netdev_flag} \
+    ${ovnkube_node_mgmt_port_netns_flag} \
     ${ovnkube_node_mode_flag} \
     ${ovn_unprivileged_flag} \
     ${ovn_v4_join_subnet_opt} \
@@ -2105,7 +2154,9 @@ ovnkube-controller-with-node() {
     ${sflow_targets} \
     ${ssl_opts} \
     ${network_qos_enabled_flag} \
+    ${ovn_acl_log_sink_flag} \
     ${ovn_enable_dnsnameresolver_flag} \
+    ${ovn_enable_dns_snooping_flag} \
     --cluster-subnets ${net_cidr} --k8s-service-cidr=${svc_cidr} \
     --export-ovs-metrics \
     --gateway-mode=${ovn_gateway_mode} ${ovn_gateway_opts} \
@@ -2119,6 +2170,7 @@ ovnkube-controller-with-node() {
     --loglevel=${ovnkube_loglevel} \
     --metrics-bind-address ${metrics_bind_address} \
     --metrics-enable-pprof \
+    --metrics-enable-network-status \
     --mtu=${mtu} \
     --nodeport \
     --ovn-metrics-bind-address ${ovn_metrics_bind_address} \
@@ -2337,6 +2389,7 @@ ovn-cluster-manager() {
     --loglevel=${ovnkube_loglevel} \
     --metrics-bind-address ${ovnkube_cluster_manager_metrics_bind_address} \
     --metrics-enable-pprof \
+    --metrics-enable-network-status \
     --pidfile ${OVN_RUNDIR}/ovnkube-cluster-manager.pid &
 
   echo "=============== ovn-cluster-manager ========== running"
@@ -2419,6 +2472,9 @@ ovn-node() {
     hybrid_overlay_flags="--enable-hybrid-overlay"
     if [[ -n "${ovn_hybrid_overlay_net_cidr}" ]]; then
       hybrid_overlay_flags="${hybrid_overlay_flags} --hybrid-overlay-cluster-subnets=${ovn_hybrid_overlay_net_cidr}"
+      if [[ ${ovn_hybrid_overlay_external_peers} == "true" ]]; then
+        hybrid_overlay_flags="${hybrid_overlay_flags} --enable-hybrid-overlay-external-peers"
+      fi
     fi
   fi
 
@@ -2576,6 +2632,10 @@ ovn-node() {
   if [[ -n "${ovnkube_node_mgmt_port_dp_resource_name}" ]] ; then
     node_mgmt_port_netdev_flags="$node_mgmt_port_netdev_flags --ovnkube-node-mgmt-port-dp-resource-name ${ovnkube_node_mgmt_port_dp_resource_name}"
   fi
+  ovnkube_node_mgmt_port_netns_flag=
+  if [[ -n "${ovnkube_node_mgmt_port_netns}" ]]; then
+    ovnkube_node_mgmt_port_netns_flag="--ovnkube-node-mgmt-port-netns=${ovnkube_node_mgmt_port_netns}"
+  fi
 
   if [[ ${ovnkube_node_mode} == "dpu" ]]; then
     # in the case of dpu mode we want the host K8s Node Name and not the DPU K8s Node Name
@@ -2693,6 +2753,12 @@ ovn-node() {
   fi
   echo "network_qos_enabled_flag=${network_qos_enabled_flag}"
 
+  ovn_acl_log_sink_flag=
+  if [[ -n ${ovn_acl_log_sink} ]]; then
+      ovn_acl_log_sink_flag="--acl-log-sink=${ovn_acl_log_sink}"
+  fi
+  echo "ovn_acl_log_sink_flag=${ovn_acl_log_sink_flag}"
+
   ovn_v4_masquerade_subnet_opt=
   if [[ -n ${ovn_v4_masquerade_subnet} ]]; then
       ovn_v4_masquerade_subnet_opt="--gateway-v4-masquerade-subnet=${ovn_v4_masquerade_subnet}"
@@ -2738,12 +2804,14 @@ ovn-node() {
         ${ovnkube_metrics_tls_opts} \
         ${ovnkube_node_certs_flags} \
         ${ovnkube_node_mgmt_port_netdev_flag} \
+        ${ovnkube_node_mgmt_port_netns_flag} \
         ${ovnkube_node_mode_flag} \
         ${ovn_node_ssl_opts} \
         ${ovn_unprivileged_flag} \
         ${routable_mtu_flag} \
         ${sflow_targets} \
         ${network_qos_enabled_flag} \
+        ${ovn_acl_log_sink_flag} \
         --cluster-subnets ${net_cidr} --k8s-service-cidr=${svc_cidr} \
         --export-ovs-metrics \
         --gateway-mode=${ovn_gateway_mode} ${ovn_gateway_opts} \
@@ -2757,6 +2825,7 @@ ovn-node() {
         --loglevel=${ovnkube_loglevel} \
         --metrics-bind-address ${ovnkube_node_metrics_bind_address} \
         --metrics-enable-pprof \
+        --metrics-enable-network-status \
         --mtu=${mtu} \
         --nodeport \
         --ovn-metrics-bind-address ${ovn_metrics_bind_address} \
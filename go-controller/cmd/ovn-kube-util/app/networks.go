@@ -0,0 +1,136 @@
+package app
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
+)
+
+var NetworksCommand = cli.Command{
+	Name:  "networks",
+	Usage: "list the networks managed by an ovnkube instance, their controllers and reconciliation state",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "url",
+			Aliases:  []string{"u"},
+			Usage:    "URL of the ovnkube metrics server, e.g. http://127.0.0.1:9410",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:  "zone",
+			Usage: "only list the networks of the network managers of the specified zone",
+		},
+		&cli.StringFlag{
+			Name:  "output",
+			Usage: "output format: table or json",
+			Value: "table",
+		},
+		&cli.BoolFlag{
+			Name:  "insecure-skip-tls-verify",
+			Usage: "do not verify the certificate of the metrics server",
+		},
+	},
+	Action: func(ctx *cli.Context) error {
+		output := ctx.String("output")
+		if output != "table" && output != "json" {
+			return fmt.Errorf("unsupported output format %q", output)
+		}
+		statuses, err := getNetworksStatus(ctx.String("url"), ctx.Bool("insecure-skip-tls-verify"))
+		if err != nil {
+			return err
+		}
+		if zone := ctx.String("zone"); zone != "" {
+			filtered := make([]networkmanager.ManagerStatus, 0, len(statuses))
+			for _, status := range statuses {
+				if status.Zone == zone {
+					filtered = append(filtered, status)
+				}
+			}
+			statuses = filtered
+		}
+		if output == "json" {
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(statuses)
+		}
+		return printNetworksStatus(os.Stdout, statuses)
+	},
+}
+
+func getNetworksStatus(url string, insecureSkipTLSVerify bool) ([]networkmanager.ManagerStatus, error) {
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: insecureSkipTLSVerify},
+		},
+	}
+	resp, err := client.Get(strings.TrimSuffix(url, "/") + networkmanager.StatusPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get networks status from %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("failed to get networks status from %s: %s: %s", url, resp.Status, strings.TrimSpace(string(body)))
+	}
+	var statuses []networkmanager.ManagerStatus
+	if err := json.NewDecoder(resp.Body).Decode(&statuses); err != nil {
+		return nil, fmt.Errorf("failed to decode networks status from %s: %w", url, err)
+	}
+	return statuses, nil
+}
+
+// printNetworksStatus prints a table of the networks of every network manager
+func printNetworksStatus(out io.Writer, statuses []networkmanager.ManagerStatus) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "MANAGER\tZONE\tNODE\tNETWORK\tID\tTOPOLOGY\tROLE\tSTATE\tLAST SYNC\tERROR")
+	for _, status := range statuses {
+		for _, network := range status.Networks {
+			lastSync := "-"
+			if network.LastSyncTime != nil {
+				lastSync = network.LastSyncTime.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\t%s\t%s\t%s\t%s\n",
+				status.Name,
+				valueOrDash(status.Zone),
+				valueOrDash(status.Node),
+				network.Name,
+				network.ID,
+				network.Topology,
+				network.Role,
+				networkState(network),
+				lastSync,
+				valueOrDash(network.LastSyncError),
+			)
+		}
+	}
+	return w.Flush()
+}
+
+func networkState(network networkmanager.NetworkStatus) string {
+	switch {
+	case network.Deleting:
+		return "Deleting"
+	case network.Started:
+		return "Started"
+	default:
+		return "Pending"
+	}
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -31,6 +31,7 @@ func main() {
 		&app.BridgesToNicCommand,
 		&app.ReadinessProbeCommand,
 		&app.OvsExporterCommand,
+		&app.NetworksCommand,
 	}
 
 	c.Before = func(ctx *cli.Context) error {
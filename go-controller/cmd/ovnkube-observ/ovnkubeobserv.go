@@ -9,6 +9,7 @@ import (
 	"syscall"
 
 	observ "github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/exporter"
 )
 
 func main() {
@@ -31,10 +32,44 @@ func main() {
 	outputFile := flag.String("output-file", "", "Output file to write the samples to.")
 	filterSrcIP := flag.String("filter-src-ip", "", "Filter in only packets from a given source ip.")
 	filterDstIP := flag.String("filter-dst-ip", "", "Filter in only packets to a given destination ip.")
+	exporterType := flag.String("exporter", "", fmt.Sprintf("Export enriched flow events instead of printing the samples. Supported exporters: %s (to stdout or output-file), %s and %s.",
+		exporter.TypeJSON, exporter.TypeOTLP, exporter.TypeIPFIX))
+	otlpEndpoint := flag.String("otlp-endpoint", exporter.DefaultOTLPEndpoint, "OTLP/HTTP logs endpoint used by the otlp exporter.")
+	ipfixCollector := flag.String("ipfix-collector", "", "IPFIX collector host:port, reached over UDP by the ipfix exporter.")
+	filterExpr := flag.String("filter", "", "BPF-style filter applied to exported flows, e.g. \"tcp and dst port 8080\". Supports tcp, udp, sctp, icmp, icmp6, [src|dst] host <ip>, [src|dst] port <port>, and, or, not and parentheses.")
+	aggregationWindow := flag.Duration("aggregation-window", 0, "Aggregate the exported flows over the given window, e.g. 10s. Zero exports every sample.")
 	flag.Parse()
 
-	reader := observ.NewSampleReader(*enableDecoder, *logCookie, *printPacket, *addOVSCollector, *filterSrcIP, *filterDstIP, *outputFile)
-	err := reader.ReadSamples(ctx)
+	if *exporterType == "" {
+		reader := observ.NewSampleReader(*enableDecoder, *logCookie, *printPacket, *addOVSCollector, *filterSrcIP, *filterDstIP, *outputFile)
+		if err := reader.ReadSamples(ctx); err != nil {
+			fmt.Println(err.Error())
+		}
+		return
+	}
+
+	filter, err := observ.ParseFlowFilter(*filterExpr)
+	if err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+	var target string
+	switch *exporterType {
+	case exporter.TypeJSON:
+		target = *outputFile
+	case exporter.TypeOTLP:
+		target = *otlpEndpoint
+	case exporter.TypeIPFIX:
+		target = *ipfixCollector
+	}
+	exp, err := exporter.NewExporter(*exporterType, target)
+	if err != nil {
+		fmt.Println(err.Error())
+		os.Exit(1)
+	}
+	reader := observ.NewSampleReader(*enableDecoder, false, false, *addOVSCollector, *filterSrcIP, *filterDstIP, "").
+		WithExporter(exp, filter, *aggregationWindow)
+	err = reader.ReadSamples(ctx)
 	if err != nil {
 		fmt.Println(err.Error())
 	}
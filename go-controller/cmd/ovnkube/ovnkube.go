@@ -24,12 +24,14 @@ import (
 
 	"github.com/ovn-org/libovsdb/client"
 
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/sampledecoder"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controllermanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
 	ovnnode "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/routemanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
@@ -314,6 +316,9 @@ func startOvnKube(ctx *cli.Context, cancel context.CancelFunc) error {
 	// Start metric server for master and node. Expose the metrics HTTP endpoint if configured.
 	// Non LE master instances also are required to expose the metrics server.
 	if config.Metrics.BindAddress != "" {
+		if config.Metrics.EnableNetworkStatus {
+			metrics.RegisterDebugHandler(networkmanager.StatusPath, networkmanager.StatusHandler())
+		}
 		metrics.StartMetricsServer(config.Metrics.BindAddress, config.Metrics.EnablePprof,
 			config.Metrics.NodeServerCert, config.Metrics.NodeServerPrivKey, ctx.Done(), ovnKubeStartWg)
 	}
@@ -600,6 +605,20 @@ func runOvnKube(ctx context.Context, runMode *ovnkubeRunMode, ovnClientset *util
 		}
 		metrics.RegisterOvnMetrics(ovnClientset.KubeClient, runMode.identity,
 			ovsClient, metricsScrapeInterval, ctx.Done())
+		if config.Metrics.EnablePolicyHitMetrics && config.OVNKubernetesFeature.EnableObservability {
+			// the northbound database may not run on the node, policy hit
+			// metrics are then unavailable but the node keeps running
+			var decoder *sampledecoder.SampleDecoder
+			nbdbSockPath, decoderErr := metrics.GetNBDBSockPath()
+			if decoderErr == nil {
+				decoder, decoderErr = sampledecoder.NewSampleDecoder(ctx, nbdbSockPath+"ovnnb_db.sock")
+			}
+			if decoderErr != nil {
+				klog.Warningf("Policy hit metrics are disabled, failed to initialize the sample decoder: %v", decoderErr)
+			} else {
+				metrics.RegisterPolicyHitsMetrics(decoder, metricsScrapeInterval, ctx.Done())
+			}
+		}
 		metrics.StartOVNMetricsServer(config.Metrics.OVNMetricsBindAddress,
 			config.Metrics.NodeServerCert, config.Metrics.NodeServerPrivKey, ctx.Done(), wg)
 	}
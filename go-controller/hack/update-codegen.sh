@@ -118,6 +118,14 @@ echo "Editing EgressQoS CRD"
 sed -i -e':begin;$!N;s/.*metadata:\n.*type: object/&\n            properties:\n              name:\n                type: string\n                pattern: ^default$/;P;D' \
 	_output/crds/k8s.ovn.org_egressqoses.yaml
 
+echo "Editing userDefinedNetworks and clusterUserDefinedNetworks CRDs"
+## Some subnets validations only apply when the network is created, which requires the
+## optionalOldSelf flag on their rules. controller-gen can't set it, so it is set here on
+## the rules starting with "oldSelf.hasValue()".
+sed -i -e 's/^\( *\)rule: oldSelf\.hasValue()/\1optionalOldSelf: true\n&/' \
+	_output/crds/k8s.ovn.org_userdefinednetworks.yaml \
+	_output/crds/k8s.ovn.org_clusteruserdefinednetworks.yaml
+
 echo "Copying the CRDs to dist/templates as j2 files... Add them to your commit..."
 echo "Copying egressFirewall CRD"
 cp _output/crds/k8s.ovn.org_egressfirewalls.yaml ../dist/templates/k8s.ovn.org_egressfirewalls.yaml.j2
@@ -137,3 +145,7 @@ echo "Copying clusteruserdefinednetworks CRD"
 cp _output/crds/k8s.ovn.org_clusteruserdefinednetworks.yaml ../dist/templates/k8s.ovn.org_clusteruserdefinednetworks.yaml.j2
 echo "Copying routeAdvertisements CRD"
 cp _output/crds/k8s.ovn.org_routeadvertisements.yaml ../dist/templates/k8s.ovn.org_routeadvertisements.yaml.j2
+echo "Copying externalOverlayPeers CRD"
+cp _output/crds/k8s.ovn.org_externaloverlaypeers.yaml ../dist/templates/k8s.ovn.org_externaloverlaypeers.yaml.j2
+echo "Copying samplingPolicies CRD"
+cp _output/crds/k8s.ovn.org_samplingpolicies.yaml ../dist/templates/k8s.ovn.org_samplingpolicies.yaml.j2
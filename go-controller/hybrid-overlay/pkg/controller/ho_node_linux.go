@@ -10,6 +10,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/hybrid-overlay/pkg/types"
+	eopapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
@@ -102,6 +103,16 @@ func (n *HONodeController) DeletePod(_ *corev1.Pod) error {
 	return nil
 }
 
+// AddExternalPeer is a no-op, external overlay peers are only reachable
+// through OVN nodes
+func (n *HONodeController) AddExternalPeer(_ *eopapi.ExternalOverlayPeer) error {
+	return nil
+}
+
+func (n *HONodeController) DeleteExternalPeer(_ *eopapi.ExternalOverlayPeer) error {
+	return nil
+}
+
 func (n *HONodeController) RunFlowSync(_ <-chan struct{}) {}
 
 func (n *HONodeController) EnsureHybridOverlayBridge(_ *corev1.Node) error {
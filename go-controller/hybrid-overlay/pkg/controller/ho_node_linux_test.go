@@ -122,6 +122,7 @@ var _ = Describe("Hybrid Overlay Node Linux Operations", func() {
 				hoNodeName,
 				f.Core().V1().Nodes().Informer(),
 				f.Core().V1().Pods().Informer(),
+				nil,
 				informer.NewTestEventHandler,
 				true,
 			)
@@ -174,6 +175,7 @@ var _ = Describe("Hybrid Overlay Node Linux Operations", func() {
 				hoNodeName,
 				f.Core().V1().Nodes().Informer(),
 				f.Core().V1().Pods().Informer(),
+				nil,
 				informer.NewTestEventHandler,
 				true,
 			)
@@ -17,6 +17,7 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/hybrid-overlay/pkg/types"
 	houtil "github.com/ovn-org/ovn-kubernetes/go-controller/hybrid-overlay/pkg/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	eopapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
 )
 
@@ -401,6 +402,15 @@ func (n *NodeController) DeletePod(pod *corev1.Pod) error {
 	return nil
 }
 
+// AddExternalPeer is not supported on windows nodes
+func (n *NodeController) AddExternalPeer(peer *eopapi.ExternalOverlayPeer) error {
+	return nil
+}
+
+func (n *NodeController) DeleteExternalPeer(peer *eopapi.ExternalOverlayPeer) error {
+	return nil
+}
+
 func (n *NodeController) RunFlowSync(stopCh <-chan struct{}) {}
 
 func (n *NodeController) EnsureHybridOverlayBridge(node *corev1.Node) error {
@@ -14,6 +14,7 @@ import (
 
 	hotypes "github.com/ovn-org/ovn-kubernetes/go-controller/hybrid-overlay/pkg/types"
 	houtil "github.com/ovn-org/ovn-kubernetes/go-controller/hybrid-overlay/pkg/util"
+	eopapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/informer"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
 	ovntypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
@@ -26,6 +27,8 @@ type nodeController interface {
 	DeletePod(*corev1.Pod) error
 	AddNode(*corev1.Node) error
 	DeleteNode(*corev1.Node) error
+	AddExternalPeer(*eopapi.ExternalOverlayPeer) error
+	DeleteExternalPeer(*eopapi.ExternalOverlayPeer) error
 	RunFlowSync(<-chan struct{})
 	EnsureHybridOverlayBridge(node *corev1.Node) error
 }
@@ -36,6 +39,8 @@ type Node struct {
 	controller       nodeController
 	nodeEventHandler informer.EventHandler
 	podEventHandler  informer.EventHandler
+	// peerEventHandler is nil unless external overlay peers are enabled
+	peerEventHandler informer.EventHandler
 	sync.Mutex
 }
 
@@ -82,12 +87,21 @@ func podChanged(old, new interface{}) bool {
 	return false
 }
 
+// peerChanged returns true if any relevant external overlay peer attributes changed
+func peerChanged(old, new interface{}) bool {
+	oldPeer := old.(*eopapi.ExternalOverlayPeer)
+	newPeer := new.(*eopapi.ExternalOverlayPeer)
+	return !reflect.DeepEqual(oldPeer.Spec, newPeer.Spec)
+}
+
 // NewNode returns a new node controller
 // This controller is designed to be used both by ovnkube-node binary and by the
 // HO binary.
 // When used by ovnkube-node binary, it prepares the OVN nodes for the HO tunnel.
 // When used by the HO binary, it prepares the windows or SDN (SDN <-> OVN
 // migration) nodes for the HO tunnel. This is flagged by setting isHONode to true.
+// peerInformer is optional and only provided when VXLAN tunnels towards
+// external overlay peers are enabled.
 
 // TODO(jtanenba) the localPodInformer no longer selects only local pods
 func NewNode(
@@ -95,6 +109,7 @@ func NewNode(
 	nodeName string,
 	nodeInformer cache.SharedIndexInformer,
 	localPodInformer cache.SharedIndexInformer,
+	peerInformer cache.SharedIndexInformer,
 	eventHandlerCreateFunction informer.EventHandlerCreateFunction,
 	isHONode bool,
 ) (*Node, error) {
@@ -150,6 +165,28 @@ func NewNode(
 	if err != nil {
 		return nil, err
 	}
+	if peerInformer != nil {
+		n.peerEventHandler, err = eventHandlerCreateFunction("externaloverlaypeer", peerInformer,
+			func(obj interface{}) error {
+				peer, ok := obj.(*eopapi.ExternalOverlayPeer)
+				if !ok {
+					return fmt.Errorf("object is not an external overlay peer")
+				}
+				return n.controller.AddExternalPeer(peer)
+			},
+			func(obj interface{}) error {
+				peer, ok := obj.(*eopapi.ExternalOverlayPeer)
+				if !ok {
+					return fmt.Errorf("object is not an external overlay peer")
+				}
+				return n.controller.DeleteExternalPeer(peer)
+			},
+			peerChanged,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return n, nil
 
 }
@@ -180,6 +217,17 @@ func (n *Node) Run(stopCh <-chan struct{}) {
 		}
 	}()
 
+	if n.peerEventHandler != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := n.peerEventHandler.Run(informer.DefaultInformerThreadiness, stopCh)
+			if err != nil {
+				klog.Error(err)
+			}
+		}()
+	}
+
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
@@ -250,6 +298,34 @@ func getNodeDetails(node *corev1.Node) (*net.IPNet, net.IP, net.HardwareAddr, er
 	return cidr, ip, drMAC, nil
 }
 
+// getExternalPeerDetails returns the external overlay peer's subnets, VTEP IP
+// and MAC address, or an error if any of them is missing or invalid.
+func getExternalPeerDetails(peer *eopapi.ExternalOverlayPeer) ([]*net.IPNet, net.IP, net.HardwareAddr, error) {
+	vtep := net.ParseIP(peer.Spec.VTEP)
+	if vtep == nil || vtep.To4() == nil {
+		return nil, nil, nil, fmt.Errorf("invalid VTEP IPv4 address %q", peer.Spec.VTEP)
+	}
+	mac, err := net.ParseMAC(peer.Spec.MAC)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid MAC %q: %v", peer.Spec.MAC, err)
+	}
+	if len(peer.Spec.Subnets) == 0 {
+		return nil, nil, nil, fmt.Errorf("no subnets")
+	}
+	subnets := make([]*net.IPNet, 0, len(peer.Spec.Subnets))
+	for _, subnet := range peer.Spec.Subnets {
+		_, cidr, err := net.ParseCIDR(string(subnet))
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("invalid subnet %q: %v", subnet, err)
+		}
+		if cidr.IP.To4() == nil {
+			return nil, nil, nil, fmt.Errorf("subnet %q is not IPv4", subnet)
+		}
+		subnets = append(subnets, cidr)
+	}
+	return subnets, vtep, mac, nil
+}
+
 func getPodDetails(pod *corev1.Pod) ([]*net.IPNet, net.HardwareAddr, error) {
 	podInfo, err := util.UnmarshalPodAnnotation(pod.Annotations, ovntypes.DefaultNetworkName)
 	if err != nil {
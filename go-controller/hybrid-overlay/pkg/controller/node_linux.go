@@ -22,13 +22,18 @@ type NodeController struct {
 	drMAC     net.HardwareAddr
 	drIP      net.IP
 	gwLRPIP   net.IP
-	vxlanPort uint16
+	// localSubnet is the local node's OVN host subnet
+	localSubnet *net.IPNet
+	vxlanPort   uint16
 	// contains a map of pods to corresponding tunnels
 	flowCache map[string]*flowCacheEntry
 	flowMutex sync.Mutex
 	// channel to indicate we need to update flows immediately
 	flowChan            chan struct{}
 	flowCacheSyncPeriod time.Duration
+	// peerSubnets maps the external overlay peers to their accepted subnets
+	peerSubnets map[string][]*net.IPNet
+	peerMutex   sync.Mutex
 
 	nodeLister     listers.NodeLister
 	localPodLister listers.PodLister
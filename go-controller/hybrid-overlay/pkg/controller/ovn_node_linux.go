@@ -22,6 +22,7 @@ import (
 	hotypes "github.com/ovn-org/ovn-kubernetes/go-controller/hybrid-overlay/pkg/types"
 	houtil "github.com/ovn-org/ovn-kubernetes/go-controller/hybrid-overlay/pkg/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	eopapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
@@ -68,6 +69,7 @@ func newOVNNodeController(
 		flowMutex:           sync.Mutex{},
 		flowChan:            make(chan struct{}, 1),
 		flowCacheSyncPeriod: 30 * time.Second,
+		peerSubnets:         make(map[string][]*net.IPNet),
 		nodeLister:          nodeLister,
 		localPodLister:      localPodLister,
 	}
@@ -148,29 +150,15 @@ func nameToCookie(nodeName string) string {
 	return fmt.Sprintf("%02x%02x%02x%02x", hash[0], hash[1], hash[2], hash[3])
 }
 
-// hybridOverlayNodeUpdate sets up or tears down VXLAN tunnels to hybrid overlay
-// nodes in the cluster
-func (n *NodeController) hybridOverlayNodeUpdate(node *corev1.Node) error {
-	if !util.NoHostSubnet(node) {
-		// remove possible hybrid overlay remaining
-		return n.DeleteNode(node)
-	}
-
-	cidr, nodeIP, drMAC, err := getNodeDetails(node)
-	if cidr == nil || nodeIP == nil || drMAC == nil {
-		klog.V(5).Infof("Cleaning up hybrid overlay resources for node %q because: %v", node.Name, err)
-		return n.DeleteNode(node)
-	}
-
-	klog.Infof("Setting up hybrid overlay tunnel to node %s", node.Name)
-
-	// (re)add flows for the node
-	cookie := nameToCookie(node.Name)
-	drMACRaw := strings.Replace(drMAC.String(), ":", "", -1)
+// remoteVTEPFlows returns the flows that make the given subnet reachable
+// through the VXLAN tunnel endpoint vtepIP. The remote end expects frames
+// destined to remoteMAC.
+func (n *NodeController) remoteVTEPFlows(cookie string, subnet *net.IPNet, vtepIP net.IP, remoteMAC net.HardwareAddr) []string {
+	remoteMACRaw := strings.Replace(remoteMAC.String(), ":", "", -1)
 
 	var flows []string
 	// Distributed Router MAC ARP responder flow; responds to ARP requests by OVN for
-	// any IP address within this node's assigned subnet and returns our hybrid overlay
+	// any IP address within the remote subnet and returns our hybrid overlay
 	// port's MAC address.
 	flows = append(flows,
 		fmt.Sprintf("cookie=0x%s,table=0,priority=100,arp,in_port=ext,arp_tpa=%s,"+
@@ -183,8 +171,8 @@ func (n *NodeController) hybridOverlayNodeUpdate(node *corev1.Node) error {
 			"move:NXM_OF_ARP_SPA[]->NXM_OF_ARP_TPA[],"+
 			"move:NXM_NX_REG0[]->NXM_OF_ARP_SPA[],"+
 			"IN_PORT",
-			cookie, cidr.String(), drMAC.String(), drMACRaw))
-	// Send all flows for the remote node's assigned subnet to that node via the VXLAN tunnel.
+			cookie, subnet.String(), remoteMAC.String(), remoteMACRaw))
+	// Send all flows for the remote subnet to the remote VTEP via the VXLAN tunnel.
 	// Windows hybrid overlay implementation requires that we set the destination MAC address
 	// to the node's Distributed Router MAC.
 	flows = append(flows,
@@ -193,7 +181,7 @@ func (n *NodeController) hybridOverlayNodeUpdate(node *corev1.Node) error {
 			"set_field:%s->tun_dst,"+
 			"set_field:%s->eth_dst,"+
 			"output:"+extVXLANName,
-			cookie, cidr.String(), hotypes.HybridOverlayVNI, nodeIP.String(), drMAC.String()))
+			cookie, subnet.String(), hotypes.HybridOverlayVNI, vtepIP.String(), remoteMAC.String()))
 
 	flows = append(flows,
 		fmt.Sprintf("cookie=0x%s,table=0,priority=101,ip,nw_dst=%s,nw_src=%s,"+
@@ -202,7 +190,29 @@ func (n *NodeController) hybridOverlayNodeUpdate(node *corev1.Node) error {
 			"set_field:%s->tun_dst,"+
 			"set_field:%s->eth_dst,"+
 			"output:"+extVXLANName,
-			cookie, cidr.String(), n.gwLRPIP.String(), hotypes.HybridOverlayVNI, n.drIP, nodeIP.String(), drMAC.String()))
+			cookie, subnet.String(), n.gwLRPIP.String(), hotypes.HybridOverlayVNI, n.drIP, vtepIP.String(), remoteMAC.String()))
+	return flows
+}
+
+// hybridOverlayNodeUpdate sets up or tears down VXLAN tunnels to hybrid overlay
+// nodes in the cluster
+func (n *NodeController) hybridOverlayNodeUpdate(node *corev1.Node) error {
+	if !util.NoHostSubnet(node) {
+		// remove possible hybrid overlay remaining
+		return n.DeleteNode(node)
+	}
+
+	cidr, nodeIP, drMAC, err := getNodeDetails(node)
+	if cidr == nil || nodeIP == nil || drMAC == nil {
+		klog.V(5).Infof("Cleaning up hybrid overlay resources for node %q because: %v", node.Name, err)
+		return n.DeleteNode(node)
+	}
+
+	klog.Infof("Setting up hybrid overlay tunnel to node %s", node.Name)
+
+	// (re)add flows for the node
+	cookie := nameToCookie(node.Name)
+	flows := n.remoteVTEPFlows(cookie, cidr, nodeIP, drMAC)
 
 	if len(config.HybridOverlay.ClusterSubnets) == 0 {
 		// No static cluster subnet is provided in config. Try to detect the hybrid overlay node subnet dynamically
@@ -260,16 +270,8 @@ func (n *NodeController) AddNode(node *corev1.Node) error {
 		}
 	} else {
 		// Make sure the local node has been initialized before adding a hybridOverlay remote node
-		if atomic.LoadUint32(n.initState) < hotypes.DistributedRouterInitialized {
-			localNode, err := n.nodeLister.Get(n.nodeName)
-			if err != nil {
-				return fmt.Errorf("cannot get local node: %s: %w", n.nodeName, err)
-			}
-			klog.Info("Initialize local node before adding a hybridOverlay remote node")
-			err = n.EnsureHybridOverlayBridge(localNode)
-			if err != nil {
-				return err
-			}
+		if err := n.ensureLocalNodeInitialized(); err != nil {
+			return err
 		}
 		// add remote node
 		klog.Infof("Add hybridOverlay remote Node %s", node.Name)
@@ -281,6 +283,130 @@ func (n *NodeController) AddNode(node *corev1.Node) error {
 	return nil
 }
 
+// ensureLocalNodeInitialized sets up the local hybrid overlay bridge if it has
+// not been initialized yet, so that tunnels to remote VTEPs can be added.
+func (n *NodeController) ensureLocalNodeInitialized() error {
+	if atomic.LoadUint32(n.initState) >= hotypes.DistributedRouterInitialized {
+		return nil
+	}
+	localNode, err := n.nodeLister.Get(n.nodeName)
+	if err != nil {
+		return fmt.Errorf("cannot get local node: %s: %w", n.nodeName, err)
+	}
+	klog.Info("Initialize local node before adding a hybridOverlay remote VTEP")
+	return n.EnsureHybridOverlayBridge(localNode)
+}
+
+// externalPeerCookie returns the flow cookie of an external overlay peer. The
+// name is prefixed so that it does not collide with a node of the same name.
+func externalPeerCookie(peerName string) string {
+	return nameToCookie("externaloverlaypeer/" + peerName)
+}
+
+// AddExternalPeer sets up the VXLAN tunnel towards an external overlay peer
+// for all of its subnets
+func (n *NodeController) AddExternalPeer(peer *eopapi.ExternalOverlayPeer) error {
+	subnets, vtepIP, mac, err := getExternalPeerDetails(peer)
+	if err != nil {
+		klog.Errorf("Ignoring invalid external overlay peer %s: %v", peer.Name, err)
+		return n.DeleteExternalPeer(peer)
+	}
+	for _, subnet := range subnets {
+		if !config.ContainedInHybridOverlayClusterSubnets(subnet) {
+			klog.Errorf("Ignoring external overlay peer %s: subnet %s is not within the hybrid overlay cluster subnets",
+				peer.Name, subnet)
+			return n.DeleteExternalPeer(peer)
+		}
+	}
+
+	if err := n.ensureLocalNodeInitialized(); err != nil {
+		return err
+	}
+	if atomic.LoadUint32(n.initState) < hotypes.DistributedRouterInitialized {
+		return fmt.Errorf("cannot add external overlay peer %s, local node %s is not initialized yet", peer.Name, n.nodeName)
+	}
+
+	n.peerMutex.Lock()
+	defer n.peerMutex.Unlock()
+	if err := n.checkExternalPeerOverlaps(peer.Name, subnets); err != nil {
+		klog.Errorf("Ignoring external overlay peer %s: %v", peer.Name, err)
+		n.deleteExternalPeer(peer.Name)
+		return nil
+	}
+	n.peerSubnets[peer.Name] = subnets
+
+	klog.Infof("Setting up hybrid overlay tunnel to external peer %s", peer.Name)
+	cookie := externalPeerCookie(peer.Name)
+	n.RLock()
+	defer n.RUnlock()
+	var flows []string
+	for _, subnet := range subnets {
+		flows = append(flows, n.remoteVTEPFlows(cookie, subnet, vtepIP, mac)...)
+	}
+	// Unlike hybrid overlay nodes, external peers are not aware of our
+	// distributed router MAC; accept their traffic towards local pods based on
+	// the tunnel source instead.
+	flows = append(flows,
+		fmt.Sprintf("cookie=0x%s,table=0,priority=100,in_port="+extVXLANName+",tun_src=%s,ip,nw_dst=%s,actions=goto_table:10",
+			cookie, vtepIP.String(), n.localSubnet.String()))
+
+	n.updateFlowCacheEntry(cookie, flows, false)
+	n.requestFlowSync()
+	return nil
+}
+
+// checkExternalPeerOverlaps returns an error if any of the given subnets of an
+// external overlay peer overlaps with the subnet of a hybrid overlay node or
+// with the subnets accepted for another external overlay peer. Must be called
+// with peerMutex held.
+func (n *NodeController) checkExternalPeerOverlaps(peerName string, subnets []*net.IPNet) error {
+	nodes, err := n.nodeLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+	for _, node := range nodes {
+		if !util.NoHostSubnet(node) {
+			continue
+		}
+		nodeSubnet, _ := getNodeSubnetAndIP(node)
+		if nodeSubnet == nil {
+			continue
+		}
+		if overlaps := util.IPNetOverlaps(nodeSubnet, subnets...); len(overlaps) > 0 {
+			return fmt.Errorf("subnet %s overlaps with subnet %s of hybrid overlay node %s",
+				overlaps[0], nodeSubnet, node.Name)
+		}
+	}
+	for otherPeer, otherSubnets := range n.peerSubnets {
+		if otherPeer == peerName {
+			continue
+		}
+		for _, otherSubnet := range otherSubnets {
+			if overlaps := util.IPNetOverlaps(otherSubnet, subnets...); len(overlaps) > 0 {
+				return fmt.Errorf("subnet %s overlaps with subnet %s of external overlay peer %s",
+					overlaps[0], otherSubnet, otherPeer)
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteExternalPeer tears down the VXLAN tunnel towards an external overlay peer
+func (n *NodeController) DeleteExternalPeer(peer *eopapi.ExternalOverlayPeer) error {
+	n.peerMutex.Lock()
+	defer n.peerMutex.Unlock()
+	n.deleteExternalPeer(peer.Name)
+	return nil
+}
+
+// deleteExternalPeer removes the flows and the accepted subnets of an external
+// overlay peer. Must be called with peerMutex held.
+func (n *NodeController) deleteExternalPeer(peerName string) {
+	delete(n.peerSubnets, peerName)
+	n.deleteFlowsByCookie(externalPeerCookie(peerName))
+	n.requestFlowSync()
+}
+
 func (n *NodeController) deleteFlowsByCookie(cookie string) {
 	n.flowMutex.Lock()
 	defer n.flowMutex.Unlock()
@@ -510,6 +636,7 @@ func (n *NodeController) EnsureHybridOverlayBridge(node *corev1.Node) error {
 	if err != nil {
 		return err
 	}
+	n.localSubnet = subnet
 
 	portName := util.GetHybridOverlayPortName(n.nodeName)
 	portMACString, haveDRMACAnnotation := node.Annotations[hotypes.HybridOverlayDRMAC]
@@ -20,6 +20,7 @@ import (
 
 	hotypes "github.com/ovn-org/ovn-kubernetes/go-controller/hybrid-overlay/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	eopapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/informer"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
 	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
@@ -326,6 +327,7 @@ var _ = Describe("Hybrid Overlay Node Linux Operations", func() {
 				thisNode,
 				f.Core().V1().Nodes().Informer(),
 				f.Core().V1().Pods().Informer(),
+				nil,
 				informer.NewTestEventHandler,
 				false,
 			)
@@ -370,6 +372,7 @@ var _ = Describe("Hybrid Overlay Node Linux Operations", func() {
 				thisNode,
 				f.Core().V1().Nodes().Informer(),
 				f.Core().V1().Pods().Informer(),
+				nil,
 				informer.NewTestEventHandler,
 				false,
 			)
@@ -412,6 +415,7 @@ var _ = Describe("Hybrid Overlay Node Linux Operations", func() {
 				thisNode,
 				f.Core().V1().Nodes().Informer(),
 				f.Core().V1().Pods().Informer(),
+				nil,
 				informer.NewTestEventHandler,
 				false,
 			)
@@ -489,6 +493,7 @@ var _ = Describe("Hybrid Overlay Node Linux Operations", func() {
 				thisNode,
 				f.Core().V1().Nodes().Informer(),
 				f.Core().V1().Pods().Informer(),
+				nil,
 				informer.NewTestEventHandler,
 				false,
 			)
@@ -589,6 +594,7 @@ var _ = Describe("Hybrid Overlay Node Linux Operations", func() {
 				thisNode,
 				f.Core().V1().Nodes().Informer(),
 				f.Core().V1().Pods().Informer(),
+				nil,
 				informer.NewTestEventHandler,
 				false,
 			)
@@ -669,6 +675,7 @@ var _ = Describe("Hybrid Overlay Node Linux Operations", func() {
 				thisNode,
 				f.Core().V1().Nodes().Informer(),
 				f.Core().V1().Pods().Informer(),
+				nil,
 				informer.NewTestEventHandler,
 				false,
 			)
@@ -731,6 +738,238 @@ var _ = Describe("Hybrid Overlay Node Linux Operations", func() {
 		}
 		appRun(app)
 	})
+	ovntest.OnSupportedPlatformsIt("sets up tunnels to external overlay peers", func() {
+		app.Action = func(ctx *cli.Context) error {
+			const (
+				peerName   string = "peer1"
+				peerSubnet string = "10.0.8.0/24"
+				peerMAC    string = "00:00:00:7f:af:05"
+				peerVTEP   string = "192.168.10.5"
+			)
+
+			annotations := createNodeAnnotationsForSubnet(thisNodeSubnet)
+			annotations[hotypes.HybridOverlayDRMAC] = thisNodeDRMAC
+			annotations[util.OVNNodeGRLRPAddrs] = "{\"default\":{\"ipv4\":\"100.64.0.3/16\"}}"
+			annotations[hotypes.HybridOverlayDRIP] = thisNodeDRIP
+			node := createNode(thisNode, "linux", thisNodeIP, annotations)
+			fakeClient := fake.NewSimpleClientset(&corev1.NodeList{
+				Items: []corev1.Node{*node},
+			})
+
+			addNodeSetupCmds(fexec, thisNode)
+			_, err := config.InitConfig(ctx, fexec, nil)
+			Expect(err).NotTo(HaveOccurred())
+			f := informers.NewSharedInformerFactory(fakeClient, informer.DefaultResyncInterval)
+
+			n, err := NewNode(
+				&kube.Kube{KClient: fakeClient},
+				thisNode,
+				f.Core().V1().Nodes().Informer(),
+				f.Core().V1().Pods().Informer(),
+				nil,
+				informer.NewTestEventHandler,
+				false,
+			)
+			Expect(err).NotTo(HaveOccurred())
+			linuxNode, okay := n.controller.(*NodeController)
+			Expect(okay).To(BeTrue())
+			// setting the flowCacheSyncPeriod to 1 hour effectively disabling for testing
+			linuxNode.flowCacheSyncPeriod = 1 * time.Hour
+
+			addEnsureHybridOverlayBridgeMocks(nlMock, thisNodeDRIP, "")
+			// initial flowSync
+			addSyncFlows(fexec)
+			// flowsync after EnsureHybridOverlayBridge()
+			addSyncFlows(fexec)
+
+			f.Start(stopChan)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				n.Run(stopChan)
+			}()
+
+			Eventually(func() bool {
+				return atomic.LoadUint32(linuxNode.initState) == hotypes.PodsInitialized
+			}, 2).Should(BeTrue())
+			Eventually(fexec.CalledMatchesExpected, 2).Should(BeTrue(), fexec.ErrorDesc)
+
+			expectedFlowCache := map[string]*flowCacheEntry{
+				"0x0": generateInitialFlowCacheEntry(mgmtIfAddr.IP.String(), thisNodeDRIP, thisNodeDRMAC),
+			}
+
+			peer := &eopapi.ExternalOverlayPeer{
+				ObjectMeta: metav1.ObjectMeta{Name: peerName},
+				Spec: eopapi.ExternalOverlayPeerSpec{
+					VTEP:    peerVTEP,
+					MAC:     peerMAC,
+					Subnets: []eopapi.CIDR{eopapi.CIDR(peerSubnet)},
+				},
+			}
+			// flowsync after AddExternalPeer
+			addSyncFlows(fexec)
+			Expect(linuxNode.AddExternalPeer(peer)).To(Succeed())
+			Eventually(fexec.CalledMatchesExpected, 2).Should(BeTrue(), fexec.ErrorDesc)
+
+			peerCookie := externalPeerCookie(peerName)
+			expectedFlowCache[peerCookie] = &flowCacheEntry{
+				flows: []string{
+					"cookie=0x" + peerCookie + ",table=0,priority=100,arp,in_port=ext,arp_tpa=" + peerSubnet + ",actions=move:NXM_OF_ETH_SRC[]->NXM_OF_ETH_DST[],mod_dl_src:" + peerMAC + ",load:0x2->NXM_OF_ARP_OP[],move:NXM_NX_ARP_SHA[]->NXM_NX_ARP_THA[],load:0x" + strings.ReplaceAll(peerMAC, ":", "") + "->NXM_NX_ARP_SHA[],move:NXM_OF_ARP_TPA[]->NXM_NX_REG0[],move:NXM_OF_ARP_SPA[]->NXM_OF_ARP_TPA[],move:NXM_NX_REG0[]->NXM_OF_ARP_SPA[],IN_PORT",
+					"cookie=0x" + peerCookie + ",table=0,priority=100,ip,nw_dst=" + peerSubnet + ",actions=load:4097->NXM_NX_TUN_ID[0..31],set_field:" + peerVTEP + "->tun_dst,set_field:" + peerMAC + "->eth_dst,output:ext-vxlan",
+					"cookie=0x" + peerCookie + ",table=0,priority=101,ip,nw_dst=" + peerSubnet + ",nw_src=100.64.0.3,actions=load:4097->NXM_NX_TUN_ID[0..31],set_field:" + thisNodeDRIP + "->nw_src,set_field:" + peerVTEP + "->tun_dst,set_field:" + peerMAC + "->eth_dst,output:ext-vxlan",
+					"cookie=0x" + peerCookie + ",table=0,priority=100,in_port=ext-vxlan,tun_src=" + peerVTEP + ",ip,nw_dst=" + thisNodeSubnet + ",actions=goto_table:10",
+				},
+			}
+			Eventually(func() error {
+				linuxNode.flowMutex.Lock()
+				defer linuxNode.flowMutex.Unlock()
+				return compareFlowCache(linuxNode.flowCache, expectedFlowCache)
+			}, 2).Should(Succeed())
+
+			// a peer subnet outside of the hybrid overlay cluster subnets is rejected
+			// and its flows are removed
+			peer.Spec.Subnets = []eopapi.CIDR{"172.16.0.0/24"}
+			addSyncFlows(fexec)
+			Expect(linuxNode.AddExternalPeer(peer)).To(Succeed())
+			Eventually(fexec.CalledMatchesExpected, 2).Should(BeTrue(), fexec.ErrorDesc)
+
+			delete(expectedFlowCache, peerCookie)
+			Eventually(func() error {
+				linuxNode.flowMutex.Lock()
+				defer linuxNode.flowMutex.Unlock()
+				return compareFlowCache(linuxNode.flowCache, expectedFlowCache)
+			}, 2).Should(Succeed())
+			return nil
+		}
+		appRun(app)
+	})
+	ovntest.OnSupportedPlatformsIt("rejects external overlay peers overlapping hybrid overlay nodes or other peers", func() {
+		app.Action = func(ctx *cli.Context) error {
+			const (
+				node1Name   string = "node1"
+				node1Subnet string = "10.0.4.0/24"
+				node1DRMAC  string = "00:00:00:7f:af:03"
+				node1IP     string = "10.11.12.1"
+
+				peerMAC  string = "00:00:00:7f:af:05"
+				peerVTEP string = "192.168.10.5"
+			)
+
+			annotations := createNodeAnnotationsForSubnet(thisNodeSubnet)
+			annotations[hotypes.HybridOverlayDRMAC] = thisNodeDRMAC
+			annotations[util.OVNNodeGRLRPAddrs] = "{\"default\":{\"ipv4\":\"100.64.0.3/16\"}}"
+			annotations[hotypes.HybridOverlayDRIP] = thisNodeDRIP
+			node := createNode(thisNode, "linux", thisNodeIP, annotations)
+			fakeClient := fake.NewSimpleClientset(&corev1.NodeList{
+				Items: []corev1.Node{*node},
+			})
+
+			addNodeSetupCmds(fexec, thisNode)
+			_, err := config.InitConfig(ctx, fexec, nil)
+			Expect(err).NotTo(HaveOccurred())
+			f := informers.NewSharedInformerFactory(fakeClient, informer.DefaultResyncInterval)
+
+			n, err := NewNode(
+				&kube.Kube{KClient: fakeClient},
+				thisNode,
+				f.Core().V1().Nodes().Informer(),
+				f.Core().V1().Pods().Informer(),
+				nil,
+				informer.NewTestEventHandler,
+				false,
+			)
+			Expect(err).NotTo(HaveOccurred())
+			linuxNode, okay := n.controller.(*NodeController)
+			Expect(okay).To(BeTrue())
+			// setting the flowCacheSyncPeriod to 1 hour effectively disabling for testing
+			linuxNode.flowCacheSyncPeriod = 1 * time.Hour
+
+			addEnsureHybridOverlayBridgeMocks(nlMock, thisNodeDRIP, "")
+			// initial flowSync
+			addSyncFlows(fexec)
+			// flowsync after EnsureHybridOverlayBridge()
+			addSyncFlows(fexec)
+
+			f.Start(stopChan)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				n.Run(stopChan)
+			}()
+
+			Eventually(func() bool {
+				return atomic.LoadUint32(linuxNode.initState) == hotypes.PodsInitialized
+			}, 2).Should(BeTrue())
+			Eventually(fexec.CalledMatchesExpected, 2).Should(BeTrue(), fexec.ErrorDesc)
+
+			// setup windows node
+			windowsAnnotation := createNodeAnnotationsForSubnet(node1Subnet)
+			windowsAnnotation[hotypes.HybridOverlayDRMAC] = node1DRMAC
+			_, err = fakeClient.CoreV1().Nodes().Create(context.TODO(), createNode(node1Name, "windows", node1IP, windowsAnnotation), metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			// flowsync after AddNode
+			addSyncFlows(fexec)
+			Eventually(fexec.CalledMatchesExpected, 2).Should(BeTrue(), fexec.ErrorDesc)
+			Eventually(func() bool {
+				linuxNode.flowMutex.Lock()
+				defer linuxNode.flowMutex.Unlock()
+				_, ok := linuxNode.flowCache[nameToCookie(node1Name)]
+				return ok
+			}, 2).Should(BeTrue())
+
+			newPeer := func(name string, subnets ...eopapi.CIDR) *eopapi.ExternalOverlayPeer {
+				return &eopapi.ExternalOverlayPeer{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Spec: eopapi.ExternalOverlayPeerSpec{
+						VTEP:    peerVTEP,
+						MAC:     peerMAC,
+						Subnets: subnets,
+					},
+				}
+			}
+			hasPeerFlows := func(name string) bool {
+				linuxNode.flowMutex.Lock()
+				defer linuxNode.flowMutex.Unlock()
+				_, ok := linuxNode.flowCache[externalPeerCookie(name)]
+				return ok
+			}
+			addPeer := func(peer *eopapi.ExternalOverlayPeer) {
+				addSyncFlows(fexec)
+				Expect(linuxNode.AddExternalPeer(peer)).To(Succeed())
+				Eventually(fexec.CalledMatchesExpected, 2).Should(BeTrue(), fexec.ErrorDesc)
+			}
+
+			peer1 := newPeer("peer1", "10.0.8.0/24")
+			addPeer(peer1)
+			Expect(hasPeerFlows(peer1.Name)).To(BeTrue())
+
+			// a peer overlapping another peer is rejected
+			peer2 := newPeer("peer2", "10.0.9.0/24", "10.0.8.128/25")
+			addPeer(peer2)
+			Expect(hasPeerFlows(peer2.Name)).To(BeFalse())
+			Expect(hasPeerFlows(peer1.Name)).To(BeTrue())
+
+			// a peer overlapping a hybrid overlay node is rejected
+			peer3 := newPeer("peer3", "10.0.4.0/23")
+			addPeer(peer3)
+			Expect(hasPeerFlows(peer3.Name)).To(BeFalse())
+
+			// updating a peer does not make it overlap with itself
+			peer1.Spec.Subnets = []eopapi.CIDR{"10.0.8.0/23"}
+			addPeer(peer1)
+			Expect(hasPeerFlows(peer1.Name)).To(BeTrue())
+
+			// the subnets of a deleted peer can be used by another peer
+			addSyncFlows(fexec)
+			Expect(linuxNode.DeleteExternalPeer(peer1)).To(Succeed())
+			Eventually(fexec.CalledMatchesExpected, 2).Should(BeTrue(), fexec.ErrorDesc)
+			addPeer(peer2)
+			Expect(hasPeerFlows(peer2.Name)).To(BeTrue())
+			Expect(hasPeerFlows(peer1.Name)).To(BeFalse())
+			return nil
+		}
+		appRun(app)
+	})
 	ovntest.OnSupportedPlatformsIt("node updates itself, windows tunnel and pod flows when distributed router IP is updated", func() {
 		app.Action = func(ctx *cli.Context) error {
 			const (
@@ -769,6 +1008,7 @@ var _ = Describe("Hybrid Overlay Node Linux Operations", func() {
 				thisNode,
 				f.Core().V1().Nodes().Informer(),
 				f.Core().V1().Pods().Informer(),
+				nil,
 				informer.NewTestEventHandler,
 				false,
 			)
@@ -909,6 +1149,7 @@ var _ = Describe("Hybrid Overlay Node Linux Operations", func() {
 				thisNode,
 				f.Core().V1().Nodes().Informer(),
 				f.Core().V1().Pods().Informer(),
+				nil,
 				informer.NewTestEventHandler,
 				false,
 			)
@@ -1038,6 +1279,7 @@ var _ = Describe("Hybrid Overlay Node Linux Operations", func() {
 				thisNode,
 				f.Core().V1().Nodes().Informer(),
 				f.Core().V1().Pods().Informer(),
+				nil,
 				informer.NewTestEventHandler,
 				false,
 			)
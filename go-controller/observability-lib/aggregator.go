@@ -0,0 +1,70 @@
+package observability_lib
+
+import (
+	"sync"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/model"
+)
+
+// flowKey identifies the flow events that are aggregated together: samples of
+// the same connection generated by the same network event.
+type flowKey struct {
+	srcIP, dstIP     string
+	srcPort, dstPort uint16
+	protocol         string
+	message          string
+}
+
+func newFlowKey(f *model.FlowEvent) flowKey {
+	return flowKey{
+		srcIP:    f.Src.IP,
+		dstIP:    f.Dst.IP,
+		srcPort:  f.Src.Port,
+		dstPort:  f.Dst.Port,
+		protocol: f.Protocol,
+		message:  f.Message,
+	}
+}
+
+// flowAggregator sums the packets and bytes of the flow events received during
+// an aggregation window.
+type flowAggregator struct {
+	sync.Mutex
+	flows map[flowKey]*model.FlowEvent
+	// order keeps the flows in the order they were first seen
+	order []flowKey
+}
+
+func newFlowAggregator() *flowAggregator {
+	return &flowAggregator{flows: map[flowKey]*model.FlowEvent{}}
+}
+
+func (a *flowAggregator) add(f *model.FlowEvent) {
+	a.Lock()
+	defer a.Unlock()
+	key := newFlowKey(f)
+	existing, ok := a.flows[key]
+	if !ok {
+		a.flows[key] = f
+		a.order = append(a.order, key)
+		return
+	}
+	existing.Packets += f.Packets
+	existing.Bytes += f.Bytes
+	if f.End.After(existing.End) {
+		existing.End = f.End
+	}
+}
+
+// flush returns the aggregated flows and starts a new aggregation window.
+func (a *flowAggregator) flush() []*model.FlowEvent {
+	a.Lock()
+	defer a.Unlock()
+	flows := make([]*model.FlowEvent, 0, len(a.order))
+	for _, key := range a.order {
+		flows = append(flows, a.flows[key])
+	}
+	a.flows = map[flowKey]*model.FlowEvent{}
+	a.order = nil
+	return flows
+}
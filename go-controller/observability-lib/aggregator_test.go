@@ -0,0 +1,45 @@
+package observability_lib
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/model"
+)
+
+func TestFlowAggregator(t *testing.T) {
+	start := time.Unix(1000, 0)
+	newFlow := func(srcPort uint16, message string, at time.Time) *model.FlowEvent {
+		return &model.FlowEvent{
+			Start:    at,
+			End:      at,
+			Src:      model.Endpoint{IP: "10.244.0.5", Port: srcPort},
+			Dst:      model.Endpoint{IP: "10.244.1.3", Port: 80},
+			Protocol: "tcp",
+			Packets:  1,
+			Bytes:    100,
+			Message:  message,
+		}
+	}
+	a := newFlowAggregator()
+	a.add(newFlow(40000, "Allowed", start))
+	a.add(newFlow(40001, "Allowed", start.Add(time.Second)))
+	a.add(newFlow(40000, "Allowed", start.Add(2*time.Second)))
+	a.add(newFlow(40000, "Dropped", start.Add(3*time.Second)))
+
+	flows := a.flush()
+	assert.Len(t, flows, 3)
+	assert.Equal(t, uint16(40000), flows[0].Src.Port)
+	assert.Equal(t, "Allowed", flows[0].Message)
+	assert.Equal(t, uint64(2), flows[0].Packets)
+	assert.Equal(t, uint64(200), flows[0].Bytes)
+	assert.Equal(t, start, flows[0].Start)
+	assert.Equal(t, start.Add(2*time.Second), flows[0].End)
+	assert.Equal(t, uint16(40001), flows[1].Src.Port)
+	assert.Equal(t, uint64(1), flows[1].Packets)
+	assert.Equal(t, "Dropped", flows[2].Message)
+
+	assert.Empty(t, a.flush())
+}
@@ -0,0 +1,57 @@
+package exporter
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/model"
+)
+
+const (
+	TypeJSON  = "json"
+	TypeOTLP  = "otlp"
+	TypeIPFIX = "ipfix"
+)
+
+// Exporter sends flow events to an external system.
+type Exporter interface {
+	Export(flows []*model.FlowEvent) error
+	Close() error
+}
+
+// NewExporter creates an exporter of the given type. target is the output file
+// for the json exporter (stdout when empty), the OTLP/HTTP logs endpoint for the
+// otlp exporter and the host:port of the UDP collector for the ipfix exporter.
+func NewExporter(exporterType, target string) (Exporter, error) {
+	switch exporterType {
+	case TypeJSON:
+		if target == "" {
+			return NewJSONLinesExporter(nopCloser{os.Stdout}), nil
+		}
+		file, err := os.Create(target)
+		if err != nil {
+			return nil, fmt.Errorf("error creating output file: %w", err)
+		}
+		return NewJSONLinesExporter(file), nil
+	case TypeOTLP:
+		if target == "" {
+			target = DefaultOTLPEndpoint
+		}
+		return NewOTLPLogsExporter(target), nil
+	case TypeIPFIX:
+		if target == "" {
+			return nil, fmt.Errorf("ipfix exporter requires a collector address")
+		}
+		return NewIPFIXExporter(target)
+	default:
+		return nil, fmt.Errorf("unknown exporter type %q, supported types are %s, %s and %s",
+			exporterType, TypeJSON, TypeOTLP, TypeIPFIX)
+	}
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error { return nil }
@@ -0,0 +1,192 @@
+package exporter
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/model"
+)
+
+func testFlows() []*model.FlowEvent {
+	start := time.Unix(1700000000, 0)
+	return []*model.FlowEvent{
+		{
+			Start:    start,
+			End:      start.Add(time.Second),
+			Src:      model.Endpoint{IP: "10.244.0.5", Port: 40000, Pod: "client", Namespace: "ns1", Network: "default"},
+			Dst:      model.Endpoint{IP: "10.244.1.3", Port: 8080},
+			Protocol: "tcp",
+			Packets:  3,
+			Bytes:    300,
+			Event: &model.ACLEvent{
+				Action:    "drop",
+				Actor:     "NetworkPolicy",
+				Name:      "deny-all",
+				Namespace: "ns1",
+				Direction: "Egress",
+			},
+			Message: "Dropped by network policy deny-all in namespace ns1, direction Egress",
+		},
+		{
+			Start:    start,
+			End:      start,
+			Src:      model.Endpoint{IP: "fd00:10:244::5"},
+			Dst:      model.Endpoint{IP: "fd00:10:244:1::3"},
+			Protocol: "icmp6",
+			Packets:  1,
+			Bytes:    118,
+		},
+	}
+}
+
+type bufferCloser struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (b *bufferCloser) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestJSONLinesExporter(t *testing.T) {
+	out := &bufferCloser{}
+	e := NewJSONLinesExporter(out)
+	flows := testFlows()
+	require.NoError(t, e.Export(flows))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+	decoded := &model.FlowEvent{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), decoded))
+	assert.Equal(t, flows[0].Src, decoded.Src)
+	assert.Equal(t, flows[0].Event, decoded.Event)
+	assert.Equal(t, flows[0].Packets, decoded.Packets)
+	assert.NotContains(t, lines[1], `"event"`)
+
+	require.NoError(t, e.Close())
+	assert.True(t, out.closed)
+}
+
+func TestOTLPLogsExporter(t *testing.T) {
+	var request otlpExportLogsRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/logs", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.NoError(t, json.Unmarshal(body, &request))
+	}))
+	defer server.Close()
+
+	e := NewOTLPLogsExporter(server.URL + "/v1/logs")
+	defer e.Close()
+	require.NoError(t, e.Export(testFlows()))
+
+	require.Len(t, request.ResourceLogs, 1)
+	require.Len(t, request.ResourceLogs[0].ScopeLogs, 1)
+	records := request.ResourceLogs[0].ScopeLogs[0].LogRecords
+	require.Len(t, records, 2)
+	assert.Equal(t, "Dropped by network policy deny-all in namespace ns1, direction Egress", *records[0].Body.StringValue)
+	attrs := map[string]string{}
+	for _, attr := range records[0].Attributes {
+		if attr.Value.StringValue != nil {
+			attrs[attr.Key] = *attr.Value.StringValue
+		} else {
+			attrs[attr.Key] = *attr.Value.IntValue
+		}
+	}
+	assert.Equal(t, "10.244.0.5", attrs["source.address"])
+	assert.Equal(t, "8080", attrs["destination.port"])
+	assert.Equal(t, "client", attrs["source.k8s.pod.name"])
+	assert.Equal(t, "3", attrs["flow.packets"])
+	assert.Equal(t, "drop", attrs["ovn.acl.action"])
+	assert.Equal(t, "deny-all", attrs["ovn.acl.name"])
+	assert.NotContains(t, attrs, "destination.k8s.pod.name")
+}
+
+func TestOTLPLogsExporterError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "overloaded", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	e := NewOTLPLogsExporter(server.URL)
+	defer e.Close()
+	err := e.Export(testFlows())
+	assert.ErrorContains(t, err, "overloaded")
+}
+
+func TestIPFIXExporter(t *testing.T) {
+	collector, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.ParseIP("127.0.0.1")})
+	require.NoError(t, err)
+	defer collector.Close()
+
+	e, err := NewIPFIXExporter(collector.LocalAddr().String())
+	require.NoError(t, err)
+	defer e.Close()
+	require.NoError(t, e.Export(testFlows()))
+
+	require.NoError(t, collector.SetReadDeadline(time.Now().Add(5*time.Second)))
+	buf := make([]byte, 1500)
+	// IPv4 message
+	n, err := collector.Read(buf)
+	require.NoError(t, err)
+	msg := buf[:n]
+	assert.Equal(t, uint16(ipfixVersion), binary.BigEndian.Uint16(msg[0:2]))
+	assert.Equal(t, uint16(n), binary.BigEndian.Uint16(msg[2:4]))
+	assert.Equal(t, uint32(0), binary.BigEndian.Uint32(msg[8:12]))
+	// template set
+	assert.Equal(t, uint16(ipfixTemplateSetID), binary.BigEndian.Uint16(msg[16:18]))
+	templateSetLength := int(binary.BigEndian.Uint16(msg[18:20]))
+	assert.Equal(t, uint16(ipfixIPv4Template), binary.BigEndian.Uint16(msg[20:22]))
+	assert.Equal(t, uint16(len(ipfixTemplates[ipfixIPv4Template])), binary.BigEndian.Uint16(msg[22:24]))
+	// data set with a single record
+	data := msg[16+templateSetLength:]
+	assert.Equal(t, uint16(ipfixIPv4Template), binary.BigEndian.Uint16(data[0:2]))
+	record := data[4:]
+	assert.Equal(t, net.ParseIP("10.244.0.5").To4(), net.IP(record[0:4]))
+	assert.Equal(t, net.ParseIP("10.244.1.3").To4(), net.IP(record[4:8]))
+	assert.Equal(t, uint16(40000), binary.BigEndian.Uint16(record[8:10]))
+	assert.Equal(t, uint16(8080), binary.BigEndian.Uint16(record[10:12]))
+	assert.Equal(t, uint8(6), record[12])
+	assert.Equal(t, uint64(3), binary.BigEndian.Uint64(record[13:21]))
+	assert.Equal(t, uint64(300), binary.BigEndian.Uint64(record[21:29]))
+	assert.Equal(t, uint8(firewallEventDenied), record[45])
+	assert.Len(t, record, 46)
+
+	// IPv6 message, sequence number counts the records sent before
+	n, err = collector.Read(buf)
+	require.NoError(t, err)
+	msg = buf[:n]
+	assert.Equal(t, uint32(1), binary.BigEndian.Uint32(msg[8:12]))
+	templateSetLength = int(binary.BigEndian.Uint16(msg[18:20]))
+	data = msg[16+templateSetLength:]
+	assert.Equal(t, uint16(ipfixIPv6Template), binary.BigEndian.Uint16(data[0:2]))
+	record = data[4:]
+	assert.Equal(t, net.ParseIP("fd00:10:244::5"), net.IP(record[0:16]))
+	assert.Equal(t, uint8(58), record[36])
+	assert.Equal(t, uint8(firewallEventIgnore), record[69])
+	assert.Len(t, record, 70)
+}
+
+func TestNewExporter(t *testing.T) {
+	_, err := NewExporter("netflow", "")
+	assert.ErrorContains(t, err, "unknown exporter type")
+	_, err = NewExporter(TypeIPFIX, "")
+	assert.ErrorContains(t, err, "requires a collector address")
+	e, err := NewExporter(TypeOTLP, "")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultOTLPEndpoint, e.(*OTLPLogsExporter).endpoint)
+}
@@ -0,0 +1,188 @@
+package exporter
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/model"
+)
+
+// IPFIX (RFC 7011) constants
+const (
+	ipfixVersion       = 10
+	ipfixTemplateSetID = 2
+	ipfixIPv4Template  = 256
+	ipfixIPv6Template  = 257
+	// observation domain of the exported records
+	ipfixObservationDomainID = 0
+	// flows per message, keeps IPv6 messages below 1500 bytes
+	ipfixMaxRecords = 16
+)
+
+// IANA information elements, see https://www.iana.org/assignments/ipfix/ipfix.xhtml
+const (
+	ieOctetDeltaCount          = 1
+	iePacketDeltaCount         = 2
+	ieProtocolIdentifier       = 4
+	ieSourceTransportPort      = 7
+	ieSourceIPv4Address        = 8
+	ieDestinationTransportPort = 11
+	ieDestinationIPv4Address   = 12
+	ieSourceIPv6Address        = 27
+	ieDestinationIPv6Address   = 28
+	ieFlowStartMilliseconds    = 152
+	ieFlowEndMilliseconds      = 153
+	ieFirewallEvent            = 233
+)
+
+// firewallEvent values
+const (
+	firewallEventIgnore  = 0
+	firewallEventCreated = 1
+	firewallEventDenied  = 3
+)
+
+type ipfixField struct {
+	id     uint16
+	length uint16
+}
+
+func ipfixTemplateFields(ipLength uint16, srcIP, dstIP uint16) []ipfixField {
+	return []ipfixField{
+		{srcIP, ipLength},
+		{dstIP, ipLength},
+		{ieSourceTransportPort, 2},
+		{ieDestinationTransportPort, 2},
+		{ieProtocolIdentifier, 1},
+		{iePacketDeltaCount, 8},
+		{ieOctetDeltaCount, 8},
+		{ieFlowStartMilliseconds, 8},
+		{ieFlowEndMilliseconds, 8},
+		{ieFirewallEvent, 1},
+	}
+}
+
+var ipfixTemplates = map[uint16][]ipfixField{
+	ipfixIPv4Template: ipfixTemplateFields(net.IPv4len, ieSourceIPv4Address, ieDestinationIPv4Address),
+	ipfixIPv6Template: ipfixTemplateFields(net.IPv6len, ieSourceIPv6Address, ieDestinationIPv6Address),
+}
+
+var protocolNumbers = map[string]uint8{
+	"icmp":  1,
+	"tcp":   6,
+	"udp":   17,
+	"icmp6": 58,
+	"sctp":  132,
+}
+
+// IPFIXExporter sends flow events as IPFIX data records to a UDP collector.
+// The templates are sent with every message, since UDP gives no guarantee that
+// the collector received them before.
+type IPFIXExporter struct {
+	conn     net.Conn
+	sequence uint32
+}
+
+func NewIPFIXExporter(collector string) (*IPFIXExporter, error) {
+	conn, err := net.Dial("udp", collector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to IPFIX collector %s: %w", collector, err)
+	}
+	return &IPFIXExporter{conn: conn}, nil
+}
+
+func (e *IPFIXExporter) Export(flows []*model.FlowEvent) error {
+	v4, v6 := []*model.FlowEvent{}, []*model.FlowEvent{}
+	for _, flow := range flows {
+		src, dst := net.ParseIP(flow.Src.IP), net.ParseIP(flow.Dst.IP)
+		if src == nil || dst == nil {
+			continue
+		}
+		if src.To4() != nil && dst.To4() != nil {
+			v4 = append(v4, flow)
+		} else {
+			v6 = append(v6, flow)
+		}
+	}
+	for _, t := range []struct {
+		templateID uint16
+		flows      []*model.FlowEvent
+	}{{ipfixIPv4Template, v4}, {ipfixIPv6Template, v6}} {
+		for start := 0; start < len(t.flows); start += ipfixMaxRecords {
+			end := min(start+ipfixMaxRecords, len(t.flows))
+			msg := e.buildMessage(t.templateID, t.flows[start:end], time.Now())
+			if _, err := e.conn.Write(msg); err != nil {
+				return fmt.Errorf("failed to send IPFIX message: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func (e *IPFIXExporter) Close() error {
+	return e.conn.Close()
+}
+
+// buildMessage encodes a message with the template set followed by a data set
+// with the given flows.
+func (e *IPFIXExporter) buildMessage(templateID uint16, flows []*model.FlowEvent, exportTime time.Time) []byte {
+	fields := ipfixTemplates[templateID]
+	templateSet := &bytes.Buffer{}
+	writeBE(templateSet, templateID, uint16(len(fields)))
+	for _, field := range fields {
+		writeBE(templateSet, field.id, field.length)
+	}
+
+	dataSet := &bytes.Buffer{}
+	for _, flow := range flows {
+		writeIPFIXRecord(dataSet, templateID, flow)
+	}
+
+	msg := &bytes.Buffer{}
+	length := 16 + 4 + templateSet.Len() + 4 + dataSet.Len()
+	writeBE(msg, uint16(ipfixVersion), uint16(length), uint32(exportTime.Unix()), e.sequence, uint32(ipfixObservationDomainID))
+	writeBE(msg, uint16(ipfixTemplateSetID), uint16(4+templateSet.Len()))
+	msg.Write(templateSet.Bytes())
+	writeBE(msg, templateID, uint16(4+dataSet.Len()))
+	msg.Write(dataSet.Bytes())
+	// sequence number counts the data records sent before this message
+	e.sequence += uint32(len(flows))
+	return msg.Bytes()
+}
+
+func writeIPFIXRecord(buf *bytes.Buffer, templateID uint16, flow *model.FlowEvent) {
+	src, dst := net.ParseIP(flow.Src.IP), net.ParseIP(flow.Dst.IP)
+	if templateID == ipfixIPv4Template {
+		src, dst = src.To4(), dst.To4()
+	} else {
+		src, dst = src.To16(), dst.To16()
+	}
+	buf.Write(src)
+	buf.Write(dst)
+	writeBE(buf, flow.Src.Port, flow.Dst.Port, protocolNumbers[flow.Protocol], flow.Packets, flow.Bytes,
+		uint64(flow.Start.UnixMilli()), uint64(flow.End.UnixMilli()), getFirewallEvent(flow))
+}
+
+func getFirewallEvent(flow *model.FlowEvent) uint8 {
+	if flow.Event == nil {
+		return firewallEventIgnore
+	}
+	switch flow.Event.Action {
+	case "allow", "allow-related", "allow-stateless":
+		return firewallEventCreated
+	case "drop", "reject":
+		return firewallEventDenied
+	default:
+		return firewallEventIgnore
+	}
+}
+
+func writeBE(buf *bytes.Buffer, values ...any) {
+	for _, v := range values {
+		// writing to a bytes.Buffer never fails
+		_ = binary.Write(buf, binary.BigEndian, v)
+	}
+}
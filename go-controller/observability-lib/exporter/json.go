@@ -0,0 +1,41 @@
+package exporter
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/model"
+)
+
+// JSONLinesExporter writes every flow event as a JSON object on its own line.
+type JSONLinesExporter struct {
+	out    io.WriteCloser
+	writer *bufio.Writer
+	enc    *json.Encoder
+}
+
+func NewJSONLinesExporter(out io.WriteCloser) *JSONLinesExporter {
+	writer := bufio.NewWriter(out)
+	return &JSONLinesExporter{
+		out:    out,
+		writer: writer,
+		enc:    json.NewEncoder(writer),
+	}
+}
+
+func (e *JSONLinesExporter) Export(flows []*model.FlowEvent) error {
+	for _, flow := range flows {
+		if err := e.enc.Encode(flow); err != nil {
+			return err
+		}
+	}
+	return e.writer.Flush()
+}
+
+func (e *JSONLinesExporter) Close() error {
+	if err := e.writer.Flush(); err != nil {
+		return err
+	}
+	return e.out.Close()
+}
@@ -0,0 +1,173 @@
+package exporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/model"
+)
+
+const (
+	// DefaultOTLPEndpoint is the OTLP/HTTP logs endpoint of a local OpenTelemetry collector.
+	DefaultOTLPEndpoint = "http://localhost:4318/v1/logs"
+	otlpServiceName     = "ovnkube-observ"
+	otlpTimeout         = 10 * time.Second
+)
+
+// The types below are the subset of the OTLP logs protobuf messages needed to
+// build an ExportLogsServiceRequest, using the OTLP/HTTP JSON encoding.
+// See https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding
+
+type otlpAnyValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	// 64 bit integers are encoded as decimal strings
+	IntValue *string `json:"intValue,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano         string         `json:"timeUnixNano"`
+	ObservedTimeUnixNano string         `json:"observedTimeUnixNano"`
+	SeverityNumber       int            `json:"severityNumber"`
+	SeverityText         string         `json:"severityText"`
+	Body                 otlpAnyValue   `json:"body"`
+	Attributes           []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeLogs struct {
+	Scope struct {
+		Name string `json:"name"`
+	} `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpResourceLogs struct {
+	Resource struct {
+		Attributes []otlpKeyValue `json:"attributes"`
+	} `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpExportLogsRequest struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+// severity number of the INFO level
+const otlpSeverityInfo = 9
+
+func stringAttr(key, value string) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{StringValue: &value}}
+}
+
+func intAttr(key string, value uint64) otlpKeyValue {
+	s := strconv.FormatUint(value, 10)
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{IntValue: &s}}
+}
+
+// OTLPLogsExporter sends flow events as OpenTelemetry log records to an
+// OTLP/HTTP endpoint, usually a collector running on the same node.
+type OTLPLogsExporter struct {
+	endpoint string
+	client   *http.Client
+}
+
+func NewOTLPLogsExporter(endpoint string) *OTLPLogsExporter {
+	return &OTLPLogsExporter{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: otlpTimeout},
+	}
+}
+
+func newOTLPLogRecord(flow *model.FlowEvent, observed time.Time) otlpLogRecord {
+	body := flow.Message
+	attrs := []otlpKeyValue{
+		stringAttr("source.address", flow.Src.IP),
+		stringAttr("destination.address", flow.Dst.IP),
+		intAttr("flow.packets", flow.Packets),
+		intAttr("flow.bytes", flow.Bytes),
+	}
+	if flow.Protocol != "" {
+		attrs = append(attrs, stringAttr("network.transport", flow.Protocol))
+	}
+	if flow.Src.Port != 0 {
+		attrs = append(attrs, intAttr("source.port", uint64(flow.Src.Port)))
+	}
+	if flow.Dst.Port != 0 {
+		attrs = append(attrs, intAttr("destination.port", uint64(flow.Dst.Port)))
+	}
+	for _, e := range []struct {
+		prefix   string
+		endpoint model.Endpoint
+	}{{"source", flow.Src}, {"destination", flow.Dst}} {
+		if e.endpoint.Pod != "" {
+			attrs = append(attrs,
+				stringAttr(e.prefix+".k8s.pod.name", e.endpoint.Pod),
+				stringAttr(e.prefix+".k8s.namespace.name", e.endpoint.Namespace),
+				stringAttr(e.prefix+".network", e.endpoint.Network))
+		}
+	}
+	if flow.Event != nil {
+		attrs = append(attrs,
+			stringAttr("ovn.acl.action", flow.Event.Action),
+			stringAttr("ovn.acl.actor", flow.Event.Actor))
+		if flow.Event.Name != "" {
+			attrs = append(attrs, stringAttr("ovn.acl.name", flow.Event.Name))
+		}
+		if flow.Event.Namespace != "" {
+			attrs = append(attrs, stringAttr("ovn.acl.namespace", flow.Event.Namespace))
+		}
+		if flow.Event.Direction != "" {
+			attrs = append(attrs, stringAttr("ovn.acl.direction", flow.Event.Direction))
+		}
+	}
+	return otlpLogRecord{
+		TimeUnixNano:         strconv.FormatInt(flow.End.UnixNano(), 10),
+		ObservedTimeUnixNano: strconv.FormatInt(observed.UnixNano(), 10),
+		SeverityNumber:       otlpSeverityInfo,
+		SeverityText:         "INFO",
+		Body:                 otlpAnyValue{StringValue: &body},
+		Attributes:           attrs,
+	}
+}
+
+func (e *OTLPLogsExporter) Export(flows []*model.FlowEvent) error {
+	if len(flows) == 0 {
+		return nil
+	}
+	now := time.Now()
+	scopeLogs := otlpScopeLogs{}
+	scopeLogs.Scope.Name = otlpServiceName
+	for _, flow := range flows {
+		scopeLogs.LogRecords = append(scopeLogs.LogRecords, newOTLPLogRecord(flow, now))
+	}
+	resourceLogs := otlpResourceLogs{ScopeLogs: []otlpScopeLogs{scopeLogs}}
+	resourceLogs.Resource.Attributes = []otlpKeyValue{stringAttr("service.name", otlpServiceName)}
+	body, err := json.Marshal(&otlpExportLogsRequest{ResourceLogs: []otlpResourceLogs{resourceLogs}})
+	if err != nil {
+		return fmt.Errorf("failed to marshal OTLP logs request: %w", err)
+	}
+	resp, err := e.client.Post(e.endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to send OTLP logs to %s: %w", e.endpoint, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("OTLP endpoint %s returned %s: %s", e.endpoint, resp.Status, string(msg))
+	}
+	return nil
+}
+
+func (e *OTLPLogsExporter) Close() error {
+	e.client.CloseIdleConnections()
+	return nil
+}
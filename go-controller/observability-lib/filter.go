@@ -0,0 +1,168 @@
+package observability_lib
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/model"
+)
+
+// FlowFilter selects flow events using a small subset of the BPF filter syntax:
+// primitives are protocols (tcp, udp, sctp, icmp, icmp6) and "[src|dst] host <ip>"
+// or "[src|dst] port <port>", combined with "and", "or", "not" and parentheses.
+// For example: "tcp and dst port 8080 and not src host 10.244.0.5".
+type FlowFilter struct {
+	match func(*model.FlowEvent) bool
+}
+
+// ParseFlowFilter parses the given filter expression. An empty expression
+// matches every flow.
+func ParseFlowFilter(expr string) (*FlowFilter, error) {
+	p := &filterParser{tokens: tokenizeFilter(expr)}
+	if len(p.tokens) == 0 {
+		return &FlowFilter{match: func(*model.FlowEvent) bool { return true }}, nil
+	}
+	match, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter %q: %w", expr, err)
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("invalid filter %q: unexpected %q", expr, p.tokens[p.pos])
+	}
+	return &FlowFilter{match: match}, nil
+}
+
+// Match returns true if the given flow is selected by the filter.
+func (f *FlowFilter) Match(flow *model.FlowEvent) bool {
+	return f.match(flow)
+}
+
+func tokenizeFilter(expr string) []string {
+	expr = strings.NewReplacer("(", " ( ", ")", " ) ").Replace(expr)
+	return strings.Fields(strings.ToLower(expr))
+}
+
+type filterParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *filterParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() (string, error) {
+	if p.pos >= len(p.tokens) {
+		return "", fmt.Errorf("unexpected end of expression")
+	}
+	token := p.tokens[p.pos]
+	p.pos++
+	return token, nil
+}
+
+func (p *filterParser) parseOr() (func(*model.FlowEvent) bool, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "or" {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(f *model.FlowEvent) bool { return l(f) || right(f) }
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (func(*model.FlowEvent) bool, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "and" {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(f *model.FlowEvent) bool { return l(f) && right(f) }
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (func(*model.FlowEvent) bool, error) {
+	token, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	switch token {
+	case "not":
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return func(f *model.FlowEvent) bool { return !inner(f) }, nil
+	case "(":
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if token, err = p.next(); err != nil || token != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		return inner, nil
+	case "tcp", "udp", "sctp", "icmp", "icmp6":
+		return func(f *model.FlowEvent) bool { return strings.ToLower(f.Protocol) == token }, nil
+	case "src", "dst":
+		kind, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		return p.parseEndpoint(token, kind)
+	default:
+		return p.parseEndpoint("", token)
+	}
+}
+
+// parseEndpoint parses a host or port primitive, direction is "src", "dst" or
+// empty to match either side of the flow.
+func (p *filterParser) parseEndpoint(direction, kind string) (func(*model.FlowEvent) bool, error) {
+	value, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	var match func(*model.Endpoint) bool
+	switch kind {
+	case "host":
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid host %q", value)
+		}
+		match = func(e *model.Endpoint) bool { return ip.Equal(net.ParseIP(e.IP)) }
+	case "port":
+		port, err := strconv.ParseUint(value, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port %q", value)
+		}
+		match = func(e *model.Endpoint) bool { return e.Port == uint16(port) }
+	default:
+		return nil, fmt.Errorf("unknown primitive %q", kind)
+	}
+	switch direction {
+	case "src":
+		return func(f *model.FlowEvent) bool { return match(&f.Src) }, nil
+	case "dst":
+		return func(f *model.FlowEvent) bool { return match(&f.Dst) }, nil
+	default:
+		return func(f *model.FlowEvent) bool { return match(&f.Src) || match(&f.Dst) }, nil
+	}
+}
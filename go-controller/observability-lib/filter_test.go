@@ -0,0 +1,73 @@
+package observability_lib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/model"
+)
+
+func TestParseFlowFilter(t *testing.T) {
+	tcpFlow := &model.FlowEvent{
+		Src:      model.Endpoint{IP: "10.244.0.5", Port: 40000},
+		Dst:      model.Endpoint{IP: "10.244.1.3", Port: 8080},
+		Protocol: "tcp",
+	}
+	udpFlow := &model.FlowEvent{
+		Src:      model.Endpoint{IP: "fd00:10:244::5", Port: 5353},
+		Dst:      model.Endpoint{IP: "fd00:10:244:1::3", Port: 53},
+		Protocol: "udp",
+	}
+	icmpFlow := &model.FlowEvent{
+		Src:      model.Endpoint{IP: "10.244.0.5"},
+		Dst:      model.Endpoint{IP: "10.244.1.3"},
+		Protocol: "icmp",
+	}
+	tests := []struct {
+		expr    string
+		matches []*model.FlowEvent
+	}{
+		{"", []*model.FlowEvent{tcpFlow, udpFlow, icmpFlow}},
+		{"tcp", []*model.FlowEvent{tcpFlow}},
+		{"TCP or UDP", []*model.FlowEvent{tcpFlow, udpFlow}},
+		{"not icmp", []*model.FlowEvent{tcpFlow, udpFlow}},
+		{"dst port 8080", []*model.FlowEvent{tcpFlow}},
+		{"src port 8080", nil},
+		{"port 53", []*model.FlowEvent{udpFlow}},
+		{"host 10.244.1.3", []*model.FlowEvent{tcpFlow, icmpFlow}},
+		{"src host fd00:10:244::5", []*model.FlowEvent{udpFlow}},
+		{"tcp and dst port 8080 and not src host 10.244.0.5", nil},
+		{"icmp or (tcp and dst port 8080)", []*model.FlowEvent{tcpFlow, icmpFlow}},
+		{"not (tcp or udp)", []*model.FlowEvent{icmpFlow}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			filter, err := ParseFlowFilter(tt.expr)
+			require.NoError(t, err)
+			var matches []*model.FlowEvent
+			for _, flow := range []*model.FlowEvent{tcpFlow, udpFlow, icmpFlow} {
+				if filter.Match(flow) {
+					matches = append(matches, flow)
+				}
+			}
+			assert.Equal(t, tt.matches, matches)
+		})
+	}
+}
+
+func TestParseFlowFilterErrors(t *testing.T) {
+	for _, expr := range []string{
+		"tcp and",
+		"(tcp or udp",
+		"tcp udp",
+		"port http",
+		"host 10.244.0",
+		"src tcp",
+		"vlan 10",
+	} {
+		_, err := ParseFlowFilter(expr)
+		assert.Error(t, err, "expression %q", expr)
+	}
+}
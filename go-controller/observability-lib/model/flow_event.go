@@ -0,0 +1,48 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// Endpoint is one side of a sampled flow. Pod, Namespace and Network are only
+// set when the IP belongs to a pod known to the local nbdb.
+type Endpoint struct {
+	IP        string `json:"ip"`
+	Port      uint16 `json:"port,omitempty"`
+	Pod       string `json:"pod,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Network   string `json:"network,omitempty"`
+}
+
+func (e *Endpoint) String() string {
+	s := e.IP
+	if e.Port != 0 {
+		s = fmt.Sprintf("%s:%d", s, e.Port)
+	}
+	if e.Pod != "" {
+		s = fmt.Sprintf("%s (pod %s/%s, network %s)", s, e.Namespace, e.Pod, e.Network)
+	}
+	return s
+}
+
+// FlowEvent is a sampled flow enriched with the network event that caused the
+// sample. When samples are aggregated, Start and End are the timestamps of the
+// first and last sample and Packets and Bytes are their totals.
+type FlowEvent struct {
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+	Src      Endpoint  `json:"src"`
+	Dst      Endpoint  `json:"dst"`
+	Protocol string    `json:"protocol,omitempty"`
+	Packets  uint64    `json:"packets"`
+	Bytes    uint64    `json:"bytes"`
+	// Event is nil when the sample could not be decoded.
+	Event   *ACLEvent `json:"event,omitempty"`
+	Message string    `json:"message,omitempty"`
+}
+
+func (f *FlowEvent) String() string {
+	return fmt.Sprintf("%s: %s src=%s, dst=%s, packets=%d, bytes=%d",
+		f.Message, f.Protocol, f.Src.String(), f.Dst.String(), f.Packets, f.Bytes)
+}
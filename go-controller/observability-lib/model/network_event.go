@@ -18,6 +18,8 @@ const (
 	netpolNodeOwnerType                 = "NetpolNode"
 	netpolNamespaceOwnerType            = "NetpolNamespace"
 	udnIsolationOwnerType               = "UDNIsolation"
+	egressIPOwnerType                   = "EgressIP"
+	egressServiceOwnerType              = "EgressService"
 
 	// nbdb constants: see also github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb
 	aclActionAllow          = "allow"
@@ -33,12 +35,12 @@ type NetworkEvent interface {
 }
 
 type ACLEvent struct {
-	NetworkEvent
-	Action    string
-	Actor     string
-	Name      string
-	Namespace string
-	Direction string
+	NetworkEvent `json:"-"`
+	Action       string `json:"action"`
+	Actor        string `json:"actor"`
+	Name         string `json:"name,omitempty"`
+	Namespace    string `json:"namespace,omitempty"`
+	Direction    string `json:"direction,omitempty"`
 }
 
 func (e *ACLEvent) String() string {
@@ -80,3 +82,42 @@ func (e *ACLEvent) String() string {
 	}
 	return fmt.Sprintf("%s by %s", action, msg)
 }
+
+// LoadBalancerEvent is generated for the new connections DNATed by a service load balancer.
+type LoadBalancerEvent struct {
+	NetworkEvent `json:"-"`
+	Name         string `json:"name"`
+	Namespace    string `json:"namespace"`
+}
+
+func (e *LoadBalancerEvent) String() string {
+	return fmt.Sprintf("DNATed by load balancer of service %s/%s", e.Namespace, e.Name)
+}
+
+// NATEvent is generated for the traffic SNATed by an egress IP or an egress service.
+type NATEvent struct {
+	NetworkEvent `json:"-"`
+	Actor        string `json:"actor"`
+	Name         string `json:"name"`
+	Namespace    string `json:"namespace,omitempty"`
+}
+
+func (e *NATEvent) String() string {
+	switch e.Actor {
+	case egressIPOwnerType:
+		return fmt.Sprintf("SNATed by egress IP %s", e.Name)
+	case egressServiceOwnerType:
+		return fmt.Sprintf("SNATed by egress service %s/%s", e.Namespace, e.Name)
+	}
+	return fmt.Sprintf("SNATed by %s %s", e.Actor, e.Name)
+}
+
+// RerouteEvent is generated for the traffic rerouted by an admin policy based external route.
+type RerouteEvent struct {
+	NetworkEvent `json:"-"`
+	NextHop      string `json:"nextHop"`
+}
+
+func (e *RerouteEvent) String() string {
+	return fmt.Sprintf("Rerouted by external route to next hop %s", e.NextHop)
+}
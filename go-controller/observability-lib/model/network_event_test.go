@@ -17,6 +17,8 @@ var mapping = map[string]string{
 	netpolNodeOwnerType:                 libovsdbops.NetpolNodeOwnerType,
 	netpolNamespaceOwnerType:            libovsdbops.NetpolNamespaceOwnerType,
 	udnIsolationOwnerType:               libovsdbops.UDNIsolationOwnerType,
+	egressIPOwnerType:                   libovsdbops.EgressIPOwnerType,
+	egressServiceOwnerType:              libovsdbops.EgressServiceOwnerType,
 	aclActionAllow:                      nbdb.ACLActionAllow,
 	aclActionAllowRelated:               nbdb.ACLActionAllowRelated,
 	aclActionAllowStateless:             nbdb.ACLActionAllowStateless,
@@ -10,7 +10,9 @@ import (
 	"log"
 	"os"
 	"strings"
+	"sync"
 	"syscall"
+	"time"
 	"unsafe"
 
 	"github.com/google/gopacket"
@@ -19,6 +21,8 @@ import (
 	"github.com/vishvananda/netlink/nl"
 	"golang.org/x/sys/unix"
 
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/exporter"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/model"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/sampledecoder"
 )
 
@@ -27,6 +31,10 @@ const (
 	PSAMPLE_NL_MCGRP_SAMPLE_NAME = "packets"
 )
 
+// exportQueueSize is the number of batches of flows waiting to be exported
+// before new samples are dropped.
+const exportQueueSize = 1024
+
 const (
 	PSAMPLE_ATTR_IIFINDEX = iota
 	PSAMPLE_ATTR_OIFINDEX
@@ -57,6 +65,15 @@ type SampleReader struct {
 
 	decoder   *sampledecoder.SampleDecoder
 	cookieStr []string
+
+	// exporter mode, see WithExporter
+	exporter          exporter.Exporter
+	filter            *FlowFilter
+	aggregationWindow time.Duration
+	aggregator        *flowAggregator
+	// exportQueue passes the flows to export to the export worker, so that a
+	// slow exporter doesn't block the reading of the samples
+	exportQueue chan []*model.FlowEvent
 }
 
 func NewSampleReader(enableDecoder, logCookie, printFullPacket, addOVSCollector bool, srcIP, dstIP, outputFile string) *SampleReader {
@@ -75,6 +92,20 @@ func NewSampleReader(enableDecoder, logCookie, printFullPacket, addOVSCollector
 	return r
 }
 
+// WithExporter makes the reader export the samples as flow events instead of
+// printing them. Samples not matching the filter are dropped. When the
+// aggregation window is not zero, the samples of the same flow are summed and
+// exported once per window.
+func (r *SampleReader) WithExporter(exp exporter.Exporter, filter *FlowFilter, aggregationWindow time.Duration) *SampleReader {
+	r.exporter = exp
+	r.filter = filter
+	r.aggregationWindow = aggregationWindow
+	if aggregationWindow > 0 {
+		r.aggregator = newFlowAggregator()
+	}
+	return r
+}
+
 func (r *SampleReader) ReadSamples(ctx context.Context) error {
 	if r.enableDecoder {
 		var err error
@@ -94,7 +125,10 @@ func (r *SampleReader) ReadSamples(ctx context.Context) error {
 		}
 	}
 	var writer io.Writer
-	if r.outputFile != "" {
+	if r.exporter != nil {
+		// keep stdout for the exported events
+		writer = os.Stderr
+	} else if r.outputFile != "" {
 		file, err := os.Create(r.outputFile)
 		if err != nil {
 			return fmt.Errorf("error creating output file: %w", err)
@@ -125,7 +159,7 @@ func (r *SampleReader) ReadSamples(ctx context.Context) error {
 	if ovsGroupID == 0 {
 		return fmt.Errorf("no mcast group found for %s", PSAMPLE_NL_MCGRP_SAMPLE_NAME)
 	} else {
-		fmt.Printf("Found group %s, id %d\n", PSAMPLE_NL_MCGRP_SAMPLE_NAME, ovsGroupID)
+		fmt.Fprintf(writer, "Found group %s, id %d\n", PSAMPLE_NL_MCGRP_SAMPLE_NAME, ovsGroupID)
 	}
 	sock, err := nl.Subscribe(nl.GENL_ID_CTRL, uint(ovsGroupID))
 	if err != nil {
@@ -141,6 +175,37 @@ func (r *SampleReader) ReadSamples(ctx context.Context) error {
 		sock.Close()
 	}()
 
+	if r.exporter != nil {
+		r.exportQueue = make(chan []*model.FlowEvent, exportQueueSize)
+		exportDone := make(chan struct{})
+		go func() {
+			defer close(exportDone)
+			r.runExporter(printlnFunc)
+		}()
+		aggregatorWG := &sync.WaitGroup{}
+		if r.aggregator != nil {
+			aggregatorWG.Add(1)
+			go func() {
+				defer aggregatorWG.Done()
+				r.exportAggregatedFlows(ctx)
+			}()
+		}
+		defer func() {
+			// once the samples aren't read anymore, queue the last aggregated
+			// flows, export all the queued flows and only then close the
+			// exporter
+			aggregatorWG.Wait()
+			if r.aggregator != nil {
+				r.queueAggregatedFlows()
+			}
+			close(r.exportQueue)
+			<-exportDone
+			if err := r.exporter.Close(); err != nil {
+				printlnFunc("ERROR: closing exporter failed:", err)
+			}
+		}()
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -178,6 +243,9 @@ func getHostEndian() binary.ByteOrder {
 var hostEndian = getHostEndian()
 
 func (r *SampleReader) parseMsg(msgs []syscall.NetlinkMessage, printlnFunc func(a ...any)) error {
+	if r.exporter != nil {
+		return r.exportMsg(msgs)
+	}
 	for _, msg := range msgs {
 		var packetStr, sampleStr string
 		data := msg.Data[nl.SizeofGenlmsg:]
@@ -241,3 +309,149 @@ func (r *SampleReader) parseMsg(msgs []syscall.NetlinkMessage, printlnFunc func(
 	}
 	return nil
 }
+
+// runExporter exports the queued flows until the queue is closed.
+func (r *SampleReader) runExporter(printlnFunc func(a ...any)) {
+	for flows := range r.exportQueue {
+		if err := r.exporter.Export(flows); err != nil {
+			printlnFunc("ERROR: export failed:", err)
+		}
+	}
+}
+
+// queueExport queues the flows for the export worker without blocking, the
+// flows are dropped when the queue is full.
+func (r *SampleReader) queueExport(flows []*model.FlowEvent) error {
+	select {
+	case r.exportQueue <- flows:
+		return nil
+	default:
+		return fmt.Errorf("export queue is full, dropping %d flows", len(flows))
+	}
+}
+
+// exportAggregatedFlows queues the aggregated flows for export at the end of
+// every aggregation window until the context is done.
+func (r *SampleReader) exportAggregatedFlows(ctx context.Context) {
+	ticker := time.NewTicker(r.aggregationWindow)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.queueAggregatedFlows()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// queueAggregatedFlows queues the flows aggregated so far for export. It waits
+// for room in the queue rather than dropping a whole aggregation window.
+func (r *SampleReader) queueAggregatedFlows() {
+	if flows := r.aggregator.flush(); len(flows) > 0 {
+		r.exportQueue <- flows
+	}
+}
+
+func (r *SampleReader) exportMsg(msgs []syscall.NetlinkMessage) error {
+	flows := make([]*model.FlowEvent, 0, len(msgs))
+	for _, msg := range msgs {
+		flow, err := r.parseFlowEvent(msg.Data[nl.SizeofGenlmsg:])
+		if err != nil {
+			return err
+		}
+		if flow == nil {
+			continue
+		}
+		if r.aggregator != nil {
+			r.aggregator.add(flow)
+		} else {
+			flows = append(flows, flow)
+		}
+	}
+	if len(flows) == 0 {
+		return nil
+	}
+	return r.queueExport(flows)
+}
+
+// parseFlowEvent builds a flow event from a psample message. nil is returned
+// when the sampled packet is filtered out.
+func (r *SampleReader) parseFlowEvent(data []byte) (*model.FlowEvent, error) {
+	now := time.Now()
+	flow := &model.FlowEvent{Start: now, End: now, Packets: 1}
+	var cookie []byte
+	for attr := range nl.ParseAttributes(data) {
+		switch attr.Type {
+		case PSAMPLE_ATTR_ORIGSIZE:
+			if len(attr.Value) == 4 {
+				size := uint32(0)
+				// size is encoded using host endian
+				if err := binary.Read(bytes.NewReader(attr.Value), hostEndian, &size); err != nil {
+					return nil, err
+				}
+				flow.Bytes = uint64(size)
+			}
+		case PSAMPLE_ATTR_USER_COOKIE:
+			cookie = attr.Value
+		case PSAMPLE_ATTR_DATA:
+			setFlowPacket(flow, attr.Value)
+			if flow.Bytes == 0 {
+				flow.Bytes = uint64(len(attr.Value))
+			}
+		}
+	}
+	if r.srcIP != "" && r.srcIP != flow.Src.IP {
+		return nil, nil
+	}
+	if r.dstIP != "" && r.dstIP != flow.Dst.IP {
+		return nil, nil
+	}
+	if r.filter != nil && !r.filter.Match(flow) {
+		return nil, nil
+	}
+	if r.decoder != nil {
+		if uint64(len(cookie)) == sampledecoder.CookieSize {
+			event, err := r.decoder.DecodeCookieBytes(cookie)
+			if err != nil {
+				flow.Message = fmt.Sprintf("decoding failed: %v", err)
+			} else {
+				flow.Message = event.String()
+				if aclEvent, ok := event.(*model.ACLEvent); ok {
+					flow.Event = aclEvent
+				}
+			}
+		}
+		r.decoder.EnrichEndpoint(&flow.Src)
+		r.decoder.EnrichEndpoint(&flow.Dst)
+	}
+	return flow, nil
+}
+
+// setFlowPacket sets the addresses, ports and protocol of the flow from the
+// sampled ethernet frame.
+func setFlowPacket(flow *model.FlowEvent, data []byte) {
+	packet := gopacket.NewPacket(data, layers.LayerTypeEthernet, gopacket.Lazy)
+	if networkLayer := packet.NetworkLayer(); networkLayer != nil {
+		src, dst := networkLayer.NetworkFlow().Endpoints()
+		flow.Src.IP = src.String()
+		flow.Dst.IP = dst.String()
+	}
+	switch l := packet.TransportLayer().(type) {
+	case *layers.TCP:
+		flow.Protocol = "tcp"
+		flow.Src.Port, flow.Dst.Port = uint16(l.SrcPort), uint16(l.DstPort)
+	case *layers.UDP:
+		flow.Protocol = "udp"
+		flow.Src.Port, flow.Dst.Port = uint16(l.SrcPort), uint16(l.DstPort)
+	case *layers.SCTP:
+		flow.Protocol = "sctp"
+		flow.Src.Port, flow.Dst.Port = uint16(l.SrcPort), uint16(l.DstPort)
+	default:
+		if packet.Layer(layers.LayerTypeICMPv4) != nil {
+			flow.Protocol = "icmp"
+		} else if packet.Layer(layers.LayerTypeICMPv6) != nil {
+			flow.Protocol = "icmp6"
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package observability_lib
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/model"
+)
+
+func serializePacket(t *testing.T, l ...gopacket.SerializableLayer) []byte {
+	buf := gopacket.NewSerializeBuffer()
+	require.NoError(t, gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true}, l...))
+	return buf.Bytes()
+}
+
+func TestSetFlowPacket(t *testing.T) {
+	eth := &layers.Ethernet{
+		SrcMAC:       net.HardwareAddr{0x0a, 0x58, 0x0a, 0xf4, 0x00, 0x05},
+		DstMAC:       net.HardwareAddr{0x0a, 0x58, 0x0a, 0xf4, 0x01, 0x03},
+		EthernetType: layers.EthernetTypeIPv4,
+	}
+	ip4 := &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: layers.IPProtocolTCP,
+		SrcIP:    net.ParseIP("10.244.0.5"),
+		DstIP:    net.ParseIP("10.244.1.3"),
+	}
+	tcp := &layers.TCP{SrcPort: 40000, DstPort: 8080, SYN: true}
+	flow := &model.FlowEvent{}
+	setFlowPacket(flow, serializePacket(t, eth, ip4, tcp))
+	assert.Equal(t, "tcp", flow.Protocol)
+	assert.Equal(t, model.Endpoint{IP: "10.244.0.5", Port: 40000}, flow.Src)
+	assert.Equal(t, model.Endpoint{IP: "10.244.1.3", Port: 8080}, flow.Dst)
+
+	eth.EthernetType = layers.EthernetTypeIPv6
+	ip6 := &layers.IPv6{
+		Version:    6,
+		HopLimit:   64,
+		NextHeader: layers.IPProtocolICMPv6,
+		SrcIP:      net.ParseIP("fd00:10:244::5"),
+		DstIP:      net.ParseIP("fd00:10:244:1::3"),
+	}
+	icmp := &layers.ICMPv6{TypeCode: layers.CreateICMPv6TypeCode(layers.ICMPv6TypeEchoRequest, 0)}
+	flow = &model.FlowEvent{}
+	setFlowPacket(flow, serializePacket(t, eth, ip6, icmp))
+	assert.Equal(t, "icmp6", flow.Protocol)
+	assert.Equal(t, model.Endpoint{IP: "fd00:10:244::5"}, flow.Src)
+	assert.Equal(t, model.Endpoint{IP: "fd00:10:244:1::3"}, flow.Dst)
+}
+
+type fakeExporter struct {
+	exported [][]*model.FlowEvent
+}
+
+func (e *fakeExporter) Export(flows []*model.FlowEvent) error {
+	e.exported = append(e.exported, flows)
+	return nil
+}
+
+func (e *fakeExporter) Close() error { return nil }
+
+func TestQueueExport(t *testing.T) {
+	exp := &fakeExporter{}
+	r := NewSampleReader(false, false, false, false, "", "", "").WithExporter(exp, nil, 0)
+	r.exportQueue = make(chan []*model.FlowEvent, 1)
+
+	flows := []*model.FlowEvent{{Packets: 1}}
+	require.NoError(t, r.queueExport(flows))
+	// the reading of the samples doesn't wait for the exporter
+	assert.Error(t, r.queueExport([]*model.FlowEvent{{Packets: 2}}))
+
+	close(r.exportQueue)
+	r.runExporter(func(...any) {})
+	assert.Equal(t, [][]*model.FlowEvent{flows}, exp.exported)
+}
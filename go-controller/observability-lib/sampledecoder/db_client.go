@@ -10,6 +10,7 @@ import (
 
 	"k8s.io/klog/v2/textlogger"
 
+	"github.com/ovn-org/libovsdb/cache"
 	"github.com/ovn-org/libovsdb/client"
 	"github.com/ovn-org/libovsdb/model"
 
@@ -19,7 +20,10 @@ import (
 
 const OVSDBTimeout = 10 * time.Second
 
-func NewNBClientWithConfig(ctx context.Context, cfg dbConfig) (client.Client, error) {
+// NewNBClientWithConfig creates a NB client monitoring the tables the decoder
+// needs. The given handlers are registered before the tables are monitored, so
+// that they receive the initial rows too.
+func NewNBClientWithConfig(ctx context.Context, cfg dbConfig, handlers ...cache.EventHandler) (client.Client, error) {
 	dbModel, err := nbdb.FullDatabaseModel()
 	if err != nil {
 		return nil, err
@@ -37,11 +41,16 @@ func NewNBClientWithConfig(ctx context.Context, cfg dbConfig) (client.Client, er
 	if err != nil {
 		return nil, err
 	}
+	for _, handler := range handlers {
+		c.Cache().AddEventHandler(handler)
+	}
 
 	_, err = c.Monitor(ctx,
 		c.NewMonitor(
 			client.WithTable(&nbdb.ACL{}),
 			client.WithTable(&nbdb.Sample{}),
+			// logical switch ports are used to find the pods of the sampled packets.
+			client.WithTable(&nbdb.LogicalSwitchPort{}),
 		),
 	)
 
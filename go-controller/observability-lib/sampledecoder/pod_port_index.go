@@ -0,0 +1,84 @@
+package sampledecoder
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/ovn-org/libovsdb/cache"
+	"github.com/ovn-org/libovsdb/model"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+)
+
+// podPortIndex indexes the pod logical switch ports by their IPs, so that the
+// endpoints of every sampled packet don't require a scan of all the ports. It
+// is kept up to date by the NB client cache events.
+type podPortIndex struct {
+	sync.RWMutex
+	ports map[string]*nbdb.LogicalSwitchPort
+}
+
+var _ cache.EventHandler = &podPortIndex{}
+
+func newPodPortIndex() *podPortIndex {
+	return &podPortIndex{
+		ports: map[string]*nbdb.LogicalSwitchPort{},
+	}
+}
+
+// getPortIPs returns the IPs of the given logical switch port if it is a pod
+// port.
+func getPortIPs(m model.Model) (*nbdb.LogicalSwitchPort, []string) {
+	lsp, ok := m.(*nbdb.LogicalSwitchPort)
+	if !ok || lsp.ExternalIDs["pod"] != "true" {
+		return nil, nil
+	}
+	var ips []string
+	for _, address := range lsp.Addresses {
+		// addresses are formatted as "<MAC> <IP>..."
+		fields := strings.Fields(address)
+		if len(fields) > 1 {
+			ips = append(ips, fields[1:]...)
+		}
+	}
+	return lsp, ips
+}
+
+func (i *podPortIndex) OnAdd(_ string, m model.Model) {
+	lsp, ips := getPortIPs(m)
+	if lsp == nil {
+		return
+	}
+	i.Lock()
+	defer i.Unlock()
+	for _, ip := range ips {
+		i.ports[ip] = lsp
+	}
+}
+
+func (i *podPortIndex) OnUpdate(table string, old, new model.Model) {
+	i.OnDelete(table, old)
+	i.OnAdd(table, new)
+}
+
+func (i *podPortIndex) OnDelete(_ string, m model.Model) {
+	lsp, ips := getPortIPs(m)
+	if lsp == nil {
+		return
+	}
+	i.Lock()
+	defer i.Unlock()
+	for _, ip := range ips {
+		// the IP may have been reassigned to another port meanwhile
+		if indexed, ok := i.ports[ip]; ok && indexed.UUID == lsp.UUID {
+			delete(i.ports, ip)
+		}
+	}
+}
+
+// get returns the pod logical switch port the given IP is assigned to.
+func (i *podPortIndex) get(ip string) *nbdb.LogicalSwitchPort {
+	i.RLock()
+	defer i.RUnlock()
+	return i.ports[ip]
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/ovn-org/libovsdb/cache"
 	"github.com/ovn-org/libovsdb/client"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/model"
@@ -14,12 +15,14 @@ import (
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/observability"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
 type SampleDecoder struct {
 	nbClient          client.Client
 	ovsdbClient       client.Client
+	podPorts          *podPortIndex
 	cleanupCollectors []int
 }
 
@@ -45,12 +48,12 @@ func getEndian() binary.ByteOrder {
 
 // getLocalNBClient only supports connecting to nbdb via unix socket.
 // address is the path to the unix socket, e.g. "/var/run/ovn/ovnnb_db.sock"
-func getLocalNBClient(ctx context.Context, address string) (client.Client, error) {
+func getLocalNBClient(ctx context.Context, address string, handlers ...cache.EventHandler) (client.Client, error) {
 	config := dbConfig{
 		address: "unix:" + address,
 		scheme:  "unix",
 	}
-	libovsdbOvnNBClient, err := NewNBClientWithConfig(ctx, config)
+	libovsdbOvnNBClient, err := NewNBClientWithConfig(ctx, config, handlers...)
 	if err != nil {
 		return nil, fmt.Errorf("error creating libovsdb client: %w ", err)
 	}
@@ -70,7 +73,8 @@ func getLocalOVSDBClient(ctx context.Context) (client.Client, error) {
 // If the default collector already exists with a different owner or different groupID an error will be returned.
 // Shutdown should be called to clean up the collector from the OVSDB.
 func NewSampleDecoderWithDefaultCollector(ctx context.Context, nbdbSocketPath string, ownerName string, groupID int) (*SampleDecoder, error) {
-	nbClient, err := getLocalNBClient(ctx, nbdbSocketPath)
+	podPorts := newPodPortIndex()
+	nbClient, err := getLocalNBClient(ctx, nbdbSocketPath, podPorts)
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +85,7 @@ func NewSampleDecoderWithDefaultCollector(ctx context.Context, nbdbSocketPath st
 	decoder := &SampleDecoder{
 		nbClient:    nbClient,
 		ovsdbClient: ovsdbClient,
+		podPorts:    podPorts,
 	}
 	err = decoder.AddCollector(observability.DefaultObservabilityCollectorSetID, groupID, ownerName)
 	if err != nil {
@@ -92,12 +97,14 @@ func NewSampleDecoderWithDefaultCollector(ctx context.Context, nbdbSocketPath st
 
 // NewSampleDecoder creates a new SampleDecoder and initializes the OVSDB client.
 func NewSampleDecoder(ctx context.Context, nbdbSocketPath string) (*SampleDecoder, error) {
-	nbClient, err := getLocalNBClient(ctx, nbdbSocketPath)
+	podPorts := newPodPortIndex()
+	nbClient, err := getLocalNBClient(ctx, nbdbSocketPath, podPorts)
 	if err != nil {
 		return nil, err
 	}
 	return &SampleDecoder{
 		nbClient: nbClient,
+		podPorts: podPorts,
 	}, nil
 }
 
@@ -121,7 +128,8 @@ func findACLBySample(nbClient client.Client, acl *nbdb.ACL) ([]*nbdb.ACL, error)
 	return found, err
 }
 
-func (d *SampleDecoder) DecodeCookieIDs(obsDomainID, obsPointID uint32) (model.NetworkEvent, error) {
+// findDBObject returns the db object the sample with the given IDs was generated for.
+func (d *SampleDecoder) findDBObject(obsDomainID, obsPointID uint32) (interface{}, error) {
 	// Find sample using obsPointID
 	sample, err := libovsdbops.FindSample(d.nbClient, int(obsPointID))
 	if err != nil || sample == nil {
@@ -155,10 +163,31 @@ func (d *SampleDecoder) DecodeCookieIDs(obsDomainID, obsPointID uint32) (model.N
 	default:
 		return nil, fmt.Errorf("unknown app ID: %d", getObservAppID(obsDomainID))
 	}
+	return dbObj, nil
+}
+
+// FindACL returns the ACL the sample with the given IDs was generated for.
+func (d *SampleDecoder) FindACL(obsDomainID, obsPointID uint32) (*nbdb.ACL, error) {
+	dbObj, err := d.findDBObject(obsDomainID, obsPointID)
+	if err != nil {
+		return nil, err
+	}
+	acl, ok := dbObj.(*nbdb.ACL)
+	if !ok {
+		return nil, fmt.Errorf("sample with obsDomainID=%d obsPointID=%d was not generated for an ACL", obsDomainID, obsPointID)
+	}
+	return acl, nil
+}
+
+func (d *SampleDecoder) DecodeCookieIDs(obsDomainID, obsPointID uint32) (model.NetworkEvent, error) {
+	dbObj, err := d.findDBObject(obsDomainID, obsPointID)
+	if err != nil {
+		return nil, err
+	}
 	var event model.NetworkEvent
 	switch o := dbObj.(type) {
 	case *nbdb.ACL:
-		event, err = newACLEvent(o)
+		event, err = newNetworkEvent(o)
 		if err != nil {
 			return nil, fmt.Errorf("failed to build ACL network event: %w", err)
 		}
@@ -169,6 +198,23 @@ func (d *SampleDecoder) DecodeCookieIDs(obsDomainID, obsPointID uint32) (model.N
 	return event, nil
 }
 
+// newNetworkEvent builds the network event of the given ACL. The sample points
+// of the features that are not ACL-based are ACLs too, see
+// observability.Manager.RunSamplePoints.
+func newNetworkEvent(o *nbdb.ACL) (model.NetworkEvent, error) {
+	switch o.ExternalIDs[libovsdbops.OwnerTypeKey.String()] {
+	case libovsdbops.LoadBalancerOwnerType:
+		return newLoadBalancerEvent(o)
+	case libovsdbops.EgressIPOwnerType, libovsdbops.EgressServiceOwnerType:
+		return newNATEvent(o)
+	case libovsdbops.APBRouteOwnerType:
+		return &model.RerouteEvent{
+			NextHop: o.ExternalIDs[libovsdbops.ObjectNameKey.String()],
+		}, nil
+	}
+	return newACLEvent(o)
+}
+
 func newACLEvent(o *nbdb.ACL) (*model.ACLEvent, error) {
 	actor := o.ExternalIDs[libovsdbops.OwnerTypeKey.String()]
 	event := model.ACLEvent{
@@ -205,6 +251,57 @@ func newACLEvent(o *nbdb.ACL) (*model.ACLEvent, error) {
 	return &event, nil
 }
 
+func newLoadBalancerEvent(o *nbdb.ACL) (*model.LoadBalancerEvent, error) {
+	objName := o.ExternalIDs[libovsdbops.ObjectNameKey.String()]
+	namespace, name, err := libovsdbops.ParseNamespaceNameKey(objName)
+	if err != nil {
+		return nil, err
+	}
+	return &model.LoadBalancerEvent{
+		Namespace: namespace,
+		Name:      name,
+	}, nil
+}
+
+func newNATEvent(o *nbdb.ACL) (*model.NATEvent, error) {
+	actor := o.ExternalIDs[libovsdbops.OwnerTypeKey.String()]
+	objName := o.ExternalIDs[libovsdbops.ObjectNameKey.String()]
+	event := model.NATEvent{
+		Actor: actor,
+		Name:  objName,
+	}
+	if actor == libovsdbops.EgressServiceOwnerType {
+		namespace, name, err := libovsdbops.ParseNamespaceNameKey(objName)
+		if err != nil {
+			return nil, err
+		}
+		event.Namespace = namespace
+		event.Name = name
+	}
+	return &event, nil
+}
+
+// EnrichEndpoint sets the pod, namespace and network of the given endpoint if
+// its IP is assigned to a local pod logical switch port.
+func (d *SampleDecoder) EnrichEndpoint(e *model.Endpoint) {
+	if e.IP == "" {
+		return
+	}
+	if lsp := d.podPorts.get(e.IP); lsp != nil {
+		setEndpointPod(e, lsp)
+	}
+}
+
+func setEndpointPod(e *model.Endpoint, lsp *nbdb.LogicalSwitchPort) {
+	e.Namespace = lsp.ExternalIDs["namespace"]
+	// logical port name is [<network prefix>]<namespace>_<pod>, pod names can't contain "_"
+	e.Pod = lsp.Name[strings.LastIndex(lsp.Name, "_")+1:]
+	e.Network = lsp.ExternalIDs[types.NetworkExternalID]
+	if e.Network == "" {
+		e.Network = types.DefaultNetworkName
+	}
+}
+
 func (d *SampleDecoder) DecodeCookieBytes(cookie []byte) (model.NetworkEvent, error) {
 	if uint64(len(cookie)) != CookieSize {
 		return nil, fmt.Errorf("invalid cookie size: %d", len(cookie))
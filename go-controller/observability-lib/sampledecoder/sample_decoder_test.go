@@ -6,9 +6,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/model"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 )
 
 func TestCreateOrUpdateACL(t *testing.T) {
@@ -66,3 +68,122 @@ func TestCreateOrUpdateACL(t *testing.T) {
 	assert.Equal(t, "Allowed by default allow from local node policy, direction Ingress", event.String())
 	assert.Equal(t, "Ingress", event.Direction)
 }
+
+func TestNewNetworkEvent(t *testing.T) {
+	event, err := newNetworkEvent(&nbdb.ACL{
+		Action: nbdb.ACLActionPass,
+		ExternalIDs: map[string]string{
+			libovsdbops.OwnerTypeKey.String():  libovsdbops.LoadBalancerOwnerType,
+			libovsdbops.ObjectNameKey.String(): "foo",
+		},
+	})
+	require.ErrorContains(t, err, "foo")
+	assert.Nil(t, event)
+
+	event, err = newNetworkEvent(&nbdb.ACL{
+		Action: nbdb.ACLActionPass,
+		ExternalIDs: map[string]string{
+			libovsdbops.OwnerTypeKey.String():  libovsdbops.LoadBalancerOwnerType,
+			libovsdbops.ObjectNameKey.String(): "bar:foo",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "DNATed by load balancer of service bar/foo", event.String())
+
+	event, err = newNetworkEvent(&nbdb.ACL{
+		Action: nbdb.ACLActionPass,
+		ExternalIDs: map[string]string{
+			libovsdbops.OwnerTypeKey.String():  libovsdbops.EgressIPOwnerType,
+			libovsdbops.ObjectNameKey.String(): "foo",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "SNATed by egress IP foo", event.String())
+
+	event, err = newNetworkEvent(&nbdb.ACL{
+		Action: nbdb.ACLActionPass,
+		ExternalIDs: map[string]string{
+			libovsdbops.OwnerTypeKey.String():  libovsdbops.EgressServiceOwnerType,
+			libovsdbops.ObjectNameKey.String(): "bar:foo",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "SNATed by egress service bar/foo", event.String())
+
+	event, err = newNetworkEvent(&nbdb.ACL{
+		Action: nbdb.ACLActionPass,
+		ExternalIDs: map[string]string{
+			libovsdbops.OwnerTypeKey.String():  libovsdbops.APBRouteOwnerType,
+			libovsdbops.ObjectNameKey.String(): "172.18.0.100",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Rerouted by external route to next hop 172.18.0.100", event.String())
+
+	event, err = newNetworkEvent(&nbdb.ACL{
+		Action: nbdb.ACLActionAllow,
+		ExternalIDs: map[string]string{
+			libovsdbops.OwnerTypeKey.String():  libovsdbops.EgressFirewallOwnerType,
+			libovsdbops.ObjectNameKey.String(): "foo",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Allowed by egress firewall in namespace foo", event.String())
+}
+
+func TestSetEndpointPod(t *testing.T) {
+	e := &model.Endpoint{IP: "10.244.0.5"}
+	setEndpointPod(e, &nbdb.LogicalSwitchPort{
+		Name:        "ns1_client-7d9f",
+		Addresses:   []string{"0a:58:0a:f4:00:05 10.244.0.5"},
+		ExternalIDs: map[string]string{"namespace": "ns1", "pod": "true"},
+	})
+	assert.Equal(t, model.Endpoint{IP: "10.244.0.5", Pod: "client-7d9f", Namespace: "ns1", Network: types.DefaultNetworkName}, *e)
+
+	e = &model.Endpoint{IP: "10.128.0.5"}
+	setEndpointPod(e, &nbdb.LogicalSwitchPort{
+		Name:        "tenant.blue_ns1_client-7d9f",
+		Addresses:   []string{"0a:58:0a:80:00:05 10.128.0.5"},
+		ExternalIDs: map[string]string{"namespace": "ns1", "pod": "true", types.NetworkExternalID: "tenant-blue"},
+	})
+	assert.Equal(t, model.Endpoint{IP: "10.128.0.5", Pod: "client-7d9f", Namespace: "ns1", Network: "tenant-blue"}, *e)
+}
+
+func TestPodPortIndex(t *testing.T) {
+	index := newPodPortIndex()
+	lsp := &nbdb.LogicalSwitchPort{
+		UUID:        "lsp-uuid",
+		Name:        "ns1_client-7d9f",
+		Addresses:   []string{"0a:58:0a:f4:00:05 10.244.0.5 fd00:10:244::5"},
+		ExternalIDs: map[string]string{"namespace": "ns1", "pod": "true"},
+	}
+	index.OnAdd(nbdb.LogicalSwitchPortTable, lsp)
+	index.OnAdd(nbdb.LogicalSwitchPortTable, &nbdb.LogicalSwitchPort{
+		UUID:      "router-port-uuid",
+		Name:      "stor-node1",
+		Addresses: []string{"router"},
+	})
+	assert.Equal(t, lsp, index.get("10.244.0.5"))
+	assert.Equal(t, lsp, index.get("fd00:10:244::5"))
+	assert.Nil(t, index.get("0a:58:0a:f4:00:05"))
+	assert.Len(t, index.ports, 2)
+
+	updated := lsp.DeepCopy()
+	updated.Addresses = []string{"0a:58:0a:f4:00:06 10.244.0.6"}
+	index.OnUpdate(nbdb.LogicalSwitchPortTable, lsp, updated)
+	assert.Nil(t, index.get("10.244.0.5"))
+	assert.Equal(t, updated, index.get("10.244.0.6"))
+
+	// a stale deletion doesn't remove the port the IP is now assigned to
+	index.OnAdd(nbdb.LogicalSwitchPortTable, &nbdb.LogicalSwitchPort{
+		UUID:        "new-lsp-uuid",
+		Name:        "ns1_server-5c8b",
+		Addresses:   []string{"0a:58:0a:f4:00:05 10.244.0.5"},
+		ExternalIDs: map[string]string{"namespace": "ns1", "pod": "true"},
+	})
+	index.OnDelete(nbdb.LogicalSwitchPortTable, lsp)
+	assert.Equal(t, "ns1_server-5c8b", index.get("10.244.0.5").Name)
+
+	index.OnDelete(nbdb.LogicalSwitchPortTable, updated)
+	assert.Nil(t, index.get("10.244.0.6"))
+}
@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"slices"
 	"sync"
 
 	iputils "github.com/containernetworking/plugins/pkg/ip"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
 
 	bitmapallocator "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/bitmap"
 	ipallocator "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip"
@@ -20,6 +23,7 @@ import (
 // identified by a name. Allocator should be threadsafe.
 type Allocator interface {
 	AddOrUpdateSubnet(name string, subnets []*net.IPNet, excludeSubnets ...*net.IPNet) error
+	AppendSubnets(name string, subnets []*net.IPNet, excludeSubnets ...*net.IPNet) error
 	DeleteSubnet(name string)
 	GetSubnets(name string) ([]*net.IPNet, error)
 	AllocateUntilFull(name string) error
@@ -58,6 +62,9 @@ type allocator struct {
 	// A RW mutex which holds subnet information
 	sync.RWMutex
 	ipamFunc ipamFactoryFunc
+	// perFamily allocates a single IP per IP family rather than one IP from
+	// each of the subnets
+	perFamily bool
 }
 
 // newIPAMAllocator provides an ipam interface which can be used for IPAM
@@ -79,6 +86,15 @@ func NewAllocator() *allocator {
 	}
 }
 
+// NewPerFamilyAllocator initializes a new subnet IP allocator that allocates a
+// single IP per IP family, out of the first subnet of that family that is not
+// full, rather than one IP from each of the subnets.
+func NewPerFamilyAllocator() *allocator {
+	allocator := NewAllocator()
+	allocator.perFamily = true
+	return allocator
+}
+
 // AddOrUpdateSubnet set to the allocator for IPAM management, or update it.
 func (allocator *allocator) AddOrUpdateSubnet(name string, subnets []*net.IPNet, excludeSubnets ...*net.IPNet) error {
 	allocator.Lock()
@@ -117,6 +133,50 @@ func (allocator *allocator) AddOrUpdateSubnet(name string, subnets []*net.IPNet,
 	return nil
 }
 
+// AppendSubnets adds subnets to the given subnet set, creating it if needed.
+// Unlike AddOrUpdateSubnet, the IPs already allocated from the subnets the set
+// already has are kept. The exclude subnets are only reserved on the appended
+// subnets.
+func (allocator *allocator) AppendSubnets(name string, subnets []*net.IPNet, excludeSubnets ...*net.IPNet) error {
+	allocator.Lock()
+	defer allocator.Unlock()
+	info := allocator.cache[name]
+	existing := sets.New[string]()
+	for _, subnet := range info.subnets {
+		existing.Insert(subnet.String())
+	}
+	var appended []*net.IPNet
+	var ipams []ipallocator.Interface
+	for _, subnet := range subnets {
+		if existing.Has(subnet.String()) {
+			continue
+		}
+		ipam, err := allocator.ipamFunc(subnet)
+		if err != nil {
+			return fmt.Errorf("failed to initialize IPAM of subnet %s for %s: %w", subnet, name, err)
+		}
+		for _, excludeSubnet := range excludeSubnets {
+			if util.ContainsCIDR(subnet, excludeSubnet) {
+				if err := reserveSubnets(excludeSubnet, ipam); err != nil {
+					return fmt.Errorf("failed to exclude subnet %s for %s: %w", excludeSubnet, name, err)
+				}
+			}
+		}
+		existing.Insert(subnet.String())
+		appended = append(appended, subnet)
+		ipams = append(ipams, ipam)
+	}
+	if len(appended) == 0 {
+		return nil
+	}
+	klog.Infof("Appending subnets %v to %s", util.StringSlice(appended), name)
+	allocator.cache[name] = subnetInfo{
+		subnets: append(slices.Clone(info.subnets), appended...),
+		ipams:   append(slices.Clone(info.ipams), ipams...),
+	}
+	return nil
+}
+
 // DeleteSubnet from the allocator
 func (allocator *allocator) DeleteSubnet(name string) {
 	allocator.Lock()
@@ -261,6 +321,14 @@ func (allocator *allocator) AllocateNextIPs(name string) ([]*net.IPNet, error) {
 		}
 	}()
 
+	if allocator.perFamily {
+		ipnets, err = allocateNextIPPerFamily(subnetInfo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to allocate new IPs for %s: %w", name, err)
+		}
+		return ipnets, nil
+	}
+
 	for idx, ipam := range subnetInfo.ipams {
 		ip, err = ipam.AllocateNext()
 		if err != nil {
@@ -278,6 +346,52 @@ func (allocator *allocator) AllocateNextIPs(name string) ([]*net.IPNet, error) {
 	return ipnets, nil
 }
 
+// allocateNextIPPerFamily allocates an IP of each of the IP families of the
+// subnet set, out of the first subnet of that family that is not full
+func allocateNextIPPerFamily(subnetInfo subnetInfo) ([]*net.IPNet, error) {
+	var ipnets []*net.IPNet
+	allocated := map[bool]int{}
+	full := map[bool]bool{}
+	for idx, ipam := range subnetInfo.ipams {
+		isIPv6 := utilnet.IsIPv6CIDR(subnetInfo.subnets[idx])
+		if _, ok := allocated[isIPv6]; ok {
+			continue
+		}
+		ip, err := ipam.AllocateNext()
+		if errors.Is(err, ipallocator.ErrFull) {
+			full[isIPv6] = true
+			continue
+		}
+		if err == nil {
+			allocated[isIPv6] = idx
+			ipnets = append(ipnets, &net.IPNet{IP: ip, Mask: subnetInfo.subnets[idx].Mask})
+			continue
+		}
+		releasePerFamilyIPs(subnetInfo, allocated, ipnets)
+		return nil, err
+	}
+	for isIPv6 := range full {
+		if _, ok := allocated[isIPv6]; !ok {
+			releasePerFamilyIPs(subnetInfo, allocated, ipnets)
+			return nil, ipallocator.ErrFull
+		}
+	}
+	// keep the IP families in the order of the first subnet set
+	if len(ipnets) > 1 && utilnet.IsIPv6(ipnets[0].IP) != utilnet.IsIPv6CIDR(subnetInfo.subnets[0]) {
+		slices.Reverse(ipnets)
+	}
+	return ipnets, nil
+}
+
+// releasePerFamilyIPs releases the IPs allocated per family by allocateNextIPPerFamily
+func releasePerFamilyIPs(subnetInfo subnetInfo, allocated map[bool]int, ipnets []*net.IPNet) {
+	for _, ipnet := range ipnets {
+		idx := allocated[utilnet.IsIPv6(ipnet.IP)]
+		subnetInfo.ipams[idx].Release(ipnet.IP)
+		klog.Warningf("Reserved IP %s was released", ipnet.IP)
+	}
+}
+
 // ReleaseIPs marks the IPs in ipnets slice as available for allocation by
 // releasing them from the IPAM pool of allocated IPs of the given subnet set.
 // If there aren't IPs to release the method does not return an error.
@@ -88,6 +88,28 @@ var _ = ginkgo.Describe("Subnet IP allocator operations", func() {
 			}
 		})
 
+		ginkgo.It("keeps the allocated IPs when appending subnets", func() {
+			err := allocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets("10.1.1.0/30"))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			ips, err := allocator.AllocateNextIPs(subnetName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(util.StringSlice(ips)).To(gomega.Equal([]string{"10.1.1.1/30"}))
+
+			err = allocator.AppendSubnets(subnetName, ovntest.MustParseIPNets("10.1.1.0/30", "10.1.2.0/24"), ovntest.MustParseIPNets("10.1.1.2/32", "10.1.2.1/32")...)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			subnets, err := allocator.GetSubnets(subnetName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(util.StringSlice(subnets)).To(gomega.Equal([]string{"10.1.1.0/30", "10.1.2.0/24"}))
+
+			// the IP allocated before is kept and the excluded IPs are only
+			// reserved on the appended subnet
+			err = allocator.AllocateIPPerSubnet(subnetName, ovntest.MustParseIPNets("10.1.1.1/30"))
+			gomega.Expect(err).To(gomega.MatchError(ipam.ErrAllocated))
+			ips, err = allocator.AllocateNextIPs(subnetName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(util.StringSlice(ips)).To(gomega.Equal([]string{"10.1.1.2/30", "10.1.2.2/24"}))
+		})
+
 	})
 
 	ginkgo.Context("when allocating IP addresses", func() {
@@ -218,6 +240,54 @@ var _ = ginkgo.Describe("Subnet IP allocator operations", func() {
 
 })
 
+var _ = ginkgo.Describe("Subnet per family IP allocator operations", func() {
+	const subnetName = "subnet1"
+	var (
+		allocator Allocator
+	)
+
+	ginkgo.BeforeEach(func() {
+		allocator = NewPerFamilyAllocator()
+	})
+
+	ginkgo.It("allocates an IP per family from the first subnet that is not full", func() {
+		subnets := []string{
+			"10.1.1.0/30",
+			"2000::/64",
+			"10.1.2.0/30",
+		}
+
+		expectedIPAllocations := [][]string{
+			{"10.1.1.1/30", "2000::1/64"},
+			{"10.1.1.2/30", "2000::2/64"},
+			{"10.1.2.1/30", "2000::3/64"},
+			{"10.1.2.2/30", "2000::4/64"},
+		}
+
+		err := allocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets(subnets...))
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		for _, expectedIPs := range expectedIPAllocations {
+			ips, err := allocator.AllocateNextIPs(subnetName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(util.StringSlice(ips)).To(gomega.Equal(expectedIPs))
+		}
+
+		// the IPv4 subnets are full, the IPv6 IP must be released
+		ips, err := allocator.AllocateNextIPs(subnetName)
+		gomega.Expect(err).To(gomega.MatchError(ipam.ErrFull))
+		gomega.Expect(ips).To(gomega.BeEmpty())
+		err = allocator.AllocateIPPerSubnet(subnetName, ovntest.MustParseIPNets("2000::5/64"))
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		// releasing an IP of the second subnet makes it available again
+		err = allocator.ReleaseIPs(subnetName, ovntest.MustParseIPNets("10.1.2.1/30"))
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		ips, err = allocator.AllocateNextIPs(subnetName)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(util.StringSlice(ips)).To(gomega.Equal([]string{"10.1.2.1/30", "2000::6/64"}))
+	})
+})
+
 func TestSubnetIPAllocator(t *testing.T) {
 	gomega.RegisterFailHandler(ginkgo.Fail)
 	ginkgo.RunSpecs(t, "Subnet IP allocator Operations Suite")
@@ -178,6 +178,13 @@ func (ncc *networkClusterController) init() error {
 		if err = ncc.tunnelIDAllocator.ReserveID("zero", types.NoTunnelID); err != nil {
 			return err
 		}
+		if util.DoesNetworkRequireRARouter(ncc.GetNetInfo()) {
+			// Reserve the id of the switch port of the router advertisements
+			// router, that every zone of the network uses
+			if err = ncc.tunnelIDAllocator.ReserveID(ncc.GetNetworkName()+"_ra_router", types.Layer2RARouterPortTunnelKey); err != nil {
+				return err
+			}
+		}
 		if util.IsNetworkSegmentationSupportEnabled() && ncc.IsPrimaryNetwork() {
 			// if the network is a primary L2 UDN network, then we need to reserve
 			// the IDs used by each node in this network's pod allocator
@@ -469,11 +476,32 @@ func (ncc *networkClusterController) Cleanup() error {
 
 func (ncc *networkClusterController) Reconcile(netInfo util.NetInfo) error {
 	reconcilePendingPods := !ncc.ReconcilableNetInfo.EqualNADs(netInfo.GetNADs()...)
+	reconcileClusterSubnets := !reflect.DeepEqual(ncc.Subnets(), netInfo.Subnets())
 	// update network information, point of no return
 	err := util.ReconcileNetInfo(ncc.ReconcilableNetInfo, netInfo)
 	if err != nil {
 		klog.Errorf("Failed to reconcile network %s: %v", ncc.GetNetworkName(), err)
 	}
+	if reconcileClusterSubnets && ncc.nodeAllocator != nil {
+		// subnets were appended to the network, make them available for node
+		// subnet allocation and retry nodes that might have failed for lack of
+		// them
+		if err := ncc.nodeAllocator.ReconcileClusterSubnets(); err != nil {
+			klog.Errorf("Failed to reconcile cluster subnets for network %s: %v", ncc.GetNetworkName(), err)
+		} else if ncc.retryNodes != nil {
+			ncc.retryNodes.RequestRetryObjs()
+		}
+	}
+	if reconcileClusterSubnets && ncc.subnetAllocator != nil {
+		// subnets were appended to the network, make them available for pod
+		// IP allocation and retry pods that might have failed for lack of them
+		ipNets, excludeSubnets := getIPAllocatorSubnets(ncc.GetNetInfo())
+		if err := ncc.subnetAllocator.AppendSubnets(ncc.GetNetworkName(), ipNets, excludeSubnets...); err != nil {
+			klog.Errorf("Failed to reconcile pod subnets for network %s: %v", ncc.GetNetworkName(), err)
+		} else if ncc.retryPods != nil {
+			ncc.retryPods.RequestRetryObjs()
+		}
+	}
 	if reconcilePendingPods && ncc.retryPods != nil {
 		if err := objretry.RequeuePendingPods(ncc.watchFactory, ncc.GetNetInfo(), ncc.retryPods); err != nil {
 			klog.Errorf("Failed to requeue pending pods for network %s: %v", ncc.GetNetworkName(), err)
@@ -774,7 +802,27 @@ func (h *networkClusterControllerEventHandler) clearInitialNodeNetworkUnavailabl
 // subnets / excluded subnets provided in `netInfo`
 func newIPAllocatorForNetwork(netInfo util.NetInfo) (subnet.Allocator, error) {
 	ipAllocator := subnet.NewAllocator()
+	if isLayer2UserDefinedPrimaryNetwork(netInfo) {
+		// pods get a single IP per IP family on primary networks, even if
+		// subnets of the same family were appended to the network
+		ipAllocator = subnet.NewPerFamilyAllocator()
+	}
+
+	ipNets, excludeSubnets := getIPAllocatorSubnets(netInfo)
+	if err := ipAllocator.AddOrUpdateSubnet(
+		netInfo.GetNetworkName(),
+		ipNets,
+		excludeSubnets...,
+	); err != nil {
+		return nil, err
+	}
+
+	return ipAllocator, nil
+}
 
+// getIPAllocatorSubnets returns the subnets of the IP allocator of the network
+// along with the subnets to exclude from them
+func getIPAllocatorSubnets(netInfo util.NetInfo) ([]*net.IPNet, []*net.IPNet) {
 	subnets := netInfo.Subnets()
 	ipNets := make([]*net.IPNet, 0, len(subnets))
 	excludeSubnets := netInfo.ExcludeSubnets()
@@ -787,16 +835,7 @@ func newIPAllocatorForNetwork(netInfo util.NetInfo) (subnet.Allocator, error) {
 			)
 		}
 	}
-
-	if err := ipAllocator.AddOrUpdateSubnet(
-		netInfo.GetNetworkName(),
-		ipNets,
-		excludeSubnets...,
-	); err != nil {
-		return nil, err
-	}
-
-	return ipAllocator, nil
+	return ipNets, excludeSubnets
 }
 
 func isLayer2UserDefinedPrimaryNetwork(netInfo util.NetInfo) bool {
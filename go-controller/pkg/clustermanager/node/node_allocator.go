@@ -9,6 +9,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
@@ -50,6 +51,10 @@ type NodeAllocator struct {
 
 	// nodeSubnets is a list of node subnets that are managed by the cluster subnet allocator
 	nodeSubnets []*net.IPNet
+
+	// clusterSubnets tracks the cluster subnets added to the cluster subnet
+	// allocator
+	clusterSubnets sets.Set[string]
 }
 
 func NewNodeAllocator(networkID int, netInfo util.NetInfo, nodeLister listers.NodeLister, kube kube.Interface, tunnelIDAllocator id.Allocator) *NodeAllocator {
@@ -97,13 +102,8 @@ func (na *NodeAllocator) Init() error {
 		return nil
 	}
 
-	clusterSubnets := na.netInfo.Subnets()
-
-	for _, clusterSubnet := range clusterSubnets {
-		if err := na.clusterSubnetAllocator.AddNetworkRange(clusterSubnet.CIDR, clusterSubnet.HostSubnetLength); err != nil {
-			return err
-		}
-		klog.V(5).Infof("Added network range %s to cluster subnet allocator", clusterSubnet.CIDR)
+	if err := na.addClusterSubnets(); err != nil {
+		return err
 	}
 
 	if na.hasHybridOverlayAllocation() {
@@ -121,6 +121,41 @@ func (na *NodeAllocator) Init() error {
 	return nil
 }
 
+// ReconcileClusterSubnets makes the cluster subnets appended to the network
+// since initialization available for node subnet allocation.
+func (na *NodeAllocator) ReconcileClusterSubnets() error {
+	if !na.hasNodeSubnetAllocation() {
+		return nil
+	}
+
+	if err := na.addClusterSubnets(); err != nil {
+		return err
+	}
+
+	na.recordSubnetCount()
+
+	return nil
+}
+
+// addClusterSubnets adds the network cluster subnets not yet added to the
+// cluster subnet allocator
+func (na *NodeAllocator) addClusterSubnets() error {
+	if na.clusterSubnets == nil {
+		na.clusterSubnets = sets.New[string]()
+	}
+	for _, clusterSubnet := range na.netInfo.Subnets() {
+		if na.clusterSubnets.Has(clusterSubnet.String()) {
+			continue
+		}
+		if err := na.clusterSubnetAllocator.AddNetworkRange(clusterSubnet.CIDR, clusterSubnet.HostSubnetLength); err != nil {
+			return err
+		}
+		na.clusterSubnets.Insert(clusterSubnet.String())
+		klog.V(5).Infof("Added network range %s to cluster subnet allocator", clusterSubnet.CIDR)
+	}
+	return nil
+}
+
 func (na *NodeAllocator) hasHybridOverlayAllocation() bool {
 	// When config.HybridOverlay.ClusterSubnets is empty, assume the subnet allocation will be managed by an external component.
 	return config.HybridOverlay.Enabled && !na.netInfo.IsSecondary() && len(config.HybridOverlay.ClusterSubnets) > 0
@@ -396,6 +396,66 @@ func TestController_allocateNodeSubnets_ReleaseOnError(t *testing.T) {
 	}
 }
 
+func TestController_ReconcileClusterSubnets(t *testing.T) {
+	netConf := &ovncnitypes.NetConf{
+		NetConf:  cnitypes.NetConf{Name: "l3-network"},
+		Topology: types.Layer3Topology,
+		Subnets:  "10.1.0.0/23/24",
+	}
+	netInfo, err := util.NewNetInfo(netConf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mutableNetInfo := util.NewMutableNetInfo(netInfo)
+
+	na := &NodeAllocator{
+		netInfo:                mutableNetInfo,
+		clusterSubnetAllocator: NewSubnetAllocator(),
+		nodeLister:             newFakeNodeLister([]*corev1.Node{}),
+	}
+
+	if err := na.Init(); err != nil {
+		t.Fatalf("Failed to initialize node allocator: %v", err)
+	}
+
+	for _, node := range []string{"node1", "node2"} {
+		if _, _, err := na.allocateNodeSubnets(na.clusterSubnetAllocator, node, nil, true, false); err != nil {
+			t.Fatalf("allocateNodeSubnets() expected no error for node %s but got: %v", node, err)
+		}
+	}
+	if _, _, err := na.allocateNodeSubnets(na.clusterSubnetAllocator, "node3", nil, true, false); err == nil {
+		t.Fatalf("allocateNodeSubnets() expected error on exhausted cluster subnets but got success")
+	}
+
+	netConf.Subnets = "10.1.0.0/23/24,10.2.0.0/23/24"
+	updatedNetInfo, err := util.NewNetInfo(netConf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := util.ReconcileNetworkConfig(mutableNetInfo, updatedNetInfo); err != nil {
+		t.Fatal(err)
+	}
+	if err := na.ReconcileClusterSubnets(); err != nil {
+		t.Fatalf("ReconcileClusterSubnets() expected no error but got: %v", err)
+	}
+	// reconciling again should not add the same ranges twice
+	if err := na.ReconcileClusterSubnets(); err != nil {
+		t.Fatalf("ReconcileClusterSubnets() expected no error but got: %v", err)
+	}
+	if v4count, _ := na.clusterSubnetAllocator.Count(); v4count != 4 {
+		t.Fatalf("Expected 4 v4 subnets available, but got %d", v4count)
+	}
+
+	got, _, err := na.allocateNodeSubnets(na.clusterSubnetAllocator, "node3", nil, true, false)
+	if err != nil {
+		t.Fatalf("allocateNodeSubnets() expected no error but got: %v", err)
+	}
+	want := ovntest.MustParseIPNets("10.2.0.0/24")
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("allocateNodeSubnets() = %v, want %v", got, want)
+	}
+}
+
 func newFakeNodeLister(nodes []*corev1.Node) v1.NodeLister {
 	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
 	for _, node := range nodes {
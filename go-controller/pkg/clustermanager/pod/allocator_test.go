@@ -86,6 +86,10 @@ func (a *ipAllocatorStub) AddOrUpdateSubnet(string, []*net.IPNet, ...*net.IPNet)
 	panic("not implemented") // TODO: Implement
 }
 
+func (a *ipAllocatorStub) AppendSubnets(string, []*net.IPNet, ...*net.IPNet) error {
+	panic("not implemented") // TODO: Implement
+}
+
 func (a ipAllocatorStub) DeleteSubnet(string) {
 	panic("not implemented") // TODO: Implement
 }
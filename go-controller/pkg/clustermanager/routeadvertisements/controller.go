@@ -18,6 +18,7 @@ import (
 	frrlisters "github.com/metallb/frr-k8s/pkg/client/listers/api/v1beta1"
 
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -25,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	metaapply "k8s.io/client-go/applyconfigurations/meta/v1"
 	corelisters "k8s.io/client-go/listers/core/v1"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
@@ -68,6 +70,8 @@ type Controller struct {
 	nodeLister      corelisters.NodeLister
 	raLister        ralisters.RouteAdvertisementsLister
 	namespaceLister corelisters.NamespaceLister
+	serviceLister   corelisters.ServiceLister
+	epsLister       discoverylisters.EndpointSliceLister
 
 	frrClient frrclientset.Interface
 	nadClient nadclientset.Interface
@@ -79,6 +83,8 @@ type Controller struct {
 	nodeController controllerutil.Controller
 	raController   controllerutil.Controller
 	nsController   controllerutil.Controller
+	svcController  controllerutil.Controller
+	epsController  controllerutil.Controller
 
 	nm networkmanager.Interface
 }
@@ -97,6 +103,8 @@ func NewController(
 		nodeLister:      wf.NodeCoreInformer().Lister(),
 		raLister:        wf.RouteAdvertisementsInformer().Lister(),
 		namespaceLister: wf.NamespaceInformer().Lister(),
+		serviceLister:   wf.ServiceCoreInformer().Lister(),
+		epsLister:       wf.EndpointSliceCoreInformer().Lister(),
 		frrClient:       ovnClient.FRRClient,
 		nadClient:       ovnClient.NetworkAttchDefClient,
 		raClient:        ovnClient.RouteAdvertisementsClient,
@@ -180,6 +188,26 @@ func NewController(
 	}
 	c.nsController = controllerutil.NewController("clustermanager routeadvertisements namespace controller", nsConfig)
 
+	svcConfig := &controllerutil.ControllerConfig[corev1.Service]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Reconcile:      c.reconcileServices,
+		Threadiness:    1,
+		Informer:       wf.ServiceCoreInformer().Informer(),
+		Lister:         wf.ServiceCoreInformer().Lister().List,
+		ObjNeedsUpdate: serviceNeedsUpdate,
+	}
+	c.svcController = controllerutil.NewController("clustermanager routeadvertisements service controller", svcConfig)
+
+	epsConfig := &controllerutil.ControllerConfig[discoveryv1.EndpointSlice]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Reconcile:      c.reconcileServices,
+		Threadiness:    1,
+		Informer:       wf.EndpointSliceCoreInformer().Informer(),
+		Lister:         wf.EndpointSliceCoreInformer().Lister().List,
+		ObjNeedsUpdate: c.endpointSliceNeedsUpdate,
+	}
+	c.epsController = controllerutil.NewController("clustermanager routeadvertisements endpointslice controller", epsConfig)
+
 	return c
 }
 
@@ -187,10 +215,12 @@ func (c *Controller) Start() error {
 	defer klog.Infof("Cluster manager routeadvertisements started")
 	return controllerutil.Start(
 		c.eipController,
+		c.epsController,
 		c.frrController,
 		c.nadController,
 		c.nodeController,
 		c.nsController,
+		c.svcController,
 		c.raController,
 	)
 }
@@ -198,10 +228,12 @@ func (c *Controller) Start() error {
 func (c *Controller) Stop() {
 	controllerutil.Stop(
 		c.eipController,
+		c.epsController,
 		c.frrController,
 		c.nadController,
 		c.nodeController,
 		c.nsController,
+		c.svcController,
 		c.raController,
 	)
 	klog.Infof("Cluster manager routeadvertisements stopped")
@@ -245,9 +277,24 @@ func (c *Controller) ReconcileNetwork(_ string, old, new util.NetInfo) {
 // VRFs. Selected EgressIP are those that serve the same namespaces as the
 // selected networks. Target VRF `auto` is not supported for EgressIPs.
 //
+// - If Services advertisements are enabled, the generated FRRConfiguration
+// will announce from the node the ClusterIPs, ExternalIPs and LoadBalancer IPs
+// of the services in the namespaces served by the selected networks on the
+// matching target VRFs. ExternalIPs and LoadBalancer IPs of services with
+// ExternalTrafficPolicy=Local are only announced from nodes with local
+// endpoints.
+//
 // - If pod network advertisements are enabled, the generated FRRConfiguration
 // will import the target VRFs on the selected networks as required.
 //
+// - If prefix aggregation is enabled, the generated FRRConfiguration will
+// announce from the node the selected Layer3 network subnets instead of the
+// subnets specific to that node.
+//
+// - The advertised prefixes will be tagged with the RouteAdvertisements BGP
+// communities and with the BGP local preference that applies to the node, if
+// any.
+//
 // - The generated FRRConfiguration will be labeled with the RouteAdvertisements
 // name and annotated with an internal key to facilitate updating it when
 // needed.
@@ -259,7 +306,8 @@ func (c *Controller) ReconcileNetwork(_ string, old, new util.NetInfo) {
 // Finally, it will update the status of the RouteAdvertisements.
 //
 // The controller processes selected events of RouteAdvertisements,
-// FRRConfigurations, Nodes, EgressIPs, NADs and namespaces.
+// FRRConfigurations, Nodes, EgressIPs, NADs, namespaces, Services and
+// EndpointSlices.
 func (c *Controller) reconcile(name string) error {
 	startTime := time.Now()
 	klog.V(5).Infof("Syncing routeadvertisements %q", name)
@@ -324,6 +372,8 @@ type selectedNetworks struct {
 	prefixLength map[string]uint32
 	// networkType is a map of selected network to their topology
 	networkTopology map[string]string
+	// networkVNIs is a map of selected network to their EVPN L3 VNI
+	networkVNIs map[string]int32
 }
 
 // generateFRRConfigurations generates FRRConfigurations for the route
@@ -355,6 +405,13 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 		networkSubnets:  map[string][]string{},
 		prefixLength:    map[string]uint32{},
 		networkTopology: map[string]string{},
+		networkVNIs:     map[string]int32{},
+	}
+	evpnVNIs := map[string]int32{}
+	if ra.Spec.EVPN != nil {
+		for _, vni := range ra.Spec.EVPN.VNIs {
+			evpnVNIs[util.GenerateCUDNNetworkName(vni.Network)] = vni.VNI
+		}
 	}
 	for _, nad := range nads {
 		networkName := util.GetAnnotatedNetworkName(nad)
@@ -381,6 +438,17 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 			return nil, nil, fmt.Errorf("%w: EgressIP advertisement is currently not supported for Layer2 networks, network: %s", errConfig, network.GetNetworkName())
 		}
 
+		if ra.Spec.EVPN != nil {
+			if network.IsDefault() {
+				return nil, nil, fmt.Errorf("%w: EVPN advertisement is not supported for the default network", errConfig)
+			}
+			vni, hasVNI := evpnVNIs[networkName]
+			if !hasVNI {
+				return nil, nil, fmt.Errorf("%w: selected network %q has no EVPN VNI assigned", errConfig, networkName)
+			}
+			selectedNetworks.networkVNIs[networkName] = vni
+		}
+
 		vrf := util.GetNetworkVRFName(network)
 		if vfrNet, hasVFR := selectedNetworks.networkVRFs[vrf]; hasVFR && vfrNet != networkName {
 			return nil, nil, fmt.Errorf("%w: vrf %q found to be mapped to multiple networks %v", errConfig, vrf, []string{vfrNet, networkName})
@@ -495,18 +563,32 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 		return eipsByNodesByNetworks[nodeName], nil
 	}
 
+	// helper to gather service IPs and cache during reconcile
+	var svcIPsByNodesByNetworks map[string]map[string]sets.Set[string]
+	getServiceIPsByNode := func(nodeName string) (map[string]sets.Set[string], error) {
+		if svcIPsByNodesByNetworks == nil {
+			svcIPsByNodesByNetworks, err = c.getServiceIPsByNodesByNetworks(networkSet, sets.KeySet(nodeToFRRConfig))
+			if err != nil {
+				return nil, err
+			}
+		}
+		return svcIPsByNodesByNetworks[nodeName], nil
+	}
+
 	// helper to gather the following prefixes:
 	//  - EgressIPs
+	//  - Service IPs
 	//  - host subnets for networks with networkTopology layer3
-	//  - network subnets for networks with networkTopology layer2
+	//  - network subnets for networks with networkTopology layer2 or layer3
+	//    if prefixes are aggregated
 	getPrefixes := func(nodeName, network, networkTopology string, networkSubnets []string) ([]string, error) {
 		// gather host subnets
 		var subnets []string
 		if advertisements.Has(ratypes.PodNetwork) {
-			if networkTopology == types.Layer2Topology {
+			if networkTopology == types.Layer2Topology || ra.Spec.AggregatePrefixes {
 				subnets = networkSubnets
 				if len(subnets) == 0 {
-					return nil, fmt.Errorf("%w: no layer2 subnets found", errConfig)
+					return nil, fmt.Errorf("%w: no %s subnets found for network %q", errConfig, networkTopology, network)
 				}
 			} else {
 				subnets, err = getHostSubnets(nodeName, network)
@@ -525,10 +607,20 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 			}
 			eips = eipsByNode[network].UnsortedList()
 		}
+		// gather service IPs
+		var svcIPs []string
+		if advertisements.Has(ratypes.Services) {
+			svcIPsByNode, err := getServiceIPsByNode(nodeName)
+			if err != nil {
+				return nil, err
+			}
+			svcIPs = svcIPsByNode[network].UnsortedList()
+		}
 
-		prefixes := make([]string, 0, len(subnets)+len(eips))
+		prefixes := make([]string, 0, len(subnets)+len(eips)+len(svcIPs))
 		prefixes = append(prefixes, subnets...)
 		prefixes = append(prefixes, eips...)
+		prefixes = append(prefixes, svcIPs...)
 		return prefixes, nil
 	}
 
@@ -546,8 +638,8 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 				return nil, nil, err
 			}
 			selectedNetworks.hostSubnets = append(selectedNetworks.hostSubnets, selectedNetworks.hostNetworkSubnets[network]...)
-			// ordered
-			slices.Sort(selectedNetworks.hostNetworkSubnets[network])
+			// ordered, dedup
+			selectedNetworks.hostNetworkSubnets[network] = sets.List(sets.New(selectedNetworks.hostNetworkSubnets[network]...))
 		}
 		// order, dedup
 		selectedNetworks.hostSubnets = sets.List(sets.New(selectedNetworks.hostSubnets...))
@@ -557,6 +649,11 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 			continue
 		}
 
+		localPreference, err := c.getLocalPreference(ra, nodeName)
+		if err != nil {
+			return nil, nil, err
+		}
+
 		matchedNetworks := sets.New[string]()
 		for _, frrConfig := range frrConfigs {
 			// generate FRRConfiguration for each source FRRConfiguration/node combination
@@ -566,6 +663,7 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 				nodeName,
 				selectedNetworks,
 				matchedNetworks,
+				localPreference,
 			)
 			if err != nil {
 				return nil, nil, err
@@ -586,16 +684,45 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 	return generated, nads, nil
 }
 
+// getLocalPreference returns the BGP local preference of the first entry of
+// the RouteAdvertisements local preferences that selects the node, nil if
+// none does.
+func (c *Controller) getLocalPreference(ra *ratypes.RouteAdvertisements, nodeName string) (*uint32, error) {
+	if len(ra.Spec.LocalPreferences) == 0 {
+		return nil, nil
+	}
+	node, err := c.nodeLister.Get(nodeName)
+	if err != nil {
+		return nil, err
+	}
+	for _, localPreference := range ra.Spec.LocalPreferences {
+		nodeSelector, err := metav1.LabelSelectorAsSelector(&localPreference.NodeSelector)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid local preference node selector: %w", errConfig, err)
+		}
+		if nodeSelector.Matches(labels.Set(node.Labels)) {
+			return &localPreference.Value, nil
+		}
+	}
+	return nil, nil
+}
+
 // generateFRRConfiguration generates a FRRConfiguration from a source for a
 // specific node. Also fills matchedNetworks with the networks that have a VRF
-// that matched any router VRF of the FRRConfiguration.
+// that matched any router VRF of the FRRConfiguration. The advertised prefixes
+// are tagged with the provided local preference, if not nil.
 func (c *Controller) generateFRRConfiguration(
 	ra *ratypes.RouteAdvertisements,
 	source *frrtypes.FRRConfiguration,
 	nodeName string,
 	selectedNetworks *selectedNetworks,
 	matchedNetworks sets.Set[string],
+	localPreference *uint32,
 ) (*frrtypes.FRRConfiguration, error) {
+	if ra.Spec.EVPN != nil {
+		return generateEVPNFRRConfiguration(ra, source, nodeName, selectedNetworks, matchedNetworks), nil
+	}
+
 	routers := []frrtypes.Router{}
 	advertisements := sets.New(ra.Spec.Advertisements...)
 
@@ -684,6 +811,22 @@ func (c *Controller) generateFRRConfiguration(
 					Prefixes: advertisePrefixes,
 				},
 			}
+			for _, community := range ra.Spec.Communities {
+				neighbor.ToAdvertise.PrefixesWithCommunity = append(neighbor.ToAdvertise.PrefixesWithCommunity,
+					frrtypes.CommunityPrefixes{
+						Prefixes:  advertisePrefixes,
+						Community: string(community),
+					},
+				)
+			}
+			if localPreference != nil {
+				neighbor.ToAdvertise.PrefixesWithLocalPref = []frrtypes.LocalPrefPrefixes{
+					{
+						Prefixes:  advertisePrefixes,
+						LocalPref: *localPreference,
+					},
+				}
+			}
 			neighbor.ToReceive = frrtypes.Receive{
 				Allowed: frrtypes.AllowedInPrefixes{
 					Mode: frrtypes.AllowRestricted,
@@ -755,6 +898,115 @@ func (c *Controller) generateFRRConfiguration(
 		return nil, nil
 	}
 
+	return newGeneratedFRRConfiguration(ra, source, nodeName, routers), nil
+}
+
+// generateEVPNFRRConfiguration generates a FRRConfiguration from a source for
+// a specific node that advertises the prefixes of each selected network as
+// EVPN route type-5 with the network L3 VNI. The EVPN sessions are established
+// with the neighbors of the source router on the default VRF, and a router is
+// added for the VRF of each selected network which are then mapped to their L3
+// VNI through raw FRR configuration as the FRRConfiguration API has no EVPN
+// support. Also fills matchedNetworks with the selected networks if the source
+// has a router on the default VRF.
+func generateEVPNFRRConfiguration(
+	ra *ratypes.RouteAdvertisements,
+	source *frrtypes.FRRConfiguration,
+	nodeName string,
+	selectedNetworks *selectedNetworks,
+	matchedNetworks sets.Set[string],
+) *frrtypes.FRRConfiguration {
+	var underlay *frrtypes.Router
+	for i := range source.Spec.BGP.Routers {
+		if source.Spec.BGP.Routers[i].VRF == "" {
+			underlay = &source.Spec.BGP.Routers[i]
+			break
+		}
+	}
+	if underlay == nil || len(underlay.Neighbors) == 0 {
+		return nil
+	}
+
+	// the underlay router does not advertise nor receive any prefix other
+	// than through the EVPN address family
+	underlayRouter := frrtypes.Router{
+		ASN:       underlay.ASN,
+		ID:        underlay.ID,
+		Neighbors: make([]frrtypes.Neighbor, 0, len(underlay.Neighbors)),
+	}
+	for _, neighbor := range underlay.Neighbors {
+		neighbor.ToAdvertise = frrtypes.Advertise{
+			Allowed: frrtypes.AllowedOutPrefixes{
+				Mode: frrtypes.AllowRestricted,
+			},
+		}
+		neighbor.ToReceive = frrtypes.Receive{
+			Allowed: frrtypes.AllowedInPrefixes{
+				Mode: frrtypes.AllowRestricted,
+			},
+		}
+		underlayRouter.Neighbors = append(underlayRouter.Neighbors, neighbor)
+	}
+	routers := []frrtypes.Router{underlayRouter}
+
+	rawConfig := &strings.Builder{}
+	for _, network := range selectedNetworks.networks { // ordered
+		prefixes := selectedNetworks.hostNetworkSubnets[network]
+		if len(prefixes) == 0 {
+			continue
+		}
+		var vrf string
+		for networkVRF, vrfNetwork := range selectedNetworks.networkVRFs {
+			if vrfNetwork == network {
+				vrf = networkVRF
+				break
+			}
+		}
+		matchedNetworks.Insert(network)
+		routers = append(routers, frrtypes.Router{
+			ASN:      underlay.ASN,
+			ID:       underlay.ID,
+			VRF:      vrf,
+			Prefixes: prefixes,
+		})
+
+		fmt.Fprintf(rawConfig, "vrf %s\n vni %d\nexit-vrf\n!\n", vrf, selectedNetworks.networkVNIs[network])
+		fmt.Fprintf(rawConfig, "router bgp %d vrf %s\n address-family l2vpn evpn\n", underlay.ASN, vrf)
+		if len(util.MatchAllIPNetsStringFamily(false, prefixes)) > 0 {
+			rawConfig.WriteString("  advertise ipv4 unicast\n")
+		}
+		if len(util.MatchAllIPNetsStringFamily(true, prefixes)) > 0 {
+			rawConfig.WriteString("  advertise ipv6 unicast\n")
+		}
+		rawConfig.WriteString(" exit-address-family\nexit\n!\n")
+	}
+	if len(routers) == 1 {
+		// no network to advertise
+		return nil
+	}
+
+	fmt.Fprintf(rawConfig, "router bgp %d\n address-family l2vpn evpn\n", underlay.ASN)
+	for _, neighbor := range underlay.Neighbors {
+		fmt.Fprintf(rawConfig, "  neighbor %s activate\n", neighbor.Address)
+	}
+	rawConfig.WriteString("  advertise-all-vni\n exit-address-family\nexit\n")
+
+	new := newGeneratedFRRConfiguration(ra, source, nodeName, routers)
+	if new.Spec.Raw.Config != "" {
+		new.Spec.Raw.Config += "\n"
+	}
+	new.Spec.Raw.Config += rawConfig.String()
+	return new
+}
+
+// newGeneratedFRRConfiguration returns a FRRConfiguration generated from a
+// source for a specific node with the provided routers.
+func newGeneratedFRRConfiguration(
+	ra *ratypes.RouteAdvertisements,
+	source *frrtypes.FRRConfiguration,
+	nodeName string,
+	routers []frrtypes.Router,
+) *frrtypes.FRRConfiguration {
 	new := &frrtypes.FRRConfiguration{}
 	new.GenerateName = generateName
 	new.Namespace = source.Namespace
@@ -778,7 +1030,7 @@ func (c *Controller) generateFRRConfiguration(
 		},
 	}
 
-	return new, nil
+	return new
 }
 
 // updateFRRConfigurations updates the FRRConfigurations that apply for a
@@ -1136,6 +1388,63 @@ func (c *Controller) getEgressIPsByNodesByNetworks(networks sets.Set[string]) (m
 	return eipsByNodesByNetworks, nil
 }
 
+// getServiceIPsByNodesByNetworks iterates all existing services in namespaces
+// served by any of the provided networks and returns a "node -> network ->
+// service IPs" map for the provided nodes. ClusterIPs are mapped to all nodes
+// while ExternalIPs and LoadBalancer IPs of services with
+// ExternalTrafficPolicy=Local are only mapped to nodes with local eligible
+// endpoints.
+func (c *Controller) getServiceIPsByNodesByNetworks(networks, nodes sets.Set[string]) (map[string]map[string]sets.Set[string], error) {
+	svcIPsByNodesByNetworks := map[string]map[string]sets.Set[string]{}
+	addServiceIPsByNodesByNetwork := func(ips []string, nodes sets.Set[string], network string) {
+		for node := range nodes {
+			if svcIPsByNodesByNetworks[node] == nil {
+				svcIPsByNodesByNetworks[node] = map[string]sets.Set[string]{}
+			}
+			if svcIPsByNodesByNetworks[node][network] == nil {
+				svcIPsByNodesByNetworks[node][network] = sets.New[string]()
+			}
+			for _, ip := range ips {
+				svcIPsByNodesByNetworks[node][network].Insert(ip + util.GetIPFullMaskString(ip))
+			}
+		}
+	}
+
+	services, err := c.serviceLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, service := range services {
+		if !util.IsClusterIPSet(service) {
+			continue
+		}
+		network := c.nm.GetActiveNetworkForNamespaceFast(service.Namespace)
+		networkName := network.GetNetworkName()
+		if !networks.Has(networkName) {
+			continue
+		}
+
+		addServiceIPsByNodesByNetwork(util.GetClusterIPs(service), nodes, networkName)
+
+		externalIPs := util.GetExternalAndLBIPs(service)
+		if len(externalIPs) == 0 {
+			continue
+		}
+		externalNodes := nodes
+		if util.ServiceExternalTrafficPolicyLocal(service) {
+			endpointSlices, err := util.GetServiceEndpointSlices(service.Namespace, service.Name, networkName, c.epsLister)
+			if err != nil {
+				return nil, err
+			}
+			externalNodes = nodes.Intersection(util.GetNodesWithLocalEligibleEndpointsFromSlices(endpointSlices, service))
+		}
+		addServiceIPsByNodesByNetwork(externalIPs, externalNodes, networkName)
+	}
+
+	return svcIPsByNodesByNetworks, nil
+}
+
 // isOwnUpdate checks if an object was updated by us last, as indicated by its
 // managed fields. Used to avoid reconciling an update that we made ourselves.
 func isOwnUpdate(managedFields []metav1.ManagedFieldsEntry) bool {
@@ -1202,6 +1511,96 @@ func egressIPNeedsUpdate(oldObj, newObj *eiptypes.EgressIP) bool {
 	return false
 }
 
+func serviceNeedsUpdate(oldObj, newObj *corev1.Service) bool {
+	if oldObj == nil || newObj == nil {
+		return true
+	}
+	return !reflect.DeepEqual(util.GetClusterIPs(oldObj), util.GetClusterIPs(newObj)) ||
+		!reflect.DeepEqual(util.GetExternalAndLBIPs(oldObj), util.GetExternalAndLBIPs(newObj)) ||
+		util.ServiceExternalTrafficPolicyLocal(oldObj) != util.ServiceExternalTrafficPolicyLocal(newObj)
+}
+
+// endpointSliceNeedsUpdate checks if an EndpointSlice update changes the
+// advertised service IPs: that is only the case for services with
+// ExternalTrafficPolicy=Local on a network advertising services, when the set
+// of nodes with local eligible endpoints changes.
+func (c *Controller) endpointSliceNeedsUpdate(oldObj, newObj *discoveryv1.EndpointSlice) bool {
+	endpointSlice := newObj
+	if endpointSlice == nil {
+		endpointSlice = oldObj
+	}
+	if endpointSlice == nil {
+		return false
+	}
+
+	network := c.nm.GetActiveNetworkForNamespaceFast(endpointSlice.Namespace)
+	if network == nil {
+		return false
+	}
+	// this also filters out EndpointSlices of other networks than the active
+	// one of the namespace
+	serviceName, err := util.ServiceFromEndpointSlice(endpointSlice, network.GetNetworkName())
+	if err != nil || serviceName == nil {
+		return false
+	}
+	// if the service does not exist, its creation will be handled by the
+	// service controller
+	service, err := c.serviceLister.Services(serviceName.Namespace).Get(serviceName.Name)
+	if err != nil {
+		return false
+	}
+	if !util.ServiceExternalTrafficPolicyLocal(service) {
+		return false
+	}
+	if !c.isNetworkAdvertisingServices(network) {
+		return false
+	}
+
+	// we only care about which nodes have local eligible endpoints for the
+	// service
+	nodesWithEndpoints := func(endpointSlice *discoveryv1.EndpointSlice) sets.Set[string] {
+		if endpointSlice == nil {
+			return sets.New[string]()
+		}
+		return util.GetNodesWithLocalEligibleEndpointsFromSlices([]*discoveryv1.EndpointSlice{endpointSlice}, service)
+	}
+	return !nodesWithEndpoints(oldObj).Equal(nodesWithEndpoints(newObj))
+}
+
+// isNetworkAdvertisingServices checks if the network is selected by any
+// RouteAdvertisements that advertises services, as annotated on its NADs.
+func (c *Controller) isNetworkAdvertisingServices(network util.NetInfo) bool {
+	nadKeys := network.GetNADs()
+	if network.IsDefault() {
+		nadKeys = []string{config.Kubernetes.OVNConfigNamespace + "/" + types.DefaultNetworkName}
+	}
+	for _, nadKey := range nadKeys {
+		namespace, name, err := cache.SplitMetaNamespaceKey(nadKey)
+		if err != nil {
+			continue
+		}
+		nad, err := c.nadLister.NetworkAttachmentDefinitions(namespace).Get(name)
+		if err != nil || nad.Annotations[types.OvnRouteAdvertisementsKey] == "" {
+			continue
+		}
+		var ras []string
+		if err := json.Unmarshal([]byte(nad.Annotations[types.OvnRouteAdvertisementsKey]), &ras); err != nil {
+			// let the RouteAdvertisements reconcile deal with it
+			return true
+		}
+		for _, raName := range ras {
+			ra, err := c.raLister.Get(raName)
+			if err != nil {
+				continue
+			}
+			if sets.New(ra.Spec.Advertisements...).Has(ratypes.Services) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func nsNeedsUpdate(oldObj, newObj *corev1.Namespace) bool {
 	// we only care about label changes, added/deleted namespaces served by a
 	// UDN will already be reflected in a network update
@@ -1272,13 +1671,24 @@ func (c *Controller) reconcileNAD(key string) error {
 
 func (c *Controller) reconcileEgressIPs(string) error {
 	// reconcile RAs that advertise EIPs
+	return c.reconcileRouteAdvertisementsOfType(ratypes.EgressIP)
+}
+
+func (c *Controller) reconcileServices(string) error {
+	// reconcile RAs that advertise services
+	return c.reconcileRouteAdvertisementsOfType(ratypes.Services)
+}
+
+// reconcileRouteAdvertisementsOfType reconciles the RouteAdvertisements that
+// have the provided advertisement type enabled
+func (c *Controller) reconcileRouteAdvertisementsOfType(advertisement ratypes.AdvertisementType) error {
 	ras, err := c.raLister.List(labels.Everything())
 	if err != nil {
 		return err
 	}
 
 	for _, ra := range ras {
-		if sets.New(ra.Spec.Advertisements...).Has(ratypes.EgressIP) {
+		if sets.New(ra.Spec.Advertisements...).Has(advertisement) {
 			c.raController.Reconcile(ra.Name)
 		}
 	}
@@ -16,9 +16,11 @@ import (
 	"github.com/onsi/gomega/format"
 
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	meta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
@@ -47,6 +49,11 @@ type testRA struct {
 	SelectsDefault           bool
 	AdvertisePods            bool
 	AdvertiseEgressIPs       bool
+	AdvertiseServices        bool
+	Communities              []string
+	LocalPreferences         map[string]uint32
+	AggregatePrefixes        bool
+	EVPNVNIs                 map[string]int32
 }
 
 func (tra testRA) RouteAdvertisements() *ratypes.RouteAdvertisements {
@@ -67,6 +74,26 @@ func (tra testRA) RouteAdvertisements() *ratypes.RouteAdvertisements {
 	if tra.AdvertiseEgressIPs {
 		ra.Spec.Advertisements = append(ra.Spec.Advertisements, ratypes.EgressIP)
 	}
+	if tra.AdvertiseServices {
+		ra.Spec.Advertisements = append(ra.Spec.Advertisements, ratypes.Services)
+	}
+	for _, community := range tra.Communities {
+		ra.Spec.Communities = append(ra.Spec.Communities, ratypes.BGPCommunity(community))
+	}
+	// local preferences are given as "node label value -> local preference"
+	for _, value := range sets.List(sets.KeySet(tra.LocalPreferences)) {
+		ra.Spec.LocalPreferences = append(ra.Spec.LocalPreferences, ratypes.LocalPreference{
+			NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"bgp": value}},
+			Value:        tra.LocalPreferences[value],
+		})
+	}
+	ra.Spec.AggregatePrefixes = tra.AggregatePrefixes
+	if tra.EVPNVNIs != nil {
+		ra.Spec.EVPN = &ratypes.EVPNConfig{}
+		for _, network := range sets.List(sets.KeySet(tra.EVPNVNIs)) {
+			ra.Spec.EVPN.VNIs = append(ra.Spec.EVPN.VNIs, ratypes.NetworkVNI{Network: network, VNI: tra.EVPNVNIs[network]})
+		}
+	}
 	if tra.NetworkSelector != nil {
 		ra.Spec.NetworkSelectors = append(ra.Spec.NetworkSelectors, apitypes.NetworkSelector{
 			NetworkSelectionType: apitypes.ClusterUserDefinedNetworks,
@@ -145,11 +172,13 @@ func (tn testNode) Node() *corev1.Node {
 }
 
 type testNeighbor struct {
-	ASN       uint32
-	Address   string
-	DisableMP *bool
-	Receive   []string
-	Advertise []string
+	ASN         uint32
+	Address     string
+	DisableMP   *bool
+	Receive     []string
+	Advertise   []string
+	Communities []string
+	LocalPref   *uint32
 }
 
 func (tn testNeighbor) Neighbor() frrapi.Neighbor {
@@ -172,6 +201,22 @@ func (tn testNeighbor) Neighbor() frrapi.Neighbor {
 	if tn.DisableMP != nil {
 		n.DisableMP = *tn.DisableMP
 	}
+	for _, community := range tn.Communities {
+		n.ToAdvertise.PrefixesWithCommunity = append(n.ToAdvertise.PrefixesWithCommunity,
+			frrapi.CommunityPrefixes{
+				Prefixes:  tn.Advertise,
+				Community: community,
+			},
+		)
+	}
+	if tn.LocalPref != nil {
+		n.ToAdvertise.PrefixesWithLocalPref = []frrapi.LocalPrefPrefixes{
+			{
+				Prefixes:  tn.Advertise,
+				LocalPref: *tn.LocalPref,
+			},
+		}
+	}
 	for _, receive := range tn.Receive {
 		sep := strings.LastIndex(receive, "/")
 		if sep == -1 {
@@ -233,6 +278,7 @@ type testFRRConfig struct {
 	Routers      []*testRouter
 	NodeSelector map[string]string
 	OwnUpdate    bool
+	RawConfig    string
 }
 
 func (tf testFRRConfig) FRRConfiguration() *frrapi.FRRConfiguration {
@@ -253,6 +299,7 @@ func (tf testFRRConfig) FRRConfiguration() *frrapi.FRRConfiguration {
 	for _, r := range tf.Routers {
 		f.Spec.BGP.Routers = append(f.Spec.BGP.Routers, r.Router())
 	}
+	f.Spec.Raw.Config = tf.RawConfig
 	if tf.OwnUpdate {
 		f.ManagedFields = append(f.ManagedFields, metav1.ManagedFieldsEntry{
 			Manager: fieldManager,
@@ -290,6 +337,65 @@ func (te testEIP) EgressIP() *eiptypes.EgressIP {
 	return &eip
 }
 
+type testService struct {
+	Name        string
+	Namespace   string
+	ClusterIP   string
+	ExternalIPs []string
+	LBIPs       []string
+	ETPLocal    bool
+}
+
+func (ts testService) Service() *corev1.Service {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ts.Name,
+			Namespace: ts.Namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Type:        corev1.ServiceTypeClusterIP,
+			ClusterIP:   ts.ClusterIP,
+			ClusterIPs:  []string{ts.ClusterIP},
+			ExternalIPs: ts.ExternalIPs,
+		},
+	}
+	if len(ts.LBIPs) > 0 {
+		svc.Spec.Type = corev1.ServiceTypeLoadBalancer
+		for _, ip := range ts.LBIPs {
+			svc.Status.LoadBalancer.Ingress = append(svc.Status.LoadBalancer.Ingress, corev1.LoadBalancerIngress{IP: ip})
+		}
+	}
+	if ts.ETPLocal {
+		svc.Spec.ExternalTrafficPolicy = corev1.ServiceExternalTrafficPolicyTypeLocal
+	}
+	return svc
+}
+
+type testEndpointSlice struct {
+	Name      string
+	Namespace string
+	Service   string
+	// Endpoints maps endpoint addresses to the node they are on
+	Endpoints map[string]string
+}
+
+func (te testEndpointSlice) EndpointSlice() *discoveryv1.EndpointSlice {
+	eps := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      te.Name,
+			Namespace: te.Namespace,
+			Labels: map[string]string{
+				discoveryv1.LabelServiceName: te.Service,
+			},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+	}
+	for address, node := range te.Endpoints {
+		eps.Endpoints = append(eps.Endpoints, ovntest.MakeReadyEndpoint(node, address))
+	}
+	return eps
+}
+
 type testNAD struct {
 	Name        string
 	Namespace   string
@@ -398,6 +504,8 @@ func TestController_reconcile(t *testing.T) {
 		nodes                []*testNode
 		namespaces           []*testNamespace
 		eips                 []*testEIP
+		services             []*testService
+		endpointSlices       []*testEndpointSlice
 		reconcile            string
 		wantErr              bool
 		expectAcceptedStatus metav1.ConditionStatus
@@ -639,6 +747,157 @@ func TestController_reconcile(t *testing.T) {
 			},
 			expectNADAnnotations: map[string]map[string]string{"default": {types.OvnRouteAdvertisementsKey: "[\"ra\"]"}},
 		},
+		{
+			name: "reconciles services RouteAdvertisement for multiple nodes and default network and target VRF",
+			ra:   &testRA{Name: "ra", AdvertiseServices: true, SelectsDefault: true},
+			frrConfigs: []*testFRRConfig{
+				{
+					Name:      "frrConfig",
+					Namespace: frrNamespace,
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"1.1.1.0/24"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100"},
+						}},
+					},
+				},
+			},
+			nodes: []*testNode{
+				{Name: "node1", SubnetsAnnotation: "{\"default\":\"1.1.1.0/24\"}"},
+				{Name: "node2", SubnetsAnnotation: "{\"default\":\"1.1.2.0/24\"}"},
+			},
+			services: []*testService{
+				{Name: "cluster", Namespace: "default", ClusterIP: "10.96.0.10"},
+				{Name: "external", Namespace: "default", ClusterIP: "10.96.0.11", ExternalIPs: []string{"5.5.5.1"}},
+				{Name: "lb", Namespace: "default", ClusterIP: "10.96.0.12", LBIPs: []string{"5.5.5.2"}},
+				{Name: "lb-local", Namespace: "default", ClusterIP: "10.96.0.13", LBIPs: []string{"5.5.5.3"}, ETPLocal: true},
+				{Name: "lb-local-no-endpoints", Namespace: "default", ClusterIP: "10.96.0.14", LBIPs: []string{"5.5.5.4"}, ETPLocal: true},
+			},
+			endpointSlices: []*testEndpointSlice{
+				{Name: "lb-local-1", Namespace: "default", Service: "lb-local", Endpoints: map[string]string{"1.1.1.3": "node1"}},
+			},
+			reconcile:            "ra",
+			expectAcceptedStatus: metav1.ConditionTrue,
+			expectFRRConfigs: []*testFRRConfig{
+				{
+					Labels:       map[string]string{types.OvnRouteAdvertisementsKey: "ra"},
+					Annotations:  map[string]string{types.OvnRouteAdvertisementsKey: "ra/frrConfig/node1"},
+					NodeSelector: map[string]string{"kubernetes.io/hostname": "node1"},
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"10.96.0.10/32", "10.96.0.11/32", "10.96.0.12/32", "10.96.0.13/32", "10.96.0.14/32", "5.5.5.1/32", "5.5.5.2/32", "5.5.5.3/32"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"10.96.0.10/32", "10.96.0.11/32", "10.96.0.12/32", "10.96.0.13/32", "10.96.0.14/32", "5.5.5.1/32", "5.5.5.2/32", "5.5.5.3/32"}},
+						}},
+					}},
+				{
+					Labels:       map[string]string{types.OvnRouteAdvertisementsKey: "ra"},
+					Annotations:  map[string]string{types.OvnRouteAdvertisementsKey: "ra/frrConfig/node2"},
+					NodeSelector: map[string]string{"kubernetes.io/hostname": "node2"},
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"10.96.0.10/32", "10.96.0.11/32", "10.96.0.12/32", "10.96.0.13/32", "10.96.0.14/32", "5.5.5.1/32", "5.5.5.2/32"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"10.96.0.10/32", "10.96.0.11/32", "10.96.0.12/32", "10.96.0.13/32", "10.96.0.14/32", "5.5.5.1/32", "5.5.5.2/32"}},
+						}},
+					}},
+			},
+			expectNADAnnotations: map[string]map[string]string{"default": {types.OvnRouteAdvertisementsKey: "[\"ra\"]"}},
+		},
+		{
+			name: "reconciles pod+services RouteAdvertisement for a single FRR config, node, non default network and non default target VRF",
+			ra:   &testRA{Name: "ra", TargetVRF: "red", AdvertisePods: true, AdvertiseServices: true, NetworkSelector: map[string]string{"selected": "true"}},
+			frrConfigs: []*testFRRConfig{
+				{
+					Name:      "frrConfig",
+					Namespace: frrNamespace,
+					Routers: []*testRouter{
+						{ASN: 1, VRF: "red", Prefixes: []string{"1.1.1.0/24"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100"},
+						}},
+					},
+				},
+			},
+			nads: []*testNAD{
+				{Name: "red", Namespace: "red", Network: util.GenerateCUDNNetworkName("red"), Topology: "layer3", Subnet: "1.2.0.0/16", Labels: map[string]string{"selected": "true"}},
+				{Name: "blue", Namespace: "blue", Network: util.GenerateCUDNNetworkName("blue"), Topology: "layer3", Subnet: "1.3.0.0/16"},
+			},
+			nodes: []*testNode{
+				{Name: "node", SubnetsAnnotation: "{\"default\":\"1.1.1.0/24\", \"cluster_udn_red\":\"1.2.1.0/24\", \"cluster_udn_blue\":\"1.3.1.0/24\"}"},
+			},
+			namespaces: []*testNamespace{
+				{Name: "red"},
+				{Name: "blue"},
+			},
+			services: []*testService{
+				{Name: "lb", Namespace: "red", ClusterIP: "10.96.0.10", LBIPs: []string{"5.5.5.1"}},
+				{Name: "lb", Namespace: "blue", ClusterIP: "10.96.0.11", LBIPs: []string{"5.5.5.2"}}, // namespace served by unselected network, ignored
+			},
+			reconcile:            "ra",
+			expectAcceptedStatus: metav1.ConditionTrue,
+			expectFRRConfigs: []*testFRRConfig{
+				{
+					Labels:       map[string]string{types.OvnRouteAdvertisementsKey: "ra"},
+					Annotations:  map[string]string{types.OvnRouteAdvertisementsKey: "ra/frrConfig/node"},
+					NodeSelector: map[string]string{"kubernetes.io/hostname": "node"},
+					Routers: []*testRouter{
+						{ASN: 1, VRF: "red", Prefixes: []string{"1.2.1.0/24", "10.96.0.10/32", "5.5.5.1/32"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"1.2.1.0/24", "10.96.0.10/32", "5.5.5.1/32"}, Receive: []string{"1.2.0.0/16/24"}},
+						}},
+					}},
+			},
+			expectNADAnnotations: map[string]map[string]string{"red": {types.OvnRouteAdvertisementsKey: "[\"ra\"]"}},
+		},
+		{
+			name: "reconciles pod RouteAdvertisement with communities, local preference and aggregated prefixes for multiple nodes",
+			ra: &testRA{
+				Name:              "ra",
+				AdvertisePods:     true,
+				SelectsDefault:    true,
+				NetworkSelector:   map[string]string{"selected": "true"},
+				Communities:       []string{"65000:100", "large:65000:1:2"},
+				LocalPreferences:  map[string]uint32{"primary": 200},
+				AggregatePrefixes: true,
+			},
+			frrConfigs: []*testFRRConfig{
+				{
+					Name:      "frrConfig",
+					Namespace: frrNamespace,
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"1.1.1.0/24"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100"},
+						}},
+					},
+				},
+			},
+			nads: []*testNAD{
+				{Name: "red", Namespace: "red", Network: util.GenerateCUDNNetworkName("red"), Topology: "layer3", Subnet: "1.2.0.0/16", Labels: map[string]string{"selected": "true"}},
+			},
+			nodes: []*testNode{
+				{Name: "node1", Labels: map[string]string{"bgp": "primary"}, SubnetsAnnotation: "{\"default\":\"1.1.1.0/24\", \"cluster_udn_red\":\"1.2.1.0/24\"}"},
+				{Name: "node2", Labels: map[string]string{"bgp": "backup"}, SubnetsAnnotation: "{\"default\":\"1.1.2.0/24\", \"cluster_udn_red\":\"1.2.2.0/24\"}"},
+			},
+			reconcile:            "ra",
+			expectAcceptedStatus: metav1.ConditionTrue,
+			expectFRRConfigs: []*testFRRConfig{
+				{
+					Labels:       map[string]string{types.OvnRouteAdvertisementsKey: "ra"},
+					Annotations:  map[string]string{types.OvnRouteAdvertisementsKey: "ra/frrConfig/node1"},
+					NodeSelector: map[string]string{"kubernetes.io/hostname": "node1"},
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"1.1.0.0/16", "1.2.0.0/16", "fd01::/48"}, Imports: []string{"red"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"1.1.0.0/16", "1.2.0.0/16"}, Receive: []string{"1.1.0.0/16/24", "1.2.0.0/16/24"}, Communities: []string{"65000:100", "large:65000:1:2"}, LocalPref: ptr.To(uint32(200))},
+						}},
+						{ASN: 1, VRF: "red", Imports: []string{"default"}},
+					}},
+				{
+					Labels:       map[string]string{types.OvnRouteAdvertisementsKey: "ra"},
+					Annotations:  map[string]string{types.OvnRouteAdvertisementsKey: "ra/frrConfig/node2"},
+					NodeSelector: map[string]string{"kubernetes.io/hostname": "node2"},
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"1.1.0.0/16", "1.2.0.0/16", "fd01::/48"}, Imports: []string{"red"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"1.1.0.0/16", "1.2.0.0/16"}, Receive: []string{"1.1.0.0/16/24", "1.2.0.0/16/24"}, Communities: []string{"65000:100", "large:65000:1:2"}},
+						}},
+						{ASN: 1, VRF: "red", Imports: []string{"default"}},
+					}},
+			},
+			expectNADAnnotations: map[string]map[string]string{"default": {types.OvnRouteAdvertisementsKey: "[\"ra\"]"}, "red": {types.OvnRouteAdvertisementsKey: "[\"ra\"]"}},
+		},
 		{
 			name: "reconciles a deleted RouteAdvertisement",
 			frrConfigs: []*testFRRConfig{
@@ -776,6 +1035,95 @@ func TestController_reconcile(t *testing.T) {
 			},
 			expectNADAnnotations: map[string]map[string]string{"default": {types.OvnRouteAdvertisementsKey: "[\"ra\"]"}, "red": {types.OvnRouteAdvertisementsKey: "[\"ra\"]"}},
 		},
+		{
+			name: "reconciles a RouteAdvertisement with EVPN for multiple selected networks",
+			ra: &testRA{
+				Name:                     "ra",
+				AdvertisePods:            true,
+				TargetVRF:                "auto",
+				FRRConfigurationSelector: map[string]string{"selected": "true"},
+				NetworkSelector:          map[string]string{"selected": "true"},
+				EVPNVNIs:                 map[string]int32{"red": 100, "green": 200},
+			},
+			nads: []*testNAD{
+				{Name: "red", Namespace: "red", Network: util.GenerateCUDNNetworkName("red"), Topology: "layer3", Subnet: "1.2.0.0/16", Labels: map[string]string{"selected": "true"}},
+				{Name: "green", Namespace: "green", Network: util.GenerateCUDNNetworkName("green"), Topology: "layer2", Subnet: "1.4.0.0/16", Labels: map[string]string{"selected": "true"}},
+			},
+			frrConfigs: []*testFRRConfig{
+				{
+					Name:      "frrConfig",
+					Namespace: frrNamespace,
+					Labels:    map[string]string{"selected": "true"},
+					Routers: []*testRouter{
+						{ASN: 1, Prefixes: []string{"1.0.1.0/24"}, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100"},
+						}},
+					},
+				},
+			},
+			nodes: []*testNode{
+				{Name: "node", SubnetsAnnotation: "{\"default\":\"1.1.1.0/24\", \"cluster_udn_red\":\"1.2.1.0/24\"}"},
+			},
+			reconcile:            "ra",
+			expectAcceptedStatus: metav1.ConditionTrue,
+			expectFRRConfigs: []*testFRRConfig{
+				{
+					Labels:       map[string]string{types.OvnRouteAdvertisementsKey: "ra"},
+					Annotations:  map[string]string{types.OvnRouteAdvertisementsKey: "ra/frrConfig/node"},
+					NodeSelector: map[string]string{"kubernetes.io/hostname": "node"},
+					Routers: []*testRouter{
+						{ASN: 1, Neighbors: []*testNeighbor{
+							{ASN: 1, Address: "1.0.0.100"},
+						}},
+						{ASN: 1, VRF: "green", Prefixes: []string{"1.4.0.0/16"}},
+						{ASN: 1, VRF: "red", Prefixes: []string{"1.2.1.0/24"}},
+					},
+					RawConfig: `vrf green
+ vni 200
+exit-vrf
+!
+router bgp 1 vrf green
+ address-family l2vpn evpn
+  advertise ipv4 unicast
+ exit-address-family
+exit
+!
+vrf red
+ vni 100
+exit-vrf
+!
+router bgp 1 vrf red
+ address-family l2vpn evpn
+  advertise ipv4 unicast
+ exit-address-family
+exit
+!
+router bgp 1
+ address-family l2vpn evpn
+  neighbor 1.0.0.100 activate
+  advertise-all-vni
+ exit-address-family
+exit
+`,
+				},
+			},
+		},
+		{
+			name: "fails to reconcile EVPN if a selected network has no VNI",
+			ra: &testRA{
+				Name:            "ra",
+				AdvertisePods:   true,
+				TargetVRF:       "auto",
+				NetworkSelector: map[string]string{"selected": "true"},
+				EVPNVNIs:        map[string]int32{"red": 100},
+			},
+			nads: []*testNAD{
+				{Name: "red", Namespace: "red", Network: util.GenerateCUDNNetworkName("red"), Topology: "layer3", Subnet: "1.2.0.0/16", Labels: map[string]string{"selected": "true"}},
+				{Name: "green", Namespace: "green", Network: util.GenerateCUDNNetworkName("green"), Topology: "layer2", Subnet: "1.4.0.0/16", Labels: map[string]string{"selected": "true"}},
+			},
+			reconcile:            "ra",
+			expectAcceptedStatus: metav1.ConditionFalse,
+		},
 		{
 			name: "fails to reconcile a secondary network",
 			ra:   &testRA{Name: "ra", AdvertisePods: true, NetworkSelector: map[string]string{"selected": "true"}},
@@ -997,6 +1345,16 @@ func TestController_reconcile(t *testing.T) {
 				g.Expect(err).ToNot(gomega.HaveOccurred())
 			}
 
+			for _, svc := range tt.services {
+				_, err := fakeClientset.KubeClient.CoreV1().Services(svc.Namespace).Create(context.Background(), svc.Service(), metav1.CreateOptions{})
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+			}
+
+			for _, eps := range tt.endpointSlices {
+				_, err := fakeClientset.KubeClient.DiscoveryV1().EndpointSlices(eps.Namespace).Create(context.Background(), eps.EndpointSlice(), metav1.CreateOptions{})
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+			}
+
 			wf, err := factory.NewClusterManagerWatchFactory(fakeClientset)
 			g.Expect(err).ToNot(gomega.HaveOccurred())
 
@@ -1036,6 +1394,8 @@ func TestController_reconcile(t *testing.T) {
 				wf.NADInformer().Informer().HasSynced,
 				wf.NodeCoreInformer().Informer().HasSynced,
 				wf.EgressIPInformer().Informer().HasSynced,
+				wf.ServiceCoreInformer().Informer().HasSynced,
+				wf.EndpointSliceCoreInformer().Informer().HasSynced,
 			)
 
 			err = nm.Start()
@@ -1114,6 +1474,7 @@ func TestUpdates(t *testing.T) {
 		},
 		{
 			Name:                     "ra2",
+			AdvertiseServices:        true,
 			FRRConfigurationSelector: map[string]string{"select": "2"},
 			NetworkSelector:          map[string]string{"select": "2"},
 			NodeSelector:             map[string]string{"select": "2"},
@@ -1127,8 +1488,19 @@ func TestUpdates(t *testing.T) {
 		},
 	}
 
+	// the default network advertised by ra2
+	defaultNAD := &testNAD{
+		Name:        types.DefaultNetworkName,
+		Namespace:   config.Kubernetes.OVNConfigNamespace,
+		Network:     types.DefaultNetworkName,
+		Annotations: map[string]string{types.OvnRouteAdvertisementsKey: "[\"ra2\"]"},
+		OwnUpdate:   true,
+	}
+	etpLocalService := &testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10", LBIPs: []string{"5.5.5.1"}, ETPLocal: true}
+
 	tests := []struct {
 		name              string
+		existingObjects   []any
 		oldObject         any
 		newObject         any
 		expectedReconcile []string
@@ -1294,6 +1666,73 @@ func TestUpdates(t *testing.T) {
 			oldObject: &testNode{Name: "eip", Generation: 1},
 			newObject: &testNode{Name: "eip", Generation: 2},
 		},
+		{
+			name:              "reconciles all RAs that advertise services on new service",
+			newObject:         &testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10"},
+			expectedReconcile: []string{"ra2"},
+		},
+		{
+			name:              "reconciles all RAs that advertise services on deleted service",
+			oldObject:         &testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10"},
+			expectedReconcile: []string{"ra2"},
+		},
+		{
+			name:              "reconciles all RAs that advertise services on updated service load balancer IPs",
+			oldObject:         &testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10", LBIPs: []string{"5.5.5.1"}},
+			newObject:         &testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10", LBIPs: []string{"5.5.5.2"}},
+			expectedReconcile: []string{"ra2"},
+		},
+		{
+			name:              "reconciles all RAs that advertise services on updated service external traffic policy",
+			oldObject:         &testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10", LBIPs: []string{"5.5.5.1"}},
+			newObject:         &testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10", LBIPs: []string{"5.5.5.1"}, ETPLocal: true},
+			expectedReconcile: []string{"ra2"},
+		},
+		{
+			name:      "does not reconcile RAs on service irrelevant change",
+			oldObject: &testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10"},
+			newObject: &testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10", ExternalIPs: []string{}},
+		},
+		{
+			name:              "reconciles all RAs that advertise services on new endpointslice",
+			existingObjects:   []any{defaultNAD, etpLocalService},
+			newObject:         &testEndpointSlice{Name: "eps", Namespace: "ns", Service: "svc", Endpoints: map[string]string{"1.1.1.3": "node1"}},
+			expectedReconcile: []string{"ra2"},
+		},
+		{
+			name:              "reconciles all RAs that advertise services on deleted endpointslice",
+			existingObjects:   []any{defaultNAD, etpLocalService},
+			oldObject:         &testEndpointSlice{Name: "eps", Namespace: "ns", Service: "svc", Endpoints: map[string]string{"1.1.1.3": "node1"}},
+			expectedReconcile: []string{"ra2"},
+		},
+		{
+			name:              "reconciles all RAs that advertise services on updated endpointslice endpoint nodes",
+			existingObjects:   []any{defaultNAD, etpLocalService},
+			oldObject:         &testEndpointSlice{Name: "eps", Namespace: "ns", Service: "svc", Endpoints: map[string]string{"1.1.1.3": "node1"}},
+			newObject:         &testEndpointSlice{Name: "eps", Namespace: "ns", Service: "svc", Endpoints: map[string]string{"1.1.1.3": "node2"}},
+			expectedReconcile: []string{"ra2"},
+		},
+		{
+			name:            "does not reconcile RAs on updated endpointslice endpoints on the same nodes",
+			existingObjects: []any{defaultNAD, etpLocalService},
+			oldObject:       &testEndpointSlice{Name: "eps", Namespace: "ns", Service: "svc", Endpoints: map[string]string{"1.1.1.3": "node1"}},
+			newObject:       &testEndpointSlice{Name: "eps", Namespace: "ns", Service: "svc", Endpoints: map[string]string{"1.1.1.3": "node1", "1.1.1.4": "node1"}},
+		},
+		{
+			name:            "does not reconcile RAs on new endpointslice of a service with cluster external traffic policy",
+			existingObjects: []any{defaultNAD, &testService{Name: "svc", Namespace: "ns", ClusterIP: "10.96.0.10", LBIPs: []string{"5.5.5.1"}}},
+			newObject:       &testEndpointSlice{Name: "eps", Namespace: "ns", Service: "svc", Endpoints: map[string]string{"1.1.1.3": "node1"}},
+		},
+		{
+			name:            "does not reconcile RAs on new endpointslice of a service on a network not advertising services",
+			existingObjects: []any{etpLocalService},
+			newObject:       &testEndpointSlice{Name: "eps", Namespace: "ns", Service: "svc", Endpoints: map[string]string{"1.1.1.3": "node1"}},
+		},
+		{
+			name:            "does not reconcile RAs on new endpointslice of a non existing service",
+			existingObjects: []any{defaultNAD},
+			newObject:       &testEndpointSlice{Name: "eps", Namespace: "ns", Service: "svc", Endpoints: map[string]string{"1.1.1.3": "node1"}},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1361,6 +1800,10 @@ func TestUpdates(t *testing.T) {
 					_, err = fakeClientset.KubeClient.CoreV1().Nodes().Create(context.Background(), t.Node(), metav1.CreateOptions{})
 				case *testNamespace:
 					_, err = fakeClientset.KubeClient.CoreV1().Namespaces().Create(context.Background(), t.Namespace(), metav1.CreateOptions{})
+				case *testService:
+					_, err = fakeClientset.KubeClient.CoreV1().Services(t.Namespace).Create(context.Background(), t.Service(), metav1.CreateOptions{})
+				case *testEndpointSlice:
+					_, err = fakeClientset.KubeClient.DiscoveryV1().EndpointSlices(t.Namespace).Create(context.Background(), t.EndpointSlice(), metav1.CreateOptions{})
 				}
 				return err
 			}
@@ -1377,6 +1820,10 @@ func TestUpdates(t *testing.T) {
 					_, err = fakeClientset.KubeClient.CoreV1().Nodes().Update(context.Background(), t.Node(), metav1.UpdateOptions{})
 				case *testNamespace:
 					_, err = fakeClientset.KubeClient.CoreV1().Namespaces().Update(context.Background(), t.Namespace(), metav1.UpdateOptions{})
+				case *testService:
+					_, err = fakeClientset.KubeClient.CoreV1().Services(t.Namespace).Update(context.Background(), t.Service(), metav1.UpdateOptions{})
+				case *testEndpointSlice:
+					_, err = fakeClientset.KubeClient.DiscoveryV1().EndpointSlices(t.Namespace).Update(context.Background(), t.EndpointSlice(), metav1.UpdateOptions{})
 				}
 				return err
 			}
@@ -1393,10 +1840,19 @@ func TestUpdates(t *testing.T) {
 					err = fakeClientset.KubeClient.CoreV1().Nodes().Delete(context.Background(), t.Name, metav1.DeleteOptions{})
 				case *testNamespace:
 					err = fakeClientset.KubeClient.CoreV1().Namespaces().Delete(context.Background(), t.Name, metav1.DeleteOptions{})
+				case *testService:
+					err = fakeClientset.KubeClient.CoreV1().Services(t.Namespace).Delete(context.Background(), t.Name, metav1.DeleteOptions{})
+				case *testEndpointSlice:
+					err = fakeClientset.KubeClient.DiscoveryV1().EndpointSlices(t.Namespace).Delete(context.Background(), t.Name, metav1.DeleteOptions{})
 				}
 				return err
 			}
 
+			for _, obj := range tt.existingObjects {
+				err = createObj(obj)
+				g.Expect(err).ToNot(gomega.HaveOccurred())
+			}
+
 			if tt.oldObject != nil {
 				err = createObj(tt.oldObject)
 				g.Expect(err).ToNot(gomega.HaveOccurred())
@@ -1433,3 +1889,148 @@ func TestUpdates(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateEVPNFRRConfiguration(t *testing.T) {
+	frrNamespace := "frrNamespace"
+	ra := (&testRA{Name: "ra", AdvertisePods: true, TargetVRF: "auto", EVPNVNIs: map[string]int32{"red": 100, "blue": 200}}).RouteAdvertisements()
+	red := util.GenerateCUDNNetworkName("red")
+	blue := util.GenerateCUDNNetworkName("blue")
+	selected := &selectedNetworks{
+		networks:    []string{blue, red},
+		networkVRFs: map[string]string{"blue": blue, "red": red},
+		hostNetworkSubnets: map[string][]string{
+			blue: {"1.4.0.0/16", "fd04::/64"},
+			red:  {"1.2.1.0/24"},
+		},
+		networkVNIs: map[string]int32{blue: 200, red: 100},
+	}
+	underlay := &testRouter{ASN: 1, Prefixes: []string{"1.0.1.0/24"}, Neighbors: []*testNeighbor{
+		{ASN: 1, Address: "1.0.0.100"},
+		{ASN: 1, Address: "1.0.0.101"},
+	}}
+
+	tests := []struct {
+		name             string
+		source           *testFRRConfig
+		networks         *selectedNetworks
+		expectFRRConfig  *testFRRConfig
+		expectedMatching []string
+	}{
+		{
+			name:     "generates the EVPN configuration of the selected networks",
+			source:   &testFRRConfig{Name: "frrConfig", Namespace: frrNamespace, Routers: []*testRouter{underlay}},
+			networks: selected,
+			expectFRRConfig: &testFRRConfig{
+				Routers: []*testRouter{
+					{ASN: 1, Neighbors: []*testNeighbor{{ASN: 1, Address: "1.0.0.100"}, {ASN: 1, Address: "1.0.0.101"}}},
+					{ASN: 1, VRF: "blue", Prefixes: []string{"1.4.0.0/16", "fd04::/64"}},
+					{ASN: 1, VRF: "red", Prefixes: []string{"1.2.1.0/24"}},
+				},
+				RawConfig: `vrf blue
+ vni 200
+exit-vrf
+!
+router bgp 1 vrf blue
+ address-family l2vpn evpn
+  advertise ipv4 unicast
+  advertise ipv6 unicast
+ exit-address-family
+exit
+!
+vrf red
+ vni 100
+exit-vrf
+!
+router bgp 1 vrf red
+ address-family l2vpn evpn
+  advertise ipv4 unicast
+ exit-address-family
+exit
+!
+router bgp 1
+ address-family l2vpn evpn
+  neighbor 1.0.0.100 activate
+  neighbor 1.0.0.101 activate
+  advertise-all-vni
+ exit-address-family
+exit
+`,
+			},
+			expectedMatching: []string{blue, red},
+		},
+		{
+			name:   "appends the EVPN configuration to the raw configuration of the source",
+			source: &testFRRConfig{Name: "frrConfig", Namespace: frrNamespace, Routers: []*testRouter{underlay}, RawConfig: "log syslog debugging"},
+			networks: &selectedNetworks{
+				networks:           []string{blue, red},
+				networkVRFs:        map[string]string{"blue": blue, "red": red},
+				hostNetworkSubnets: map[string][]string{red: {"fd02::/64"}},
+				networkVNIs:        map[string]int32{blue: 200, red: 100},
+			},
+			expectFRRConfig: &testFRRConfig{
+				Routers: []*testRouter{
+					{ASN: 1, Neighbors: []*testNeighbor{{ASN: 1, Address: "1.0.0.100"}, {ASN: 1, Address: "1.0.0.101"}}},
+					{ASN: 1, VRF: "red", Prefixes: []string{"fd02::/64"}},
+				},
+				RawConfig: `log syslog debugging
+vrf red
+ vni 100
+exit-vrf
+!
+router bgp 1 vrf red
+ address-family l2vpn evpn
+  advertise ipv6 unicast
+ exit-address-family
+exit
+!
+router bgp 1
+ address-family l2vpn evpn
+  neighbor 1.0.0.100 activate
+  neighbor 1.0.0.101 activate
+  advertise-all-vni
+ exit-address-family
+exit
+`,
+			},
+			expectedMatching: []string{red},
+		},
+		{
+			name:     "does not generate a configuration without a router on the default VRF",
+			source:   &testFRRConfig{Name: "frrConfig", Namespace: frrNamespace, Routers: []*testRouter{{ASN: 1, VRF: "red", Neighbors: underlay.Neighbors}}},
+			networks: selected,
+		},
+		{
+			name:     "does not generate a configuration without neighbors on the default VRF",
+			source:   &testFRRConfig{Name: "frrConfig", Namespace: frrNamespace, Routers: []*testRouter{{ASN: 1}}},
+			networks: selected,
+		},
+		{
+			name:   "does not generate a configuration without subnets to advertise on the node",
+			source: &testFRRConfig{Name: "frrConfig", Namespace: frrNamespace, Routers: []*testRouter{underlay}},
+			networks: &selectedNetworks{
+				networks:    []string{red},
+				networkVRFs: map[string]string{"red": red},
+				networkVNIs: map[string]int32{red: 100},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			matchedNetworks := sets.New[string]()
+			frrConfig := generateEVPNFRRConfiguration(ra, tt.source.FRRConfiguration(), "node", tt.networks, matchedNetworks)
+			if tt.expectFRRConfig == nil {
+				g.Expect(frrConfig).To(gomega.BeNil())
+				return
+			}
+			g.Expect(frrConfig).ToNot(gomega.BeNil())
+			expected := tt.expectFRRConfig.FRRConfiguration()
+			g.Expect(frrConfig.Spec.BGP.Routers).To(gomega.Equal(expected.Spec.BGP.Routers))
+			g.Expect(frrConfig.Spec.Raw.Config).To(gomega.Equal(expected.Spec.Raw.Config))
+			g.Expect(frrConfig.Spec.NodeSelector.MatchLabels).To(gomega.Equal(map[string]string{"kubernetes.io/hostname": "node"}))
+			g.Expect(frrConfig.Annotations).To(gomega.HaveKeyWithValue(types.OvnRouteAdvertisementsKey, "ra/frrConfig/node"))
+			g.Expect(sets.List(matchedNetworks)).To(gomega.Equal(tt.expectedMatching))
+		})
+	}
+}
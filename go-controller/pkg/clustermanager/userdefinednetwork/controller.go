@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"slices"
 	"strings"
 	"sync"
@@ -40,7 +41,12 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
-const conditionTypeNetworkCreated = "NetworkCreated"
+const (
+	conditionTypeNetworkCreated = "NetworkCreated"
+	conditionTypeNetworkUpdated = "NetworkUpdated"
+
+	conditionTypeRouteImportsAccepted = "RouteImportsAccepted"
+)
 
 type RenderNetAttachDefManifest func(obj client.Object, targetNamespace string) (*netv1.NetworkAttachmentDefinition, error)
 
@@ -371,9 +377,23 @@ func (c *Controller) reconcileUDN(key string) error {
 
 	udnCopy := udn.DeepCopy()
 
+	prevNAD, err := c.nadLister.NetworkAttachmentDefinitions(namespace).Get(name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get NetworkAttachmentDefinition %q from cache: %v", key, err)
+	}
+
 	nadCopy, syncErr := c.syncUserDefinedNetwork(udnCopy)
 
-	updateStatusErr := c.updateUserDefinedNetworkStatus(udnCopy, nadCopy, syncErr)
+	var conditions []*metav1.Condition
+	if syncErr == nil {
+		networkUpdatedCondition, err := newNetworkUpdatedCondition(prevNAD, nadCopy)
+		if err != nil {
+			klog.Errorf("Failed to determine updates of UserDefinedNetwork %q: %v", key, err)
+		}
+		conditions = append(conditions, networkUpdatedCondition)
+	}
+
+	updateStatusErr := c.updateUserDefinedNetworkStatus(udnCopy, nadCopy, syncErr, conditions...)
 
 	var networkInUse *networkInUseError
 	if errors.As(syncErr, &networkInUse) {
@@ -417,14 +437,21 @@ func (c *Controller) syncUserDefinedNetwork(udn *userdefinednetworkv1.UserDefine
 	return c.updateNAD(udn, udn.Namespace)
 }
 
-func (c *Controller) updateUserDefinedNetworkStatus(udn *userdefinednetworkv1.UserDefinedNetwork, nad *netv1.NetworkAttachmentDefinition, syncError error) error {
+// updateUserDefinedNetworkStatus updates the UserDefinedNetwork NetworkCreated condition along with any other given
+// condition.
+func (c *Controller) updateUserDefinedNetworkStatus(
+	udn *userdefinednetworkv1.UserDefinedNetwork,
+	nad *netv1.NetworkAttachmentDefinition,
+	syncError error,
+	otherConditions ...*metav1.Condition,
+) error {
 	if udn == nil {
 		return nil
 	}
 
 	networkCreatedCondition := newNetworkCreatedCondition(nad, syncError)
 
-	conditions, updated := updateCondition(udn.Status.Conditions, networkCreatedCondition)
+	conditions, updated := updateConditions(udn.Status.Conditions, append(otherConditions, networkCreatedCondition)...)
 
 	if updated {
 		var err error
@@ -479,21 +506,49 @@ func newNetworkCreatedCondition(nad *netv1.NetworkAttachmentDefinition, syncErro
 	return networkCreatedCondition
 }
 
-func updateCondition(conditions []metav1.Condition, cond *metav1.Condition) ([]metav1.Condition, bool) {
-	if len(conditions) == 0 {
-		return append(conditions, *cond), true
+// newNetworkUpdatedCondition returns a NetworkUpdated condition describing the network configuration updated in place
+// from the previous NAD, or nil if there is no such update.
+func newNetworkUpdatedCondition(prevNAD, nad *netv1.NetworkAttachmentDefinition) (*metav1.Condition, error) {
+	update, err := NetAttachDefInPlaceUpdate(prevNAD, nad)
+	if err != nil || update == "" {
+		return nil, err
 	}
+	return &metav1.Condition{
+		Type:               conditionTypeNetworkUpdated,
+		Status:             metav1.ConditionTrue,
+		Reason:             "NetworkAttachmentDefinitionUpdated",
+		Message:            fmt.Sprintf("NetworkAttachmentDefinition has been updated: %s", update),
+		LastTransitionTime: metav1.Now(),
+	}, nil
+}
 
+func updateCondition(conditions []metav1.Condition, cond *metav1.Condition) ([]metav1.Condition, bool) {
 	idx := slices.IndexFunc(conditions, func(c metav1.Condition) bool {
-		return (c.Type == cond.Type) &&
-			(c.Status != cond.Status || c.Reason != cond.Reason || c.Message != cond.Message)
+		return c.Type == cond.Type
 	})
-	if idx != -1 {
+	if idx == -1 {
+		return append(conditions, *cond), true
+	}
+	if c := conditions[idx]; c.Status != cond.Status || c.Reason != cond.Reason || c.Message != cond.Message {
 		return slices.Replace(conditions, idx, idx+1, *cond), true
 	}
 	return conditions, false
 }
 
+// updateConditions updates the given conditions, nil conditions are ignored.
+func updateConditions(conditions []metav1.Condition, conds ...*metav1.Condition) ([]metav1.Condition, bool) {
+	var updated bool
+	for _, cond := range conds {
+		if cond == nil {
+			continue
+		}
+		var condUpdated bool
+		conditions, condUpdated = updateCondition(conditions, cond)
+		updated = updated || condUpdated
+	}
+	return conditions, updated
+}
+
 func (c *Controller) cudnNeedUpdate(_ *userdefinednetworkv1.ClusterUserDefinedNetwork, _ *userdefinednetworkv1.ClusterUserDefinedNetwork) bool {
 	return true
 }
@@ -515,15 +570,38 @@ func (c *Controller) reconcileCUDN(key string) error {
 
 	cudnCopy := cudn.DeepCopy()
 
+	prevNADs, err := c.getClusterUDNNADs(name)
+	if err != nil {
+		return err
+	}
+
 	nads, syncErr := c.syncClusterUDN(cudnCopy)
 
-	updateStatusErr := c.updateClusterUDNStatus(cudnCopy, nads, syncErr)
+	var conditions []*metav1.Condition
+	if syncErr == nil {
+		networkUpdatedCondition, err := newClusterNetworkUpdatedCondition(prevNADs, nads)
+		if err != nil {
+			klog.Errorf("Failed to determine updates of ClusterUserDefinedNetwork %q: %v", key, err)
+		}
+		conditions = append(conditions, networkUpdatedCondition)
+	}
+	if cudnCopy != nil {
+		conditions = append(conditions, newClusterNetworkRouteImportsCondition(cudnCopy.Spec.RouteImports))
+	}
+
+	updateStatusErr := c.updateClusterUDNStatus(cudnCopy, nads, syncErr, conditions...)
 
 	var networkInUse *networkInUseError
 	if errors.As(syncErr, &networkInUse) {
 		c.cudnController.ReconcileAfter(key, c.networkInUseRequeueInterval)
 		return updateStatusErr
 	}
+	// the conflicting network might go away, check again later
+	var vlanConflict *localnetVLANConflictError
+	if errors.As(syncErr, &vlanConflict) {
+		c.cudnController.ReconcileAfter(key, c.networkInUseRequeueInterval)
+		return updateStatusErr
+	}
 
 	return errors.Join(syncErr, updateStatusErr)
 }
@@ -583,6 +661,10 @@ func (c *Controller) syncClusterUDN(cudn *userdefinednetworkv1.ClusterUserDefine
 		klog.Infof("Added Finalizer to ClusterUserDefinedNetwork %q", cudnName)
 	}
 
+	if err := c.validateLocalnetVLANs(cudn); err != nil {
+		return nil, err
+	}
+
 	selectedNamespaces, err := c.getSelectedNamespaces(cudn.Spec.NamespaceSelector)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get selected namespaces: %w", err)
@@ -630,7 +712,29 @@ func (c *Controller) getSelectedNamespaces(sel metav1.LabelSelector) (sets.Set[s
 	return selectedNamespaces, nil
 }
 
-func (c *Controller) updateClusterUDNStatus(cudn *userdefinednetworkv1.ClusterUserDefinedNetwork, nads []netv1.NetworkAttachmentDefinition, syncError error) error {
+// getClusterUDNNADs returns the NADs named after the given ClusterUserDefinedNetwork indexed by namespace.
+func (c *Controller) getClusterUDNNADs(name string) (map[string]*netv1.NetworkAttachmentDefinition, error) {
+	nads, err := c.nadLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list NetworkAttachmentDefinitions: %w", err)
+	}
+	cudnNADs := map[string]*netv1.NetworkAttachmentDefinition{}
+	for _, nad := range nads {
+		if nad.Name == name {
+			cudnNADs[nad.Namespace] = nad
+		}
+	}
+	return cudnNADs, nil
+}
+
+// updateClusterUDNStatus updates the ClusterUserDefinedNetwork NetworkCreated condition along with any other given
+// condition.
+func (c *Controller) updateClusterUDNStatus(
+	cudn *userdefinednetworkv1.ClusterUserDefinedNetwork,
+	nads []netv1.NetworkAttachmentDefinition,
+	syncError error,
+	otherConditions ...*metav1.Condition,
+) error {
 	if cudn == nil {
 		return nil
 	}
@@ -642,7 +746,7 @@ func (c *Controller) updateClusterUDNStatus(cudn *userdefinednetworkv1.ClusterUs
 
 	networkCreatedCondition := newClusterNetworCreatedCondition(nads, syncError)
 
-	conditions, updated := updateCondition(cudn.Status.Conditions, networkCreatedCondition)
+	conditions, updated := updateConditions(cudn.Status.Conditions, append(otherConditions, networkCreatedCondition)...)
 	if !updated {
 		return nil
 	}
@@ -710,3 +814,67 @@ func newClusterNetworCreatedCondition(nads []netv1.NetworkAttachmentDefinition,
 
 	return condition
 }
+
+// newClusterNetworkUpdatedCondition returns a NetworkUpdated condition describing the network configuration updated
+// in place from the previous NADs, or nil if there is no such update.
+func newClusterNetworkUpdatedCondition(
+	prevNADs map[string]*netv1.NetworkAttachmentDefinition,
+	nads []netv1.NetworkAttachmentDefinition,
+) (*metav1.Condition, error) {
+	var update string
+	var namespaces []string
+	for i := range nads {
+		nadUpdate, err := NetAttachDefInPlaceUpdate(prevNADs[nads[i].Namespace], &nads[i])
+		if err != nil {
+			return nil, err
+		}
+		if nadUpdate == "" {
+			continue
+		}
+		// all the NADs are rendered from the same spec and thus hold the
+		// same update
+		update = nadUpdate
+		namespaces = append(namespaces, nads[i].Namespace)
+	}
+	if len(namespaces) == 0 {
+		return nil, nil
+	}
+	slices.Sort(namespaces)
+	return &metav1.Condition{
+		Type:   conditionTypeNetworkUpdated,
+		Status: metav1.ConditionTrue,
+		Reason: "NetworkAttachmentDefinitionUpdated",
+		Message: fmt.Sprintf("NetworkAttachmentDefinition has been updated in following namespaces: [%s]: %s",
+			strings.Join(namespaces, ", "), update),
+		LastTransitionTime: metav1.Now(),
+	}, nil
+}
+
+// newClusterNetworkRouteImportsCondition returns a RouteImportsAccepted condition reporting the prefixes imported from
+// more than one network, which are not imported at all, or nil if the network imports no routes.
+func newClusterNetworkRouteImportsCondition(routeImports []userdefinednetworkv1.RouteImport) *metav1.Condition {
+	if len(routeImports) == 0 {
+		return nil
+	}
+	condition := &metav1.Condition{
+		Type:               conditionTypeRouteImportsAccepted,
+		Status:             metav1.ConditionTrue,
+		Reason:             "RouteImportsAccepted",
+		Message:            "All route imports have been accepted",
+		LastTransitionTime: metav1.Now(),
+	}
+	conflicts := util.GetRouteImportConflicts(routeImports)
+	if len(conflicts) == 0 {
+		return condition
+	}
+	prefixes := slices.Sorted(maps.Keys(conflicts))
+	var rejected []string
+	for _, prefix := range prefixes {
+		rejected = append(rejected, fmt.Sprintf("%s from [%s]", prefix, strings.Join(conflicts[prefix], ", ")))
+	}
+	condition.Status = metav1.ConditionFalse
+	condition.Reason = "RouteImportConflict"
+	condition.Message = fmt.Sprintf("Prefixes imported from more than one network are not imported: %s",
+		strings.Join(rejected, "; "))
+	return condition
+}
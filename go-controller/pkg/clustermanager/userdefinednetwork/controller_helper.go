@@ -10,11 +10,13 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/userdefinednetwork/template"
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 	utiludn "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/udn"
@@ -129,3 +131,84 @@ func (c *Controller) deleteNAD(obj client.Object, namespace string) error {
 
 	return nil
 }
+
+// localnetVLANConflictError is returned when a Localnet ClusterUserDefinedNetwork uses VLANs already in use by
+// another ClusterUserDefinedNetwork on the same physical network.
+type localnetVLANConflictError struct {
+	physicalNetworkName string
+	cudnName            string
+	vlans               []userdefinednetworkv1.VLANID
+}
+
+func (e *localnetVLANConflictError) Error() string {
+	if len(e.vlans) == 1 && e.vlans[0] == untaggedVLAN {
+		return fmt.Sprintf("untagged traffic of physical network %q is already in use by ClusterUserDefinedNetwork %q",
+			e.physicalNetworkName, e.cudnName)
+	}
+	return fmt.Sprintf("VLANs %v of physical network %q are already in use by ClusterUserDefinedNetwork %q",
+		e.vlans, e.physicalNetworkName, e.cudnName)
+}
+
+// validateLocalnetVLANs checks the VLANs of the given Localnet ClusterUserDefinedNetwork don't overlap with the ones
+// of other ClusterUserDefinedNetworks sharing its physical network. On conflicts, the oldest network keeps its VLANs.
+func (c *Controller) validateLocalnetVLANs(cudn *userdefinednetworkv1.ClusterUserDefinedNetwork) error {
+	vlans := localnetVLANs(cudn)
+	if vlans.Len() == 0 {
+		return nil
+	}
+	cudns, err := c.cudnLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list ClusterUserDefinedNetworks: %w", err)
+	}
+	for _, other := range cudns {
+		if other.Name == cudn.Name || !other.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if other.Spec.Network.Localnet == nil ||
+			other.Spec.Network.Localnet.PhysicalNetworkName != cudn.Spec.Network.Localnet.PhysicalNetworkName {
+			continue
+		}
+		if !isOlderClusterUDN(other, cudn) {
+			continue
+		}
+		if overlap := vlans.Intersection(localnetVLANs(other)); overlap.Len() > 0 {
+			return &localnetVLANConflictError{
+				physicalNetworkName: cudn.Spec.Network.Localnet.PhysicalNetworkName,
+				cudnName:            other.Name,
+				vlans:               sets.List(overlap),
+			}
+		}
+	}
+	return nil
+}
+
+// untaggedVLAN stands for the untagged traffic of a physical network in the VLANs of a Localnet
+// ClusterUserDefinedNetwork, 0 not being a valid VLAN ID.
+const untaggedVLAN userdefinednetworkv1.VLANID = 0
+
+// localnetVLANs returns the access or trunk VLANs of a Localnet ClusterUserDefinedNetwork, or untaggedVLAN when it
+// has no VLAN configured.
+func localnetVLANs(cudn *userdefinednetworkv1.ClusterUserDefinedNetwork) sets.Set[userdefinednetworkv1.VLANID] {
+	vlans := sets.New[userdefinednetworkv1.VLANID]()
+	localnet := cudn.Spec.Network.Localnet
+	if localnet == nil {
+		return vlans
+	}
+	if localnet.VLAN == nil {
+		return vlans.Insert(untaggedVLAN)
+	}
+	if localnet.VLAN.Access != nil {
+		vlans.Insert(userdefinednetworkv1.VLANID(localnet.VLAN.Access.ID))
+	}
+	if localnet.VLAN.Trunk != nil {
+		vlans.Insert(localnet.VLAN.Trunk.IDs...)
+	}
+	return vlans
+}
+
+func isOlderClusterUDN(a, b *userdefinednetworkv1.ClusterUserDefinedNetwork) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.Name < b.Name
+}
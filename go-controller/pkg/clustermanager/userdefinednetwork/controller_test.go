@@ -407,7 +407,7 @@ var _ = Describe("User Defined Network Controller", func() {
 				}
 				cudn := testClusterUDN("test", testNamespaces...)
 				cudn.Spec.Network = udnv1.NetworkSpec{Topology: udnv1.NetworkTopologyLayer2, Layer2: &udnv1.Layer2Config{
-					Subnets: udnv1.DualStackCIDRs{"10.10.10.0/24"},
+					Subnets: udnv1.Layer2Subnets{"10.10.10.0/24"},
 				}}
 				objs = append(objs, cudn)
 
@@ -1141,6 +1141,72 @@ var _ = Describe("User Defined Network Controller", func() {
 			Expect(err).To(MatchError(expectedErr))
 		})
 
+		It("should fail when localnet VLANs conflict with an older CR on the same physical network", func() {
+			localnetCUDN := func(name string, created time.Time, physNet string, vlan *udnv1.VLANConfig) *udnv1.ClusterUserDefinedNetwork {
+				cudn := testClusterUDN(name, "blue")
+				cudn.CreationTimestamp = metav1.NewTime(created)
+				cudn.Spec.Network = udnv1.NetworkSpec{
+					Topology: udnv1.NetworkTopologyLocalnet,
+					Localnet: &udnv1.LocalnetConfig{
+						Role:                udnv1.NetworkRoleSecondary,
+						PhysicalNetworkName: physNet,
+						VLAN:                vlan,
+					},
+				}
+				return cudn
+			}
+			now := time.Now()
+			oldCUDN := localnetCUDN("old", now.Add(-time.Hour), "physnet",
+				&udnv1.VLANConfig{Mode: udnv1.VLANModeTrunk, Trunk: &udnv1.TrunkVLANConfig{IDs: []udnv1.VLANID{10, 20}}})
+			otherPhysNetCUDN := localnetCUDN("other", now.Add(-time.Hour), "another-physnet",
+				&udnv1.VLANConfig{Mode: udnv1.VLANModeAccess, Access: &udnv1.AccessVLANConfig{ID: 30}})
+			conflictingCUDN := localnetCUDN("conflicting", now, "physnet",
+				&udnv1.VLANConfig{Mode: udnv1.VLANModeAccess, Access: &udnv1.AccessVLANConfig{ID: 20}})
+			validCUDN := localnetCUDN("valid", now, "physnet",
+				&udnv1.VLANConfig{Mode: udnv1.VLANModeAccess, Access: &udnv1.AccessVLANConfig{ID: 30}})
+			c := newTestController(noopRenderNadStub(), oldCUDN, otherPhysNetCUDN, conflictingCUDN, validCUDN)
+
+			_, err := c.syncClusterUDN(conflictingCUDN)
+			Expect(err).To(MatchError(`VLANs [20] of physical network "physnet" are already in use by ClusterUserDefinedNetwork "old"`))
+
+			_, err = c.syncClusterUDN(oldCUDN)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = c.syncClusterUDN(validCUDN)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should fail when a localnet without VLAN conflicts with an older CR on the same physical network", func() {
+			localnetCUDN := func(name string, created time.Time, vlan *udnv1.VLANConfig) *udnv1.ClusterUserDefinedNetwork {
+				cudn := testClusterUDN(name, "blue")
+				cudn.CreationTimestamp = metav1.NewTime(created)
+				cudn.Spec.Network = udnv1.NetworkSpec{
+					Topology: udnv1.NetworkTopologyLocalnet,
+					Localnet: &udnv1.LocalnetConfig{
+						Role:                udnv1.NetworkRoleSecondary,
+						PhysicalNetworkName: "physnet",
+						VLAN:                vlan,
+					},
+				}
+				return cudn
+			}
+			now := time.Now()
+			oldCUDN := localnetCUDN("old", now.Add(-time.Hour), nil)
+			conflictingCUDN := localnetCUDN("conflicting", now, nil)
+			trunkCUDN := localnetCUDN("trunk", now,
+				&udnv1.VLANConfig{Mode: udnv1.VLANModeTrunk, Trunk: &udnv1.TrunkVLANConfig{IDs: []udnv1.VLANID{10}}})
+			c := newTestController(noopRenderNadStub(), oldCUDN, conflictingCUDN, trunkCUDN)
+
+			_, err := c.syncClusterUDN(conflictingCUDN)
+			Expect(err).To(MatchError(`untagged traffic of physical network "physnet" is already in use by ClusterUserDefinedNetwork "old"`))
+
+			_, err = c.syncClusterUDN(oldCUDN)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = c.syncClusterUDN(trunkCUDN)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
 		It("when CR is deleted, CR has no finalizer, should succeed", func() {
 			deletedCUDN := testClusterUDN("test", "blue")
 			deletedCUDN.Finalizers = []string{}
@@ -1305,6 +1371,46 @@ var _ = Describe("User Defined Network Controller", func() {
 				},
 			}))
 		})
+		It("should reflect route imports conflicts", func() {
+			cudn := testClusterUDN("test", "red")
+			cudn.Spec.RouteImports = []udnv1.RouteImport{
+				{Network: "blue", Prefixes: []udnv1.CIDR{"10.10.0.0/16", "10.50.0.0/24"}},
+				{Network: "green", Prefixes: []udnv1.CIDR{"10.50.0.1/24"}},
+			}
+			c := newTestController(noopRenderNadStub(), cudn)
+
+			testNADs := []netv1.NetworkAttachmentDefinition{*testClusterUdnNAD(cudn.Name, "red")}
+			Expect(c.updateClusterUDNStatus(cudn, testNADs, nil, newClusterNetworkRouteImportsCondition(cudn.Spec.RouteImports))).To(Succeed())
+
+			cudn, err := cs.UserDefinedNetworkClient.K8sV1().ClusterUserDefinedNetworks().Get(context.Background(), cudn.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(normalizeConditions(cudn.Status.Conditions)).To(ConsistOf([]metav1.Condition{
+				{
+					Type:    "NetworkCreated",
+					Status:  "True",
+					Reason:  "NetworkAttachmentDefinitionCreated",
+					Message: "NetworkAttachmentDefinition has been created in following namespaces: [red]",
+				},
+				{
+					Type:    "RouteImportsAccepted",
+					Status:  "False",
+					Reason:  "RouteImportConflict",
+					Message: "Prefixes imported from more than one network are not imported: 10.50.0.0/24 from [blue, green]",
+				},
+			}))
+
+			cudn.Spec.RouteImports = cudn.Spec.RouteImports[:1]
+			Expect(c.updateClusterUDNStatus(cudn, testNADs, nil, newClusterNetworkRouteImportsCondition(cudn.Spec.RouteImports))).To(Succeed())
+
+			cudn, err = cs.UserDefinedNetworkClient.K8sV1().ClusterUserDefinedNetworks().Get(context.Background(), cudn.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(normalizeConditions(cudn.Status.Conditions)).To(ContainElement(metav1.Condition{
+				Type:    "RouteImportsAccepted",
+				Status:  "True",
+				Reason:  "RouteImportsAccepted",
+				Message: "All route imports have been accepted",
+			}))
+		})
 	})
 })
 
@@ -3,10 +3,12 @@ package userdefinednetwork
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	netv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/userdefinednetwork/template"
 	cnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
@@ -50,3 +52,49 @@ func PrimaryNetAttachDefNotExist(nads []*netv1.NetworkAttachmentDefinition) erro
 	}
 	return nil
 }
+
+// NetAttachDefInPlaceUpdate describes the network configuration updated in place from the previous to the current
+// NAD: MTU changes and appended subnets. It returns an empty string if there is no such update.
+func NetAttachDefInPlaceUpdate(prevNAD, nad *netv1.NetworkAttachmentDefinition) (string, error) {
+	if prevNAD == nil || nad == nil || prevNAD.Spec.Config == nad.Spec.Config {
+		return "", nil
+	}
+	var prevNetConf, netConf *cnitypes.NetConf
+	if err := json.Unmarshal([]byte(prevNAD.Spec.Config), &prevNetConf); err != nil {
+		return "", fmt.Errorf("failed to unmarshal NetworkAttachmentDefinition [%s/%s]: %w", prevNAD.Namespace, prevNAD.Name, err)
+	}
+	if err := json.Unmarshal([]byte(nad.Spec.Config), &netConf); err != nil {
+		return "", fmt.Errorf("failed to unmarshal NetworkAttachmentDefinition [%s/%s]: %w", nad.Namespace, nad.Name, err)
+	}
+
+	var updates []string
+	if prevNetConf.MTU != netConf.MTU {
+		updates = append(updates, fmt.Sprintf("MTU changed from %s to %s",
+			mtuString(prevNetConf.MTU), mtuString(netConf.MTU)))
+	}
+	prevSubnets := sets.New(splitSubnets(prevNetConf.Subnets)...)
+	var appendedSubnets []string
+	for _, subnet := range splitSubnets(netConf.Subnets) {
+		if !prevSubnets.Has(subnet) {
+			appendedSubnets = append(appendedSubnets, subnet)
+		}
+	}
+	if len(appendedSubnets) > 0 {
+		updates = append(updates, fmt.Sprintf("subnets %v appended", appendedSubnets))
+	}
+	return strings.Join(updates, "; "), nil
+}
+
+func mtuString(mtu int) string {
+	if mtu == 0 {
+		return "default"
+	}
+	return fmt.Sprintf("%d", mtu)
+}
+
+func splitSubnets(subnets string) []string {
+	if subnets == "" {
+		return nil
+	}
+	return strings.Split(subnets, ",")
+}
@@ -122,3 +122,46 @@ var _ = Describe("PrimaryNetAttachDefNotExist", func() {
 		Expect(PrimaryNetAttachDefNotExist(nads)).ToNot(Succeed())
 	})
 })
+
+var _ = Describe("NetAttachDefInPlaceUpdate", func() {
+	nadWithConfig := func(config string) *netv1.NetworkAttachmentDefinition {
+		return &netv1.NetworkAttachmentDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-net", Namespace: "blue"},
+			Spec:       netv1.NetworkAttachmentDefinitionSpec{Config: config},
+		}
+	}
+
+	DescribeTable("should describe the update",
+		func(prevNAD, nad *netv1.NetworkAttachmentDefinition, expectedUpdate string) {
+			Expect(NetAttachDefInPlaceUpdate(prevNAD, nad)).To(Equal(expectedUpdate))
+		},
+		Entry("no previous NAD",
+			nil,
+			nadWithConfig(`{"cniVersion": "1.0.0","type": "ovn-k8s-cni-overlay","mtu": 1400,"subnets": "10.1.0.0/16/24"}`),
+			"",
+		),
+		Entry("no changes",
+			nadWithConfig(`{"cniVersion": "1.0.0","type": "ovn-k8s-cni-overlay","mtu": 1400,"subnets": "10.1.0.0/16/24"}`),
+			nadWithConfig(`{"cniVersion": "1.0.0","type": "ovn-k8s-cni-overlay","mtu": 1400,"subnets": "10.1.0.0/16/24"}`),
+			"",
+		),
+		Entry("MTU changed",
+			nadWithConfig(`{"cniVersion": "1.0.0","type": "ovn-k8s-cni-overlay","subnets": "10.1.0.0/16/24"}`),
+			nadWithConfig(`{"cniVersion": "1.0.0","type": "ovn-k8s-cni-overlay","mtu": 1300,"subnets": "10.1.0.0/16/24"}`),
+			"MTU changed from default to 1300",
+		),
+		Entry("MTU changed and subnets appended",
+			nadWithConfig(`{"cniVersion": "1.0.0","type": "ovn-k8s-cni-overlay","mtu": 1400,"subnets": "10.1.0.0/16/24"}`),
+			nadWithConfig(`{"cniVersion": "1.0.0","type": "ovn-k8s-cni-overlay","mtu": 1300,"subnets": "10.1.0.0/16/24,10.2.0.0/16/24"}`),
+			"MTU changed from 1400 to 1300; subnets [10.2.0.0/16/24] appended",
+		),
+	)
+
+	It("should fail given an invalid NAD config", func() {
+		_, err := NetAttachDefInPlaceUpdate(
+			nadWithConfig(`{"cniVersion": "1.0.0","type": "ovn-k8s-cni-overlay","mtu": 1400}`),
+			nadWithConfig(`INVALID`),
+		)
+		Expect(err).To(HaveOccurred())
+	})
+})
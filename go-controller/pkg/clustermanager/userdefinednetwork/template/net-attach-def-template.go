@@ -35,6 +35,18 @@ type SpecGetter interface {
 	GetLocalnet() *userdefinednetworkv1.LocalnetConfig
 }
 
+// NamespaceIsolationGetter is implemented by the specs of networks that span
+// multiple namespaces.
+type NamespaceIsolationGetter interface {
+	GetNamespaceIsolation() userdefinednetworkv1.NamespaceIsolationMode
+}
+
+// ExternalIPsGetter is implemented by the specs of networks that can be
+// identified by per node external IPs.
+type ExternalIPsGetter interface {
+	GetExternalIPs() []userdefinednetworkv1.NodeExternalIPs
+}
+
 func RenderNetAttachDefManifest(obj client.Object, targetNamespace string) (*netv1.NetworkAttachmentDefinition, error) {
 	if obj == nil {
 		return nil, nil
@@ -54,7 +66,7 @@ func RenderNetAttachDefManifest(obj client.Object, targetNamespace string) (*net
 		networkName = util.GenerateUDNNetworkName(targetNamespace, obj.GetName())
 	case *userdefinednetworkv1.ClusterUserDefinedNetwork:
 		ownerRef = *metav1.NewControllerRef(obj, userdefinednetworkv1.SchemeGroupVersion.WithKind("ClusterUserDefinedNetwork"))
-		spec = &o.Spec.Network
+		spec = &o.Spec
 		networkName = util.GenerateCUDNNetworkName(obj.GetName())
 	default:
 		return nil, fmt.Errorf("unknown type %T", obj)
@@ -147,10 +159,14 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter) (map[s
 		if !ipamEnabled(cfg.IPAM) && len(cfg.Subnets) > 0 {
 			return nil, fmt.Errorf("subnets must be unset when ipam.mode is Disabled")
 		}
+		if !ipamEnabled(cfg.IPAM) && cfg.DHCP == userdefinednetworkv1.DHCPEnabled {
+			return nil, fmt.Errorf("dhcp Enabled is only supported when ipam.mode is Enabled")
+		}
 
 		netConfSpec.Role = strings.ToLower(string(cfg.Role))
 		netConfSpec.MTU = int(cfg.MTU)
 		netConfSpec.AllowPersistentIPs = cfg.IPAM != nil && cfg.IPAM.Lifecycle == userdefinednetworkv1.IPAMLifecyclePersistent
+		netConfSpec.EnableDHCP = cfg.DHCP == userdefinednetworkv1.DHCPEnabled
 		netConfSpec.Subnets = cidrString(cfg.Subnets)
 		netConfSpec.JoinSubnet = cidrString(renderJoinSubnets(cfg.Role, cfg.JoinSubnets))
 	case userdefinednetworkv1.NetworkTopologyLocalnet:
@@ -165,6 +181,29 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter) (map[s
 		if cfg.VLAN != nil && cfg.VLAN.Access != nil {
 			netConfSpec.VLANID = int(cfg.VLAN.Access.ID)
 		}
+		if cfg.VLAN != nil && cfg.VLAN.Trunk != nil {
+			for _, id := range cfg.VLAN.Trunk.IDs {
+				netConfSpec.TrunkVLANIDs = append(netConfSpec.TrunkVLANIDs, int(id))
+			}
+		}
+	}
+
+	if isolationGetter, ok := spec.(NamespaceIsolationGetter); ok &&
+		isolationGetter.GetNamespaceIsolation() == userdefinednetworkv1.NamespaceIsolationEnabled {
+		if netConfSpec.Subnets == "" {
+			return nil, fmt.Errorf("namespaceIsolation Enabled is only supported when the network IPAM is enabled")
+		}
+		netConfSpec.IsolateNamespaces = true
+	}
+
+	if externalIPsGetter, ok := spec.(ExternalIPsGetter); ok && len(externalIPsGetter.GetExternalIPs()) > 0 {
+		if netConfSpec.Role != types.NetworkRolePrimary || spec.GetTopology() == userdefinednetworkv1.NetworkTopologyLocalnet {
+			return nil, fmt.Errorf("externalIPs is only supported for Primary Layer3 and Layer2 networks")
+		}
+		netConfSpec.ExternalIPs = map[string]string{}
+		for _, nodeExternalIPs := range externalIPsGetter.GetExternalIPs() {
+			netConfSpec.ExternalIPs[nodeExternalIPs.Node] = ipString(nodeExternalIPs.IPs)
+		}
 	}
 
 	if err := util.ValidateNetConf(nadName, netConfSpec); err != nil {
@@ -200,6 +239,15 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter) (map[s
 	if netConfSpec.AllowPersistentIPs {
 		cniNetConf["allowPersistentIPs"] = netConfSpec.AllowPersistentIPs
 	}
+	if netConfSpec.EnableDHCP {
+		cniNetConf["enableDHCP"] = netConfSpec.EnableDHCP
+	}
+	if netConfSpec.IsolateNamespaces {
+		cniNetConf["isolateNamespaces"] = netConfSpec.IsolateNamespaces
+	}
+	if len(netConfSpec.ExternalIPs) > 0 {
+		cniNetConf["externalIPs"] = netConfSpec.ExternalIPs
+	}
 	if netConfSpec.PhysicalNetworkName != "" {
 		cniNetConf["physicalNetworkName"] = netConfSpec.PhysicalNetworkName
 	}
@@ -209,6 +257,9 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter) (map[s
 	if netConfSpec.VLANID != 0 {
 		cniNetConf["vlanID"] = netConfSpec.VLANID
 	}
+	if len(netConfSpec.TrunkVLANIDs) > 0 {
+		cniNetConf["trunkVLANIDs"] = netConfSpec.TrunkVLANIDs
+	}
 	return cniNetConf, nil
 }
 
@@ -266,7 +317,7 @@ func layer3SubnetsString(subnets []userdefinednetworkv1.Layer3Subnet) string {
 }
 
 type cidr interface {
-	userdefinednetworkv1.DualStackCIDRs | []userdefinednetworkv1.CIDR
+	userdefinednetworkv1.DualStackCIDRs | userdefinednetworkv1.Layer2Subnets | []userdefinednetworkv1.CIDR
 }
 
 func cidrString[T cidr](subnets T) string {
@@ -277,6 +328,16 @@ func cidrString[T cidr](subnets T) string {
 	return strings.Join(cidrs, ",")
 }
 
+// ipString converts DualStackIPs to comma seperated string
+// (e.g.: "172.18.0.100,fc00::100").
+func ipString(ips userdefinednetworkv1.DualStackIPs) string {
+	var ipStrs []string
+	for _, ip := range ips {
+		ipStrs = append(ipStrs, string(ip))
+	}
+	return strings.Join(ipStrs, ",")
+}
+
 func GetSpec(obj client.Object) SpecGetter {
 	switch o := obj.(type) {
 	case *userdefinednetworkv1.UserDefinedNetwork:
@@ -24,7 +24,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 			&udnv1.UserDefinedNetworkSpec{
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
-					Subnets: udnv1.DualStackCIDRs{"abc"},
+					Subnets: udnv1.Layer2Subnets{"abc"},
 				},
 			},
 		),
@@ -187,7 +187,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:    udnv1.NetworkRoleSecondary,
-					Subnets: udnv1.DualStackCIDRs{"192.168.100.0/16"},
+					Subnets: udnv1.Layer2Subnets{"192.168.100.0/16"},
 					IPAM: &udnv1.IPAMConfig{
 						Lifecycle: udnv1.IPAMLifecyclePersistent,
 						Mode:      udnv1.IPAMDisabled,
@@ -200,7 +200,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:    udnv1.NetworkRoleSecondary,
-					Subnets: udnv1.DualStackCIDRs{},
+					Subnets: udnv1.Layer2Subnets{},
 					IPAM: &udnv1.IPAMConfig{
 						Mode: udnv1.IPAMEnabled,
 					},
@@ -212,7 +212,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:    udnv1.NetworkRoleSecondary,
-					Subnets: udnv1.DualStackCIDRs{"192.168.100.0/16"},
+					Subnets: udnv1.Layer2Subnets{"192.168.100.0/16"},
 					IPAM: &udnv1.IPAMConfig{
 						Mode: udnv1.IPAMDisabled,
 					},
@@ -252,6 +252,30 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				},
 			}}},
 		),
+		Entry("CUDN, namespace isolation & disabled ipam mode",
+			&udnv1.ClusterUserDefinedNetwork{Spec: udnv1.ClusterUserDefinedNetworkSpec{
+				Network: udnv1.NetworkSpec{
+					Topology: udnv1.NetworkTopologyLayer2,
+					Layer2: &udnv1.Layer2Config{
+						Role: udnv1.NetworkRoleSecondary,
+						IPAM: &udnv1.IPAMConfig{Mode: udnv1.IPAMDisabled},
+					},
+				},
+				NamespaceIsolation: udnv1.NamespaceIsolationEnabled,
+			}},
+		),
+		Entry("CUDN, external IPs & secondary network",
+			&udnv1.ClusterUserDefinedNetwork{Spec: udnv1.ClusterUserDefinedNetworkSpec{
+				Network: udnv1.NetworkSpec{
+					Topology: udnv1.NetworkTopologyLayer3,
+					Layer3: &udnv1.Layer3Config{
+						Role:    udnv1.NetworkRoleSecondary,
+						Subnets: []udnv1.Layer3Subnet{{CIDR: "192.168.100.0/16"}},
+					},
+				},
+				ExternalIPs: []udnv1.NodeExternalIPs{{Node: "node1", IPs: udnv1.DualStackIPs{"172.18.0.100"}}},
+			}},
+		),
 	)
 
 	It("should return no error given no UDN", func() {
@@ -272,6 +296,68 @@ var _ = Describe("NetAttachDefTemplate", func() {
 		Expect(err).To(HaveOccurred())
 	})
 
+	It("should create CUDN NAD isolating namespaces", func() {
+		cudn := &udnv1.ClusterUserDefinedNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-net", UID: "1"},
+			Spec: udnv1.ClusterUserDefinedNetworkSpec{
+				Network: udnv1.NetworkSpec{
+					Topology: udnv1.NetworkTopologyLayer2,
+					Layer2: &udnv1.Layer2Config{
+						Role:    udnv1.NetworkRoleSecondary,
+						Subnets: udnv1.Layer2Subnets{"192.168.100.0/24"},
+					},
+				},
+				NamespaceIsolation: udnv1.NamespaceIsolationEnabled,
+			},
+		}
+		nad, err := RenderNetAttachDefManifest(cudn, "mynamespace")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nad.Spec.Config).To(MatchJSON(`{
+		  "cniVersion": "1.0.0",
+		  "type": "ovn-k8s-cni-overlay",
+		  "name": "cluster_udn_test-net",
+		  "netAttachDefName": "mynamespace/test-net",
+		  "role": "secondary",
+		  "topology": "layer2",
+		  "subnets": "192.168.100.0/24",
+		  "isolateNamespaces": true
+		}`))
+	})
+
+	It("should create CUDN NAD with external IPs", func() {
+		cudn := &udnv1.ClusterUserDefinedNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-net", UID: "1"},
+			Spec: udnv1.ClusterUserDefinedNetworkSpec{
+				Network: udnv1.NetworkSpec{
+					Topology: udnv1.NetworkTopologyLayer2,
+					Layer2: &udnv1.Layer2Config{
+						Role:    udnv1.NetworkRolePrimary,
+						Subnets: udnv1.Layer2Subnets{"192.168.100.0/24", "2001:dbb::/64"},
+					},
+				},
+				ExternalIPs: []udnv1.NodeExternalIPs{
+					{Node: "node1", IPs: udnv1.DualStackIPs{"172.18.0.100", "fc00::100"}},
+					{Node: "node2", IPs: udnv1.DualStackIPs{"172.18.0.101"}},
+				},
+			},
+		}
+		config.IPv4Mode = true
+		config.IPv6Mode = true
+		nad, err := RenderNetAttachDefManifest(cudn, "mynamespace")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nad.Spec.Config).To(MatchJSON(`{
+		  "cniVersion": "1.0.0",
+		  "type": "ovn-k8s-cni-overlay",
+		  "name": "cluster_udn_test-net",
+		  "netAttachDefName": "mynamespace/test-net",
+		  "role": "primary",
+		  "topology": "layer2",
+		  "joinSubnet": "100.65.0.0/16,fd99::/64",
+		  "subnets": "192.168.100.0/24,2001:dbb::/64",
+		  "externalIPs": {"node1": "172.18.0.100,fc00::100", "node2": "172.18.0.101"}
+		}`))
+	})
+
 	DescribeTable("should create UDN NAD from spec",
 		func(testSpec udnv1.UserDefinedNetworkSpec, expectedNadNetConf string) {
 			testUdn := &udnv1.UserDefinedNetwork{
@@ -336,7 +422,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:    udnv1.NetworkRolePrimary,
-					Subnets: udnv1.DualStackCIDRs{"192.168.100.0/24", "2001:dbb::/64"},
+					Subnets: udnv1.Layer2Subnets{"192.168.100.0/24", "2001:dbb::/64"},
 					MTU:     1500,
 					IPAM: &udnv1.IPAMConfig{
 						Lifecycle: udnv1.IPAMLifecyclePersistent,
@@ -356,12 +442,35 @@ var _ = Describe("NetAttachDefTemplate", func() {
 			  "allowPersistentIPs": true
         	}`,
 		),
+		Entry("primary network, layer2, DHCP enabled",
+			udnv1.UserDefinedNetworkSpec{
+				Topology: udnv1.NetworkTopologyLayer2,
+				Layer2: &udnv1.Layer2Config{
+					Role:    udnv1.NetworkRolePrimary,
+					Subnets: udnv1.Layer2Subnets{"192.168.100.0/24", "2001:dbb::/64"},
+					MTU:     1500,
+					DHCP:    udnv1.DHCPEnabled,
+				},
+			},
+			`{
+			  "cniVersion": "1.0.0",
+			  "type": "ovn-k8s-cni-overlay",
+			  "name": "mynamespace_test-net",
+			  "netAttachDefName": "mynamespace/test-net",
+			  "role": "primary",
+			  "topology": "layer2",
+			  "joinSubnet": "100.65.0.0/16,fd99::/64",
+			  "subnets": "192.168.100.0/24,2001:dbb::/64",
+			  "mtu": 1500,
+			  "enableDHCP": true
+        	}`,
+		),
 		Entry("primary network, should override join-subnets when specified",
 			udnv1.UserDefinedNetworkSpec{
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:        udnv1.NetworkRolePrimary,
-					Subnets:     udnv1.DualStackCIDRs{"192.168.100.0/24", "2001:dbb::/64"},
+					Subnets:     udnv1.Layer2Subnets{"192.168.100.0/24", "2001:dbb::/64"},
 					JoinSubnets: udnv1.DualStackCIDRs{"100.62.0.0/24", "fd92::/64"},
 					MTU:         1500,
 					IPAM: &udnv1.IPAMConfig{
@@ -387,7 +496,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:    udnv1.NetworkRoleSecondary,
-					Subnets: udnv1.DualStackCIDRs{"192.168.100.0/24", "2001:dbb::/64"},
+					Subnets: udnv1.Layer2Subnets{"192.168.100.0/24", "2001:dbb::/64"},
 					MTU:     1500,
 					IPAM: &udnv1.IPAMConfig{
 						Lifecycle: udnv1.IPAMLifecyclePersistent,
@@ -471,7 +580,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:    udnv1.NetworkRolePrimary,
-					Subnets: udnv1.DualStackCIDRs{"192.168.100.0/24", "2001:dbb::/64"},
+					Subnets: udnv1.Layer2Subnets{"192.168.100.0/24", "2001:dbb::/64"},
 					MTU:     1500,
 					IPAM: &udnv1.IPAMConfig{
 						Lifecycle: udnv1.IPAMLifecyclePersistent,
@@ -496,7 +605,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:        udnv1.NetworkRolePrimary,
-					Subnets:     udnv1.DualStackCIDRs{"192.168.100.0/24", "2001:dbb::/64"},
+					Subnets:     udnv1.Layer2Subnets{"192.168.100.0/24", "2001:dbb::/64"},
 					JoinSubnets: udnv1.DualStackCIDRs{"100.62.0.0/24", "fd92::/64"},
 					MTU:         1500,
 					IPAM: &udnv1.IPAMConfig{
@@ -522,7 +631,7 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				Topology: udnv1.NetworkTopologyLayer2,
 				Layer2: &udnv1.Layer2Config{
 					Role:    udnv1.NetworkRoleSecondary,
-					Subnets: udnv1.DualStackCIDRs{"192.168.100.0/24", "2001:dbb::/64"},
+					Subnets: udnv1.Layer2Subnets{"192.168.100.0/24", "2001:dbb::/64"},
 					MTU:     1500,
 					IPAM: &udnv1.IPAMConfig{
 						Lifecycle: udnv1.IPAMLifecyclePersistent,
@@ -571,6 +680,31 @@ var _ = Describe("NetAttachDefTemplate", func() {
 			  "allowPersistentIPs": true
 			}`,
 		),
+		Entry("secondary network, localnet, trunk VLAN",
+			udnv1.NetworkSpec{
+				Topology: udnv1.NetworkTopologyLocalnet,
+				Localnet: &udnv1.LocalnetConfig{
+					Role:                udnv1.NetworkRoleSecondary,
+					PhysicalNetworkName: "mylocalnet1",
+					MTU:                 1600,
+					VLAN:                &udnv1.VLANConfig{Mode: udnv1.VLANModeTrunk, Trunk: &udnv1.TrunkVLANConfig{IDs: []udnv1.VLANID{200, 300}}},
+					IPAM: &udnv1.IPAMConfig{
+						Mode: udnv1.IPAMDisabled,
+					},
+				},
+			},
+			`{
+			  "cniVersion": "1.0.0",
+			  "type": "ovn-k8s-cni-overlay",
+			  "name": "cluster_udn_test-net",
+			  "netAttachDefName": "mynamespace/test-net",
+			  "role": "secondary",
+			  "topology": "localnet",
+		      "physicalNetworkName": "mylocalnet1",
+			  "mtu": 1600,
+              "trunkVLANIDs": [200, 300]
+			}`,
+		),
 		Entry("secondary network, localnet, when MTU is unset it should set default MTU",
 			udnv1.NetworkSpec{
 				Topology: udnv1.NetworkTopologyLocalnet,
@@ -44,11 +44,29 @@ type NetConf struct {
 	JoinSubnet string `json:"joinSubnet,omitempty"`
 	// VLANID, valid in localnet topology network only
 	VLANID int `json:"vlanID,omitempty"`
+	// TrunkVLANIDs, valid in localnet topology network only and mutually
+	// exclusive with VLANID. Traffic tagged with these VLAN IDs is passed
+	// as is to the pods.
+	TrunkVLANIDs []int `json:"trunkVLANIDs,omitempty"`
 	// AllowPersistentIPs is valid on both localnet / layer topologies.
 	// It allows for having IP allocations that outlive the pod for which
 	// they are originally created - e.g. a KubeVirt VM's migration, or
 	// restart.
 	AllowPersistentIPs bool `json:"allowPersistentIPs,omitempty"`
+	// EnableDHCP is valid on layer2 topology only. When set, OVN serves
+	// DHCPv4 / DHCPv6 for the IPs allocated to every pod attached to the
+	// network.
+	EnableDHCP bool `json:"enableDHCP,omitempty"`
+	// IsolateNamespaces drops the traffic between pods of different
+	// namespaces attached to the network, while pods of the same namespace
+	// can still reach each other. Requires subnets to be set.
+	IsolateNamespaces bool `json:"isolateNamespaces,omitempty"`
+	// ExternalIPs is valid on primary layer3 / layer2 topologies in shared
+	// gateway mode only. It maps node names to comma-separated lists of IPs,
+	// at most one per IP family, the traffic of the network egressing the
+	// node is SNATed to and its NodePort services are reachable on.
+	// eg. {"node1": "172.18.0.100,fc00:f853:ccd:e793::100"}
+	ExternalIPs map[string]string `json:"externalIPs,omitempty"`
 
 	// PhysicalNetworkName indicates the name of the physical network to which
 	// the OVN overlay will connect. Only applies to `localnet` topologies.
@@ -82,14 +82,17 @@ var (
 
 	// Logging holds logging-related parsed config file parameters and command-line overrides
 	Logging = LoggingConfig{
-		File:                "", // do not log to a file by default
-		CNIFile:             "",
-		LibovsdbFile:        "",
-		Level:               4,
-		LogFileMaxSize:      100, // Size in Megabytes
-		LogFileMaxBackups:   5,
-		LogFileMaxAge:       5, //days
-		ACLLoggingRateLimit: 20,
+		File:                    "", // do not log to a file by default
+		CNIFile:                 "",
+		LibovsdbFile:            "",
+		Level:                   4,
+		LogFileMaxSize:          100, // Size in Megabytes
+		LogFileMaxBackups:       5,
+		LogFileMaxAge:           5, //days
+		ACLLoggingRateLimit:     20,
+		ACLLogSinkSource:        "/var/log/ovn/ovn-controller.log",
+		ACLLogSinkDir:           "/var/log/ovn-kubernetes/acl",
+		ACLLogSinkEventInterval: 60,
 	}
 
 	// Monitoring holds monitoring-related parsed config file parameters and command-line overrides
@@ -134,6 +137,7 @@ var (
 	// OVNKubernetesFeatureConfig holds OVN-Kubernetes feature enhancement config file parameters and command-line overrides
 	OVNKubernetesFeature = OVNKubernetesFeatureConfig{
 		EgressIPReachabiltyTotalTimeout: 1,
+		DNSSnoopingServices:             "kube-system/kube-dns",
 	}
 
 	// OvnNorth holds northbound OVN database client and server authentication and location details
@@ -317,8 +321,30 @@ type LoggingConfig struct {
 	LogFileMaxAge int `gcfg:"logfile-maxage"`
 	// Logging rate-limiting meter
 	ACLLoggingRateLimit int `gcfg:"acl-logging-rate-limit"`
+	// ACLLogSink enables the ovnkube-node ACL log sink, that writes the ACL log
+	// entries of ovn-controller to per-namespace files or to Kubernetes events
+	ACLLogSink ACLLogSinkType `gcfg:"acl-log-sink"`
+	// ACLLogSinkSource is the ovn-controller log file the ACL log sink reads from
+	ACLLogSinkSource string `gcfg:"acl-log-sink-source"`
+	// ACLLogSinkDir is the directory the file ACL log sink writes per-namespace logs to
+	ACLLogSinkDir string `gcfg:"acl-log-sink-dir"`
+	// ACLLogSinkEventInterval is the minimum number of seconds between two events
+	// of the event ACL log sink for the same ACL and verdict
+	ACLLogSinkEventInterval int `gcfg:"acl-log-sink-event-interval"`
 }
 
+// ACLLogSinkType holds the destination of the ACL log sink
+type ACLLogSinkType string
+
+const (
+	// ACLLogSinkDisabled indicates the ACL log sink is disabled
+	ACLLogSinkDisabled ACLLogSinkType = ""
+	// ACLLogSinkFile writes ACL log entries as JSON lines to one file per namespace
+	ACLLogSinkFile ACLLogSinkType = "file"
+	// ACLLogSinkEvent writes ACL log entries as rate-limited events on the policy objects
+	ACLLogSinkEvent ACLLogSinkType = "event"
+)
+
 // MonitoringConfig holds monitoring-related parsed config file parameters and command-line overrides
 type MonitoringConfig struct {
 	// RawNetFlowTargets holds the unparsed NetFlow targets. Should only be used inside the config module.
@@ -407,6 +433,12 @@ type MetricsConfig struct {
 	// configuration duration and optionally, its application to all nodes
 	EnableConfigDuration bool `gcfg:"enable-config-duration"`
 	EnableScaleMetrics   bool `gcfg:"enable-scale-metrics"`
+	// EnablePolicyHitMetrics holds the boolean flag to enable OVN-Kubernetes node to export the number of packets
+	// that matched each network policy and admin network policy rule. Requires observability to be enabled.
+	EnablePolicyHitMetrics bool `gcfg:"enable-policy-hit-metrics"`
+	// EnableNetworkStatus holds the boolean flag to serve the state of the network controllers on the metrics
+	// port, for debugging purposes
+	EnableNetworkStatus bool `gcfg:"enable-network-status"`
 }
 
 // OVNKubernetesFeatureConfig holds OVN-Kubernetes feature enhancement config file parameters and command-line overrides
@@ -435,7 +467,20 @@ type OVNKubernetesFeatureConfig struct {
 	EnableDNSNameResolver        bool `gcfg:"enable-dns-name-resolver"`
 	EnableServiceTemplateSupport bool `gcfg:"enable-svc-template-support"`
 	EnableObservability          bool `gcfg:"enable-observability"`
-	EnableNetworkQoS             bool `gcfg:"enable-network-qos"`
+	// EnableSamplingPolicy only samples the namespaces and pods selected by
+	// SamplingPolicy objects instead of the whole cluster.
+	EnableSamplingPolicy bool `gcfg:"enable-sampling-policy"`
+	EnableNetworkQoS     bool `gcfg:"enable-network-qos"`
+	// EnableStatelessNetPolReturnACLs generates the ACLs of the return traffic
+	// of stateless network policies, by swapping the peers and the ports of
+	// their rules.
+	EnableStatelessNetPolReturnACLs bool `gcfg:"enable-stateless-netpol-return-acls"`
+	// EnableDNSSnooping resolves the DNS names of EgressFirewall rules,
+	// including wildcard names, from the DNS responses sent to the local pods.
+	EnableDNSSnooping bool `gcfg:"enable-dns-snooping"`
+	// DNSSnoopingServices holds the comma-separated namespaced names of the
+	// DNS services whose responses are snooped.
+	DNSSnoopingServices string `gcfg:"dns-snooping-services"`
 }
 
 // GatewayMode holds the node gateway mode
@@ -540,6 +585,9 @@ type HybridOverlayConfig struct {
 	ClusterSubnets []CIDRNetworkEntry
 	// VXLANPort holds the VXLAN tunnel UDP port number.
 	VXLANPort uint `gcfg:"hybrid-overlay-vxlan-port"`
+	// ExternalPeers indicates whether VXLAN tunnels to ExternalOverlayPeer
+	// resources are programmed.
+	ExternalPeers bool `gcfg:"external-peers"`
 }
 
 // OvnKubeNodeConfig holds ovnkube-node configurations
@@ -548,6 +596,10 @@ type OvnKubeNodeConfig struct {
 	DPResourceDeviceIdsMap map[string][]string
 	MgmtPortNetdev         string `gcfg:"mgmt-port-netdev"`
 	MgmtPortDPResourceName string `gcfg:"mgmt-port-dp-resource-name"`
+	// MgmtPortNetns is the name, or the path, of a dedicated network
+	// namespace where the OVS internal management port is isolated from the
+	// host network namespace.
+	MgmtPortNetns string `gcfg:"mgmt-port-netns"`
 }
 
 // ClusterManagerConfig holds configuration for ovnkube-cluster-manager
@@ -957,6 +1009,29 @@ var CommonFlags = []cli.Flag{
 		Destination: &cliConfig.Logging.ACLLoggingRateLimit,
 		Value:       20,
 	},
+	&cli.StringFlag{
+		Name: "acl-log-sink",
+		Usage: "Write the ACL log entries of ovn-controller to per-namespace files (\"file\") " +
+			"or to events on the policy objects (\"event\"). If not given, the ACL log sink is disabled.",
+	},
+	&cli.StringFlag{
+		Name:        "acl-log-sink-source",
+		Usage:       "The ovn-controller log file the ACL log sink reads ACL log entries from",
+		Destination: &cliConfig.Logging.ACLLogSinkSource,
+		Value:       Logging.ACLLogSinkSource,
+	},
+	&cli.StringFlag{
+		Name:        "acl-log-sink-dir",
+		Usage:       "The directory the file ACL log sink writes per-namespace ACL logs to",
+		Destination: &cliConfig.Logging.ACLLogSinkDir,
+		Value:       Logging.ACLLogSinkDir,
+	},
+	&cli.IntFlag{
+		Name:        "acl-log-sink-event-interval",
+		Usage:       "The minimum number of seconds between two events of the event ACL log sink for the same ACL and verdict",
+		Destination: &cliConfig.Logging.ACLLogSinkEventInterval,
+		Value:       Logging.ACLLogSinkEventInterval,
+	},
 	&cli.StringFlag{
 		Name:        "zone",
 		Usage:       "zone name to which ovnkube-node/ovnkube-controller belongs to",
@@ -1110,6 +1185,13 @@ var OVNK8sFeatureFlags = []cli.Flag{
 		Destination: &cliConfig.OVNKubernetesFeature.EnableStatelessNetPol,
 		Value:       OVNKubernetesFeature.EnableStatelessNetPol,
 	},
+	&cli.BoolFlag{
+		Name: "enable-stateless-netpol-return-acls",
+		Usage: "Configure to also allow the return traffic of stateless network policies, " +
+			"with the ports of their rules as source ports. Requires stateless network policies.",
+		Destination: &cliConfig.OVNKubernetesFeature.EnableStatelessNetPolReturnACLs,
+		Value:       OVNKubernetesFeature.EnableStatelessNetPolReturnACLs,
+	},
 	&cli.BoolFlag{
 		Name:        "enable-interconnect",
 		Usage:       "Configure to enable interconnecting multiple zones.",
@@ -1140,6 +1222,19 @@ var OVNK8sFeatureFlags = []cli.Flag{
 		Destination: &cliConfig.OVNKubernetesFeature.EnableDNSNameResolver,
 		Value:       OVNKubernetesFeature.EnableDNSNameResolver,
 	},
+	&cli.BoolFlag{
+		Name: "enable-dns-snooping",
+		Usage: "Configure to resolve the DNS names of egress firewall rules, including wildcard names, " +
+			"from the DNS responses sent to the local pods. Requires interconnect.",
+		Destination: &cliConfig.OVNKubernetesFeature.EnableDNSSnooping,
+		Value:       OVNKubernetesFeature.EnableDNSSnooping,
+	},
+	&cli.StringFlag{
+		Name:        "dns-snooping-services",
+		Usage:       "A comma-separated list of namespaced names of the DNS services whose responses are snooped",
+		Destination: &cliConfig.OVNKubernetesFeature.DNSSnoopingServices,
+		Value:       OVNKubernetesFeature.DNSSnoopingServices,
+	},
 	&cli.BoolFlag{
 		Name:        "enable-svc-template-support",
 		Usage:       "Configure to use svc-template with ovn-kubernetes.",
@@ -1152,6 +1247,12 @@ var OVNK8sFeatureFlags = []cli.Flag{
 		Destination: &cliConfig.OVNKubernetesFeature.EnableObservability,
 		Value:       OVNKubernetesFeature.EnableObservability,
 	},
+	&cli.BoolFlag{
+		Name:        "enable-sampling-policy",
+		Usage:       "Configure to use SamplingPolicy CRD to select the namespaces and pods to sample, requires observability to be enabled.",
+		Destination: &cliConfig.OVNKubernetesFeature.EnableSamplingPolicy,
+		Value:       OVNKubernetesFeature.EnableSamplingPolicy,
+	},
 	&cli.BoolFlag{
 		Name:        "enable-network-qos",
 		Usage:       "Configure to use NetworkQoS CRD feature with ovn-kubernetes.",
@@ -1327,6 +1428,16 @@ var MetricsFlags = []cli.Flag{
 		Usage:       "Enables metrics related to scaling",
 		Destination: &cliConfig.Metrics.EnableScaleMetrics,
 	},
+	&cli.BoolFlag{
+		Name:        "metrics-enable-policy-hits",
+		Usage:       "Enables metrics counting the packets that matched each network policy rule on the node, requires observability to be enabled",
+		Destination: &cliConfig.Metrics.EnablePolicyHitMetrics,
+	},
+	&cli.BoolFlag{
+		Name:        "metrics-enable-network-status",
+		Usage:       "If true, then also serve the state of the network controllers on the metrics port, under /debug/networks.",
+		Destination: &cliConfig.Metrics.EnableNetworkStatus,
+	},
 }
 
 // OvnNBFlags capture OVN northbound database options
@@ -1608,6 +1719,13 @@ var HybridOverlayFlags = []cli.Flag{
 		Usage:       "The UDP port used by the VXLAN protocol for hybrid networks.",
 		Destination: &cliConfig.HybridOverlay.VXLANPort,
 	},
+	&cli.BoolFlag{
+		Name: "enable-hybrid-overlay-external-peers",
+		Usage: "Enables hybrid overlay VXLAN tunnels towards hosts outside of the " +
+			"cluster described by ExternalOverlayPeer resources. Requires " +
+			"hybrid-overlay-cluster-subnets to be set.",
+		Destination: &cliConfig.HybridOverlay.ExternalPeers,
+	},
 }
 
 // OvnKubeNodeFlags captures ovnkube-node specific configurations
@@ -1633,6 +1751,14 @@ var OvnKubeNodeFlags = []cli.Flag{
 		Value:       OvnKubeNode.MgmtPortDPResourceName,
 		Destination: &cliConfig.OvnKubeNode.MgmtPortDPResourceName,
 	},
+	&cli.StringFlag{
+		Name: "ovnkube-node-mgmt-port-netns",
+		Usage: "When provided, the OVS internal management port ovn-k8s-mp0 is isolated in this network namespace, " +
+			"created if it does not exist, instead of the host network namespace. Either a name of a network " +
+			"namespace under /var/run/netns or an absolute path to a network namespace.",
+		Value:       OvnKubeNode.MgmtPortNetns,
+		Destination: &cliConfig.OvnKubeNode.MgmtPortNetns,
+	},
 	&cli.BoolFlag{
 		Name:        "disable-ovn-iface-id-ver",
 		Usage:       "Deprecated; iface-id-ver is always enabled",
@@ -2016,6 +2142,20 @@ func buildOVNKubernetesFeatureConfig(cli, file *config) error {
 	if err := overrideFields(&OVNKubernetesFeature, &cli.OVNKubernetesFeature, &savedOVNKubernetesFeature); err != nil {
 		return err
 	}
+	if OVNKubernetesFeature.EnableStatelessNetPolReturnACLs && !OVNKubernetesFeature.EnableStatelessNetPol {
+		return fmt.Errorf("stateless network policy return acls require stateless network policies to be enabled")
+	}
+	if OVNKubernetesFeature.EnableDNSSnooping {
+		if OVNKubernetesFeature.EnableDNSNameResolver {
+			return fmt.Errorf("dns snooping and dns name resolver can't be enabled together")
+		}
+		if !OVNKubernetesFeature.EnableInterconnect {
+			return fmt.Errorf("dns snooping requires interconnect to be enabled")
+		}
+		if _, err := parseServicesNamespacedNames(OVNKubernetesFeature.DNSSnoopingServices); err != nil {
+			return fmt.Errorf("dns snooping services field is invalid: %v", err)
+		}
+	}
 	return nil
 }
 
@@ -2127,6 +2267,10 @@ func buildHybridOverlayConfig(cli, file *config) error {
 		return fmt.Errorf("hybrid overlay vxlan port is invalid. The port cannot be larger than 65535")
 	}
 
+	if HybridOverlay.ExternalPeers && (!HybridOverlay.Enabled || len(HybridOverlay.RawClusterSubnets) == 0) {
+		return fmt.Errorf("hybrid overlay external peers require hybrid overlay to be enabled with cluster subnets")
+	}
+
 	return nil
 }
 
@@ -2149,6 +2293,19 @@ func completeHybridOverlayConfig(allSubnets *ConfigSubnets) error {
 	return nil
 }
 
+// ContainedInHybridOverlayClusterSubnets returns true if the given subnet is
+// fully contained within one of the hybrid overlay cluster subnets.
+func ContainedInHybridOverlayClusterSubnets(subnet *net.IPNet) bool {
+	subnetSize, _ := subnet.Mask.Size()
+	for _, entry := range HybridOverlay.ClusterSubnets {
+		clusterSize, _ := entry.CIDR.Mask.Size()
+		if entry.CIDR.Contains(subnet.IP) && clusterSize <= subnetSize {
+			return true
+		}
+	}
+	return false
+}
+
 func buildClusterManagerConfig(cli, file *config) error {
 	// Copy config file values over default values
 	if err := overrideFields(&ClusterManager, &file.ClusterManager, &savedClusterManager); err != nil {
@@ -2352,6 +2509,7 @@ func initConfigWithPath(ctx *cli.Context, exec kexec.Interface, saPath string, d
 	}
 
 	// Logging setup
+	cliConfig.Logging.ACLLogSink = ACLLogSinkType(ctx.String("acl-log-sink"))
 	if err = overrideFields(&Logging, &cfg.Logging, &savedLogging); err != nil {
 		return "", err
 	}
@@ -2359,6 +2517,16 @@ func initConfigWithPath(ctx *cli.Context, exec kexec.Interface, saPath string, d
 		return "", err
 	}
 
+	switch Logging.ACLLogSink {
+	case ACLLogSinkDisabled, ACLLogSinkFile, ACLLogSinkEvent:
+	default:
+		return "", fmt.Errorf("invalid acl log sink %q: expect one of %s,%s", Logging.ACLLogSink,
+			ACLLogSinkFile, ACLLogSinkEvent)
+	}
+	if Logging.ACLLogSinkEventInterval <= 0 {
+		return "", fmt.Errorf("invalid acl log sink event interval %d: must be positive", Logging.ACLLogSinkEventInterval)
+	}
+
 	var level klog.Level
 	if err := level.Set(strconv.Itoa(Logging.Level)); err != nil {
 		return "", fmt.Errorf("failed to set klog log level %v", err)
@@ -2789,5 +2957,20 @@ func buildOvnKubeNodeConfig(cli, file *config) error {
 	if OvnKubeNode.Mode == types.NodeModeDPUHost && OvnKubeNode.MgmtPortNetdev == "" && OvnKubeNode.MgmtPortDPResourceName == "" {
 		return fmt.Errorf("ovnkube-node-mgmt-port-netdev or ovnkube-node-mgmt-port-dp-resource-name must be provided")
 	}
+
+	// an isolated management port is only supported with an OVS internal
+	// port and when the host does not rely on it to forward traffic
+	if OvnKubeNode.MgmtPortNetns != "" {
+		if OvnKubeNode.Mode != types.NodeModeFull || OvnKubeNode.MgmtPortNetdev != "" || OvnKubeNode.MgmtPortDPResourceName != "" {
+			return fmt.Errorf("ovnkube-node-mgmt-port-netns is only supported in ovnkube-node mode %s with an OVS internal management port",
+				types.NodeModeFull)
+		}
+		if Gateway.Mode == GatewayModeLocal {
+			return fmt.Errorf("ovnkube-node-mgmt-port-netns is not supported with gateway mode %s", GatewayModeLocal)
+		}
+		if OVNKubernetesFeature.EgressIPNodeHealthCheckPort != 0 {
+			return fmt.Errorf("ovnkube-node-mgmt-port-netns is not supported with egressip-node-healthcheck-port")
+		}
+	}
 	return nil
 }
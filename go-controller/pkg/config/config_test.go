@@ -166,10 +166,13 @@ node-server-privkey=/path/to/node-metrics-private.key
 node-server-cert=/path/to/node-metrics.crt
 enable-config-duration=true
 enable-scale-metrics=true
+enable-policy-hit-metrics=true
+enable-network-status=true
 
 [logging]
 loglevel=5
 logfile=/var/log/ovnkube.log
+acl-log-sink=event
 
 [monitoring]
 netflow-targets=2.2.2.2:2055
@@ -620,6 +623,8 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(Logging.File).To(gomega.Equal("/var/log/ovnkube.log"))
 			gomega.Expect(Logging.Level).To(gomega.Equal(5))
 			gomega.Expect(Logging.ACLLoggingRateLimit).To(gomega.Equal(20))
+			gomega.Expect(Logging.ACLLogSink).To(gomega.Equal(ACLLogSinkEvent))
+			gomega.Expect(Logging.ACLLogSinkEventInterval).To(gomega.Equal(60))
 			gomega.Expect(Monitoring.RawNetFlowTargets).To(gomega.Equal("2.2.2.2:2055"))
 			gomega.Expect(Monitoring.RawSFlowTargets).To(gomega.Equal("2.2.2.2:2056"))
 			gomega.Expect(Monitoring.RawIPFIXTargets).To(gomega.Equal("2.2.2.2:2057"))
@@ -653,6 +658,8 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(Metrics.NodeServerCert).To(gomega.Equal("/path/to/node-metrics.crt"))
 			gomega.Expect(Metrics.EnableConfigDuration).To(gomega.BeTrue())
 			gomega.Expect(Metrics.EnableScaleMetrics).To(gomega.BeTrue())
+			gomega.Expect(Metrics.EnablePolicyHitMetrics).To(gomega.BeTrue())
+			gomega.Expect(Metrics.EnableNetworkStatus).To(gomega.BeTrue())
 
 			gomega.Expect(OvnNorth.Scheme).To(gomega.Equal(OvnDBSchemeSSL))
 			gomega.Expect(OvnNorth.PrivKey).To(gomega.Equal("/path/to/nb-client-private.key"))
@@ -733,6 +740,8 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(Logging.File).To(gomega.Equal("/some/logfile"))
 			gomega.Expect(Logging.Level).To(gomega.Equal(3))
 			gomega.Expect(Logging.ACLLoggingRateLimit).To(gomega.Equal(30))
+			gomega.Expect(Logging.ACLLogSink).To(gomega.Equal(ACLLogSinkFile))
+			gomega.Expect(Logging.ACLLogSinkDir).To(gomega.Equal("/var/log/acl"))
 			gomega.Expect(CNI.ConfDir).To(gomega.Equal("/some/cni/dir"))
 			gomega.Expect(CNI.Plugin).To(gomega.Equal("a-plugin"))
 			gomega.Expect(Kubernetes.Kubeconfig).To(gomega.Equal(kubeconfigFile))
@@ -763,6 +772,8 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(Metrics.NodeServerCert).To(gomega.Equal("/tls/nodecert"))
 			gomega.Expect(Metrics.EnableConfigDuration).To(gomega.BeTrue())
 			gomega.Expect(Metrics.EnableScaleMetrics).To(gomega.BeTrue())
+			gomega.Expect(Metrics.EnablePolicyHitMetrics).To(gomega.BeTrue())
+			gomega.Expect(Metrics.EnableNetworkStatus).To(gomega.BeTrue())
 
 			gomega.Expect(OvnNorth.Scheme).To(gomega.Equal(OvnDBSchemeSSL))
 			gomega.Expect(OvnNorth.PrivKey).To(gomega.Equal("/client/privkey"))
@@ -820,6 +831,8 @@ var _ = Describe("Config Operations", func() {
 			"-loglevel=3",
 			"-logfile=/some/logfile",
 			"-acl-logging-rate-limit=30",
+			"-acl-log-sink=file",
+			"-acl-log-sink-dir=/var/log/acl",
 			"-cni-conf-dir=/some/cni/dir",
 			"-cni-plugin=a-plugin",
 			"-cluster-subnets=10.130.0.0/15/24",
@@ -1119,6 +1132,64 @@ enable-pprof=true
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
 	})
 
+	It("returns an error when the acl log sink is invalid", func() {
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).To(gomega.MatchError("invalid acl log sink \"syslog\": expect one of file,event"))
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-acl-log-sink=syslog",
+		}
+		err := app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	It("returns an error when stateless netpol return acls are enabled without stateless netpol", func() {
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).To(gomega.MatchError("stateless network policy return acls require stateless network policies to be enabled"))
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-enable-stateless-netpol-return-acls",
+		}
+		err := app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	It("returns an error when dns snooping is enabled without interconnect", func() {
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).To(gomega.MatchError("dns snooping requires interconnect to be enabled"))
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-enable-dns-snooping",
+		}
+		err := app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	It("returns an error when dns snooping and dns name resolver are enabled together", func() {
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).To(gomega.MatchError("dns snooping and dns name resolver can't be enabled together"))
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-enable-interconnect",
+			"-enable-dns-snooping",
+			"-enable-dns-name-resolver",
+		}
+		err := app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
 	It("returns an error when the vlan-id is specified for mode other than shared gateway mode", func() {
 		app.Action = func(ctx *cli.Context) error {
 			_, err := InitConfig(ctx, kexec.New(), nil)
@@ -2031,5 +2102,58 @@ udn-allowed-default-services= ns/svc, ns1/svc1
 			err := buildOvnKubeNodeConfig(&cliConfig, &file)
 			gomega.Expect(err).ToNot(gomega.HaveOccurred())
 		})
+
+		It("Succeeds if management port network namespace provided in the full mode", func() {
+			cliConfig := config{
+				OvnKubeNode: OvnKubeNodeConfig{
+					Mode:          types.NodeModeFull,
+					MgmtPortNetns: "ovn-k8s-mp",
+				},
+			}
+			file := config{
+				OvnKubeNode: OvnKubeNodeConfig{
+					Mode: types.NodeModeFull,
+				},
+			}
+			err := buildOvnKubeNodeConfig(&cliConfig, &file)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(OvnKubeNode.MgmtPortNetns).To(gomega.Equal("ovn-k8s-mp"))
+		})
+
+		It("Fails if management port network namespace provided with a management netdev", func() {
+			cliConfig := config{
+				OvnKubeNode: OvnKubeNodeConfig{
+					Mode:           types.NodeModeFull,
+					MgmtPortNetdev: "ens1f0v0",
+					MgmtPortNetns:  "ovn-k8s-mp",
+				},
+			}
+			file := config{
+				OvnKubeNode: OvnKubeNodeConfig{
+					Mode: types.NodeModeFull,
+				},
+			}
+			err := buildOvnKubeNodeConfig(&cliConfig, &file)
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("with an OVS internal management port"))
+		})
+
+		It("Fails if management port network namespace provided in local gateway mode", func() {
+			Gateway.Mode = GatewayModeLocal
+			cliConfig := config{
+				OvnKubeNode: OvnKubeNodeConfig{
+					Mode:          types.NodeModeFull,
+					MgmtPortNetns: "ovn-k8s-mp",
+				},
+			}
+			file := config{
+				OvnKubeNode: OvnKubeNodeConfig{
+					Mode: types.NodeModeFull,
+				},
+			}
+			err := buildOvnKubeNodeConfig(&cliConfig, &file)
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(err.Error()).To(gomega.ContainSubstring("not supported with gateway mode local"))
+		})
 	})
 })
@@ -29,6 +29,7 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/observability"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/samplingpolicy"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/udnenabledsvc"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/routeimport"
 	ovntypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
@@ -306,33 +307,7 @@ func (cm *ControllerManager) configureMetrics(stopChan <-chan struct{}) {
 }
 
 func (cm *ControllerManager) createACLLoggingMeter() error {
-	band := &nbdb.MeterBand{
-		Action: ovntypes.MeterAction,
-		Rate:   config.Logging.ACLLoggingRateLimit,
-	}
-	ops, err := libovsdbops.CreateMeterBandOps(cm.nbClient, nil, band)
-	if err != nil {
-		return fmt.Errorf("can't create meter band %v: %v", band, err)
-	}
-
-	meterFairness := true
-	meter := &nbdb.Meter{
-		Name: ovntypes.OvnACLLoggingMeter,
-		Fair: &meterFairness,
-		Unit: ovntypes.PacketsPerSecond,
-	}
-	ops, err = libovsdbops.CreateOrUpdateMeterOps(cm.nbClient, ops, meter, []*nbdb.MeterBand{band},
-		&meter.Bands, &meter.Fair, &meter.Unit)
-	if err != nil {
-		return fmt.Errorf("can't create meter %v: %v", meter, err)
-	}
-
-	_, err = libovsdbops.TransactAndCheck(cm.nbClient, ops)
-	if err != nil {
-		return fmt.Errorf("can't transact ACL logging meter: %v", err)
-	}
-
-	return nil
+	return libovsdbutil.EnsureACLLoggingMeter(cm.nbClient, nil)
 }
 
 // newCommonNetworkControllerInfo creates and returns the common networkController info
@@ -458,6 +433,16 @@ func (cm *ControllerManager) Start(ctx context.Context) error {
 		if err = observabilityManager.Init(); err != nil {
 			return fmt.Errorf("failed to init observability manager: %w", err)
 		}
+		observabilityManager.RunSamplePoints(ovn.DefaultNetworkControllerName, cm.stopChan)
+		if config.OVNKubernetesFeature.EnableSamplingPolicy {
+			samplingPolicyController := samplingpolicy.NewController(cm.nbClient, observabilityManager,
+				cm.watchFactory.SamplingPolicyInformer(), cm.watchFactory.NetworkPolicyCoreInformer(), cm.watchFactory.PodCoreInformer())
+			go func() {
+				if err := samplingPolicyController.Run(cm.stopChan); err != nil {
+					klog.Errorf("SamplingPolicy controller failed: %v", err)
+				}
+			}()
+		}
 	} else {
 		err = observability.Cleanup(cm.nbClient)
 		if err != nil {
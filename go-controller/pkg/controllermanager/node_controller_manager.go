@@ -48,6 +48,8 @@ type NodeControllerManager struct {
 	routeManager *routemanager.Controller
 	// iprule manager that creates and manages iprules for all UDNs
 	ruleManager *iprulemanager.Controller
+	// route leak manager that leaks routes between UDN VRFs
+	routeLeakManager *node.UDNRouteLeakManager
 	// ovs client that allows to read ovs info
 	ovsClient client.Client
 }
@@ -128,6 +130,10 @@ func NewNodeControllerManager(ovnClient *util.OVNClientset, wf factory.NodeWatch
 	if util.IsNetworkSegmentationSupportEnabled() {
 		ncm.vrfManager = vrfmanager.NewController(ncm.routeManager)
 		ncm.ruleManager = iprulemanager.NewController(config.IPv4Mode, config.IPv6Mode)
+		if cudnInformer := wf.ClusterUserDefinedNetworkInformer(); cudnInformer != nil {
+			ncm.routeLeakManager = node.NewUDNRouteLeakManager(cudnInformer, ncm.networkManager.Interface(),
+				ncm.vrfManager, ncm.ruleManager)
+		}
 	}
 	return ncm, nil
 }
@@ -223,6 +229,16 @@ func (ncm *NodeControllerManager) Start(ctx context.Context) (err error) {
 		if err := ncm.ruleManager.OwnPriority(node.UDNMasqueradeIPRulePriority); err != nil {
 			return fmt.Errorf("failed to own priority %d for IP rules: %v", node.UDNMasqueradeIPRulePriority, err)
 		}
+		if err := ncm.ruleManager.OwnPriority(node.UDNRouteLeakIPRulePriority); err != nil {
+			return fmt.Errorf("failed to own priority %d for IP rules: %v", node.UDNRouteLeakIPRulePriority, err)
+		}
+	}
+
+	if ncm.routeLeakManager != nil {
+		err = ncm.routeLeakManager.Start()
+		if err != nil {
+			return fmt.Errorf("failed to start UDN route leak manager: %w", err)
+		}
 	}
 	return nil
 }
@@ -240,6 +256,10 @@ func (ncm *NodeControllerManager) Stop() {
 	if ncm.networkManager != nil {
 		ncm.networkManager.Stop()
 	}
+
+	if ncm.routeLeakManager != nil {
+		ncm.routeLeakManager.Stop()
+	}
 }
 
 // checkForStaleOVSRepresentorInterfaces checks for stale OVS ports backed by Repreresentor interfaces,
@@ -378,5 +398,9 @@ func checkForStaleOVSInternalPorts() {
 }
 
 func (ncm *NodeControllerManager) Reconcile(_ string, _, _ util.NetInfo) error {
+	if ncm.routeLeakManager != nil {
+		// networks routes are leaked from or into might have changed
+		ncm.routeLeakManager.Reconcile()
+	}
 	return nil
 }
@@ -0,0 +1,62 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	externaloverlaypeerv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1"
+)
+
+// ExternalOverlayPeerSpecApplyConfiguration represents a declarative configuration of the ExternalOverlayPeerSpec type for use
+// with apply.
+type ExternalOverlayPeerSpecApplyConfiguration struct {
+	VTEP    *string                      `json:"vtep,omitempty"`
+	MAC     *string                      `json:"mac,omitempty"`
+	Subnets []externaloverlaypeerv1.CIDR `json:"subnets,omitempty"`
+}
+
+// ExternalOverlayPeerSpecApplyConfiguration constructs a declarative configuration of the ExternalOverlayPeerSpec type for use with
+// apply.
+func ExternalOverlayPeerSpec() *ExternalOverlayPeerSpecApplyConfiguration {
+	return &ExternalOverlayPeerSpecApplyConfiguration{}
+}
+
+// WithVTEP sets the VTEP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VTEP field is set to the value of the last call.
+func (b *ExternalOverlayPeerSpecApplyConfiguration) WithVTEP(value string) *ExternalOverlayPeerSpecApplyConfiguration {
+	b.VTEP = &value
+	return b
+}
+
+// WithMAC sets the MAC field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MAC field is set to the value of the last call.
+func (b *ExternalOverlayPeerSpecApplyConfiguration) WithMAC(value string) *ExternalOverlayPeerSpecApplyConfiguration {
+	b.MAC = &value
+	return b
+}
+
+// WithSubnets adds the given value to the Subnets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Subnets field.
+func (b *ExternalOverlayPeerSpecApplyConfiguration) WithSubnets(values ...externaloverlaypeerv1.CIDR) *ExternalOverlayPeerSpecApplyConfiguration {
+	for i := range values {
+		b.Subnets = append(b.Subnets, values[i])
+	}
+	return b
+}
@@ -0,0 +1,71 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+
+	externaloverlaypeerv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1"
+	applyconfigurationexternaloverlaypeerv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1/apis/applyconfiguration/externaloverlaypeer/v1"
+	scheme "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1/apis/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// ExternalOverlayPeersGetter has a method to return a ExternalOverlayPeerInterface.
+// A group's client should implement this interface.
+type ExternalOverlayPeersGetter interface {
+	ExternalOverlayPeers() ExternalOverlayPeerInterface
+}
+
+// ExternalOverlayPeerInterface has methods to work with ExternalOverlayPeer resources.
+type ExternalOverlayPeerInterface interface {
+	Create(ctx context.Context, externalOverlayPeer *externaloverlaypeerv1.ExternalOverlayPeer, opts metav1.CreateOptions) (*externaloverlaypeerv1.ExternalOverlayPeer, error)
+	Update(ctx context.Context, externalOverlayPeer *externaloverlaypeerv1.ExternalOverlayPeer, opts metav1.UpdateOptions) (*externaloverlaypeerv1.ExternalOverlayPeer, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*externaloverlaypeerv1.ExternalOverlayPeer, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*externaloverlaypeerv1.ExternalOverlayPeerList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *externaloverlaypeerv1.ExternalOverlayPeer, err error)
+	Apply(ctx context.Context, externalOverlayPeer *applyconfigurationexternaloverlaypeerv1.ExternalOverlayPeerApplyConfiguration, opts metav1.ApplyOptions) (result *externaloverlaypeerv1.ExternalOverlayPeer, err error)
+	ExternalOverlayPeerExpansion
+}
+
+// externalOverlayPeers implements ExternalOverlayPeerInterface
+type externalOverlayPeers struct {
+	*gentype.ClientWithListAndApply[*externaloverlaypeerv1.ExternalOverlayPeer, *externaloverlaypeerv1.ExternalOverlayPeerList, *applyconfigurationexternaloverlaypeerv1.ExternalOverlayPeerApplyConfiguration]
+}
+
+// newExternalOverlayPeers returns a ExternalOverlayPeers
+func newExternalOverlayPeers(c *K8sV1Client) *externalOverlayPeers {
+	return &externalOverlayPeers{
+		gentype.NewClientWithListAndApply[*externaloverlaypeerv1.ExternalOverlayPeer, *externaloverlaypeerv1.ExternalOverlayPeerList, *applyconfigurationexternaloverlaypeerv1.ExternalOverlayPeerApplyConfiguration](
+			"externaloverlaypeers",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *externaloverlaypeerv1.ExternalOverlayPeer { return &externaloverlaypeerv1.ExternalOverlayPeer{} },
+			func() *externaloverlaypeerv1.ExternalOverlayPeerList {
+				return &externaloverlaypeerv1.ExternalOverlayPeerList{}
+			},
+		),
+	}
+}
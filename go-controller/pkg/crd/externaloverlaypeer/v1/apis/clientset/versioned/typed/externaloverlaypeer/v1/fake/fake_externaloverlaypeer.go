@@ -0,0 +1,52 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1"
+	externaloverlaypeerv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1/apis/applyconfiguration/externaloverlaypeer/v1"
+	typedexternaloverlaypeerv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1/apis/clientset/versioned/typed/externaloverlaypeer/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeExternalOverlayPeers implements ExternalOverlayPeerInterface
+type fakeExternalOverlayPeers struct {
+	*gentype.FakeClientWithListAndApply[*v1.ExternalOverlayPeer, *v1.ExternalOverlayPeerList, *externaloverlaypeerv1.ExternalOverlayPeerApplyConfiguration]
+	Fake *FakeK8sV1
+}
+
+func newFakeExternalOverlayPeers(fake *FakeK8sV1) typedexternaloverlaypeerv1.ExternalOverlayPeerInterface {
+	return &fakeExternalOverlayPeers{
+		gentype.NewFakeClientWithListAndApply[*v1.ExternalOverlayPeer, *v1.ExternalOverlayPeerList, *externaloverlaypeerv1.ExternalOverlayPeerApplyConfiguration](
+			fake.Fake,
+			"",
+			v1.SchemeGroupVersion.WithResource("externaloverlaypeers"),
+			v1.SchemeGroupVersion.WithKind("ExternalOverlayPeer"),
+			func() *v1.ExternalOverlayPeer { return &v1.ExternalOverlayPeer{} },
+			func() *v1.ExternalOverlayPeerList { return &v1.ExternalOverlayPeerList{} },
+			func(dst, src *v1.ExternalOverlayPeerList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.ExternalOverlayPeerList) []*v1.ExternalOverlayPeer {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1.ExternalOverlayPeerList, items []*v1.ExternalOverlayPeer) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,88 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+	time "time"
+
+	crdexternaloverlaypeerv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1"
+	versioned "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1/apis/clientset/versioned"
+	internalinterfaces "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1/apis/informers/externalversions/internalinterfaces"
+	externaloverlaypeerv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1/apis/listers/externaloverlaypeer/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ExternalOverlayPeerInformer provides access to a shared informer and lister for
+// ExternalOverlayPeers.
+type ExternalOverlayPeerInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() externaloverlaypeerv1.ExternalOverlayPeerLister
+}
+
+type externalOverlayPeerInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewExternalOverlayPeerInformer constructs a new informer for ExternalOverlayPeer type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewExternalOverlayPeerInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredExternalOverlayPeerInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredExternalOverlayPeerInformer constructs a new informer for ExternalOverlayPeer type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredExternalOverlayPeerInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.K8sV1().ExternalOverlayPeers().List(context.TODO(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.K8sV1().ExternalOverlayPeers().Watch(context.TODO(), options)
+			},
+		},
+		&crdexternaloverlaypeerv1.ExternalOverlayPeer{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *externalOverlayPeerInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredExternalOverlayPeerInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *externalOverlayPeerInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&crdexternaloverlaypeerv1.ExternalOverlayPeer{}, f.defaultInformer)
+}
+
+func (f *externalOverlayPeerInformer) Lister() externaloverlaypeerv1.ExternalOverlayPeerLister {
+	return externaloverlaypeerv1.NewExternalOverlayPeerLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,47 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	externaloverlaypeerv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ExternalOverlayPeerLister helps list ExternalOverlayPeers.
+// All objects returned here must be treated as read-only.
+type ExternalOverlayPeerLister interface {
+	// List lists all ExternalOverlayPeers in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*externaloverlaypeerv1.ExternalOverlayPeer, err error)
+	// Get retrieves the ExternalOverlayPeer from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*externaloverlaypeerv1.ExternalOverlayPeer, error)
+	ExternalOverlayPeerListerExpansion
+}
+
+// externalOverlayPeerLister implements the ExternalOverlayPeerLister interface.
+type externalOverlayPeerLister struct {
+	listers.ResourceIndexer[*externaloverlaypeerv1.ExternalOverlayPeer]
+}
+
+// NewExternalOverlayPeerLister returns a new ExternalOverlayPeerLister.
+func NewExternalOverlayPeerLister(indexer cache.Indexer) ExternalOverlayPeerLister {
+	return &externalOverlayPeerLister{listers.New[*externaloverlaypeerv1.ExternalOverlayPeer](indexer, externaloverlaypeerv1.Resource("externaloverlaypeer"))}
+}
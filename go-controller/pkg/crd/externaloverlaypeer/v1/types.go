@@ -0,0 +1,65 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=externaloverlaypeers,scope=Cluster,shortName=eop,singular=externaloverlaypeer
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="VTEP",type=string,JSONPath=".spec.vtep"
+// +kubebuilder:printcolumn:name="Subnets",type=string,JSONPath=".spec.subnets"
+// ExternalOverlayPeer describes a host outside of the Kubernetes cluster that
+// terminates the hybrid overlay VXLAN tunnel. The subnets behind the peer are
+// made reachable from pods through the hybrid overlay on every Linux node.
+type ExternalOverlayPeer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +required
+	Spec ExternalOverlayPeerSpec `json:"spec"`
+}
+
+// ExternalOverlayPeerSpec defines the desired state of ExternalOverlayPeer
+type ExternalOverlayPeerSpec struct {
+	// vtep is the IPv4 address of the VXLAN tunnel endpoint of the peer. It
+	// must be reachable from the nodes.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="isIP(self) && ip(self).family() == 4",message="vtep must be a valid IPv4 address"
+	// +required
+	VTEP string `json:"vtep"`
+
+	// mac is the MAC address the peer expects as the inner destination
+	// address of the frames it receives over the tunnel.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^([0-9A-Fa-f]{2}:){5}[0-9A-Fa-f]{2}$`
+	// +required
+	MAC string `json:"mac"`
+
+	// subnets are the IPv4 subnets reachable behind the peer. They must be
+	// contained within the configured hybrid overlay cluster subnets.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, x == y))",message="subnets must be unique"
+	// +required
+	Subnets []CIDR `json:"subnets"`
+}
+
+// CIDR is an IPv4 subnet in CIDR notation.
+// +kubebuilder:validation:XValidation:rule="isCIDR(self) && cidr(self).ip().family() == 4",message="CIDR must be a valid IPv4 network address"
+// +kubebuilder:validation:MaxLength=43
+type CIDR string
+
+// ExternalOverlayPeerList contains a list of ExternalOverlayPeer
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ExternalOverlayPeerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ExternalOverlayPeer `json:"items"`
+}
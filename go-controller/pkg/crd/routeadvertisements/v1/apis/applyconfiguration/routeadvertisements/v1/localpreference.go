@@ -0,0 +1,51 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// LocalPreferenceApplyConfiguration represents a declarative configuration of the LocalPreference type for use
+// with apply.
+type LocalPreferenceApplyConfiguration struct {
+	NodeSelector *metav1.LabelSelectorApplyConfiguration `json:"nodeSelector,omitempty"`
+	Value        *uint32                                 `json:"value,omitempty"`
+}
+
+// LocalPreferenceApplyConfiguration constructs a declarative configuration of the LocalPreference type for use with
+// apply.
+func LocalPreference() *LocalPreferenceApplyConfiguration {
+	return &LocalPreferenceApplyConfiguration{}
+}
+
+// WithNodeSelector sets the NodeSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeSelector field is set to the value of the last call.
+func (b *LocalPreferenceApplyConfiguration) WithNodeSelector(value *metav1.LabelSelectorApplyConfiguration) *LocalPreferenceApplyConfiguration {
+	b.NodeSelector = value
+	return b
+}
+
+// WithValue sets the Value field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Value field is set to the value of the last call.
+func (b *LocalPreferenceApplyConfiguration) WithValue(value uint32) *LocalPreferenceApplyConfiguration {
+	b.Value = &value
+	return b
+}
@@ -0,0 +1,47 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// NetworkVNIApplyConfiguration represents a declarative configuration of the NetworkVNI type for use
+// with apply.
+type NetworkVNIApplyConfiguration struct {
+	Network *string `json:"network,omitempty"`
+	VNI     *int32  `json:"vni,omitempty"`
+}
+
+// NetworkVNIApplyConfiguration constructs a declarative configuration of the NetworkVNI type for use with
+// apply.
+func NetworkVNI() *NetworkVNIApplyConfiguration {
+	return &NetworkVNIApplyConfiguration{}
+}
+
+// WithNetwork sets the Network field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Network field is set to the value of the last call.
+func (b *NetworkVNIApplyConfiguration) WithNetwork(value string) *NetworkVNIApplyConfiguration {
+	b.Network = &value
+	return b
+}
+
+// WithVNI sets the VNI field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VNI field is set to the value of the last call.
+func (b *NetworkVNIApplyConfiguration) WithVNI(value int32) *NetworkVNIApplyConfiguration {
+	b.VNI = &value
+	return b
+}
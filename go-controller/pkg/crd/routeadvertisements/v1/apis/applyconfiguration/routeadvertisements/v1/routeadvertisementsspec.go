@@ -31,6 +31,11 @@ type RouteAdvertisementsSpecApplyConfiguration struct {
 	NodeSelector             *metav1.LabelSelectorApplyConfiguration   `json:"nodeSelector,omitempty"`
 	FRRConfigurationSelector *metav1.LabelSelectorApplyConfiguration   `json:"frrConfigurationSelector,omitempty"`
 	Advertisements           []routeadvertisementsv1.AdvertisementType `json:"advertisements,omitempty"`
+	Communities              []routeadvertisementsv1.BGPCommunity      `json:"communities,omitempty"`
+	LocalPreferences         []LocalPreferenceApplyConfiguration       `json:"localPreferences,omitempty"`
+	AggregatePrefixes        *bool                                     `json:"aggregatePrefixes,omitempty"`
+	EVPN                     *EVPNConfigApplyConfiguration             `json:"evpn,omitempty"`
+	ImportPolicy             *RouteImportPolicyApplyConfiguration      `json:"importPolicy,omitempty"`
 }
 
 // RouteAdvertisementsSpecApplyConfiguration constructs a declarative configuration of the RouteAdvertisementsSpec type for use with
@@ -80,3 +85,50 @@ func (b *RouteAdvertisementsSpecApplyConfiguration) WithAdvertisements(values ..
 	}
 	return b
 }
+
+// WithCommunities adds the given value to the Communities field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Communities field.
+func (b *RouteAdvertisementsSpecApplyConfiguration) WithCommunities(values ...routeadvertisementsv1.BGPCommunity) *RouteAdvertisementsSpecApplyConfiguration {
+	for i := range values {
+		b.Communities = append(b.Communities, values[i])
+	}
+	return b
+}
+
+// WithLocalPreferences adds the given value to the LocalPreferences field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the LocalPreferences field.
+func (b *RouteAdvertisementsSpecApplyConfiguration) WithLocalPreferences(values ...*LocalPreferenceApplyConfiguration) *RouteAdvertisementsSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithLocalPreferences")
+		}
+		b.LocalPreferences = append(b.LocalPreferences, *values[i])
+	}
+	return b
+}
+
+// WithAggregatePrefixes sets the AggregatePrefixes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AggregatePrefixes field is set to the value of the last call.
+func (b *RouteAdvertisementsSpecApplyConfiguration) WithAggregatePrefixes(value bool) *RouteAdvertisementsSpecApplyConfiguration {
+	b.AggregatePrefixes = &value
+	return b
+}
+
+// WithEVPN sets the EVPN field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EVPN field is set to the value of the last call.
+func (b *RouteAdvertisementsSpecApplyConfiguration) WithEVPN(value *EVPNConfigApplyConfiguration) *RouteAdvertisementsSpecApplyConfiguration {
+	b.EVPN = value
+	return b
+}
+
+// WithImportPolicy sets the ImportPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ImportPolicy field is set to the value of the last call.
+func (b *RouteAdvertisementsSpecApplyConfiguration) WithImportPolicy(value *RouteImportPolicyApplyConfiguration) *RouteAdvertisementsSpecApplyConfiguration {
+	b.ImportPolicy = value
+	return b
+}
@@ -0,0 +1,73 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	routeadvertisementsv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1"
+)
+
+// RouteImportPolicyApplyConfiguration represents a declarative configuration of the RouteImportPolicy type for use
+// with apply.
+type RouteImportPolicyApplyConfiguration struct {
+	AllowedPrefixes   []routeadvertisementsv1.CIDR             `json:"allowedPrefixes,omitempty"`
+	AllowedNextHops   []routeadvertisementsv1.CIDR             `json:"allowedNextHops,omitempty"`
+	MaxPrefixes       *int32                                   `json:"maxPrefixes,omitempty"`
+	MaxPrefixesAction *routeadvertisementsv1.MaxPrefixesAction `json:"maxPrefixesAction,omitempty"`
+}
+
+// RouteImportPolicyApplyConfiguration constructs a declarative configuration of the RouteImportPolicy type for use with
+// apply.
+func RouteImportPolicy() *RouteImportPolicyApplyConfiguration {
+	return &RouteImportPolicyApplyConfiguration{}
+}
+
+// WithAllowedPrefixes adds the given value to the AllowedPrefixes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AllowedPrefixes field.
+func (b *RouteImportPolicyApplyConfiguration) WithAllowedPrefixes(values ...routeadvertisementsv1.CIDR) *RouteImportPolicyApplyConfiguration {
+	for i := range values {
+		b.AllowedPrefixes = append(b.AllowedPrefixes, values[i])
+	}
+	return b
+}
+
+// WithAllowedNextHops adds the given value to the AllowedNextHops field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AllowedNextHops field.
+func (b *RouteImportPolicyApplyConfiguration) WithAllowedNextHops(values ...routeadvertisementsv1.CIDR) *RouteImportPolicyApplyConfiguration {
+	for i := range values {
+		b.AllowedNextHops = append(b.AllowedNextHops, values[i])
+	}
+	return b
+}
+
+// WithMaxPrefixes sets the MaxPrefixes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxPrefixes field is set to the value of the last call.
+func (b *RouteImportPolicyApplyConfiguration) WithMaxPrefixes(value int32) *RouteImportPolicyApplyConfiguration {
+	b.MaxPrefixes = &value
+	return b
+}
+
+// WithMaxPrefixesAction sets the MaxPrefixesAction field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxPrefixesAction field is set to the value of the last call.
+func (b *RouteImportPolicyApplyConfiguration) WithMaxPrefixesAction(value routeadvertisementsv1.MaxPrefixesAction) *RouteImportPolicyApplyConfiguration {
+	b.MaxPrefixesAction = &value
+	return b
+}
@@ -31,12 +31,20 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=k8s.ovn.org, Version=v1
+	case v1.SchemeGroupVersion.WithKind("EVPNConfig"):
+		return &routeadvertisementsv1.EVPNConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("LocalPreference"):
+		return &routeadvertisementsv1.LocalPreferenceApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NetworkVNI"):
+		return &routeadvertisementsv1.NetworkVNIApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RouteAdvertisements"):
 		return &routeadvertisementsv1.RouteAdvertisementsApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RouteAdvertisementsSpec"):
 		return &routeadvertisementsv1.RouteAdvertisementsSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RouteAdvertisementsStatus"):
 		return &routeadvertisementsv1.RouteAdvertisementsStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RouteImportPolicy"):
+		return &routeadvertisementsv1.RouteImportPolicyApplyConfiguration{}
 
 	}
 	return nil
@@ -27,6 +27,7 @@ type RouteAdvertisements struct {
 // RouteAdvertisementsSpec defines the desired state of RouteAdvertisements
 // +kubebuilder:validation:XValidation:rule="(!has(self.nodeSelector.matchLabels) && !has(self.nodeSelector.matchExpressions)) || !('PodNetwork' in self.advertisements)",message="If 'PodNetwork' is selected for advertisement, a 'nodeSelector' can't be specified as it needs to be advertised on all nodes"
 // +kubebuilder:validation:XValidation:rule="!self.networkSelectors.exists(i, i.networkSelectionType != 'DefaultNetwork' && i.networkSelectionType != 'ClusterUserDefinedNetworks')",message="Only DefaultNetwork or ClusterUserDefinedNetworks can be selected"
+// +kubebuilder:validation:XValidation:rule="!has(self.evpn) || (has(self.targetVRF) && self.targetVRF == 'auto' && 'PodNetwork' in self.advertisements)",message="EVPN requires 'PodNetwork' to be advertised with target VRF 'auto'"
 type RouteAdvertisementsSpec struct {
 	// targetVRF determines which VRF the routes should be advertised in.
 	// +kubebuilder:validation:Optional
@@ -51,13 +52,157 @@ type RouteAdvertisementsSpec struct {
 	// advertisements determines what is advertised.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinItems=1
-	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:MaxItems=3
 	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, x == y))"
 	Advertisements []AdvertisementType `json:"advertisements,omitempty"`
+
+	// communities is a list of BGP communities the advertised prefixes are
+	// tagged with. A community is either a standard community in the
+	// `<0-65535>:<0-65535>` format or a large community in the
+	// `large:<0-4294967295>:<0-4294967295>:<0-4294967295>` format. To tag the
+	// prefixes of each network with different communities, select each network
+	// with a different RouteAdvertisements.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=32
+	// +listType=set
+	Communities []BGPCommunity `json:"communities,omitempty"`
+
+	// localPreferences sets the BGP local preference of the prefixes
+	// advertised from the selected nodes. If multiple entries select the same
+	// node, the first one applies.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=atomic
+	LocalPreferences []LocalPreference `json:"localPreferences,omitempty"`
+
+	// aggregatePrefixes determines that the subnets of the selected Layer3
+	// networks are advertised from all the nodes instead of the subnet
+	// specific to each node when the pod network is advertised.
+	// +kubebuilder:validation:Optional
+	AggregatePrefixes bool `json:"aggregatePrefixes,omitempty"`
+
+	// evpn determines that the pod network of the selected networks is
+	// advertised as EVPN route type-5 with an L3 VNI per network instead of as
+	// plain IP BGP routes. Requires target VRF `auto` as each network is
+	// advertised from its own VRF, and the selected FRRConfigurations to have
+	// a router on the default VRF with the neighbors to establish the EVPN
+	// sessions with.
+	// +kubebuilder:validation:Optional
+	EVPN *EVPNConfig `json:"evpn,omitempty"`
+
+	// importPolicy restricts the BGP routes learned on the nodes that are
+	// imported into the selected networks. If a network is selected by
+	// multiple RouteAdvertisements with an import policy, their allowed
+	// prefixes and next hops are merged and the lowest maximum number of
+	// prefixes applies. If not set, all the learned routes are imported.
+	// +kubebuilder:validation:Optional
+	ImportPolicy *RouteImportPolicy `json:"importPolicy,omitempty"`
+}
+
+// RouteImportPolicy restricts the BGP routes learned on the nodes that are
+// imported into a network.
+type RouteImportPolicy struct {
+	// allowedPrefixes limits the imported routes to those with a destination
+	// contained in any of these prefixes. If not set, routes to any
+	// destination are imported.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=set
+	AllowedPrefixes []CIDR `json:"allowedPrefixes,omitempty"`
+
+	// allowedNextHops limits the imported routes to those with a next hop
+	// contained in any of these prefixes. Next hops of multipath routes that
+	// are not allowed are not imported. If not set, routes through any next
+	// hop are imported.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=set
+	AllowedNextHops []CIDR `json:"allowedNextHops,omitempty"`
+
+	// maxPrefixes is the maximum number of prefixes imported into a network
+	// on a node. If the allowed learned routes exceed it, maxPrefixesAction
+	// applies. If not set, there is no maximum.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxPrefixes *int32 `json:"maxPrefixes,omitempty"`
+
+	// maxPrefixesAction determines what happens when the allowed learned
+	// routes exceed maxPrefixes. With `KeepImported`, routes already imported
+	// are kept as long as they are still learned but no new route is
+	// imported. With `WithdrawAll`, all the imported routes are withdrawn.
+	// Importing resumes normally once the learned routes no longer exceed
+	// maxPrefixes.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=KeepImported
+	MaxPrefixesAction MaxPrefixesAction `json:"maxPrefixesAction,omitempty"`
+}
+
+// MaxPrefixesAction determines what happens when the learned routes exceed the
+// maximum number of prefixes to import.
+// +kubebuilder:validation:Enum=KeepImported;WithdrawAll
+type MaxPrefixesAction string
+
+const (
+	// KeepImported keeps the already imported routes but stops importing new
+	// ones.
+	KeepImported MaxPrefixesAction = "KeepImported"
+
+	// WithdrawAll withdraws all the imported routes.
+	WithdrawAll MaxPrefixesAction = "WithdrawAll"
+)
+
+// CIDR is an IPv4 or IPv6 prefix in CIDR notation.
+// +kubebuilder:validation:XValidation:rule="isCIDR(self)",message="CIDR is invalid"
+// +kubebuilder:validation:MaxLength=43
+type CIDR string
+
+// EVPNConfig is the EVPN configuration of a RouteAdvertisements.
+type EVPNConfig struct {
+	// vnis assigns an L3 VNI to each of the selected networks. All selected
+	// networks must have an L3 VNI assigned.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=network
+	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, x.vni == y.vni))",message="vni must be unique"
+	VNIs []NetworkVNI `json:"vnis"`
+}
+
+// NetworkVNI is the L3 VNI assigned to a network.
+type NetworkVNI struct {
+	// network is the name of the selected ClusterUserDefinedNetwork.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Network string `json:"network"`
+
+	// vni is the L3 VNI of the network.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16777215
+	VNI int32 `json:"vni"`
+}
+
+// BGPCommunity is a standard or large BGP community.
+// +kubebuilder:validation:Pattern=`^([0-9]{1,5}:[0-9]{1,5}|large:[0-9]{1,10}:[0-9]{1,10}:[0-9]{1,10})$`
+type BGPCommunity string
+
+// LocalPreference is the BGP local preference of the prefixes advertised from
+// a set of nodes.
+type LocalPreference struct {
+	// nodeSelector selects the nodes the local preference applies to. This
+	// field follows standard label selector semantics.
+	// +kubebuilder:validation:Required
+	NodeSelector metav1.LabelSelector `json:"nodeSelector"`
+
+	// value is the BGP local preference.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=4294967295
+	Value uint32 `json:"value"`
 }
 
 // AdvertisementType determines the type of advertisement.
-// +kubebuilder:validation:Enum=PodNetwork;EgressIP
+// +kubebuilder:validation:Enum=PodNetwork;EgressIP;Services
 type AdvertisementType string
 
 const (
@@ -66,6 +211,12 @@ const (
 
 	// EgressIP determines that egress IPs are being advertised.
 	EgressIP AdvertisementType = "EgressIP"
+
+	// Services determines that the ClusterIPs, ExternalIPs and LoadBalancer
+	// IPs of the services on the selected networks are advertised. ExternalIPs
+	// and LoadBalancer IPs of services with ExternalTrafficPolicy=Local are
+	// only advertised from nodes with local endpoints.
+	Services AdvertisementType = "Services"
 )
 
 // RouteAdvertisementsStatus defines the observed state of RouteAdvertisements.
@@ -26,6 +26,60 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EVPNConfig) DeepCopyInto(out *EVPNConfig) {
+	*out = *in
+	if in.VNIs != nil {
+		in, out := &in.VNIs, &out.VNIs
+		*out = make([]NetworkVNI, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EVPNConfig.
+func (in *EVPNConfig) DeepCopy() *EVPNConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EVPNConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalPreference) DeepCopyInto(out *LocalPreference) {
+	*out = *in
+	in.NodeSelector.DeepCopyInto(&out.NodeSelector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalPreference.
+func (in *LocalPreference) DeepCopy() *LocalPreference {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalPreference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkVNI) DeepCopyInto(out *NetworkVNI) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkVNI.
+func (in *NetworkVNI) DeepCopy() *NetworkVNI {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkVNI)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteAdvertisements) DeepCopyInto(out *RouteAdvertisements) {
 	*out = *in
@@ -104,6 +158,28 @@ func (in *RouteAdvertisementsSpec) DeepCopyInto(out *RouteAdvertisementsSpec) {
 		*out = make([]AdvertisementType, len(*in))
 		copy(*out, *in)
 	}
+	if in.Communities != nil {
+		in, out := &in.Communities, &out.Communities
+		*out = make([]BGPCommunity, len(*in))
+		copy(*out, *in)
+	}
+	if in.LocalPreferences != nil {
+		in, out := &in.LocalPreferences, &out.LocalPreferences
+		*out = make([]LocalPreference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EVPN != nil {
+		in, out := &in.EVPN, &out.EVPN
+		*out = new(EVPNConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImportPolicy != nil {
+		in, out := &in.ImportPolicy, &out.ImportPolicy
+		*out = new(RouteImportPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -139,3 +215,34 @@ func (in *RouteAdvertisementsStatus) DeepCopy() *RouteAdvertisementsStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteImportPolicy) DeepCopyInto(out *RouteImportPolicy) {
+	*out = *in
+	if in.AllowedPrefixes != nil {
+		in, out := &in.AllowedPrefixes, &out.AllowedPrefixes
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedNextHops != nil {
+		in, out := &in.AllowedNextHops, &out.AllowedNextHops
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxPrefixes != nil {
+		in, out := &in.MaxPrefixes, &out.MaxPrefixes
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteImportPolicy.
+func (in *RouteImportPolicy) DeepCopy() *RouteImportPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteImportPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
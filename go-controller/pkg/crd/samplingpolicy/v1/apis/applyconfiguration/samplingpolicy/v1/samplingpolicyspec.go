@@ -0,0 +1,63 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	samplingpolicyv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/samplingpolicy/v1"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// SamplingPolicySpecApplyConfiguration represents a declarative configuration of the SamplingPolicySpec type for use
+// with apply.
+type SamplingPolicySpecApplyConfiguration struct {
+	PodSelector *metav1.LabelSelectorApplyConfiguration `json:"podSelector,omitempty"`
+	Features    []samplingpolicyv1.SamplingFeature      `json:"features,omitempty"`
+	Probability *int32                                  `json:"probability,omitempty"`
+}
+
+// SamplingPolicySpecApplyConfiguration constructs a declarative configuration of the SamplingPolicySpec type for use with
+// apply.
+func SamplingPolicySpec() *SamplingPolicySpecApplyConfiguration {
+	return &SamplingPolicySpecApplyConfiguration{}
+}
+
+// WithPodSelector sets the PodSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodSelector field is set to the value of the last call.
+func (b *SamplingPolicySpecApplyConfiguration) WithPodSelector(value *metav1.LabelSelectorApplyConfiguration) *SamplingPolicySpecApplyConfiguration {
+	b.PodSelector = value
+	return b
+}
+
+// WithFeatures adds the given value to the Features field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Features field.
+func (b *SamplingPolicySpecApplyConfiguration) WithFeatures(values ...samplingpolicyv1.SamplingFeature) *SamplingPolicySpecApplyConfiguration {
+	for i := range values {
+		b.Features = append(b.Features, values[i])
+	}
+	return b
+}
+
+// WithProbability sets the Probability field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Probability field is set to the value of the last call.
+func (b *SamplingPolicySpecApplyConfiguration) WithProbability(value int32) *SamplingPolicySpecApplyConfiguration {
+	b.Probability = &value
+	return b
+}
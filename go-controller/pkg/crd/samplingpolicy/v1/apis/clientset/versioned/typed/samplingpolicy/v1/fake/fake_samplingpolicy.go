@@ -0,0 +1,50 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/samplingpolicy/v1"
+	samplingpolicyv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/samplingpolicy/v1/apis/applyconfiguration/samplingpolicy/v1"
+	typedsamplingpolicyv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/samplingpolicy/v1/apis/clientset/versioned/typed/samplingpolicy/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeSamplingPolicies implements SamplingPolicyInterface
+type fakeSamplingPolicies struct {
+	*gentype.FakeClientWithListAndApply[*v1.SamplingPolicy, *v1.SamplingPolicyList, *samplingpolicyv1.SamplingPolicyApplyConfiguration]
+	Fake *FakeK8sV1
+}
+
+func newFakeSamplingPolicies(fake *FakeK8sV1, namespace string) typedsamplingpolicyv1.SamplingPolicyInterface {
+	return &fakeSamplingPolicies{
+		gentype.NewFakeClientWithListAndApply[*v1.SamplingPolicy, *v1.SamplingPolicyList, *samplingpolicyv1.SamplingPolicyApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1.SchemeGroupVersion.WithResource("samplingpolicies"),
+			v1.SchemeGroupVersion.WithKind("SamplingPolicy"),
+			func() *v1.SamplingPolicy { return &v1.SamplingPolicy{} },
+			func() *v1.SamplingPolicyList { return &v1.SamplingPolicyList{} },
+			func(dst, src *v1.SamplingPolicyList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.SamplingPolicyList) []*v1.SamplingPolicy { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1.SamplingPolicyList, items []*v1.SamplingPolicy) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
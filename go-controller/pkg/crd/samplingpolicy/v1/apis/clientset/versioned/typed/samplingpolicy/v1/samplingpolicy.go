@@ -0,0 +1,69 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+
+	samplingpolicyv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/samplingpolicy/v1"
+	applyconfigurationsamplingpolicyv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/samplingpolicy/v1/apis/applyconfiguration/samplingpolicy/v1"
+	scheme "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/samplingpolicy/v1/apis/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// SamplingPoliciesGetter has a method to return a SamplingPolicyInterface.
+// A group's client should implement this interface.
+type SamplingPoliciesGetter interface {
+	SamplingPolicies(namespace string) SamplingPolicyInterface
+}
+
+// SamplingPolicyInterface has methods to work with SamplingPolicy resources.
+type SamplingPolicyInterface interface {
+	Create(ctx context.Context, samplingPolicy *samplingpolicyv1.SamplingPolicy, opts metav1.CreateOptions) (*samplingpolicyv1.SamplingPolicy, error)
+	Update(ctx context.Context, samplingPolicy *samplingpolicyv1.SamplingPolicy, opts metav1.UpdateOptions) (*samplingpolicyv1.SamplingPolicy, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*samplingpolicyv1.SamplingPolicy, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*samplingpolicyv1.SamplingPolicyList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *samplingpolicyv1.SamplingPolicy, err error)
+	Apply(ctx context.Context, samplingPolicy *applyconfigurationsamplingpolicyv1.SamplingPolicyApplyConfiguration, opts metav1.ApplyOptions) (result *samplingpolicyv1.SamplingPolicy, err error)
+	SamplingPolicyExpansion
+}
+
+// samplingPolicies implements SamplingPolicyInterface
+type samplingPolicies struct {
+	*gentype.ClientWithListAndApply[*samplingpolicyv1.SamplingPolicy, *samplingpolicyv1.SamplingPolicyList, *applyconfigurationsamplingpolicyv1.SamplingPolicyApplyConfiguration]
+}
+
+// newSamplingPolicies returns a SamplingPolicies
+func newSamplingPolicies(c *K8sV1Client, namespace string) *samplingPolicies {
+	return &samplingPolicies{
+		gentype.NewClientWithListAndApply[*samplingpolicyv1.SamplingPolicy, *samplingpolicyv1.SamplingPolicyList, *applyconfigurationsamplingpolicyv1.SamplingPolicyApplyConfiguration](
+			"samplingpolicies",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *samplingpolicyv1.SamplingPolicy { return &samplingpolicyv1.SamplingPolicy{} },
+			func() *samplingpolicyv1.SamplingPolicyList { return &samplingpolicyv1.SamplingPolicyList{} },
+		),
+	}
+}
@@ -0,0 +1,69 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	samplingpolicyv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/samplingpolicy/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// SamplingPolicyLister helps list SamplingPolicies.
+// All objects returned here must be treated as read-only.
+type SamplingPolicyLister interface {
+	// List lists all SamplingPolicies in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*samplingpolicyv1.SamplingPolicy, err error)
+	// SamplingPolicies returns an object that can list and get SamplingPolicies.
+	SamplingPolicies(namespace string) SamplingPolicyNamespaceLister
+	SamplingPolicyListerExpansion
+}
+
+// samplingPolicyLister implements the SamplingPolicyLister interface.
+type samplingPolicyLister struct {
+	listers.ResourceIndexer[*samplingpolicyv1.SamplingPolicy]
+}
+
+// NewSamplingPolicyLister returns a new SamplingPolicyLister.
+func NewSamplingPolicyLister(indexer cache.Indexer) SamplingPolicyLister {
+	return &samplingPolicyLister{listers.New[*samplingpolicyv1.SamplingPolicy](indexer, samplingpolicyv1.Resource("samplingpolicy"))}
+}
+
+// SamplingPolicies returns an object that can list and get SamplingPolicies.
+func (s *samplingPolicyLister) SamplingPolicies(namespace string) SamplingPolicyNamespaceLister {
+	return samplingPolicyNamespaceLister{listers.NewNamespaced[*samplingpolicyv1.SamplingPolicy](s.ResourceIndexer, namespace)}
+}
+
+// SamplingPolicyNamespaceLister helps list and get SamplingPolicies.
+// All objects returned here must be treated as read-only.
+type SamplingPolicyNamespaceLister interface {
+	// List lists all SamplingPolicies in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*samplingpolicyv1.SamplingPolicy, err error)
+	// Get retrieves the SamplingPolicy from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*samplingpolicyv1.SamplingPolicy, error)
+	SamplingPolicyNamespaceListerExpansion
+}
+
+// samplingPolicyNamespaceLister implements the SamplingPolicyNamespaceLister
+// interface.
+type samplingPolicyNamespaceLister struct {
+	listers.ResourceIndexer[*samplingpolicyv1.SamplingPolicy]
+}
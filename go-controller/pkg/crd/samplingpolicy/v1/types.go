@@ -0,0 +1,73 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=samplingpolicies,scope=Namespaced,shortName=sp,singular=samplingpolicy
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Features",type=string,JSONPath=".spec.features"
+// +kubebuilder:printcolumn:name="Probability",type=integer,JSONPath=".spec.probability"
+// SamplingPolicy enables OVN observability sampling for the network
+// policies, egress firewall and multicast ACLs of its namespace. It is only
+// used when ovn-kubernetes runs with the enable-sampling-policy option, in
+// which case nothing is sampled outside the namespaces with a SamplingPolicy.
+type SamplingPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +required
+	Spec SamplingPolicySpec `json:"spec"`
+}
+
+// SamplingPolicySpec defines the desired state of SamplingPolicy
+// +kubebuilder:validation:XValidation:rule="!has(self.podSelector) || ((!has(self.podSelector.matchLabels) || size(self.podSelector.matchLabels) == 0) && (!has(self.podSelector.matchExpressions) || size(self.podSelector.matchExpressions) == 0)) || self.features.all(f, f == 'NetworkPolicy')",message="podSelector can only be set with the NetworkPolicy feature"
+type SamplingPolicySpec struct {
+	// podSelector selects the pods of the namespace to sample. An empty
+	// selector selects all pods of the namespace.
+	// A non-empty selector can only be set with the NetworkPolicy feature. As
+	// the ACLs of a network policy apply to all the pods it selects, only the
+	// network policies that select none but the selected pods are sampled, and
+	// the namespace default deny ACLs are not.
+	// +optional
+	PodSelector metav1.LabelSelector `json:"podSelector,omitempty"`
+
+	// features are the ovn-kubernetes features to sample.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	// +required
+	Features []SamplingFeature `json:"features"`
+
+	// probability is the percentage of packets to sample, from 1 to 100.
+	// When several SamplingPolicies select the same objects, the highest
+	// probability is used.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=100
+	// +optional
+	Probability int32 `json:"probability,omitempty"`
+}
+
+// SamplingFeature is an ovn-kubernetes feature that can be sampled per namespace.
+// +kubebuilder:validation:Enum=NetworkPolicy;EgressFirewall;Multicast
+type SamplingFeature string
+
+const (
+	NetworkPolicyFeature  SamplingFeature = "NetworkPolicy"
+	EgressFirewallFeature SamplingFeature = "EgressFirewall"
+	MulticastFeature      SamplingFeature = "Multicast"
+)
+
+// SamplingPolicyList contains a list of SamplingPolicy
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type SamplingPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SamplingPolicy `json:"items"`
+}
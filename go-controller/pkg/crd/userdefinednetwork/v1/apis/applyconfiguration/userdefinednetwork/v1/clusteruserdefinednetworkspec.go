@@ -18,14 +18,18 @@ limitations under the License.
 package v1
 
 import (
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
 	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
 // ClusterUserDefinedNetworkSpecApplyConfiguration represents a declarative configuration of the ClusterUserDefinedNetworkSpec type for use
 // with apply.
 type ClusterUserDefinedNetworkSpecApplyConfiguration struct {
-	NamespaceSelector *metav1.LabelSelectorApplyConfiguration `json:"namespaceSelector,omitempty"`
-	Network           *NetworkSpecApplyConfiguration          `json:"network,omitempty"`
+	NamespaceSelector  *metav1.LabelSelectorApplyConfiguration      `json:"namespaceSelector,omitempty"`
+	Network            *NetworkSpecApplyConfiguration               `json:"network,omitempty"`
+	RouteImports       []RouteImportApplyConfiguration              `json:"routeImports,omitempty"`
+	NamespaceIsolation *userdefinednetworkv1.NamespaceIsolationMode `json:"namespaceIsolation,omitempty"`
+	ExternalIPs        []NodeExternalIPsApplyConfiguration          `json:"externalIPs,omitempty"`
 }
 
 // ClusterUserDefinedNetworkSpecApplyConfiguration constructs a declarative configuration of the ClusterUserDefinedNetworkSpec type for use with
@@ -49,3 +53,37 @@ func (b *ClusterUserDefinedNetworkSpecApplyConfiguration) WithNetwork(value *Net
 	b.Network = value
 	return b
 }
+
+// WithRouteImports adds the given value to the RouteImports field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RouteImports field.
+func (b *ClusterUserDefinedNetworkSpecApplyConfiguration) WithRouteImports(values ...*RouteImportApplyConfiguration) *ClusterUserDefinedNetworkSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRouteImports")
+		}
+		b.RouteImports = append(b.RouteImports, *values[i])
+	}
+	return b
+}
+
+// WithNamespaceIsolation sets the NamespaceIsolation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NamespaceIsolation field is set to the value of the last call.
+func (b *ClusterUserDefinedNetworkSpecApplyConfiguration) WithNamespaceIsolation(value userdefinednetworkv1.NamespaceIsolationMode) *ClusterUserDefinedNetworkSpecApplyConfiguration {
+	b.NamespaceIsolation = &value
+	return b
+}
+
+// WithExternalIPs adds the given value to the ExternalIPs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ExternalIPs field.
+func (b *ClusterUserDefinedNetworkSpecApplyConfiguration) WithExternalIPs(values ...*NodeExternalIPsApplyConfiguration) *ClusterUserDefinedNetworkSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithExternalIPs")
+		}
+		b.ExternalIPs = append(b.ExternalIPs, *values[i])
+	}
+	return b
+}
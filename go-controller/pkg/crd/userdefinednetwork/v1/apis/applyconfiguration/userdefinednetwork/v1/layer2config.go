@@ -26,9 +26,10 @@ import (
 type Layer2ConfigApplyConfiguration struct {
 	Role        *userdefinednetworkv1.NetworkRole    `json:"role,omitempty"`
 	MTU         *int32                               `json:"mtu,omitempty"`
-	Subnets     *userdefinednetworkv1.DualStackCIDRs `json:"subnets,omitempty"`
+	Subnets     *userdefinednetworkv1.Layer2Subnets  `json:"subnets,omitempty"`
 	JoinSubnets *userdefinednetworkv1.DualStackCIDRs `json:"joinSubnets,omitempty"`
 	IPAM        *IPAMConfigApplyConfiguration        `json:"ipam,omitempty"`
+	DHCP        *userdefinednetworkv1.DHCPMode       `json:"dhcp,omitempty"`
 }
 
 // Layer2ConfigApplyConfiguration constructs a declarative configuration of the Layer2Config type for use with
@@ -56,7 +57,7 @@ func (b *Layer2ConfigApplyConfiguration) WithMTU(value int32) *Layer2ConfigApply
 // WithSubnets sets the Subnets field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Subnets field is set to the value of the last call.
-func (b *Layer2ConfigApplyConfiguration) WithSubnets(value userdefinednetworkv1.DualStackCIDRs) *Layer2ConfigApplyConfiguration {
+func (b *Layer2ConfigApplyConfiguration) WithSubnets(value userdefinednetworkv1.Layer2Subnets) *Layer2ConfigApplyConfiguration {
 	b.Subnets = &value
 	return b
 }
@@ -76,3 +77,11 @@ func (b *Layer2ConfigApplyConfiguration) WithIPAM(value *IPAMConfigApplyConfigur
 	b.IPAM = value
 	return b
 }
+
+// WithDHCP sets the DHCP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DHCP field is set to the value of the last call.
+func (b *Layer2ConfigApplyConfiguration) WithDHCP(value userdefinednetworkv1.DHCPMode) *Layer2ConfigApplyConfiguration {
+	b.DHCP = &value
+	return b
+}
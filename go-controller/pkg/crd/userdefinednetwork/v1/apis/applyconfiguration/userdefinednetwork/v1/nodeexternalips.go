@@ -0,0 +1,51 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+)
+
+// NodeExternalIPsApplyConfiguration represents a declarative configuration of the NodeExternalIPs type for use
+// with apply.
+type NodeExternalIPsApplyConfiguration struct {
+	Node *string                            `json:"node,omitempty"`
+	IPs  *userdefinednetworkv1.DualStackIPs `json:"ips,omitempty"`
+}
+
+// NodeExternalIPsApplyConfiguration constructs a declarative configuration of the NodeExternalIPs type for use with
+// apply.
+func NodeExternalIPs() *NodeExternalIPsApplyConfiguration {
+	return &NodeExternalIPsApplyConfiguration{}
+}
+
+// WithNode sets the Node field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Node field is set to the value of the last call.
+func (b *NodeExternalIPsApplyConfiguration) WithNode(value string) *NodeExternalIPsApplyConfiguration {
+	b.Node = &value
+	return b
+}
+
+// WithIPs sets the IPs field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPs field is set to the value of the last call.
+func (b *NodeExternalIPsApplyConfiguration) WithIPs(value userdefinednetworkv1.DualStackIPs) *NodeExternalIPsApplyConfiguration {
+	b.IPs = &value
+	return b
+}
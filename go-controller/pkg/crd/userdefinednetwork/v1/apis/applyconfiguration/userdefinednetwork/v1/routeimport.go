@@ -0,0 +1,53 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+)
+
+// RouteImportApplyConfiguration represents a declarative configuration of the RouteImport type for use
+// with apply.
+type RouteImportApplyConfiguration struct {
+	Network  *string                     `json:"network,omitempty"`
+	Prefixes []userdefinednetworkv1.CIDR `json:"prefixes,omitempty"`
+}
+
+// RouteImportApplyConfiguration constructs a declarative configuration of the RouteImport type for use with
+// apply.
+func RouteImport() *RouteImportApplyConfiguration {
+	return &RouteImportApplyConfiguration{}
+}
+
+// WithNetwork sets the Network field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Network field is set to the value of the last call.
+func (b *RouteImportApplyConfiguration) WithNetwork(value string) *RouteImportApplyConfiguration {
+	b.Network = &value
+	return b
+}
+
+// WithPrefixes adds the given value to the Prefixes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Prefixes field.
+func (b *RouteImportApplyConfiguration) WithPrefixes(values ...userdefinednetworkv1.CIDR) *RouteImportApplyConfiguration {
+	for i := range values {
+		b.Prefixes = append(b.Prefixes, values[i])
+	}
+	return b
+}
@@ -0,0 +1,44 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+)
+
+// TrunkVLANConfigApplyConfiguration represents a declarative configuration of the TrunkVLANConfig type for use
+// with apply.
+type TrunkVLANConfigApplyConfiguration struct {
+	IDs []userdefinednetworkv1.VLANID `json:"ids,omitempty"`
+}
+
+// TrunkVLANConfigApplyConfiguration constructs a declarative configuration of the TrunkVLANConfig type for use with
+// apply.
+func TrunkVLANConfig() *TrunkVLANConfigApplyConfiguration {
+	return &TrunkVLANConfigApplyConfiguration{}
+}
+
+// WithIDs adds the given value to the IDs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the IDs field.
+func (b *TrunkVLANConfigApplyConfiguration) WithIDs(values ...userdefinednetworkv1.VLANID) *TrunkVLANConfigApplyConfiguration {
+	for i := range values {
+		b.IDs = append(b.IDs, values[i])
+	}
+	return b
+}
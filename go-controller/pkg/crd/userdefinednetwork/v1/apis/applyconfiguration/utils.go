@@ -51,6 +51,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &userdefinednetworkv1.LocalnetConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("NetworkSpec"):
 		return &userdefinednetworkv1.NetworkSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NodeExternalIPs"):
+		return &userdefinednetworkv1.NodeExternalIPsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RouteImport"):
+		return &userdefinednetworkv1.RouteImportApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("TrunkVLANConfig"):
+		return &userdefinednetworkv1.TrunkVLANConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("UserDefinedNetwork"):
 		return &userdefinednetworkv1.UserDefinedNetworkApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("UserDefinedNetworkSpec"):
@@ -22,6 +22,11 @@ type ClusterUserDefinedNetwork struct {
 }
 
 // ClusterUserDefinedNetworkSpec defines the desired state of ClusterUserDefinedNetwork.
+// +kubebuilder:validation:XValidation:rule="!has(self.routeImports) || (has(self.network.layer3) && self.network.layer3.role == 'Primary') || (has(self.network.layer2) && self.network.layer2.role == 'Primary')", message="routeImports is only supported for Primary Layer3 and Layer2 networks"
+// +kubebuilder:validation:XValidation:rule="!has(self.namespaceIsolation) || self.namespaceIsolation != 'Enabled' || has(self.network.layer3) || (has(self.network.layer2) && has(self.network.layer2.subnets)) || (has(self.network.localnet) && has(self.network.localnet.subnets))", message="namespaceIsolation Enabled is only supported when the network IPAM is enabled"
+// +kubebuilder:validation:XValidation:rule="has(self.namespaceIsolation) == has(oldSelf.namespaceIsolation) && (!has(self.namespaceIsolation) || self.namespaceIsolation == oldSelf.namespaceIsolation)", message="namespaceIsolation is immutable"
+// +kubebuilder:validation:XValidation:rule="!has(self.externalIPs) || (has(self.network.layer3) && self.network.layer3.role == 'Primary') || (has(self.network.layer2) && self.network.layer2.role == 'Primary')", message="externalIPs is only supported for Primary Layer3 and Layer2 networks"
+// +kubebuilder:validation:XValidation:rule="has(self.externalIPs) == has(oldSelf.externalIPs) && (!has(self.externalIPs) || self.externalIPs == oldSelf.externalIPs)", message="externalIPs is immutable"
 type ClusterUserDefinedNetworkSpec struct {
 	// NamespaceSelector Label selector for which namespace network should be available for.
 	// +kubebuilder:validation:Required
@@ -33,12 +38,104 @@ type ClusterUserDefinedNetworkSpec struct {
 	// +kubebuilder:validation:XValidation:rule="has(self.topology) && self.topology == 'Layer3' ? has(self.layer3): !has(self.layer3)", message="spec.layer3 is required when topology is Layer3 and forbidden otherwise"
 	// +kubebuilder:validation:XValidation:rule="has(self.topology) && self.topology == 'Layer2' ? has(self.layer2): !has(self.layer2)", message="spec.layer2 is required when topology is Layer2 and forbidden otherwise"
 	// +kubebuilder:validation:XValidation:rule="has(self.topology) && self.topology == 'Localnet' ? has(self.localnet): !has(self.localnet)", message="spec.localnet is required when topology is Localnet and forbidden otherwise"
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="Network spec is immutable"
+	// +kubebuilder:validation:XValidation:rule="self.topology == oldSelf.topology", message="Topology is immutable"
+	// +kubebuilder:validation:XValidation:rule="has(self.localnet) == has(oldSelf.localnet) && (!has(self.localnet) || self.localnet == oldSelf.localnet)", message="Localnet spec is immutable"
 	// +required
 	Network NetworkSpec `json:"network"`
+
+	// RouteImports leaks routes from other networks into the VRF of this network on every node.
+	//
+	// It allows workloads on this network to reach selected prefixes of another network, for example a network
+	// hosting shared DNS or monitoring services, without merging both networks.
+	// Routes are leaked in one direction only: the referenced network must import the subnets of this network as well
+	// for the return traffic to flow, unless it is the cluster default network.
+	// Prefixes imported from more than one network are not imported at all and are reported on the
+	// RouteImportsAccepted condition.
+	// Routes are only leaked between the VRFs of the nodes; the traffic does not cross the OVN logical topology
+	// of the referenced network.
+	// Only supported for Primary Layer3 and Layer2 networks.
+	//
+	// +kubebuilder:validation:MaxItems=8
+	// +listType=map
+	// +listMapKey=network
+	// +optional
+	RouteImports []RouteImport `json:"routeImports,omitempty"`
+
+	// NamespaceIsolation controls whether pods of different namespaces attached to the network can reach each other.
+	//
+	// `Enabled` drops the traffic between pods of different namespaces by default, while pods of the same namespace
+	// can still reach each other. The traffic between namespaces can still be allowed with (Multi)NetworkPolicies or
+	// BaselineAdminNetworkPolicies.
+	// `Disabled` lets pods of all the selected namespaces reach each other.
+	// Requires the network IPAM to be enabled. Defaults to `Disabled`.
+	//
+	// +optional
+	NamespaceIsolation NamespaceIsolationMode `json:"namespaceIsolation,omitempty"`
+
+	// ExternalIPs are the source IPs identifying the network outside of the cluster, per node.
+	//
+	// The traffic of the pods of the network egressing a node listed here is SNATed to the external IP of the
+	// node instead of the node IP, and NodePort services of the network are also reachable on the external IP
+	// of the node, so that external firewalls can tell apart the traffic of the different networks.
+	// External IPs must be reachable on the node external interface subnet and not be used by anything else.
+	// Nodes not listed keep using their node IP. Not supported in local gateway mode, where the egress traffic
+	// is routed through the host.
+	// Only supported for Primary Layer3 and Layer2 networks.
+	//
+	// +kubebuilder:validation:MaxItems=256
+	// +listType=map
+	// +listMapKey=node
+	// +optional
+	ExternalIPs []NodeExternalIPs `json:"externalIPs,omitempty"`
+}
+
+// NodeExternalIPs describes the external IPs of a network on a node.
+type NodeExternalIPs struct {
+	// Node is the name of the node.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +required
+	Node string `json:"node"`
+
+	// IPs are the external IPs of the network on the node, at most one per IP family.
+	//
+	// +required
+	IPs DualStackIPs `json:"ips"`
+}
+
+// +kubebuilder:validation:Enum=Enabled;Disabled
+type NamespaceIsolationMode string
+
+const (
+	NamespaceIsolationEnabled  NamespaceIsolationMode = "Enabled"
+	NamespaceIsolationDisabled NamespaceIsolationMode = "Disabled"
+)
+
+// RouteImport describes the prefixes imported from a network.
+type RouteImport struct {
+	// Network is the name of the ClusterUserDefinedNetwork to import the prefixes from.
+	// The special value "default" refers to the cluster default network.
+	// The referenced ClusterUserDefinedNetwork must be a Primary Layer3 or Layer2 network.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +required
+	Network string `json:"network"`
+
+	// Prefixes are the destinations reachable through the referenced network that are imported.
+	// Traffic to these prefixes is routed according to the routing table of the referenced network.
+	// Prefixes must not overlap with the subnets of this network.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +required
+	Prefixes []CIDR `json:"prefixes"`
 }
 
 // NetworkSpec defines the desired state of UserDefinedNetworkSpec.
+// The network can only be updated in place to change its MTU or to append
+// subnets to a Layer3 or Layer2 network, the rest of the spec is immutable.
 // +union
 type NetworkSpec struct {
 	// Topology describes network configuration.
@@ -90,6 +187,7 @@ const NetworkTopologyLocalnet NetworkTopology = "Localnet"
 // +kubebuilder:validation:XValidation:rule="!has(self.ipam) || !has(self.ipam.mode) || self.ipam.mode == 'Enabled' ? has(self.subnets) : !has(self.subnets)", message="Subnets is required with ipam.mode is Enabled or unset, and forbidden otherwise"
 // +kubebuilder:validation:XValidation:rule="!has(self.excludeSubnets) || has(self.subnets)", message="excludeSubnets must be unset when subnets is unset"
 // +kubebuilder:validation:XValidation:rule="!has(self.subnets) || !has(self.mtu) || !self.subnets.exists_one(i, isCIDR(i) && cidr(i).ip().family() == 6) || self.mtu >= 1280", message="MTU should be greater than or equal to 1280 when an IPv6 subnet is used"
+// +kubebuilder:validation:XValidation:rule="!has(self.vlan) || self.vlan.mode != 'Trunk' || !has(self.subnets)", message="Subnets must be unset when vlan mode is 'Trunk'"
 // + ---
 // + TODO: enable the below validation once the following issue is resolved https://github.com/kubernetes/kubernetes/issues/130441
 // + kubebuilder:validation:XValidation:rule="!has(self.excludeSubnets) || self.excludeSubnets.all(e, self.subnets.exists(s, cidr(s).containsCIDR(cidr(e))))",message="excludeSubnets must be subnetworks of the networks specified in the subnets field",fieldPath=".excludeSubnets"
@@ -179,10 +277,15 @@ type LocalnetConfig struct {
 	// vlan configuration for the network.
 	// vlan.mode is the VLAN mode.
 	//   When "Access" is set, OVN-Kubernetes configures the network logical switch port in access mode.
+	//   When "Trunk" is set, OVN-Kubernetes passes the traffic of the given VLANs tagged to the connected pods.
 	// vlan.access is the access VLAN configuration.
 	// vlan.access.id is the VLAN ID (VID) to be set on the network logical switch port.
+	// vlan.trunk is the trunk VLAN configuration.
+	// vlan.trunk.ids are the VLAN IDs (VIDs) the connected pods are allowed to send and receive tagged traffic on.
 	// vlan is optional, when omitted the underlying network default VLAN will be used (usually `1`).
 	// When set, OVN-Kubernetes will apply VLAN configuration to the SDN infra and to the connected pods.
+	// Several networks may share the same `physicalNetworkName` as long as their VLAN IDs don't overlap, and at most
+	// one of them omits vlan.
 	//
 	// +optional
 	VLAN *VLANConfig `json:"vlan,omitempty"`
@@ -198,18 +301,39 @@ type AccessVLANConfig struct {
 	ID int32 `json:"id"`
 }
 
-// +kubebuilder:validation:Enum=Access
+// TrunkVLANConfig describes a trunk VLAN configuration.
+type TrunkVLANConfig struct {
+	// ids are the VLAN IDs (VIDs) passed tagged to the connected pods.
+	// Each id should be higher than 0 and lower than 4095.
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=4094
+	// +listType=set
+	IDs []VLANID `json:"ids"`
+}
+
+// +kubebuilder:validation:Minimum=1
+// +kubebuilder:validation:Maximum=4094
+type VLANID int32
+
+// +kubebuilder:validation:Enum=Access;Trunk
 type VLANMode string
 
-const VLANModeAccess VLANMode = "Access"
+const (
+	VLANModeAccess VLANMode = "Access"
+	VLANModeTrunk  VLANMode = "Trunk"
+)
 
 // VLANConfig describes the network VLAN configuration.
 // +union
 // +kubebuilder:validation:XValidation:rule="has(self.mode) && self.mode == 'Access' ? has(self.access): !has(self.access)", message="vlan access config is required when vlan mode is 'Access', and forbidden otherwise"
+// +kubebuilder:validation:XValidation:rule="has(self.mode) && self.mode == 'Trunk' ? has(self.trunk): !has(self.trunk)", message="vlan trunk config is required when vlan mode is 'Trunk', and forbidden otherwise"
 type VLANConfig struct {
 	// mode describe the network VLAN mode.
-	// Allowed value is "Access".
+	// Allowed values are "Access" and "Trunk".
 	// Access sets the network logical switch port in access mode, according to the config.
+	// Trunk lets the connected pods send and receive traffic tagged with the configured VLAN IDs, the pods are
+	// responsible for tagging their traffic. Untagged traffic is dropped, and subnets must be unset.
 	// +required
 	// +unionDiscriminator
 	Mode VLANMode `json:"mode"`
@@ -217,4 +341,8 @@ type VLANConfig struct {
 	// Access is the access VLAN configuration
 	// +optional
 	Access *AccessVLANConfig `json:"access"`
+
+	// Trunk is the trunk VLAN configuration
+	// +optional
+	Trunk *TrunkVLANConfig `json:"trunk,omitempty"`
 }
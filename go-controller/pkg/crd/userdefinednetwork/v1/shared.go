@@ -24,7 +24,9 @@ const (
 )
 
 // +kubebuilder:validation:XValidation:rule="!has(self.joinSubnets) || has(self.role) && self.role == 'Primary'", message="JoinSubnets is only supported for Primary network"
-// +kubebuilder:validation:XValidation:rule="!has(self.subnets) || !has(self.mtu) || !self.subnets.exists_one(i, isCIDR(i.cidr) && cidr(i.cidr).ip().family() == 6) || self.mtu >= 1280", message="MTU should be greater than or equal to 1280 when IPv6 subnet is used"
+// +kubebuilder:validation:XValidation:rule="!has(self.subnets) || !has(self.mtu) || !self.subnets.exists(i, isCIDR(i.cidr) && cidr(i.cidr).ip().family() == 6) || self.mtu >= 1280", message="MTU should be greater than or equal to 1280 when IPv6 subnet is used"
+// +kubebuilder:validation:XValidation:rule="self.role == oldSelf.role", message="Role is immutable"
+// +kubebuilder:validation:XValidation:rule="has(self.joinSubnets) == has(oldSelf.joinSubnets) && (!has(self.joinSubnets) || self.joinSubnets == oldSelf.joinSubnets)", message="JoinSubnets is immutable"
 type Layer3Config struct {
 	// Role describes the network role in the pod.
 	//
@@ -40,6 +42,7 @@ type Layer3Config struct {
 	// MTU is the maximum transmission unit for a network.
 	//
 	// MTU is optional, if not provided, the globally configured value in OVN-Kubernetes (defaults to 1400) is used for the network.
+	// MTU can be changed on an existing network, in which case the interfaces of the running pods are updated too.
 	//
 	// +kubebuilder:validation:Minimum=576
 	// +kubebuilder:validation:Maximum=65536
@@ -50,11 +53,16 @@ type Layer3Config struct {
 	//
 	// Dual-stack clusters may set 2 subnets (one for each IP family), otherwise only 1 subnet is allowed.
 	// Given subnet is split into smaller subnets for every node.
+	// Subnets of the IP families already in use can be appended to an existing network to make more node subnets
+	// available. Existing subnets can't be removed or modified.
 	//
 	// +kubebuilder:validation:MinItems=1
-	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:MaxItems=8
 	// +required
-	// +kubebuilder:validation:XValidation:rule="size(self) != 2 || !isCIDR(self[0].cidr) || !isCIDR(self[1].cidr) || cidr(self[0].cidr).ip().family() != cidr(self[1].cidr).ip().family()", message="When 2 CIDRs are set, they must be from different IP families"
+	// +kubebuilder:validation:XValidation:rule="oldSelf.hasValue() || size(self) <= 2", message="At most 2 subnets can be set when the network is created"
+	// +kubebuilder:validation:XValidation:rule="oldSelf.hasValue() || size(self) != 2 || !isCIDR(self[0].cidr) || !isCIDR(self[1].cidr) || cidr(self[0].cidr).ip().family() != cidr(self[1].cidr).ip().family()", message="When 2 CIDRs are set, they must be from different IP families"
+	// +kubebuilder:validation:XValidation:rule="oldSelf.all(s, s in self)", message="Subnets can only be appended, existing subnets can't be removed or modified"
+	// +kubebuilder:validation:XValidation:rule="self.all(s, !isCIDR(s.cidr) || oldSelf.exists(o, isCIDR(o.cidr) && cidr(o.cidr).ip().family() == cidr(s.cidr).ip().family()))", message="Appended subnets must be of an IP family already in use"
 	Subnets []Layer3Subnet `json:"subnets,omitempty"`
 
 	// JoinSubnets are used inside the OVN network topology.
@@ -90,7 +98,12 @@ type Layer3Subnet struct {
 // +kubebuilder:validation:XValidation:rule="!has(self.ipam) || !has(self.ipam.mode) || self.ipam.mode != 'Disabled' || !has(self.subnets)", message="Subnets must be unset when ipam.mode is Disabled"
 // +kubebuilder:validation:XValidation:rule="!has(self.ipam) || !has(self.ipam.mode) || self.ipam.mode != 'Disabled' || self.role == 'Secondary'", message="Disabled ipam.mode is only supported for Secondary network"
 // +kubebuilder:validation:XValidation:rule="!has(self.joinSubnets) || has(self.role) && self.role == 'Primary'", message="JoinSubnets is only supported for Primary network"
-// +kubebuilder:validation:XValidation:rule="!has(self.subnets) || !has(self.mtu) || !self.subnets.exists_one(i, isCIDR(i) && cidr(i).ip().family() == 6) || self.mtu >= 1280", message="MTU should be greater than or equal to 1280 when IPv6 subnet is used"
+// +kubebuilder:validation:XValidation:rule="!has(self.subnets) || !has(self.mtu) || !self.subnets.exists(i, isCIDR(i) && cidr(i).ip().family() == 6) || self.mtu >= 1280", message="MTU should be greater than or equal to 1280 when IPv6 subnet is used"
+// +kubebuilder:validation:XValidation:rule="self.role == oldSelf.role", message="Role is immutable"
+// +kubebuilder:validation:XValidation:rule="has(self.joinSubnets) == has(oldSelf.joinSubnets) && (!has(self.joinSubnets) || self.joinSubnets == oldSelf.joinSubnets)", message="JoinSubnets is immutable"
+// +kubebuilder:validation:XValidation:rule="has(self.ipam) == has(oldSelf.ipam) && (!has(self.ipam) || self.ipam == oldSelf.ipam)", message="IPAM is immutable"
+// +kubebuilder:validation:XValidation:rule="!has(self.dhcp) || self.dhcp != 'Enabled' || !has(self.ipam) || !has(self.ipam.mode) || self.ipam.mode == 'Enabled'", message="DHCP Enabled is only supported when ipam.mode is Enabled"
+// +kubebuilder:validation:XValidation:rule="has(self.dhcp) == has(oldSelf.dhcp) && (!has(self.dhcp) || self.dhcp == oldSelf.dhcp)", message="DHCP is immutable"
 type Layer2Config struct {
 	// Role describes the network role in the pod.
 	//
@@ -104,6 +117,7 @@ type Layer2Config struct {
 
 	// MTU is the maximum transmission unit for a network.
 	// MTU is optional, if not provided, the globally configured value in OVN-Kubernetes (defaults to 1400) is used for the network.
+	// MTU can be changed on an existing network, in which case the interfaces of the running pods are updated too.
 	//
 	// +kubebuilder:validation:Minimum=576
 	// +kubebuilder:validation:Maximum=65536
@@ -115,9 +129,13 @@ type Layer2Config struct {
 	//
 	// The format should match standard CIDR notation (for example, "10.128.0.0/16").
 	// This field must be omitted if `ipam.mode` is `Disabled`.
+	// Subnets of the IP families already in use can be appended to an existing network when its addresses run out.
+	// Existing subnets can't be removed or modified. Pods of a "Primary" network get an IP address of each IP family
+	// from the first subnet of that family with free addresses, while pods of a "Secondary" network get an IP address
+	// from each of the subnets.
 	//
 	// +optional
-	Subnets DualStackCIDRs `json:"subnets,omitempty"`
+	Subnets Layer2Subnets `json:"subnets,omitempty"`
 
 	// JoinSubnets are used inside the OVN network topology.
 	//
@@ -132,6 +150,18 @@ type Layer2Config struct {
 	// IPAM section contains IPAM-related configuration for the network.
 	// +optional
 	IPAM *IPAMConfig `json:"ipam,omitempty"`
+
+	// DHCP controls whether the network serves the IP configuration of the pods attached to it, for workloads
+	// that configure their own networking instead of relying on the one set up by the CNI.
+	// `Enabled` means every pod on the network is offered DHCPv4 and DHCPv6 leases for the IP addresses allocated to
+	// it, along with the gateway, MTU and DNS server. IPv6 router advertisements are also sent, with a low router
+	// preference: from the network gateway on "Primary" networks, and from a router that does not forward traffic on
+	// "Secondary" networks.
+	// Only supported when `ipam.mode` is `Enabled`.
+	// Defaults to `Disabled`.
+	//
+	// +optional
+	DHCP DHCPMode `json:"dhcp,omitempty"`
 }
 
 // +kubebuilder:validation:XValidation:rule="!has(self.lifecycle) || self.lifecycle != 'Persistent' || !has(self.mode) || self.mode == 'Enabled'", message="lifecycle Persistent is only supported when ipam.mode is Enabled"
@@ -167,6 +197,14 @@ const (
 	IPAMDisabled IPAMMode = "Disabled"
 )
 
+// +kubebuilder:validation:Enum=Enabled;Disabled
+type DHCPMode string
+
+const (
+	DHCPEnabled  DHCPMode = "Enabled"
+	DHCPDisabled DHCPMode = "Disabled"
+)
+
 type NetworkRole string
 
 const (
@@ -187,3 +225,22 @@ type CIDR string
 // +kubebuilder:validation:MaxItems=2
 // +kubebuilder:validation:XValidation:rule="size(self) != 2 || !isCIDR(self[0]) || !isCIDR(self[1]) || cidr(self[0]).ip().family() != cidr(self[1]).ip().family()", message="When 2 CIDRs are set, they must be from different IP families"
 type DualStackCIDRs []CIDR
+
+// Layer2Subnets are DualStackCIDRs when the network is created, to which subnets
+// of the IP families already in use can be appended afterwards.
+// +kubebuilder:validation:MinItems=1
+// +kubebuilder:validation:MaxItems=8
+// +kubebuilder:validation:XValidation:rule="oldSelf.hasValue() || size(self) <= 2", message="At most 2 subnets can be set when the network is created"
+// +kubebuilder:validation:XValidation:rule="oldSelf.hasValue() || size(self) != 2 || !isCIDR(self[0]) || !isCIDR(self[1]) || cidr(self[0]).ip().family() != cidr(self[1]).ip().family()", message="When 2 CIDRs are set, they must be from different IP families"
+// +kubebuilder:validation:XValidation:rule="oldSelf.all(s, s in self)", message="Subnets can only be appended, existing subnets can't be removed or modified"
+// +kubebuilder:validation:XValidation:rule="self.all(s, !isCIDR(s) || oldSelf.exists(o, isCIDR(o) && cidr(o).ip().family() == cidr(s).ip().family()))", message="Appended subnets must be of an IP family already in use"
+type Layer2Subnets []CIDR
+
+// +kubebuilder:validation:XValidation:rule="isIP(self)", message="IP is invalid"
+// +kubebuilder:validation:MaxLength=45
+type IP string
+
+// +kubebuilder:validation:MinItems=1
+// +kubebuilder:validation:MaxItems=2
+// +kubebuilder:validation:XValidation:rule="size(self) != 2 || !isIP(self[0]) || !isIP(self[1]) || ip(self[0]).family() != ip(self[1]).family()", message="When 2 IPs are set, they must be from different IP families"
+type DualStackIPs []IP
@@ -32,3 +32,27 @@ func (s *NetworkSpec) GetLayer2() *Layer2Config {
 func (s *NetworkSpec) GetLocalnet() *LocalnetConfig {
 	return s.Localnet
 }
+
+func (s *ClusterUserDefinedNetworkSpec) GetTopology() NetworkTopology {
+	return s.Network.GetTopology()
+}
+
+func (s *ClusterUserDefinedNetworkSpec) GetLayer3() *Layer3Config {
+	return s.Network.GetLayer3()
+}
+
+func (s *ClusterUserDefinedNetworkSpec) GetLayer2() *Layer2Config {
+	return s.Network.GetLayer2()
+}
+
+func (s *ClusterUserDefinedNetworkSpec) GetLocalnet() *LocalnetConfig {
+	return s.Network.GetLocalnet()
+}
+
+func (s *ClusterUserDefinedNetworkSpec) GetNamespaceIsolation() NamespaceIsolationMode {
+	return s.NamespaceIsolation
+}
+
+func (s *ClusterUserDefinedNetworkSpec) GetExternalIPs() []NodeExternalIPs {
+	return s.ExternalIPs
+}
@@ -14,7 +14,7 @@ type UserDefinedNetwork struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="Spec is immutable"
+	// +kubebuilder:validation:XValidation:rule="self.topology == oldSelf.topology", message="Topology is immutable"
 	// +kubebuilder:validation:XValidation:rule="has(self.topology) && self.topology == 'Layer3' ? has(self.layer3): !has(self.layer3)", message="spec.layer3 is required when topology is Layer3 and forbidden otherwise"
 	// +kubebuilder:validation:XValidation:rule="has(self.topology) && self.topology == 'Layer2' ? has(self.layer2): !has(self.layer2)", message="spec.layer2 is required when topology is Layer2 and forbidden otherwise"
 	// +required
@@ -24,6 +24,8 @@ type UserDefinedNetwork struct {
 }
 
 // UserDefinedNetworkSpec defines the desired state of UserDefinedNetworkSpec.
+// The network can only be updated in place to change its MTU or to append
+// subnets to a Layer3 or Layer2 network, the rest of the spec is immutable.
 // +union
 type UserDefinedNetworkSpec struct {
 	// Topology describes network configuration.
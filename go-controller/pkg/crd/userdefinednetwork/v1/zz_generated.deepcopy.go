@@ -107,6 +107,20 @@ func (in *ClusterUserDefinedNetworkSpec) DeepCopyInto(out *ClusterUserDefinedNet
 	*out = *in
 	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
 	in.Network.DeepCopyInto(&out.Network)
+	if in.RouteImports != nil {
+		in, out := &in.RouteImports, &out.RouteImports
+		*out = make([]RouteImport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExternalIPs != nil {
+		in, out := &in.ExternalIPs, &out.ExternalIPs
+		*out = make([]NodeExternalIPs, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -163,6 +177,26 @@ func (in DualStackCIDRs) DeepCopy() DualStackCIDRs {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in DualStackIPs) DeepCopyInto(out *DualStackIPs) {
+	{
+		in := &in
+		*out = make(DualStackIPs, len(*in))
+		copy(*out, *in)
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DualStackIPs.
+func (in DualStackIPs) DeepCopy() DualStackIPs {
+	if in == nil {
+		return nil
+	}
+	out := new(DualStackIPs)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMConfig) DeepCopyInto(out *IPAMConfig) {
 	*out = *in
@@ -184,7 +218,7 @@ func (in *Layer2Config) DeepCopyInto(out *Layer2Config) {
 	*out = *in
 	if in.Subnets != nil {
 		in, out := &in.Subnets, &out.Subnets
-		*out = make(DualStackCIDRs, len(*in))
+		*out = make(Layer2Subnets, len(*in))
 		copy(*out, *in)
 	}
 	if in.JoinSubnets != nil {
@@ -210,6 +244,26 @@ func (in *Layer2Config) DeepCopy() *Layer2Config {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in Layer2Subnets) DeepCopyInto(out *Layer2Subnets) {
+	{
+		in := &in
+		*out = make(Layer2Subnets, len(*in))
+		copy(*out, *in)
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Layer2Subnets.
+func (in Layer2Subnets) DeepCopy() Layer2Subnets {
+	if in == nil {
+		return nil
+	}
+	out := new(Layer2Subnets)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Layer3Config) DeepCopyInto(out *Layer3Config) {
 	*out = *in
@@ -319,6 +373,69 @@ func (in *NetworkSpec) DeepCopy() *NetworkSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeExternalIPs) DeepCopyInto(out *NodeExternalIPs) {
+	*out = *in
+	if in.IPs != nil {
+		in, out := &in.IPs, &out.IPs
+		*out = make(DualStackIPs, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeExternalIPs.
+func (in *NodeExternalIPs) DeepCopy() *NodeExternalIPs {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeExternalIPs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteImport) DeepCopyInto(out *RouteImport) {
+	*out = *in
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteImport.
+func (in *RouteImport) DeepCopy() *RouteImport {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrunkVLANConfig) DeepCopyInto(out *TrunkVLANConfig) {
+	*out = *in
+	if in.IDs != nil {
+		in, out := &in.IDs, &out.IDs
+		*out = make([]VLANID, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrunkVLANConfig.
+func (in *TrunkVLANConfig) DeepCopy() *TrunkVLANConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TrunkVLANConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserDefinedNetwork) DeepCopyInto(out *UserDefinedNetwork) {
 	*out = *in
@@ -437,6 +554,11 @@ func (in *VLANConfig) DeepCopyInto(out *VLANConfig) {
 		*out = new(AccessVLANConfig)
 		**out = **in
 	}
+	if in.Trunk != nil {
+		in, out := &in.Trunk, &out.Trunk
+		*out = new(TrunkVLANConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -47,6 +47,7 @@ import (
 	certificatesinformers "k8s.io/client-go/informers/certificates/v1"
 	v1coreinformers "k8s.io/client-go/informers/core/v1"
 	discoveryinformers "k8s.io/client-go/informers/discovery/v1"
+	networkinginformers "k8s.io/client-go/informers/networking/v1"
 	"k8s.io/client-go/kubernetes"
 	listers "k8s.io/client-go/listers/core/v1"
 	discoverylisters "k8s.io/client-go/listers/discovery/v1"
@@ -81,6 +82,10 @@ import (
 	egressservicescheme "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1/apis/clientset/versioned/scheme"
 	egressserviceinformerfactory "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1/apis/informers/externalversions"
 	egressserviceinformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1/apis/informers/externalversions/egressservice/v1"
+	externaloverlaypeerapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1"
+	externaloverlaypeerscheme "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1/apis/clientset/versioned/scheme"
+	externaloverlaypeerinformerfactory "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1/apis/informers/externalversions"
+	externaloverlaypeerinformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1/apis/informers/externalversions/externaloverlaypeer/v1"
 	networkqosapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1"
 	networkqosscheme "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1/apis/clientset/versioned/scheme"
 	networkqosinformerfactory "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1/apis/informers/externalversions"
@@ -90,6 +95,10 @@ import (
 	routeadvertisementsscheme "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/clientset/versioned/scheme"
 	routeadvertisementsinformerfactory "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/informers/externalversions"
 	routeadvertisementsinformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/informers/externalversions/routeadvertisements/v1"
+	samplingpolicyapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/samplingpolicy/v1"
+	samplingpolicyscheme "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/samplingpolicy/v1/apis/clientset/versioned/scheme"
+	samplingpolicyinformerfactory "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/samplingpolicy/v1/apis/informers/externalversions"
+	samplingpolicyinformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/samplingpolicy/v1/apis/informers/externalversions/samplingpolicy/v1"
 	userdefinednetworkapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
 	userdefinednetworkscheme "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/clientset/versioned/scheme"
 	userdefinednetworkapiinformerfactory "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/informers/externalversions"
@@ -124,6 +133,8 @@ type WatchFactory struct {
 	raFactory            routeadvertisementsinformerfactory.SharedInformerFactory
 	frrFactory           frrinformerfactory.SharedInformerFactory
 	networkQoSFactory    networkqosinformerfactory.SharedInformerFactory
+	eopFactory           externaloverlaypeerinformerfactory.SharedInformerFactory
+	spFactory            samplingpolicyinformerfactory.SharedInformerFactory
 	informers            map[reflect.Type]*informer
 
 	stopChan chan struct{}
@@ -152,6 +163,8 @@ func (wf *WatchFactory) ShallowClone() *WatchFactory {
 		raFactory:            wf.raFactory,
 		frrFactory:           wf.frrFactory,
 		networkQoSFactory:    wf.networkQoSFactory,
+		eopFactory:           wf.eopFactory,
+		spFactory:            wf.spFactory,
 		informers:            wf.informers,
 		stopChan:             wf.stopChan,
 
@@ -522,6 +535,15 @@ func NewOVNKubeControllerWatchFactory(ovnClientset *util.OVNKubeControllerClient
 		}
 	}
 
+	if config.OVNKubernetesFeature.EnableObservability && config.OVNKubernetesFeature.EnableSamplingPolicy {
+		if err := samplingpolicyapi.AddToScheme(samplingpolicyscheme.Scheme); err != nil {
+			return nil, err
+		}
+		wf.spFactory = samplingpolicyinformerfactory.NewSharedInformerFactory(ovnClientset.SamplingPolicyClient, resyncInterval)
+		// make sure shared informer is created for a factory, so on wf.spFactory.Start() it is initialized and caches are synced.
+		wf.spFactory.K8s().V1().SamplingPolicies().Informer()
+	}
+
 	return wf, nil
 }
 
@@ -665,6 +687,24 @@ func (wf *WatchFactory) Start() error {
 		}
 	}
 
+	if wf.eopFactory != nil {
+		wf.eopFactory.Start(wf.stopChan)
+		for oType, synced := range waitForCacheSyncWithTimeout(wf.eopFactory, wf.stopChan) {
+			if !synced {
+				return fmt.Errorf("error in syncing cache for %v informer", oType)
+			}
+		}
+	}
+
+	if wf.spFactory != nil {
+		wf.spFactory.Start(wf.stopChan)
+		for oType, synced := range waitForCacheSyncWithTimeout(wf.spFactory, wf.stopChan) {
+			if !synced {
+				return fmt.Errorf("error in syncing cache for %v informer", oType)
+			}
+		}
+	}
+
 	if config.OVNKubernetesFeature.EnableNetworkQoS && wf.networkQoSFactory != nil {
 		wf.networkQoSFactory.Start(wf.stopChan)
 		for oType, synced := range waitForCacheSyncWithTimeout(wf.networkQoSFactory, wf.stopChan) {
@@ -727,6 +767,14 @@ func (wf *WatchFactory) Stop() {
 	if wf.networkQoSFactory != nil {
 		wf.networkQoSFactory.Shutdown()
 	}
+
+	if wf.eopFactory != nil {
+		wf.eopFactory.Shutdown()
+	}
+
+	if wf.spFactory != nil {
+		wf.spFactory.Shutdown()
+	}
 }
 
 // NewNodeWatchFactory initializes a watch factory with significantly fewer
@@ -896,6 +944,15 @@ func NewNodeWatchFactory(ovnClientset *util.OVNNodeClientset, nodeName string) (
 		}
 	}
 
+	if config.HybridOverlay.ExternalPeers {
+		if err := externaloverlaypeerapi.AddToScheme(externaloverlaypeerscheme.Scheme); err != nil {
+			return nil, err
+		}
+		wf.eopFactory = externaloverlaypeerinformerfactory.NewSharedInformerFactory(ovnClientset.ExternalOverlayPeerClient, resyncInterval)
+		// make sure shared informer is created for a factory, so on wf.eopFactory.Start() it is initialized and caches are synced.
+		wf.eopFactory.K8s().V1().ExternalOverlayPeers().Informer()
+	}
+
 	return wf, nil
 }
 
@@ -1755,6 +1812,10 @@ func (wf *WatchFactory) ServiceCoreInformer() v1coreinformers.ServiceInformer {
 	return wf.iFactory.Core().V1().Services()
 }
 
+func (wf *WatchFactory) NetworkPolicyCoreInformer() networkinginformers.NetworkPolicyInformer {
+	return wf.iFactory.Networking().V1().NetworkPolicies()
+}
+
 func (wf *WatchFactory) EndpointSliceInformer() cache.SharedIndexInformer {
 	return wf.informers[EndpointSliceType].inf
 }
@@ -1823,6 +1884,14 @@ func (wf *WatchFactory) NetworkQoSInformer() networkqosinformer.NetworkQoSInform
 	return wf.networkQoSFactory.K8s().V1alpha1().NetworkQoSes()
 }
 
+func (wf *WatchFactory) ExternalOverlayPeerInformer() externaloverlaypeerinformer.ExternalOverlayPeerInformer {
+	return wf.eopFactory.K8s().V1().ExternalOverlayPeers()
+}
+
+func (wf *WatchFactory) SamplingPolicyInformer() samplingpolicyinformer.SamplingPolicyInformer {
+	return wf.spFactory.K8s().V1().SamplingPolicies()
+}
+
 // withServiceNameAndNoHeadlessServiceSelector returns a LabelSelector (added to the
 // watcher for EndpointSlices) that will only choose EndpointSlices with a non-empty
 // "kubernetes.io/service-name" label and without "service.kubernetes.io/headless"
@@ -10,6 +10,8 @@ import (
 
 	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/informers/externalversions/egressip/v1"
 
+	externaloverlaypeerv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1/apis/informers/externalversions/externaloverlaypeer/v1"
+
 	factory "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 
 	informerscorev1 "k8s.io/client-go/informers/core/v1"
@@ -242,6 +244,26 @@ func (_m *NodeWatchFactory) EgressIPInformer() egressipv1.EgressIPInformer {
 	return r0
 }
 
+// ExternalOverlayPeerInformer provides a mock function with given fields:
+func (_m *NodeWatchFactory) ExternalOverlayPeerInformer() externaloverlaypeerv1.ExternalOverlayPeerInformer {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExternalOverlayPeerInformer")
+	}
+
+	var r0 externaloverlaypeerv1.ExternalOverlayPeerInformer
+	if rf, ok := ret.Get(0).(func() externaloverlaypeerv1.ExternalOverlayPeerInformer); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(externaloverlaypeerv1.ExternalOverlayPeerInformer)
+		}
+	}
+
+	return r0
+}
+
 // GetAllPods provides a mock function with given fields:
 func (_m *NodeWatchFactory) GetAllPods() ([]*corev1.Pod, error) {
 	ret := _m.Called()
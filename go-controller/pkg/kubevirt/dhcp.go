@@ -100,6 +100,22 @@ func EnsureDHCPOptionsForLSP(controllerName string, nbClient libovsdbclient.Clie
 	return nil
 }
 
+// EnsureDHCPOptionsForPodLSP configures DHCP options for any pod, VM or not,
+// attached to a network that serves DHCP to all of its pods. The options are
+// owned by the pod and have to be removed with DeletePodDHCPOptions.
+func EnsureDHCPOptionsForPodLSP(controllerName string, nbClient libovsdbclient.Client, pod *corev1.Pod, ips []*net.IPNet, lsp *nbdb.LogicalSwitchPort, opts ...DHCPConfigsOpt) error {
+	podKey := ktypes.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
+	dhcpConfigs, err := composeDHCPConfigsForOwner(libovsdbops.PodDHCPOptions, controllerName, podKey, ips, opts...)
+	if err != nil {
+		return fmt.Errorf("failed composing DHCP options: %v", err)
+	}
+	err = libovsdbops.CreateOrUpdateDhcpOptions(nbClient, lsp, dhcpConfigs.V4, dhcpConfigs.V6)
+	if err != nil {
+		return fmt.Errorf("failed creation or updating OVN operations to add DHCP options: %v", err)
+	}
+	return nil
+}
+
 func composeDHCPConfigs(controllerName string, vmKey ktypes.NamespacedName, podIPs []*net.IPNet, opts ...DHCPConfigsOpt) (*dhcpConfigs, error) {
 	if len(podIPs) == 0 {
 		return nil, fmt.Errorf("missing podIPs to compose dhcp options")
@@ -107,6 +123,13 @@ func composeDHCPConfigs(controllerName string, vmKey ktypes.NamespacedName, podI
 	if vmKey.Name == "" {
 		return nil, fmt.Errorf("missing vmName to compose dhcp options")
 	}
+	return composeDHCPConfigsForOwner(libovsdbops.VirtualMachineDHCPOptions, controllerName, vmKey, podIPs, opts...)
+}
+
+func composeDHCPConfigsForOwner(idsType *libovsdbops.ObjectIDsType, controllerName string, ownerKey ktypes.NamespacedName, podIPs []*net.IPNet, opts ...DHCPConfigsOpt) (*dhcpConfigs, error) {
+	if len(podIPs) == 0 {
+		return nil, fmt.Errorf("missing podIPs to compose dhcp options")
+	}
 
 	dhcpConfigs := &dhcpConfigs{}
 	for _, ip := range podIPs {
@@ -115,9 +138,9 @@ func composeDHCPConfigs(controllerName string, vmKey ktypes.NamespacedName, podI
 			return nil, fmt.Errorf("failed converting podIPs to cidr to configure dhcp: %v", err)
 		}
 		if utilnet.IsIPv4CIDR(cidr) {
-			dhcpConfigs.V4 = ComposeDHCPv4Options(cidr.String(), controllerName, vmKey)
+			dhcpConfigs.V4 = composeDHCPv4Options(idsType, cidr.String(), controllerName, ownerKey)
 		} else if utilnet.IsIPv6CIDR(cidr) {
-			dhcpConfigs.V6 = ComposeDHCPv6Options(cidr.String(), controllerName, vmKey)
+			dhcpConfigs.V6 = composeDHCPv6Options(idsType, cidr.String(), controllerName, ownerKey)
 		}
 	}
 	for _, opt := range opts {
@@ -144,6 +167,10 @@ func RetrieveDNSServiceClusterIPs(k8scli *factory.WatchFactory) (string, string,
 }
 
 func ComposeDHCPv4Options(cidr, controllerName string, vmKey ktypes.NamespacedName) *nbdb.DHCPOptions {
+	return composeDHCPv4Options(libovsdbops.VirtualMachineDHCPOptions, cidr, controllerName, vmKey)
+}
+
+func composeDHCPv4Options(idsType *libovsdbops.ObjectIDsType, cidr, controllerName string, ownerKey ktypes.NamespacedName) *nbdb.DHCPOptions {
 	serverMAC := util.IPAddrToHWAddr(net.ParseIP(ARPProxyIPv4)).String()
 	dhcpOptions := &nbdb.DHCPOptions{
 		Cidr: cidr,
@@ -151,28 +178,32 @@ func ComposeDHCPv4Options(cidr, controllerName string, vmKey ktypes.NamespacedNa
 			"lease_time": fmt.Sprintf("%d", dhcpLeaseTime),
 			"server_id":  ARPProxyIPv4,
 			"server_mac": serverMAC,
-			"hostname":   fmt.Sprintf("%q", vmKey.Name),
+			"hostname":   fmt.Sprintf("%q", ownerKey.Name),
 		},
 	}
-	return composeDHCPOptions(controllerName, vmKey, dhcpOptions)
+	return composeDHCPOptions(idsType, controllerName, ownerKey, dhcpOptions)
 }
 
 func ComposeDHCPv6Options(cidr, controllerName string, vmKey ktypes.NamespacedName) *nbdb.DHCPOptions {
+	return composeDHCPv6Options(libovsdbops.VirtualMachineDHCPOptions, cidr, controllerName, vmKey)
+}
+
+func composeDHCPv6Options(idsType *libovsdbops.ObjectIDsType, cidr, controllerName string, ownerKey ktypes.NamespacedName) *nbdb.DHCPOptions {
 	serverMAC := util.IPAddrToHWAddr(net.ParseIP(ARPProxyIPv6)).String()
 	dhcpOptions := &nbdb.DHCPOptions{
 		Cidr: cidr,
 		Options: map[string]string{
 			"server_id": serverMAC,
-			"fqdn":      fmt.Sprintf("%q", vmKey.Name), // equivalent to ipv4 "hostname" option
+			"fqdn":      fmt.Sprintf("%q", ownerKey.Name), // equivalent to ipv4 "hostname" option
 		},
 	}
-	return composeDHCPOptions(controllerName, vmKey, dhcpOptions)
+	return composeDHCPOptions(idsType, controllerName, ownerKey, dhcpOptions)
 }
 
-func composeDHCPOptions(controllerName string, vmKey ktypes.NamespacedName, dhcpOptions *nbdb.DHCPOptions) *nbdb.DHCPOptions {
-	dhcpvOptionsDbObjectID := libovsdbops.NewDbObjectIDs(libovsdbops.VirtualMachineDHCPOptions, controllerName,
+func composeDHCPOptions(idsType *libovsdbops.ObjectIDsType, controllerName string, ownerKey ktypes.NamespacedName, dhcpOptions *nbdb.DHCPOptions) *nbdb.DHCPOptions {
+	dhcpvOptionsDbObjectID := libovsdbops.NewDbObjectIDs(idsType, controllerName,
 		map[libovsdbops.ExternalIDKey]string{
-			libovsdbops.ObjectNameKey: vmKey.String(),
+			libovsdbops.ObjectNameKey: ownerKey.String(),
 			libovsdbops.CIDRKey:       strings.ReplaceAll(dhcpOptions.Cidr, ":", "."),
 		})
 	dhcpOptions.ExternalIDs = dhcpvOptionsDbObjectID.GetExternalIDs()
@@ -192,3 +223,13 @@ func DeleteDHCPOptions(nbClient libovsdbclient.Client, pod *corev1.Pod) error {
 	}
 	return nil
 }
+
+// DeletePodDHCPOptions removes the DHCP options configured for the pod by
+// EnsureDHCPOptionsForPodLSP.
+func DeletePodDHCPOptions(controllerName string, nbClient libovsdbclient.Client, pod *corev1.Pod) error {
+	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.PodDHCPOptions, controllerName,
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey: ktypes.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}.String(),
+		})
+	return libovsdbops.DeleteDHCPOptionsWithPredicate(nbClient, libovsdbops.GetPredicate[*nbdb.DHCPOptions](predicateIDs, nil))
+}
@@ -5,6 +5,7 @@ import (
 
 	ktypes "k8s.io/apimachinery/pkg/types"
 
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -210,3 +211,32 @@ var _ = Describe("Kubevirt", func() {
 	)
 
 })
+
+var _ = Describe("Pod DHCP options", func() {
+	It("should be owned by the pod", func() {
+		_, cidr, err := net.ParseCIDR("192.168.25.0/24")
+		Expect(err).ToNot(HaveOccurred())
+		obtaineddhcpConfigs, err := composeDHCPConfigsForOwner(libovsdbops.PodDHCPOptions, "l2Controller",
+			ktypes.NamespacedName{Namespace: "namespace1", Name: "pod1"}, []*net.IPNet{cidr}, WithIPv4MTU(1400))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(obtaineddhcpConfigs.V6).To(BeNil())
+		Expect(obtaineddhcpConfigs.V4).To(Equal(&nbdb.DHCPOptions{
+			Cidr: "192.168.25.0/24",
+			ExternalIDs: map[string]string{
+				"k8s.ovn.org/owner-controller": "l2Controller",
+				"k8s.ovn.org/owner-type":       "Pod",
+				"k8s.ovn.org/name":             "namespace1/pod1",
+				"k8s.ovn.org/cidr":             "192.168.25.0/24",
+				"k8s.ovn.org/id":               "l2Controller:Pod:namespace1/pod1:192.168.25.0/24",
+				"k8s.ovn.org/zone":             "local",
+			},
+			Options: map[string]string{
+				"lease_time": "3500",
+				"server_mac": "0a:58:a9:fe:01:01",
+				"server_id":  "169.254.1.1",
+				"hostname":   `"pod1"`,
+				"mtu":        "1400",
+			},
+		}))
+	})
+})
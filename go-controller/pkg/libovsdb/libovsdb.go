@@ -23,6 +23,7 @@ import (
 	"k8s.io/klog/v2"
 	"k8s.io/klog/v2/textlogger"
 
+	"github.com/ovn-org/libovsdb/cache"
 	"github.com/ovn-org/libovsdb/client"
 	"github.com/ovn-org/libovsdb/model"
 
@@ -225,6 +226,45 @@ func NewNBClientWithConfig(cfg config.OvnAuthConfig, promRegistry prometheus.Reg
 	return c, nil
 }
 
+// NewACLNBClientWithConfig creates a new OVN Northbound Database client that
+// only monitors the name and external IDs of ACLs. It is meant for node
+// components that need to map ACLs back to the objects that own them, the
+// given handlers get the ACL events from the initial monitor dump on.
+func NewACLNBClientWithConfig(cfg config.OvnAuthConfig, stopCh <-chan struct{}, handlers ...cache.EventHandler) (client.Client, error) {
+	dbModel, err := nbdb.FullDatabaseModel()
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := newClient(cfg, dbModel, stopCh)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, handler := range handlers {
+		c.Cache().AddEventHandler(handler)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.Default.OVSDBTxnTimeout*2)
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	acl := nbdb.ACL{}
+	_, err = c.Monitor(ctx,
+		c.NewMonitor(
+			client.WithTable(&acl, &acl.Name, &acl.ExternalIDs),
+		),
+	)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	return c, nil
+}
+
 // NewOVSClient creates a new openvswitch Database client
 func NewOVSClient(stopCh <-chan struct{}) (client.Client, error) {
 	cfg := &config.OvnAuthConfig{
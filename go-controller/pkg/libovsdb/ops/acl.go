@@ -96,13 +96,18 @@ func BuildACL(name string, direction nbdb.ACLDirection, priority int, match stri
 	return acl
 }
 
-func SetACLLogging(acl *nbdb.ACL, severity nbdb.ACLSeverity, log bool) {
+func SetACLLogging(acl *nbdb.ACL, severity nbdb.ACLSeverity, log bool, meter string) {
 	var realSeverity *string
+	var realMeter *string
 	if len(severity) != 0 {
 		realSeverity = &severity
 	}
+	if len(meter) != 0 {
+		realMeter = &meter
+	}
 	acl.Severity = realSeverity
 	acl.Log = log
+	acl.Meter = realMeter
 }
 
 // CreateOrUpdateACLsOps creates or updates the provided ACLs returning the
@@ -169,7 +174,28 @@ func UpdateACLsLoggingOps(nbClient libovsdbclient.Client, ops []ovsdb.Operation,
 		acl := acls[i]
 		opModel := operationModel{
 			Model:          acl,
-			OnModelUpdates: []interface{}{&acl.Severity, &acl.Log},
+			OnModelUpdates: []interface{}{&acl.Severity, &acl.Log, &acl.Meter},
+			ErrNotFound:    true,
+			BulkOp:         false,
+		}
+		opModels = append(opModels, opModel)
+	}
+
+	modelClient := newModelClient(nbClient)
+	return modelClient.CreateOrUpdateOps(ops, opModels...)
+}
+
+// UpdateACLsSampleOps updates the samples on the provided ACLs based on the
+// given sampling config and returns the corresponding ops
+func UpdateACLsSampleOps(nbClient libovsdbclient.Client, ops []ovsdb.Operation, samplingConfig *SamplingConfig, acls ...*nbdb.ACL) ([]ovsdb.Operation, error) {
+	opModels := make([]operationModel, 0, len(acls))
+	for i := range acls {
+		// can't use i in the predicate, for loop replaces it in-memory
+		acl := acls[i]
+		opModels = addSample(samplingConfig, opModels, acl)
+		opModel := operationModel{
+			Model:          acl,
+			OnModelUpdates: []interface{}{&acl.SampleNew, &acl.SampleEst},
 			ErrNotFound:    true,
 			BulkOp:         false,
 		}
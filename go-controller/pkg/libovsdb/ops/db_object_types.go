@@ -35,8 +35,13 @@ const (
 	NetpolNodeOwnerType         ownerType = "NetpolNode"
 	NetpolNamespaceOwnerType    ownerType = "NetpolNamespace"
 	VirtualMachineOwnerType     ownerType = "VirtualMachine"
+	PodOwnerType                ownerType = "Pod"
+	TrunkVLANOwnerType          ownerType = "TrunkVLAN"
+	NamespaceIsolationOwnerType ownerType = "NamespaceIsolation"
 	UDNEnabledServiceOwnerType  ownerType = "UDNEnabledService"
 	AdvertisedNetworkOwnerType  ownerType = "AdvertisedNetwork"
+	LoadBalancerOwnerType       ownerType = "LoadBalancer"
+	APBRouteOwnerType           ownerType = "APBRoute"
 	// NetworkPolicyPortIndexOwnerType is the old version of NetworkPolicyOwnerType, kept for sync only
 	NetworkPolicyPortIndexOwnerType ownerType = "NetworkPolicyPortIndexOwnerType"
 	// ClusterOwnerType means the object is cluster-scoped and doesn't belong to any k8s objects
@@ -283,6 +288,44 @@ var ACLUDN = newObjectIDsType(acl, UDNIsolationOwnerType, []ExternalIDKey{
 	PolicyDirectionKey,
 })
 
+var ACLTrunkVLAN = newObjectIDsType(acl, TrunkVLANOwnerType, []ExternalIDKey{
+	// network name
+	ObjectNameKey,
+	// egress or ingress
+	PolicyDirectionKey,
+})
+
+var ACLNamespaceIsolation = newObjectIDsType(acl, NamespaceIsolationOwnerType, []ExternalIDKey{
+	// namespace
+	ObjectNameKey,
+})
+
+// ACLLoadBalancerSample, ACLEgressIPSample, ACLEgressServiceSample and ACLAPBRouteSample
+// are the pass ACLs used to sample the traffic handled by other db objects, see
+// observability.Manager.RunSamplePoints.
+var ACLLoadBalancerSample = newObjectIDsType(acl, LoadBalancerOwnerType, []ExternalIDKey{
+	// service namespace+name
+	ObjectNameKey,
+	IPFamilyKey,
+})
+
+var ACLEgressIPSample = newObjectIDsType(acl, EgressIPOwnerType, []ExternalIDKey{
+	// egress IP name
+	ObjectNameKey,
+	IPFamilyKey,
+})
+
+var ACLEgressServiceSample = newObjectIDsType(acl, EgressServiceOwnerType, []ExternalIDKey{
+	// service namespace+name
+	ObjectNameKey,
+	IPFamilyKey,
+})
+
+var ACLAPBRouteSample = newObjectIDsType(acl, APBRouteOwnerType, []ExternalIDKey{
+	// next hop of the external route
+	ObjectNameKey,
+})
+
 var VirtualMachineDHCPOptions = newObjectIDsType(dhcpOptions, VirtualMachineOwnerType, []ExternalIDKey{
 	// We can have multiple VMs with same CIDR they  may have different
 	// hostname.
@@ -292,6 +335,13 @@ var VirtualMachineDHCPOptions = newObjectIDsType(dhcpOptions, VirtualMachineOwne
 	CIDRKey,
 })
 
+var PodDHCPOptions = newObjectIDsType(dhcpOptions, PodOwnerType, []ExternalIDKey{
+	// pod "namespace/name"
+	ObjectNameKey,
+	// CIDR field from DHCPOptions with ":" replaced by "."
+	CIDRKey,
+})
+
 var PortGroupNamespace = newObjectIDsType(portGroup, NamespaceOwnerType, []ExternalIDKey{
 	// namespace name
 	ObjectNameKey,
@@ -1,16 +1,31 @@
 package ops
 
 import (
+	"context"
 	"reflect"
 
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	libovsdbclient "github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/model"
 	"github.com/ovn-org/libovsdb/ovsdb"
 
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 )
 
+type meterPredicate func(*nbdb.Meter) bool
+
+// FindMetersWithPredicate looks up meters from the cache based on a given
+// predicate
+func FindMetersWithPredicate(nbClient libovsdbclient.Client, p meterPredicate) ([]*nbdb.Meter, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), config.Default.OVSDBTxnTimeout)
+	defer cancel()
+	meters := []*nbdb.Meter{}
+	err := nbClient.WhereCache(p).List(ctx, &meters)
+	return meters, err
+}
+
 func equalsMeterBand(a, b *nbdb.MeterBand) bool {
 	return a.Action == b.Action &&
 		a.BurstSize == b.BurstSize &&
@@ -65,3 +80,36 @@ func CreateOrUpdateMeterOps(nbClient libovsdbclient.Client, ops []ovsdb.Operatio
 	m := newModelClient(nbClient)
 	return m.CreateOrUpdateOps(ops, opModel)
 }
+
+// DeleteUnusedMetersOps returns the ops to delete the provided meters. The
+// transaction fails if an ACL references any of them by then, so that a meter
+// is never deleted from under an ACL that started using it concurrently. The
+// meter bands are removed by OVSDB once unreferenced.
+func DeleteUnusedMetersOps(nbClient libovsdbclient.Client, ops []ovsdb.Operation, meters ...*nbdb.Meter) ([]ovsdb.Operation, error) {
+	opModels := make([]operationModel, 0, len(meters))
+	for i := range meters {
+		// can't use i in the predicate, for loop replaces it in-memory
+		meter := meters[i]
+		acl := &nbdb.ACL{Meter: &meter.Name}
+		timeout := 0
+		// wait until the set of ACLs referencing the meter differs from a
+		// referencing one, i.e. until no ACL references it
+		waitOps, err := nbClient.WhereAny(acl, model.Condition{
+			Field:    &acl.Meter,
+			Function: ovsdb.ConditionEqual,
+			Value:    acl.Meter,
+		}).Wait(ovsdb.WaitConditionNotEqual, &timeout, acl, &acl.Meter)
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, waitOps...)
+		opModels = append(opModels, operationModel{
+			Model:       meter,
+			ErrNotFound: false,
+			BulkOp:      false,
+		})
+	}
+
+	m := newModelClient(nbClient)
+	return m.DeleteOps(ops, opModels...)
+}
@@ -63,3 +63,45 @@ func TestCreateMeterBandOps(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteUnusedMetersOps(t *testing.T) {
+	meterName := "meter"
+	meter := &nbdb.Meter{
+		UUID: buildNamedUUID(),
+		Name: meterName,
+	}
+	acl := &nbdb.ACL{
+		UUID:  buildNamedUUID(),
+		Meter: &meterName,
+	}
+	nbClient, cleanup, err := libovsdbtest.NewNBTestHarness(libovsdbtest.TestSetup{
+		NBData: []libovsdbtest.TestData{
+			meter,
+			acl,
+			&nbdb.PortGroup{
+				UUID: buildNamedUUID(),
+				Name: "pg",
+				ACLs: []string{acl.UUID},
+			},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("failed to set up test harness: %v", err)
+	}
+	t.Cleanup(cleanup.Cleanup)
+
+	ops, err := DeleteUnusedMetersOps(nbClient, nil, &nbdb.Meter{Name: meterName})
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+	if _, err = TransactAndCheck(nbClient, ops); err == nil {
+		t.Fatal("expected the deletion of a meter referenced by an ACL to fail")
+	}
+	meters, err := FindMetersWithPredicate(nbClient, func(*nbdb.Meter) bool { return true })
+	if err != nil {
+		t.Fatalf("got unexpected error: %v", err)
+	}
+	if len(meters) != 1 {
+		t.Fatalf("expected the meter referenced by an ACL to be kept, got %v", meters)
+	}
+}
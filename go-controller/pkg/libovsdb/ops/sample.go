@@ -2,6 +2,7 @@ package ops
 
 import (
 	"hash/fnv"
+	"sync"
 
 	"golang.org/x/net/context"
 
@@ -136,19 +137,77 @@ const (
 	AdminNetworkPolicySample SampleFeature = "AdminNetworkPolicy"
 	MulticastSample          SampleFeature = "Multicast"
 	UDNIsolationSample       SampleFeature = "UDNIsolation"
+	// LoadBalancerSample, NATSample and RerouteSample are not ACL-based features,
+	// their traffic is sampled with dedicated ACLs.
+	LoadBalancerSample SampleFeature = "LoadBalancer"
+	NATSample          SampleFeature = "NAT"
+	RerouteSample      SampleFeature = "Reroute"
 )
 
 // SamplingConfig is used to configure sampling for different db objects.
 type SamplingConfig struct {
 	featureCollectors map[SampleFeature][]string
+	lock              sync.RWMutex
+	// namespace => sampling config that overrides featureCollectors for the
+	// db objects owned by that namespace.
+	namespaceCollectors map[string]*NamespaceSamplingConfig
+}
+
+// NamespaceSamplingConfig configures sampling for the db objects of a single namespace.
+type NamespaceSamplingConfig struct {
+	// FeatureCollectors are used for all db objects of the namespace.
+	FeatureCollectors map[SampleFeature][]string
+	// NetworkPolicyCollectors are used for the ACLs of the given network policy
+	// name, and take precedence over FeatureCollectors[NetworkPolicySample].
+	NetworkPolicyCollectors map[string][]string
 }
 
 func NewSamplingConfig(featureCollectors map[SampleFeature][]string) *SamplingConfig {
 	return &SamplingConfig{
-		featureCollectors: featureCollectors,
+		featureCollectors:   featureCollectors,
+		namespaceCollectors: map[string]*NamespaceSamplingConfig{},
 	}
 }
 
+// SetNamespaceSampling replaces the sampling config of the given namespace.
+func (c *SamplingConfig) SetNamespaceSampling(namespace string, nsConfig *NamespaceSamplingConfig) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.namespaceCollectors[namespace] = nsConfig
+}
+
+// DeleteNamespaceSampling removes the sampling config of the given namespace,
+// its db objects fall back to the global feature config.
+func (c *SamplingConfig) DeleteNamespaceSampling(namespace string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.namespaceCollectors, namespace)
+}
+
+// IsFeatureSampled returns true if the given feature is sampled with the global config.
+func (c *SamplingConfig) IsFeatureSampled(feature SampleFeature) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return len(c.featureCollectors[feature]) > 0
+}
+
+func (c *SamplingConfig) getACLCollectors(acl *nbdb.ACL) []string {
+	feature := getACLSampleFeature(acl)
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if namespace, policyName := GetACLSampleNamespace(acl); namespace != "" {
+		if nsConfig, ok := c.namespaceCollectors[namespace]; ok {
+			if collectors, ok := nsConfig.NetworkPolicyCollectors[policyName]; ok && policyName != "" {
+				return collectors
+			}
+			if collectors, ok := nsConfig.FeatureCollectors[feature]; ok {
+				return collectors
+			}
+		}
+	}
+	return c.featureCollectors[feature]
+}
+
 func addSample(c *SamplingConfig, opModels []operationModel, model model.Model) []operationModel {
 	switch t := model.(type) {
 	case *nbdb.ACL:
@@ -164,7 +223,7 @@ func createOrUpdateSampleForACL(opModels []operationModel, c *SamplingConfig, ac
 		acl.SampleNew = nil
 		return opModels
 	}
-	collectors := c.featureCollectors[getACLSampleFeature(acl)]
+	collectors := c.getACLCollectors(acl)
 	if len(collectors) == 0 {
 		acl.SampleEst = nil
 		acl.SampleNew = nil
@@ -218,6 +277,30 @@ func getACLSampleFeature(acl *nbdb.ACL) SampleFeature {
 		return EgressFirewallSample
 	case UDNIsolationOwnerType:
 		return UDNIsolationSample
+	case LoadBalancerOwnerType:
+		return LoadBalancerSample
+	case EgressIPOwnerType, EgressServiceOwnerType:
+		return NATSample
+	case APBRouteOwnerType:
+		return RerouteSample
 	}
 	return ""
 }
+
+// GetACLSampleNamespace returns the namespace owning the given ACL, and the
+// network policy name for network policy ACLs. Namespace is empty for the ACLs
+// that can only be sampled with the global config.
+func GetACLSampleNamespace(acl *nbdb.ACL) (string, string) {
+	objectName := acl.ExternalIDs[ObjectNameKey.String()]
+	switch acl.ExternalIDs[OwnerTypeKey.String()] {
+	case NetworkPolicyOwnerType:
+		namespace, name, err := ParseNamespaceNameKey(objectName)
+		if err != nil {
+			return "", ""
+		}
+		return namespace, name
+	case NetpolNamespaceOwnerType, EgressFirewallOwnerType, MulticastNamespaceOwnerType:
+		return objectName, ""
+	}
+	return "", ""
+}
@@ -1,18 +1,23 @@
 package util
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	knet "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	libovsdbclient "github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/ovsdb"
 
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	ovnkubeutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	utilerrors "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/errors"
 )
 
 // aclPipelineType defines when ACLs will be applied (direction and pipeline stage).
@@ -117,7 +122,7 @@ func BuildACL(dbIDs *libovsdbops.DbObjectIDs, priority int, match, action string
 		priority,
 		match,
 		action,
-		types.OvnACLLoggingMeter,
+		GetACLLoggingMeter(logLevels),
 		logSeverity,
 		log,
 		externalIDs,
@@ -166,6 +171,139 @@ type ACLLoggingLevels struct {
 	Allow string `json:"allow,omitempty"`
 	Deny  string `json:"deny,omitempty"`
 	Pass  string `json:"pass,omitempty"`
+	// RateLimit is the maximum number of packets per second logged by each
+	// ACL. When unset, the cluster wide rate limit of the default ACL logging
+	// meter applies.
+	RateLimit int `json:"rateLimit,omitempty"`
+}
+
+// ParseACLLoggingLevels parses the value of the k8s.ovn.org/acl-logging
+// annotation of the given kind of object. Invalid severities and rate limits
+// are disabled and reported in the returned error.
+func ParseACLLoggingLevels(annotation, kind string) (*ACLLoggingLevels, error) {
+	aclLogLevels := &ACLLoggingLevels{}
+	// If the annotation is "" or "{}", use empty strings. Otherwise, parse the annotation.
+	if annotation != "" && annotation != "{}" {
+		err := json.Unmarshal([]byte(annotation), aclLogLevels)
+		if err != nil {
+			// Disable all logging to ensure idempotency.
+			return &ACLLoggingLevels{}, fmt.Errorf("could not unmarshal %s ACL annotation '%s', disabling logging, err: %q",
+				kind, annotation, err)
+		}
+	}
+
+	// Valid log levels are the various preestablished levels or the empty string.
+	validLogLevels := sets.NewString(nbdb.ACLSeverityAlert, nbdb.ACLSeverityWarning, nbdb.ACLSeverityNotice,
+		nbdb.ACLSeverityInfo, nbdb.ACLSeverityDebug, "")
+	var errs []error
+	if !validLogLevels.Has(aclLogLevels.Deny) {
+		errs = append(errs, fmt.Errorf("disabling deny logging due to an invalid deny annotation. "+
+			"%q is not a valid log severity", aclLogLevels.Deny))
+		aclLogLevels.Deny = ""
+	}
+	if !validLogLevels.Has(aclLogLevels.Allow) {
+		errs = append(errs, fmt.Errorf("disabling allow logging due to an invalid allow annotation. "+
+			"%q is not a valid log severity", aclLogLevels.Allow))
+		aclLogLevels.Allow = ""
+	}
+	if !validLogLevels.Has(aclLogLevels.Pass) {
+		errs = append(errs, fmt.Errorf("disabling pass logging due to an invalid pass annotation. "+
+			"%q is not a valid log severity", aclLogLevels.Pass))
+		aclLogLevels.Pass = ""
+	}
+	if aclLogLevels.RateLimit < 0 {
+		errs = append(errs, fmt.Errorf("using the default rate limit due to an invalid rate limit annotation. "+
+			"%d is not a valid rate limit", aclLogLevels.RateLimit))
+		aclLogLevels.RateLimit = 0
+	}
+	return aclLogLevels, utilerrors.Join(errs...)
+}
+
+// GetACLLoggingMeter returns the name of the meter rate limiting the logs of
+// ACLs with the given logging levels. ACLs with a specific rate limit share a
+// meter per rate: as the meter is fair, each ACL is still rate limited
+// individually.
+func GetACLLoggingMeter(aclLogging *ACLLoggingLevels) string {
+	if aclLogging == nil || aclLogging.RateLimit <= 0 {
+		return types.OvnACLLoggingMeter
+	}
+	return fmt.Sprintf("%s-%d", types.OvnACLLoggingMeter, aclLogging.RateLimit)
+}
+
+// CreateOrUpdateACLLoggingMeterOps returns the ops to create or update the
+// meter rate limiting the logs of ACLs with the given logging levels.
+func CreateOrUpdateACLLoggingMeterOps(nbClient libovsdbclient.Client, ops []ovsdb.Operation,
+	aclLogging *ACLLoggingLevels) ([]ovsdb.Operation, error) {
+	rate := config.Logging.ACLLoggingRateLimit
+	if aclLogging != nil && aclLogging.RateLimit > 0 {
+		rate = aclLogging.RateLimit
+	}
+	band := &nbdb.MeterBand{
+		Action: types.MeterAction,
+		Rate:   rate,
+	}
+	ops, err := libovsdbops.CreateMeterBandOps(nbClient, ops, band)
+	if err != nil {
+		return nil, fmt.Errorf("can't create meter band %v: %v", band, err)
+	}
+
+	meterFairness := true
+	meter := &nbdb.Meter{
+		Name: GetACLLoggingMeter(aclLogging),
+		Fair: &meterFairness,
+		Unit: types.PacketsPerSecond,
+	}
+	ops, err = libovsdbops.CreateOrUpdateMeterOps(nbClient, ops, meter, []*nbdb.MeterBand{band},
+		&meter.Bands, &meter.Fair, &meter.Unit)
+	if err != nil {
+		return nil, fmt.Errorf("can't create meter %v: %v", meter, err)
+	}
+	return ops, nil
+}
+
+// EnsureACLLoggingMeter creates or updates the meter rate limiting the logs of
+// ACLs with the given logging levels.
+func EnsureACLLoggingMeter(nbClient libovsdbclient.Client, aclLogging *ACLLoggingLevels) error {
+	ops, err := CreateOrUpdateACLLoggingMeterOps(nbClient, nil, aclLogging)
+	if err != nil {
+		return err
+	}
+	if _, err = libovsdbops.TransactAndCheck(nbClient, ops); err != nil {
+		return fmt.Errorf("can't transact ACL logging meter %s: %v", GetACLLoggingMeter(aclLogging), err)
+	}
+	return nil
+}
+
+// DeleteUnusedACLLoggingMeters deletes the per-rate ACL logging meters no ACL
+// references anymore. The default ACL logging meter is always kept.
+func DeleteUnusedACLLoggingMeters(nbClient libovsdbclient.Client) error {
+	usedMeters := sets.New[string]()
+	_, err := libovsdbops.FindACLsWithPredicate(nbClient, func(acl *nbdb.ACL) bool {
+		if acl.Meter != nil {
+			usedMeters.Insert(*acl.Meter)
+		}
+		return false
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list ACLs: %v", err)
+	}
+	unusedMeters, err := libovsdbops.FindMetersWithPredicate(nbClient, func(meter *nbdb.Meter) bool {
+		return strings.HasPrefix(meter.Name, types.OvnACLLoggingMeter+"-") && !usedMeters.Has(meter.Name)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list ACL logging meters: %v", err)
+	}
+	if len(unusedMeters) == 0 {
+		return nil
+	}
+	ops, err := libovsdbops.DeleteUnusedMetersOps(nbClient, nil, unusedMeters...)
+	if err != nil {
+		return fmt.Errorf("unable to get delete ACL logging meters ops: %v", err)
+	}
+	if _, err = libovsdbops.TransactAndCheck(nbClient, ops); err != nil {
+		return fmt.Errorf("unable to delete unused ACL logging meters: %v", err)
+	}
+	return nil
 }
 
 func getLogSeverity(action string, aclLogging *ACLLoggingLevels) (log bool, severity string) {
@@ -197,11 +335,21 @@ func UpdateACLLogging(nbClient libovsdbclient.Client, ACLs []*nbdb.ACL, aclLoggi
 	if len(ACLs) == 0 {
 		return nil
 	}
+	var ops []ovsdb.Operation
+	var err error
+	if aclLogging != nil && aclLogging.RateLimit > 0 {
+		// ensure the meter in the same transaction, in case it was garbage
+		// collected since the logging levels were parsed
+		ops, err = CreateOrUpdateACLLoggingMeterOps(nbClient, ops, aclLogging)
+		if err != nil {
+			return err
+		}
+	}
 	for i := range ACLs {
 		log, severity := getLogSeverity(ACLs[i].Action, aclLogging)
-		libovsdbops.SetACLLogging(ACLs[i], severity, log)
+		libovsdbops.SetACLLogging(ACLs[i], severity, log, GetACLLoggingMeter(aclLogging))
 	}
-	ops, err := libovsdbops.UpdateACLsLoggingOps(nbClient, nil, ACLs...)
+	ops, err = libovsdbops.UpdateACLsLoggingOps(nbClient, ops, ACLs...)
 	if err != nil {
 		return fmt.Errorf("unable to get ACL logging ops: %v", err)
 	}
@@ -269,7 +417,8 @@ type gressRulePortsForL4ACLMatch struct {
 	portRange []string // list of provided port ranges in OVN ACL format
 }
 
-func getProtocolPortsMap(rulePorts []*NetworkPolicyPort) map[string]*gressRulePortsForL4ACLMatch {
+// portField is the L4 field the ports are matched against, "dst" or "src".
+func getProtocolPortsMap(rulePorts []*NetworkPolicyPort, portField string) map[string]*gressRulePortsForL4ACLMatch {
 	gressProtoPortsMap := make(map[string]*gressRulePortsForL4ACLMatch)
 	for _, pp := range rulePorts {
 		gpp, ok := gressProtoPortsMap[pp.Protocol]
@@ -278,7 +427,7 @@ func getProtocolPortsMap(rulePorts []*NetworkPolicyPort) map[string]*gressRulePo
 			gressProtoPortsMap[pp.Protocol] = gpp
 		}
 		if pp.EndPort != 0 && pp.EndPort != pp.Port {
-			gpp.portRange = append(gpp.portRange, fmt.Sprintf("%d<=%s.dst<=%d", pp.Port, pp.Protocol, pp.EndPort))
+			gpp.portRange = append(gpp.portRange, fmt.Sprintf("%d<=%s.%s<=%d", pp.Port, pp.Protocol, portField, pp.EndPort))
 		} else if pp.Port != 0 {
 			gpp.portList = append(gpp.portList, fmt.Sprintf("%d", pp.Port))
 		}
@@ -286,15 +435,15 @@ func getProtocolPortsMap(rulePorts []*NetworkPolicyPort) map[string]*gressRulePo
 	return gressProtoPortsMap
 }
 
-func getL4Match(protocol string, ports *gressRulePortsForL4ACLMatch) string {
+func getL4Match(protocol string, ports *gressRulePortsForL4ACLMatch, portField string) string {
 	allL4Matches := []string{}
 	if len(ports.portList) > 0 {
 		// if there is just one port, then don't use `{}`
-		template := "%s.dst==%s"
+		template := "%s.%s==%s"
 		if len(ports.portList) > 1 {
-			template = "%s.dst=={%s}"
+			template = "%s.%s=={%s}"
 		}
-		allL4Matches = append(allL4Matches, fmt.Sprintf(template, protocol, strings.Join(ports.portList, ",")))
+		allL4Matches = append(allL4Matches, fmt.Sprintf(template, protocol, portField, strings.Join(ports.portList, ",")))
 	}
 	allL4Matches = append(allL4Matches, ports.portRange...)
 	l4Match := protocol
@@ -313,15 +462,26 @@ func getL4Match(protocol string, ports *gressRulePortsForL4ACLMatch) string {
 // It returns a map that has protocol as the key and the l4Match as the value
 // If len(rulePorts)==0; it returns map["None"] = "None" which means there is no L4 match
 func GetL4MatchesFromNetworkPolicyPorts(rulePorts []*NetworkPolicyPort) map[string]string {
+	return getL4MatchesFromNetworkPolicyPorts(rulePorts, "dst")
+}
+
+// GetReturnL4MatchesFromNetworkPolicyPorts is like GetL4MatchesFromNetworkPolicyPorts,
+// but it matches the source ports instead of the destination ports, to build the
+// ACLs of the return traffic of stateless network policies.
+func GetReturnL4MatchesFromNetworkPolicyPorts(rulePorts []*NetworkPolicyPort) map[string]string {
+	return getL4MatchesFromNetworkPolicyPorts(rulePorts, "src")
+}
+
+func getL4MatchesFromNetworkPolicyPorts(rulePorts []*NetworkPolicyPort, portField string) map[string]string {
 	l4Matches := make(map[string]string)
-	gressProtoPortsMap := getProtocolPortsMap(rulePorts)
+	gressProtoPortsMap := getProtocolPortsMap(rulePorts, portField)
 	if len(gressProtoPortsMap) == 0 {
 		gressProtoPortsMap[UnspecifiedL4Protocol] = nil
 	}
 	for protocol, ports := range gressProtoPortsMap {
 		l4Match := UnspecifiedL4Match
 		if ports != nil {
-			l4Match = getL4Match(protocol, ports)
+			l4Match = getL4Match(protocol, ports, portField)
 		}
 		l4Matches[protocol] = l4Match
 	}
@@ -1,11 +1,15 @@
 package util
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	corev1 "k8s.io/api/core/v1"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
 )
 
 func TestConvertK8sProtocolToOVNProtocol(t *testing.T) {
@@ -160,15 +164,20 @@ func TestGetL4Match(t *testing.T) {
 	}
 
 	for _, tc := range testcases {
-		protocolPortsMap := getProtocolPortsMap(tc.portPolices)
+		protocolPortsMap := getProtocolPortsMap(tc.portPolices, "dst")
 		if tc.expected == "" {
 			assert.Empty(t, protocolPortsMap)
 			continue
 		}
 		assert.Len(t, protocolPortsMap, 1)
 		assert.Contains(t, protocolPortsMap, tc.protocol)
-		l4Match := getL4Match(tc.protocol, protocolPortsMap[tc.protocol])
+		l4Match := getL4Match(tc.protocol, protocolPortsMap[tc.protocol], "dst")
 		assert.Equal(t, tc.expected, l4Match)
+
+		// return traffic matches the same ports as source ports
+		returnPortsMap := getProtocolPortsMap(tc.portPolices, "src")
+		returnL4Match := getL4Match(tc.protocol, returnPortsMap[tc.protocol], "src")
+		assert.Equal(t, strings.ReplaceAll(tc.expected, ".dst", ".src"), returnL4Match)
 	}
 }
 
@@ -299,3 +308,97 @@ func TestGetL3L4MatchesFromNamedPorts(t *testing.T) {
 		}
 	}
 }
+
+func TestParseACLLoggingLevels(t *testing.T) {
+	testcases := []struct {
+		desc       string
+		annotation string
+		expected   *ACLLoggingLevels
+		err        string
+	}{
+		{
+			desc:     "empty annotation",
+			expected: &ACLLoggingLevels{},
+		},
+		{
+			desc:       "valid annotation with rate limit",
+			annotation: `{"allow": "info", "deny": "alert", "rateLimit": 10}`,
+			expected:   &ACLLoggingLevels{Allow: "info", Deny: "alert", RateLimit: 10},
+		},
+		{
+			desc:       "malformed annotation",
+			annotation: `{"allow": "info"`,
+			expected:   &ACLLoggingLevels{},
+			err:        "could not unmarshal test ACL annotation",
+		},
+		{
+			desc:       "invalid severity",
+			annotation: `{"allow": "info", "deny": "loud"}`,
+			expected:   &ACLLoggingLevels{Allow: "info"},
+			err:        "disabling deny logging due to an invalid deny annotation",
+		},
+		{
+			desc:       "invalid rate limit",
+			annotation: `{"allow": "info", "rateLimit": -1}`,
+			expected:   &ACLLoggingLevels{Allow: "info"},
+			err:        "using the default rate limit due to an invalid rate limit annotation",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.desc, func(t *testing.T) {
+			aclLogging, err := ParseACLLoggingLevels(tc.annotation, "test")
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expected, aclLogging)
+		})
+	}
+}
+
+func TestGetACLLoggingMeter(t *testing.T) {
+	assert.Equal(t, "acl-logging", GetACLLoggingMeter(nil))
+	assert.Equal(t, "acl-logging", GetACLLoggingMeter(&ACLLoggingLevels{Allow: "info"}))
+	assert.Equal(t, "acl-logging-10", GetACLLoggingMeter(&ACLLoggingLevels{Allow: "info", RateLimit: 10}))
+}
+
+func TestDeleteUnusedACLLoggingMeters(t *testing.T) {
+	usedMeter := "acl-logging-10"
+	acl := &nbdb.ACL{
+		UUID:   "acl-uuid",
+		Action: nbdb.ACLActionAllow,
+		Meter:  &usedMeter,
+	}
+	pg := &nbdb.PortGroup{UUID: "pg-uuid", Name: "pg", ACLs: []string{acl.UUID}}
+	defaultMeter := &nbdb.Meter{UUID: "default-meter-uuid", Name: "acl-logging"}
+	rateLimiterMeter := &nbdb.Meter{UUID: "rate-limiter-uuid", Name: "rate-limiter"}
+	perRateMeter := &nbdb.Meter{UUID: "per-rate-meter-uuid", Name: usedMeter}
+	nbClient, cleanup, err := libovsdbtest.NewNBTestHarness(libovsdbtest.TestSetup{
+		NBData: []libovsdbtest.TestData{
+			acl,
+			pg,
+			defaultMeter,
+			rateLimiterMeter,
+			perRateMeter,
+			&nbdb.Meter{UUID: "unused-meter-uuid", Name: "acl-logging-20"},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("failed to set up test harness: %v", err)
+	}
+	t.Cleanup(cleanup.Cleanup)
+
+	if err = DeleteUnusedACLLoggingMeters(nbClient); err != nil {
+		t.Fatalf("failed to delete unused ACL logging meters: %v", err)
+	}
+
+	matcher := libovsdbtest.HaveData([]libovsdbtest.TestData{acl, pg, defaultMeter, rateLimiterMeter, perRateMeter})
+	success, err := matcher.Match(nbClient)
+	if err != nil {
+		t.Fatalf("failed to match the NB data: %v", err)
+	}
+	if !success {
+		t.Fatal(matcher.FailureMessage(nbClient))
+	}
+}
@@ -483,6 +483,15 @@ func writePlainText(statusCode int, text string, w http.ResponseWriter) {
 	fmt.Fprintln(w, text)
 }
 
+var debugHandlers = map[string]http.Handler{}
+
+// RegisterDebugHandler registers a read-only handler to be served on the
+// provided path by the OVN K8s metrics server. Must be called before the
+// metrics server is started.
+func RegisterDebugHandler(path string, handler http.Handler) {
+	debugHandlers[path] = handler
+}
+
 // StartMetricsServer runs the prometheus listener so that OVN K8s metrics can be collected
 // It puts the endpoint behind TLS if certFile and keyFile are defined.
 func StartMetricsServer(bindAddress string, enablePprof bool, certFile string, keyFile string,
@@ -490,6 +499,10 @@ func StartMetricsServer(bindAddress string, enablePprof bool, certFile string, k
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.Handler())
 
+	for path, handler := range debugHandlers {
+		mux.Handle(path, handler)
+	}
+
 	if enablePprof {
 		mux.HandleFunc("/debug/pprof/", pprof.Index)
 		mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
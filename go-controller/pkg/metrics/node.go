@@ -47,6 +47,21 @@ var metricOvnKubeNodeLogFileSize = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 	},
 )
 
+// MetricOVSCPUAffinity exposes the CPU affinity ovnkube-node sets on the
+// threads of the OVS and OVN daemons when OVS CPU pinning is enabled
+var MetricOVSCPUAffinity = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Subsystem: types.MetricOvnkubeSubsystemNode,
+	Name:      "ovs_cpu_affinity",
+	Help: "A metric with a constant '1' value labeled by process, group of threads " +
+		"and list of CPUs the threads of the process are pinned to."},
+	[]string{
+		"process",
+		"threads",
+		"cpus",
+	},
+)
+
 var registerNodeMetricsOnce sync.Once
 
 func RegisterNodeMetrics(stopChan <-chan struct{}) {
@@ -80,6 +95,7 @@ func RegisterNodeMetrics(stopChan <-chan struct{}) {
 			}
 		}
 		prometheus.MustRegister(metricOvnKubeNodeLogFileSize)
+		prometheus.MustRegister(MetricOVSCPUAffinity)
 		go ovnKubeLogFileSizeMetricsUpdater(metricOvnKubeNodeLogFileSize, stopChan)
 	})
 }
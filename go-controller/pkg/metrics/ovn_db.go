@@ -322,7 +322,9 @@ var (
 	sbDbSchemaVersion string
 )
 
-func getNBDBSockPath() (string, error) {
+// GetNBDBSockPath returns the directory of the local OVN northbound database
+// socket.
+func GetNBDBSockPath() (string, error) {
 	paths := []string{"/var/run/openvswitch/", "/var/run/ovn/"}
 	for _, basePath := range paths {
 		if _, err := os.Stat(basePath + "ovnnb_db.sock"); err == nil {
@@ -340,7 +342,7 @@ func getOvnDbVersionInfo() {
 	if err == nil && strings.HasPrefix(stdout, "ovsdb-server (Open vSwitch) ") {
 		ovnDbVersion = strings.Fields(stdout)[3]
 	}
-	basePath, err := getNBDBSockPath()
+	basePath, err := GetNBDBSockPath()
 	if err != nil {
 		klog.Errorf("OVN db schema versions can't be fetched: %s", err)
 		return
@@ -164,6 +164,37 @@ var metricOvnKubeControllerLogFileSize = prometheus.NewGaugeVec(prometheus.Gauge
 	},
 )
 
+var metricRouteImportImportedRoutes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Subsystem: types.MetricOvnkubeSubsystemController,
+	Name:      "route_import_imported_routes",
+	Help:      "The number of BGP routes imported into the gateway router of a network"},
+	[]string{
+		"network_name",
+	},
+)
+
+var metricRouteImportRejectedRoutes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Subsystem: types.MetricOvnkubeSubsystemController,
+	Name:      "route_import_rejected_routes",
+	Help:      "The number of BGP routes not imported into the gateway router of a network because of its import policy"},
+	[]string{
+		"network_name",
+		"reason",
+	},
+)
+
+var metricRouteImportMaxPrefixesExceeded = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: types.MetricOvnkubeNamespace,
+	Subsystem: types.MetricOvnkubeSubsystemController,
+	Name:      "route_import_max_prefixes_exceeded",
+	Help:      "Identifies whether the BGP routes to import into the gateway router of a network exceed(1) or not(0) the maximum number of prefixes of its import policy"},
+	[]string{
+		"network_name",
+	},
+)
+
 var metricEgressIPAssignLatency = prometheus.NewHistogram(prometheus.HistogramOpts{
 	Namespace: types.MetricOvnkubeNamespace,
 	Subsystem: types.MetricOvnkubeSubsystemController,
@@ -414,6 +445,9 @@ func RegisterOVNKubeControllerFunctional(stopChan <-chan struct{}) {
 	prometheus.MustRegister(metricEgressRoutingViaHost)
 	prometheus.MustRegister(metricANPCount)
 	prometheus.MustRegister(metricBANPCount)
+	prometheus.MustRegister(metricRouteImportImportedRoutes)
+	prometheus.MustRegister(metricRouteImportRejectedRoutes)
+	prometheus.MustRegister(metricRouteImportMaxPrefixesExceeded)
 	if err := prometheus.Register(MetricResourceRetryFailuresCount); err != nil {
 		if _, ok := err.(prometheus.AlreadyRegisteredError); !ok {
 			panic(err)
@@ -649,6 +683,29 @@ func DecrementBANPCount() {
 	metricBANPCount.Dec()
 }
 
+// UpdateRouteImportMetrics records for a network the number of imported BGP
+// routes, the number of rejected BGP routes by reason and whether the maximum
+// number of prefixes to import is exceeded.
+func UpdateRouteImportMetrics(network string, imported int, rejected map[string]int, maxPrefixesExceeded bool) {
+	metricRouteImportImportedRoutes.WithLabelValues(network).Set(float64(imported))
+	metricRouteImportRejectedRoutes.DeletePartialMatch(prometheus.Labels{"network_name": network})
+	for reason, count := range rejected {
+		metricRouteImportRejectedRoutes.WithLabelValues(network, reason).Set(float64(count))
+	}
+	if maxPrefixesExceeded {
+		metricRouteImportMaxPrefixesExceeded.WithLabelValues(network).Set(1)
+	} else {
+		metricRouteImportMaxPrefixesExceeded.WithLabelValues(network).Set(0)
+	}
+}
+
+// DeleteRouteImportMetrics deletes the route import metrics of a network.
+func DeleteRouteImportMetrics(network string) {
+	metricRouteImportImportedRoutes.DeleteLabelValues(network)
+	metricRouteImportRejectedRoutes.DeletePartialMatch(prometheus.Labels{"network_name": network})
+	metricRouteImportMaxPrefixesExceeded.DeleteLabelValues(network)
+}
+
 type (
 	timestampType int
 	operation     int
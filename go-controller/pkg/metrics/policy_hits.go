@@ -0,0 +1,289 @@
+package metrics
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// ACLSampleDecoder finds the ACL an OVS sample was generated for.
+type ACLSampleDecoder interface {
+	FindACL(obsDomainID, obsPointID uint32) (*nbdb.ACL, error)
+}
+
+var metricPolicyHitsPacketsDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(types.MetricOvnkubeNamespace, types.MetricOvnkubeSubsystemNode, "policy_hits_packets_total"),
+	"The number of packets that matched a network policy rule on the node.",
+	[]string{
+		"kind",
+		"namespace",
+		"name",
+		"direction",
+		"rule",
+		"action",
+	},
+	nil,
+)
+
+var (
+	flowDurationRegex = regexp.MustCompile(`duration=([\d.]+)s`)
+	flowPacketsRegex  = regexp.MustCompile(`n_packets=(\d+)`)
+	flowSampleRegex   = regexp.MustCompile(`sample\([^)]*obs_domain_id=(\d+),obs_point_id=(\d+)`)
+	// flowStatsRegex matches the fields of a dumped flow that change over
+	// its lifetime
+	flowStatsRegex = regexp.MustCompile(`(duration|n_packets|n_bytes|idle_age|hard_age)=[^,\s]*,\s*`)
+)
+
+type sampleIDs struct {
+	obsDomainID uint32
+	obsPointID  uint32
+}
+
+// sampledFlow is an OVS flow with sample actions.
+type sampledFlow struct {
+	duration float64
+	packets  uint64
+	samples  []sampleIDs
+}
+
+// policyRule identifies a network policy rule, its fields are the labels of
+// the policy hits metric.
+type policyRule struct {
+	kind      string
+	namespace string
+	name      string
+	direction string
+	rule      string
+	action    string
+}
+
+// policyHitsCollector exports the number of packets that matched each network
+// policy rule. OVN adds a sample action to the OVS flows of the ACLs when
+// observability is enabled: the packets counters of the flows are summed per
+// sample, and every sample is mapped back to the policy rule of its ACL.
+// The flows counters restart from zero when the flows are reinstalled, so the
+// packets they counted since the previous update are accumulated per rule
+// instead for the metric to be a counter. The flows of a rule may also be
+// missing for a while, e.g. when they are reinstalled or when none of the local
+// ports is selected anymore, so the hits of a rule are kept as long as its ACL
+// exists.
+type policyHitsCollector struct {
+	sync.Mutex
+	ovsOfctl ovsClient
+	decoder  ACLSampleDecoder
+	// flows are the sampled flows of the previous update, only accessed by
+	// update
+	flows map[string]sampledFlow
+	// samples are the samples of the rules with hits, to find their ACLs
+	// when they have no flows, only accessed by update
+	samples map[policyRule]sets.Set[sampleIDs]
+	hits    map[policyRule]uint64
+}
+
+func newPolicyHitsCollector(ovsOfctl ovsClient, decoder ACLSampleDecoder) *policyHitsCollector {
+	return &policyHitsCollector{
+		ovsOfctl: ovsOfctl,
+		decoder:  decoder,
+		flows:    map[string]sampledFlow{},
+		samples:  map[policyRule]sets.Set[sampleIDs]{},
+		hits:     map[policyRule]uint64{},
+	}
+}
+
+func (c *policyHitsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- metricPolicyHitsPacketsDesc
+}
+
+func (c *policyHitsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.Lock()
+	defer c.Unlock()
+	for rule, packets := range c.hits {
+		ch <- prometheus.MustNewConstMetric(metricPolicyHitsPacketsDesc, prometheus.CounterValue, float64(packets),
+			rule.kind, rule.namespace, rule.name, rule.direction, rule.rule, rule.action)
+	}
+}
+
+// update dumps the flows of the integration bridge and adds the packets they
+// counted since the previous update to the hits of their policy rules. The
+// rules without flows anymore are dropped once their ACLs are deleted.
+func (c *policyHitsCollector) update() error {
+	stdout, stderr, err := c.ovsOfctl("-t", "5", "dump-flows", "br-int")
+	if err != nil {
+		return fmt.Errorf("failed to dump flows of br-int, stderr(%s): (%v)", stderr, err)
+	}
+	flows := parseSampledFlows(stdout)
+	samplePackets := map[sampleIDs]uint64{}
+	for key, flow := range flows {
+		packets := flow.packets
+		// a flow reinstalled since the previous update counts from zero again
+		if previous, ok := c.flows[key]; ok && flow.duration >= previous.duration && flow.packets >= previous.packets {
+			packets -= previous.packets
+		}
+		for _, ids := range flow.samples {
+			samplePackets[ids] += packets
+		}
+	}
+	c.flows = flows
+
+	c.Lock()
+	defer c.Unlock()
+	hits := make(map[policyRule]uint64, len(samplePackets))
+	samples := make(map[policyRule]sets.Set[sampleIDs], len(samplePackets))
+	for ids, packets := range samplePackets {
+		rule, ok := c.findPolicyRule(ids)
+		if !ok {
+			continue
+		}
+		if _, ok := hits[rule]; !ok {
+			hits[rule] = c.hits[rule]
+			samples[rule] = sets.New[sampleIDs]()
+		}
+		hits[rule] += packets
+		samples[rule].Insert(ids)
+	}
+	// keep the hits of the rules without flows as long as one of their ACLs
+	// still exists
+	for rule, packets := range c.hits {
+		if _, ok := hits[rule]; ok {
+			continue
+		}
+		for ids := range c.samples[rule] {
+			if aclRule, ok := c.findPolicyRule(ids); ok && aclRule == rule {
+				hits[rule] = packets
+				samples[rule] = c.samples[rule]
+				break
+			}
+		}
+	}
+	for rule, packets := range hits {
+		if packets == 0 {
+			delete(hits, rule)
+			delete(samples, rule)
+		}
+	}
+	c.hits = hits
+	c.samples = samples
+	return nil
+}
+
+// findPolicyRule returns the policy rule of the ACL the given sample was
+// generated for, if any.
+func (c *policyHitsCollector) findPolicyRule(ids sampleIDs) (policyRule, bool) {
+	acl, err := c.decoder.FindACL(ids.obsDomainID, ids.obsPointID)
+	if err != nil {
+		// samples of other features or stale flows
+		klog.V(5).Infof("Failed to find ACL for sample obsDomainID=%d obsPointID=%d: %v",
+			ids.obsDomainID, ids.obsPointID, err)
+		return policyRule{}, false
+	}
+	return getACLPolicyRule(acl)
+}
+
+// parseSampledFlows returns the flows with sample actions of the given
+// ovs-ofctl dump-flows output, indexed by the flow without its statistics.
+func parseSampledFlows(dump string) map[string]sampledFlow {
+	flows := map[string]sampledFlow{}
+	for _, line := range strings.Split(dump, "\n") {
+		if !strings.Contains(line, "sample(") {
+			continue
+		}
+		packetsMatch := flowPacketsRegex.FindStringSubmatch(line)
+		if packetsMatch == nil {
+			continue
+		}
+		packets, err := strconv.ParseUint(packetsMatch[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		flow := sampledFlow{packets: packets}
+		if durationMatch := flowDurationRegex.FindStringSubmatch(line); durationMatch != nil {
+			flow.duration, _ = strconv.ParseFloat(durationMatch[1], 64)
+		}
+		for _, sampleMatch := range flowSampleRegex.FindAllStringSubmatch(line, -1) {
+			obsDomainID, err := strconv.ParseUint(sampleMatch[1], 10, 32)
+			if err != nil {
+				continue
+			}
+			obsPointID, err := strconv.ParseUint(sampleMatch[2], 10, 32)
+			if err != nil {
+				continue
+			}
+			flow.samples = append(flow.samples, sampleIDs{obsDomainID: uint32(obsDomainID), obsPointID: uint32(obsPointID)})
+		}
+		if len(flow.samples) > 0 {
+			flows[strings.TrimSpace(flowStatsRegex.ReplaceAllString(line, ""))] = flow
+		}
+	}
+	return flows
+}
+
+// getACLPolicyRule returns the policy rule the given ACL was created for, if
+// any.
+func getACLPolicyRule(acl *nbdb.ACL) (policyRule, bool) {
+	rule := policyRule{
+		kind:      acl.ExternalIDs[libovsdbops.OwnerTypeKey.String()],
+		direction: acl.ExternalIDs[libovsdbops.PolicyDirectionKey.String()],
+		action:    acl.Action,
+	}
+	objectName := acl.ExternalIDs[libovsdbops.ObjectNameKey.String()]
+	switch rule.kind {
+	case libovsdbops.NetworkPolicyOwnerType:
+		namespace, name, err := libovsdbops.ParseNamespaceNameKey(objectName)
+		if err != nil {
+			return rule, false
+		}
+		rule.namespace = namespace
+		rule.name = name
+		rule.rule = acl.ExternalIDs[libovsdbops.GressIdxKey.String()]
+	case libovsdbops.AdminNetworkPolicyOwnerType, libovsdbops.BaselineAdminNetworkPolicyOwnerType:
+		rule.name = objectName
+		rule.rule = acl.ExternalIDs[libovsdbops.GressIdxKey.String()]
+	case libovsdbops.NetpolNamespaceOwnerType:
+		// traffic not allowed by any network policy of the namespace
+		rule.namespace = objectName
+	default:
+		return rule, false
+	}
+	return rule, true
+}
+
+func policyHitsMetricsUpdater(c *policyHitsCollector, metricsScrapeInterval int, stopChan <-chan struct{}) {
+	ticker := time.NewTicker(time.Duration(metricsScrapeInterval) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := c.update(); err != nil {
+				klog.Errorf("Updating policy hits metrics failed: %v", err)
+			}
+		case <-stopChan:
+			return
+		}
+	}
+}
+
+var registerPolicyHitsMetricsOnce sync.Once
+
+// RegisterPolicyHitsMetrics registers the metrics counting the packets that
+// matched each network policy rule on the node. The decoder is used to map the
+// samples of the OVS flows back to their ACLs.
+func RegisterPolicyHitsMetrics(decoder ACLSampleDecoder, metricsScrapeInterval int, stopChan <-chan struct{}) {
+	registerPolicyHitsMetricsOnce.Do(func() {
+		c := newPolicyHitsCollector(util.RunOVSOfctl, decoder)
+		ovnRegistry.MustRegister(c)
+		go policyHitsMetricsUpdater(c, metricsScrapeInterval, stopChan)
+	})
+}
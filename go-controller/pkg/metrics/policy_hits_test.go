@@ -0,0 +1,176 @@
+package metrics
+
+import (
+	"fmt"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+)
+
+const dumpFlowsSampleOutput = `NXST_FLOW reply (xid=0x4):
+ cookie=0x1a2b, duration=10.5s, table=44, n_packets=7, n_bytes=700, priority=1001,ip,metadata=0x1 actions=sample(probability=65535,collector_set_id=42,obs_domain_id=33554433,obs_point_id=10),resubmit(,45)
+ cookie=0x1a2c, duration=10.5s, table=44, n_packets=3, n_bytes=300, priority=1001,ipv6,metadata=0x1 actions=sample(probability=65535,collector_set_id=42,obs_domain_id=33554433,obs_point_id=10),resubmit(,45)
+ cookie=0x1a2d, duration=10.5s, table=44, n_packets=5, n_bytes=500, priority=1000,ip,metadata=0x1 actions=sample(probability=65535,collector_set_id=42,obs_domain_id=33554433,obs_point_id=11),drop
+ cookie=0x1a2e, duration=10.5s, table=44, n_packets=0, n_bytes=0, priority=1000,ip,metadata=0x1 actions=sample(probability=65535,collector_set_id=42,obs_domain_id=33554433,obs_point_id=12),drop
+ cookie=0x1a2f, duration=10.5s, table=44, n_packets=9, n_bytes=900, priority=900,ip,metadata=0x1 actions=sample(probability=65535,collector_set_id=42,obs_domain_id=16777217,obs_point_id=1),drop
+ cookie=0x1a30, duration=10.5s, table=45, n_packets=100, n_bytes=10000, priority=0,metadata=0x1 actions=resubmit(,46)`
+
+type fakeACLSampleDecoder struct {
+	acls map[sampleIDs]*nbdb.ACL
+}
+
+func (d *fakeACLSampleDecoder) FindACL(obsDomainID, obsPointID uint32) (*nbdb.ACL, error) {
+	acl, ok := d.acls[sampleIDs{obsDomainID: obsDomainID, obsPointID: obsPointID}]
+	if !ok {
+		return nil, fmt.Errorf("no ACL found for sample %d/%d", obsDomainID, obsPointID)
+	}
+	return acl, nil
+}
+
+func collectPolicyHits(c *policyHitsCollector) map[string]float64 {
+	ch := make(chan prometheus.Metric, 10)
+	c.Collect(ch)
+	close(ch)
+	hits := map[string]float64{}
+	for m := range ch {
+		pb := &dto.Metric{}
+		gomega.Expect(m.Write(pb)).To(gomega.Succeed())
+		labels := map[string]string{}
+		for _, l := range pb.GetLabel() {
+			labels[l.GetName()] = l.GetValue()
+		}
+		key := fmt.Sprintf("%s/%s/%s/%s/%s/%s", labels["kind"], labels["namespace"], labels["name"],
+			labels["direction"], labels["rule"], labels["action"])
+		hits[key] = pb.GetCounter().GetValue()
+	}
+	return hits
+}
+
+var _ = ginkgo.Describe("Policy hits metrics", func() {
+	allowACL := &nbdb.ACL{
+		Action: nbdb.ACLActionAllowRelated,
+		ExternalIDs: map[string]string{
+			libovsdbops.OwnerTypeKey.String():       libovsdbops.NetworkPolicyOwnerType,
+			libovsdbops.ObjectNameKey.String():      libovsdbops.BuildNamespaceNameKey("ns1", "allow-web"),
+			libovsdbops.PolicyDirectionKey.String(): "Ingress",
+			libovsdbops.GressIdxKey.String():        "0",
+		},
+	}
+	denyACL := &nbdb.ACL{
+		Action: nbdb.ACLActionDrop,
+		ExternalIDs: map[string]string{
+			libovsdbops.OwnerTypeKey.String():       libovsdbops.NetpolNamespaceOwnerType,
+			libovsdbops.ObjectNameKey.String():      "ns1",
+			libovsdbops.PolicyDirectionKey.String(): "Ingress",
+		},
+	}
+	anpACL := &nbdb.ACL{
+		Action: nbdb.ACLActionDrop,
+		ExternalIDs: map[string]string{
+			libovsdbops.OwnerTypeKey.String():       libovsdbops.AdminNetworkPolicyOwnerType,
+			libovsdbops.ObjectNameKey.String():      "cluster-control",
+			libovsdbops.PolicyDirectionKey.String(): "Egress",
+			libovsdbops.GressIdxKey.String():        "2",
+		},
+	}
+
+	ginkgo.It("counts the packets of every policy rule", func() {
+		decoder := &fakeACLSampleDecoder{acls: map[sampleIDs]*nbdb.ACL{
+			{obsDomainID: 33554433, obsPointID: 10}: allowACL,
+			{obsDomainID: 33554433, obsPointID: 11}: denyACL,
+			{obsDomainID: 33554433, obsPointID: 12}: anpACL,
+		}}
+		ovsOfctl := NewFakeOVSClient([]clientOutput{{stdout: dumpFlowsSampleOutput}})
+		c := newPolicyHitsCollector(ovsOfctl.FakeCall, decoder)
+		gomega.Expect(c.update()).To(gomega.Succeed())
+		gomega.Expect(collectPolicyHits(c)).To(gomega.Equal(map[string]float64{
+			"NetworkPolicy/ns1/allow-web/Ingress/0/allow-related": 10,
+			"NetpolNamespace/ns1//Ingress//drop":                  5,
+		}))
+	})
+
+	ginkgo.It("keeps counting the packets of a rule when its flows are reinstalled", func() {
+		decoder := &fakeACLSampleDecoder{acls: map[sampleIDs]*nbdb.ACL{
+			{obsDomainID: 33554433, obsPointID: 10}: allowACL,
+			{obsDomainID: 33554433, obsPointID: 11}: denyACL,
+		}}
+		// the first flow was reinstalled and the second one counted one more packet
+		reinstalledFlowsOutput := `NXST_FLOW reply (xid=0x4):
+ cookie=0x1a2b, duration=1.5s, table=44, n_packets=2, n_bytes=200, priority=1001,ip,metadata=0x1 actions=sample(probability=65535,collector_set_id=42,obs_domain_id=33554433,obs_point_id=10),resubmit(,45)
+ cookie=0x1a2c, duration=40.5s, table=44, n_packets=4, n_bytes=400, priority=1001,ipv6,metadata=0x1 actions=sample(probability=65535,collector_set_id=42,obs_domain_id=33554433,obs_point_id=10),resubmit(,45)
+ cookie=0x1a2d, duration=40.5s, table=44, n_packets=5, n_bytes=500, priority=1000,ip,metadata=0x1 actions=sample(probability=65535,collector_set_id=42,obs_domain_id=33554433,obs_point_id=11),drop`
+		ovsOfctl := NewFakeOVSClient([]clientOutput{
+			{stdout: dumpFlowsSampleOutput},
+			{stdout: reinstalledFlowsOutput},
+		})
+		c := newPolicyHitsCollector(ovsOfctl.FakeCall, decoder)
+		gomega.Expect(c.update()).To(gomega.Succeed())
+		gomega.Expect(c.update()).To(gomega.Succeed())
+		gomega.Expect(collectPolicyHits(c)).To(gomega.Equal(map[string]float64{
+			"NetworkPolicy/ns1/allow-web/Ingress/0/allow-related": 13,
+			"NetpolNamespace/ns1//Ingress//drop":                  5,
+		}))
+	})
+
+	ginkgo.It("keeps counting the packets of a rule while its ACL exists", func() {
+		decoder := &fakeACLSampleDecoder{acls: map[sampleIDs]*nbdb.ACL{
+			{obsDomainID: 33554433, obsPointID: 10}: allowACL,
+			{obsDomainID: 33554433, obsPointID: 11}: denyACL,
+		}}
+		noSampledFlowsOutput := `NXST_FLOW reply (xid=0x4):
+ cookie=0x1a30, duration=20.5s, table=45, n_packets=100, n_bytes=10000, priority=0,metadata=0x1 actions=resubmit(,46)`
+		reinstalledFlowsOutput := `NXST_FLOW reply (xid=0x4):
+ cookie=0x1a2b, duration=1.5s, table=44, n_packets=2, n_bytes=200, priority=1001,ip,metadata=0x1 actions=sample(probability=65535,collector_set_id=42,obs_domain_id=33554433,obs_point_id=10),resubmit(,45)
+ cookie=0x1a2d, duration=1.5s, table=44, n_packets=1, n_bytes=100, priority=1000,ip,metadata=0x1 actions=sample(probability=65535,collector_set_id=42,obs_domain_id=33554433,obs_point_id=11),drop`
+		ovsOfctl := NewFakeOVSClient([]clientOutput{
+			{stdout: dumpFlowsSampleOutput},
+			{stdout: noSampledFlowsOutput},
+			{stdout: reinstalledFlowsOutput},
+			{stdout: noSampledFlowsOutput},
+		})
+		c := newPolicyHitsCollector(ovsOfctl.FakeCall, decoder)
+		gomega.Expect(c.update()).To(gomega.Succeed())
+
+		ginkgo.By("removing the flows of the rules while their ACLs exist")
+		gomega.Expect(c.update()).To(gomega.Succeed())
+		gomega.Expect(collectPolicyHits(c)).To(gomega.Equal(map[string]float64{
+			"NetworkPolicy/ns1/allow-web/Ingress/0/allow-related": 10,
+			"NetpolNamespace/ns1//Ingress//drop":                  5,
+		}))
+
+		ginkgo.By("reinstalling the flows of the rules")
+		gomega.Expect(c.update()).To(gomega.Succeed())
+		gomega.Expect(collectPolicyHits(c)).To(gomega.Equal(map[string]float64{
+			"NetworkPolicy/ns1/allow-web/Ingress/0/allow-related": 12,
+			"NetpolNamespace/ns1//Ingress//drop":                  6,
+		}))
+
+		ginkgo.By("removing the flows of the rules and the ACL of one of them")
+		delete(decoder.acls, sampleIDs{obsDomainID: 33554433, obsPointID: 11})
+		gomega.Expect(c.update()).To(gomega.Succeed())
+		gomega.Expect(collectPolicyHits(c)).To(gomega.Equal(map[string]float64{
+			"NetworkPolicy/ns1/allow-web/Ingress/0/allow-related": 12,
+		}))
+	})
+
+	ginkgo.It("keeps the previous counters when dumping the flows fails", func() {
+		decoder := &fakeACLSampleDecoder{acls: map[sampleIDs]*nbdb.ACL{
+			{obsDomainID: 33554433, obsPointID: 10}: allowACL,
+		}}
+		ovsOfctl := NewFakeOVSClient([]clientOutput{
+			{stdout: dumpFlowsSampleOutput},
+			{stderr: "connection refused", err: fmt.Errorf("failed to connect")},
+		})
+		c := newPolicyHitsCollector(ovsOfctl.FakeCall, decoder)
+		gomega.Expect(c.update()).To(gomega.Succeed())
+		gomega.Expect(c.update()).NotTo(gomega.Succeed())
+		gomega.Expect(collectPolicyHits(c)).To(gomega.Equal(map[string]float64{
+			"NetworkPolicy/ns1/allow-web/Ingress/0/allow-related": 10,
+		}))
+	})
+})
@@ -25,8 +25,10 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/id"
+	udntemplate "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/userdefinednetwork/template"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
 	rainformers "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/informers/externalversions/routeadvertisements/v1"
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
 	userdefinednetworkinformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/informers/externalversions/userdefinednetwork/v1"
 	userdefinednetworklister "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/listers/userdefinednetwork/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
@@ -312,7 +314,24 @@ func (c *nadController) syncNAD(key string, nad *nettypes.NetworkAttachmentDefin
 		ensureNetwork = util.NewMutableNetInfo(nadNetwork)
 	case util.AreNetworksCompatible(currentNetwork, nadNetwork):
 		// the NAD refers to an existing compatible network, ensure that
-		// existing network holds a reference to this NAD
+		// existing network holds a reference to this NAD and takes any
+		// configuration updated in place from it, unless the NAD has not been
+		// rendered yet from an update of the (C)UDN it is owned by, in which
+		// case it would revert the update taken from other NADs
+		ensureNetwork = currentNetwork
+		if c.isNADOutdated(nad, nadNetwork) {
+			klog.V(4).Infof("%s: NAD %s is outdated, not updating network %s from it", c.name, key, nadNetworkName)
+			break
+		}
+		err = util.ReconcileNetworkConfig(ensureNetwork, nadNetwork)
+		if err != nil {
+			return fmt.Errorf("%s: failed to update network %s from NAD %s: %w", c.name, nadNetworkName, key, err)
+		}
+	case util.AreNetworksCompatible(nadNetwork, currentNetwork):
+		// the NAD refers to an existing network that had its configuration
+		// updated in place from a different NAD, like subnets appended, and
+		// this NAD has not been updated yet. Ensure that existing network holds
+		// a reference to this NAD without reverting the update.
 		ensureNetwork = currentNetwork
 	case sets.New(key).HasAll(currentNetwork.GetNADs()...):
 		// the NAD is the only NAD referring to an existing incompatible
@@ -471,6 +490,53 @@ func (c *nadController) GetActiveNetworkForNamespace(namespace string) (util.Net
 	return nil, util.NewInvalidPrimaryNetworkError(namespace)
 }
 
+// isNADOutdated returns whether the NAD is owned by a UserDefinedNetwork or a
+// ClusterUserDefinedNetwork with an MTU that has not been rendered to the NAD
+// yet.
+func (c *nadController) isNADOutdated(nad *nettypes.NetworkAttachmentDefinition, nadNetwork util.NetInfo) bool {
+	owner := metav1.GetControllerOf(nad)
+	if owner == nil {
+		return false
+	}
+	var desired *nettypes.NetworkAttachmentDefinition
+	var err error
+	switch owner.Kind {
+	case "UserDefinedNetwork":
+		if c.udnLister == nil {
+			return false
+		}
+		var udn *userdefinednetworkv1.UserDefinedNetwork
+		udn, err = c.udnLister.UserDefinedNetworks(nad.Namespace).Get(owner.Name)
+		if err != nil || udn.UID != owner.UID {
+			return false
+		}
+		desired, err = udntemplate.RenderNetAttachDefManifest(udn, nad.Namespace)
+	case "ClusterUserDefinedNetwork":
+		if c.cudnLister == nil {
+			return false
+		}
+		var cudn *userdefinednetworkv1.ClusterUserDefinedNetwork
+		cudn, err = c.cudnLister.Get(owner.Name)
+		if err != nil || cudn.UID != owner.UID {
+			return false
+		}
+		desired, err = udntemplate.RenderNetAttachDefManifest(cudn, nad.Namespace)
+	default:
+		return false
+	}
+	if err != nil {
+		klog.Warningf("%s: failed to render NAD %s/%s from its owner %s %s: %v", c.name, nad.Namespace, nad.Name, owner.Kind, owner.Name, err)
+		return false
+	}
+	desired.Namespace = nad.Namespace
+	desiredNetwork, err := util.ParseNADInfo(desired)
+	if err != nil {
+		klog.Warningf("%s: failed to parse NAD %s/%s rendered from its owner %s %s: %v", c.name, nad.Namespace, nad.Name, owner.Kind, owner.Name, err)
+		return false
+	}
+	return desiredNetwork.MTU() != nadNetwork.MTU()
+}
+
 func (c *nadController) GetActiveNetworkForNamespaceFast(namespace string) util.NetInfo {
 	network, _ := c.getActiveNetworkForNamespace(namespace)
 	return network
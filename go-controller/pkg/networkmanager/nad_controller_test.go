@@ -22,8 +22,11 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/id"
+	udntemplate "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/userdefinednetwork/template"
 	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	userdefinednetworklister "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/listers/userdefinednetwork/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
@@ -162,6 +165,27 @@ func TestNADController(t *testing.T) {
 		MTU:     1400,
 	}
 
+	networkCLayer3 := &ovncnitypes.NetConf{
+		Topology: types.Layer3Topology,
+		NetConf: cnitypes.NetConf{
+			Name: "networkCLayer3",
+			Type: "ovn-k8s-cni-overlay",
+		},
+		Subnets: "10.1.0.0/16/24",
+		Role:    types.NetworkRoleSecondary,
+		MTU:     1400,
+	}
+	networkCLayer3Updated := &ovncnitypes.NetConf{
+		Topology: types.Layer3Topology,
+		NetConf: cnitypes.NetConf{
+			Name: "networkCLayer3",
+			Type: "ovn-k8s-cni-overlay",
+		},
+		Subnets: "10.1.0.0/16/24,10.2.0.0/16/24",
+		Role:    types.NetworkRoleSecondary,
+		MTU:     1300,
+	}
+
 	networkBSecondary := &ovncnitypes.NetConf{
 		Topology: types.LocalnetTopology,
 		NetConf: cnitypes.NetConf{
@@ -406,6 +430,52 @@ func TestNADController(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "NAD added then updated in place",
+			args: []args{
+				{
+					nad:     "test/nad_1",
+					network: networkCLayer3,
+				},
+				{
+					nad:     "test/nad_1",
+					network: networkCLayer3Updated,
+				},
+			},
+			expected: []expected{
+				{
+					network: networkCLayer3Updated,
+					nads:    []string{"test/nad_1"},
+				},
+			},
+		},
+		{
+			name: "two NADs added then one updated in place",
+			args: []args{
+				{
+					nad:     "test/nad_1",
+					network: networkCLayer3,
+				},
+				{
+					nad:     "test/nad_2",
+					network: networkCLayer3,
+				},
+				{
+					nad:     "test/nad_1",
+					network: networkCLayer3Updated,
+				},
+				{
+					nad:     "test/nad_2",
+					network: networkCLayer3,
+				},
+			},
+			expected: []expected{
+				{
+					network: networkCLayer3Updated,
+					nads:    []string{"test/nad_1", "test/nad_2"},
+				},
+			},
+		},
 		{
 			name: "NAD added then incompatible NAD added",
 			args: []args{
@@ -543,6 +613,10 @@ func TestNADController(t *testing.T) {
 						g.Expect(netController.networks).To(gomega.HaveKey(name))
 						g.Expect(util.AreNetworksCompatible(netController.networks[name], netInfo)).To(gomega.BeTrue(),
 							fmt.Sprintf("matching network config for network %s", name))
+						g.Expect(netController.networks[name].MTU()).To(gomega.Equal(netInfo.MTU()),
+							fmt.Sprintf("matching MTU for network %s", name))
+						g.Expect(netController.networks[name].Subnets()).To(gomega.Equal(netInfo.Subnets()),
+							fmt.Sprintf("matching subnets for network %s", name))
 						g.Expect(netController.networks[name].GetNADs()).To(gomega.ConsistOf(expected.nads),
 							fmt.Sprintf("matching NADs for network %s", name))
 						id, err := nadController.networkIDAllocator.AllocateID(name)
@@ -554,6 +628,10 @@ func TestNADController(t *testing.T) {
 							g.Expect(tcm.controllers).To(gomega.HaveKey(testNetworkKey))
 							g.Expect(util.AreNetworksCompatible(tcm.controllers[testNetworkKey], netInfo)).To(gomega.BeTrue(),
 								fmt.Sprintf("matching network config for network %s", name))
+							g.Expect(tcm.controllers[testNetworkKey].MTU()).To(gomega.Equal(netInfo.MTU()),
+								fmt.Sprintf("matching MTU for network %s", name))
+							g.Expect(tcm.controllers[testNetworkKey].Subnets()).To(gomega.Equal(netInfo.Subnets()),
+								fmt.Sprintf("matching subnets for network %s", name))
 							g.Expect(tcm.controllers[testNetworkKey].GetNADs()).To(gomega.ConsistOf(expected.nads),
 								fmt.Sprintf("matching NADs for network %s", name))
 							g.Expect(tcm.controllers[testNetworkKey].GetNetworkID()).To(gomega.Equal(id))
@@ -599,6 +677,81 @@ func TestNADController(t *testing.T) {
 	}
 }
 
+func TestSyncOutdatedNAD(t *testing.T) {
+	g := gomega.NewWithT(t)
+	g.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+	config.OVNKubernetesFeature.EnableNetworkSegmentation = true
+	config.OVNKubernetesFeature.EnableMultiNetwork = true
+
+	cudn := &userdefinednetworkv1.ClusterUserDefinedNetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: "blue", UID: "1"},
+		Spec: userdefinednetworkv1.ClusterUserDefinedNetworkSpec{
+			Network: userdefinednetworkv1.NetworkSpec{
+				Topology: userdefinednetworkv1.NetworkTopologyLayer3,
+				Layer3: &userdefinednetworkv1.Layer3Config{
+					Role:    userdefinednetworkv1.NetworkRoleSecondary,
+					Subnets: []userdefinednetworkv1.Layer3Subnet{{CIDR: "10.1.0.0/16"}},
+					MTU:     1400,
+				},
+			},
+		},
+	}
+	renderNAD := func(cudn *userdefinednetworkv1.ClusterUserDefinedNetwork, namespace string) *nettypes.NetworkAttachmentDefinition {
+		nad, err := udntemplate.RenderNetAttachDefManifest(cudn, namespace)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		nad.Namespace = namespace
+		return nad
+	}
+	nad1 := renderNAD(cudn, "ns1")
+	nad2 := renderNAD(cudn, "ns2")
+	updatedCUDN := cudn.DeepCopy()
+	updatedCUDN.Spec.Network.Layer3.MTU = 1300
+	updatedNAD1 := renderNAD(updatedCUDN, "ns1")
+	updatedNAD2 := renderNAD(updatedCUDN, "ns2")
+
+	cudnIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	g.Expect(cudnIndexer.Add(updatedCUDN)).To(gomega.Succeed())
+	tcm := &testControllerManager{
+		controllers: map[string]NetworkController{},
+		defaultNetwork: &testNetworkController{
+			ReconcilableNetInfo: &util.DefaultNetInfo{},
+		},
+	}
+	fakeClient := util.GetOVNClientset().GetClusterManagerClientset()
+	for _, nad := range []*nettypes.NetworkAttachmentDefinition{nad1, nad2} {
+		_, err := fakeClient.NetworkAttchDefClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(nad.Namespace).Create(context.Background(), nad, metav1.CreateOptions{})
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+	}
+	nadController := &nadController{
+		nads:               map[string]string{},
+		primaryNADs:        map[string]string{},
+		networkController:  newNetworkController("", "", "", tcm, nil),
+		networkIDAllocator: id.NewIDAllocator("NetworkIDs", MaxNetworks),
+		nadClient:          fakeClient.NetworkAttchDefClient,
+		namespaceLister:    &fakeNamespaceLister{},
+		cudnLister:         userdefinednetworklister.NewClusterUserDefinedNetworkLister(cudnIndexer),
+	}
+	g.Expect(nadController.networkIDAllocator.ReserveID(types.DefaultNetworkName, types.DefaultNetworkID)).To(gomega.Succeed())
+
+	networkName := util.GenerateCUDNNetworkName(cudn.Name)
+	syncNAD := func(nad *nettypes.NetworkAttachmentDefinition, expectedMTU int) {
+		g.Expect(nadController.syncNAD(nad.Namespace+"/"+nad.Name, nad)).To(gomega.Succeed())
+		network := nadController.networkController.getNetwork(networkName)
+		g.Expect(network).NotTo(gomega.BeNil())
+		g.Expect(network.MTU()).To(gomega.Equal(expectedMTU))
+	}
+
+	// the network is created from the NADs rendered before the CUDN update
+	syncNAD(nad1, 1400)
+	syncNAD(nad2, 1400)
+	// the network takes the MTU from the first NAD rendered after the update
+	syncNAD(updatedNAD1, 1300)
+	// the NAD not rendered yet does not revert the MTU
+	syncNAD(nad2, 1300)
+	syncNAD(updatedNAD2, 1300)
+	g.Expect(nadController.networkController.getNetwork(networkName).GetNADs()).To(gomega.ConsistOf("ns1/blue", "ns2/blue"))
+}
+
 func TestSyncAll(t *testing.T) {
 	network_A := &ovncnitypes.NetConf{
 		Topology: types.Layer3Topology,
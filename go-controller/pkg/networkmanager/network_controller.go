@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"reflect"
+	"slices"
 	"sync"
 	"time"
 
@@ -30,11 +32,13 @@ import (
 func newNetworkController(name, zone, node string, cm ControllerManager, wf watchFactory) *networkController {
 	nc := &networkController{
 		name:               fmt.Sprintf("[%s network controller]", name),
+		managerName:        name,
 		node:               node,
 		zone:               zone,
 		cm:                 cm,
 		networks:           map[string]util.MutableNetInfo{},
 		networkControllers: map[string]*networkControllerState{},
+		syncStatus:         map[string]networkSyncStatus{},
 	}
 
 	// this controller does not feed from an informer, networks are manually
@@ -96,9 +100,10 @@ type networkControllerState struct {
 type networkController struct {
 	sync.RWMutex
 
-	name string
-	zone string
-	node string
+	name        string
+	managerName string
+	zone        string
+	node        string
 
 	nadLister  nadlisters.NetworkAttachmentDefinitionLister
 	raLister   ralisters.RouteAdvertisementsLister
@@ -111,6 +116,9 @@ type networkController struct {
 	cm                 ControllerManager
 	networks           map[string]util.MutableNetInfo
 	networkControllers map[string]*networkControllerState
+
+	// syncStatus holds the outcome of the last sync of every network
+	syncStatus map[string]networkSyncStatus
 }
 
 // Start will cleanup stale networks that have not been ensured via
@@ -123,13 +131,19 @@ func (c *networkController) Start() error {
 	if c.nodeController != nil {
 		controllers = append(controllers, c.nodeController)
 	}
-	return controller.StartWithInitialSync(
+	err := controller.StartWithInitialSync(
 		c.syncAll,
 		controllers...,
 	)
+	if err != nil {
+		return err
+	}
+	registerStatus(c)
+	return nil
 }
 
 func (c *networkController) Stop() {
+	unregisterStatus(c)
 	controllers := []controller.Reconciler{c.networkReconciler}
 	if c.raController != nil {
 		controllers = append(controllers, c.raController)
@@ -268,10 +282,11 @@ func (c *networkController) syncRunningNetworks() error {
 }
 
 // syncNetwork must be called with nm mutex locked
-func (c *networkController) syncNetwork(network string) error {
+func (c *networkController) syncNetwork(network string) (err error) {
 	startTime := time.Now()
 	klog.V(5).Infof("%s: sync network %s", c.name, network)
 	defer func() {
+		c.setSyncStatus(network, err)
 		klog.V(4).Infof("%s: finished syncing network %s, took %v", c.name, network, time.Since(startTime))
 	}()
 
@@ -292,7 +307,7 @@ func (c *networkController) syncNetwork(network string) error {
 	}
 
 	// fetch other relevant network information
-	err := c.gatherNetwork(want)
+	err = c.gatherNetwork(want)
 	if err != nil {
 		return fmt.Errorf("failed to fetch other network information for network %s: %w", network, err)
 	}
@@ -411,7 +426,9 @@ func (c *networkController) setAdvertisements(network util.MutableNetInfo) error
 
 	podAdvertisements := map[string][]string{}
 	eipAdvertisements := map[string][]string{}
-	for raName := range raNames {
+	var evpnVNI int
+	var importPolicy *util.RouteImportPolicy
+	for _, raName := range sets.List(raNames) {
 		ra, err := c.raLister.Get(raName)
 		if err != nil {
 			return err
@@ -443,6 +460,27 @@ func (c *networkController) setAdvertisements(network util.MutableNetInfo) error
 			return err
 		}
 
+		if ra.Spec.EVPN != nil {
+			for _, vni := range ra.Spec.EVPN.VNIs {
+				if util.GenerateCUDNNetworkName(vni.Network) != network.GetNetworkName() {
+					continue
+				}
+				if evpnVNI != 0 && evpnVNI != int(vni.VNI) {
+					klog.Warningf("Network %q has conflicting EVPN VNIs %d and %d, using %d from RouteAdvertisements %q",
+						network.GetNetworkName(), evpnVNI, vni.VNI, evpnVNI, ra.Name)
+					break
+				}
+				evpnVNI = int(vni.VNI)
+			}
+		}
+
+		if ra.Spec.ImportPolicy != nil {
+			importPolicy, err = mergeRouteImportPolicy(importPolicy, ra.Spec.ImportPolicy)
+			if err != nil {
+				return fmt.Errorf("failed to reconcile network %q: invalid import policy on RouteAdvertisements %q: %w", network.GetNetworkName(), ra.Name, err)
+			}
+		}
+
 		vrf := ra.Spec.TargetVRF
 		if vrf == "" {
 			vrf = types.DefaultNetworkName
@@ -462,9 +500,73 @@ func (c *networkController) setAdvertisements(network util.MutableNetInfo) error
 	}
 	network.SetPodNetworkAdvertisedVRFs(podAdvertisements)
 	network.SetEgressIPAdvertisedVRFs(eipAdvertisements)
+	network.SetEVPNVNI(evpnVNI)
+	network.SetRouteImportPolicy(importPolicy)
 	return nil
 }
 
+// mergeRouteImportPolicy merges the import policy of a RouteAdvertisements into
+// the provided route import policy, if any. The merged policy allows the
+// prefixes and next hops allowed by either policy and has the lowest maximum
+// number of prefixes.
+func mergeRouteImportPolicy(policy *util.RouteImportPolicy, raPolicy *ratypes.RouteImportPolicy) (*util.RouteImportPolicy, error) {
+	parseCIDRs := func(cidrs []ratypes.CIDR) ([]*net.IPNet, error) {
+		var ipNets []*net.IPNet
+		for _, cidr := range cidrs {
+			_, ipNet, err := net.ParseCIDR(string(cidr))
+			if err != nil {
+				return nil, err
+			}
+			ipNets = append(ipNets, ipNet)
+		}
+		return ipNets, nil
+	}
+
+	allowedPrefixes, err := parseCIDRs(raPolicy.AllowedPrefixes)
+	if err != nil {
+		return nil, err
+	}
+	allowedNextHops, err := parseCIDRs(raPolicy.AllowedNextHops)
+	if err != nil {
+		return nil, err
+	}
+	merged := &util.RouteImportPolicy{
+		AllowedPrefixes:       allowedPrefixes,
+		AllowedNextHops:       allowedNextHops,
+		WithdrawOnMaxPrefixes: raPolicy.MaxPrefixesAction == ratypes.WithdrawAll,
+	}
+	if raPolicy.MaxPrefixes != nil {
+		merged.MaxPrefixes = int(*raPolicy.MaxPrefixes)
+	}
+	if policy == nil {
+		return merged, nil
+	}
+
+	// an empty list allows anything
+	if len(policy.AllowedPrefixes) == 0 || len(merged.AllowedPrefixes) == 0 {
+		merged.AllowedPrefixes = nil
+	} else {
+		merged.AllowedPrefixes = append(slices.Clone(policy.AllowedPrefixes), merged.AllowedPrefixes...)
+	}
+	if len(policy.AllowedNextHops) == 0 || len(merged.AllowedNextHops) == 0 {
+		merged.AllowedNextHops = nil
+	} else {
+		merged.AllowedNextHops = append(slices.Clone(policy.AllowedNextHops), merged.AllowedNextHops...)
+	}
+
+	// the lowest maximum applies
+	switch {
+	case policy.MaxPrefixes == 0:
+	case merged.MaxPrefixes == 0 || policy.MaxPrefixes < merged.MaxPrefixes:
+		merged.MaxPrefixes = policy.MaxPrefixes
+		merged.WithdrawOnMaxPrefixes = policy.WithdrawOnMaxPrefixes
+	case policy.MaxPrefixes == merged.MaxPrefixes:
+		merged.WithdrawOnMaxPrefixes = merged.WithdrawOnMaxPrefixes || policy.WithdrawOnMaxPrefixes
+	}
+
+	return merged, nil
+}
+
 func (c *networkController) hasRouteAdvertisements() bool {
 	return util.IsRouteAdvertisementsEnabled()
 }
@@ -2,6 +2,7 @@ package networkmanager
 
 import (
 	"context"
+	"net"
 	"testing"
 
 	cnitypes "github.com/containernetworking/cni/pkg/types"
@@ -15,6 +16,7 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	ratypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
+	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
@@ -240,3 +242,70 @@ func TestSetAdvertisements(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeRouteImportPolicy(t *testing.T) {
+	maxPrefixes := func(max int32) *int32 { return &max }
+	tests := []struct {
+		name     string
+		policy   *util.RouteImportPolicy
+		raPolicy *ratypes.RouteImportPolicy
+		expected *util.RouteImportPolicy
+	}{
+		{
+			name: "converts the first policy",
+			raPolicy: &ratypes.RouteImportPolicy{
+				AllowedPrefixes:   []ratypes.CIDR{"10.0.0.0/8"},
+				AllowedNextHops:   []ratypes.CIDR{"192.168.0.0/16"},
+				MaxPrefixes:       maxPrefixes(10),
+				MaxPrefixesAction: ratypes.WithdrawAll,
+			},
+			expected: &util.RouteImportPolicy{
+				AllowedPrefixes:       []*net.IPNet{ovntest.MustParseIPNet("10.0.0.0/8")},
+				AllowedNextHops:       []*net.IPNet{ovntest.MustParseIPNet("192.168.0.0/16")},
+				MaxPrefixes:           10,
+				WithdrawOnMaxPrefixes: true,
+			},
+		},
+		{
+			name: "merges allowed prefixes and next hops and keeps the lowest maximum",
+			policy: &util.RouteImportPolicy{
+				AllowedPrefixes:       []*net.IPNet{ovntest.MustParseIPNet("10.0.0.0/8")},
+				AllowedNextHops:       []*net.IPNet{ovntest.MustParseIPNet("192.168.0.0/16")},
+				MaxPrefixes:           10,
+				WithdrawOnMaxPrefixes: true,
+			},
+			raPolicy: &ratypes.RouteImportPolicy{
+				AllowedPrefixes:   []ratypes.CIDR{"20.0.0.0/8"},
+				MaxPrefixes:       maxPrefixes(20),
+				MaxPrefixesAction: ratypes.KeepImported,
+			},
+			expected: &util.RouteImportPolicy{
+				AllowedPrefixes:       []*net.IPNet{ovntest.MustParseIPNet("10.0.0.0/8"), ovntest.MustParseIPNet("20.0.0.0/8")},
+				MaxPrefixes:           10,
+				WithdrawOnMaxPrefixes: true,
+			},
+		},
+		{
+			name: "withdraws all with the same maximum if any policy does",
+			policy: &util.RouteImportPolicy{
+				MaxPrefixes: 10,
+			},
+			raPolicy: &ratypes.RouteImportPolicy{
+				MaxPrefixes:       maxPrefixes(10),
+				MaxPrefixesAction: ratypes.WithdrawAll,
+			},
+			expected: &util.RouteImportPolicy{
+				MaxPrefixes:           10,
+				WithdrawOnMaxPrefixes: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			merged, err := mergeRouteImportPolicy(tt.policy, tt.raPolicy)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(merged).To(gomega.Equal(tt.expected))
+		})
+	}
+}
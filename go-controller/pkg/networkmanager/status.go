@@ -0,0 +1,194 @@
+package networkmanager
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// StatusPath is the path the status of the network managers running in the
+// process is served on by the metrics server.
+const StatusPath = "/debug/networks"
+
+// ManagerStatus is the status of the networks known to a network manager.
+type ManagerStatus struct {
+	// Name of the network manager: cluster, zone or node.
+	Name string `json:"name"`
+	// Zone the network manager manages networks for, if any.
+	Zone string `json:"zone,omitempty"`
+	// Node the network manager manages networks for, if any.
+	Node string `json:"node,omitempty"`
+	// Networks known to the network manager sorted by name.
+	Networks []NetworkStatus `json:"networks"`
+}
+
+// NetworkStatus is the status of a network known to a network manager.
+type NetworkStatus struct {
+	Name     string   `json:"name"`
+	ID       int      `json:"id"`
+	Topology string   `json:"topology"`
+	Role     string   `json:"role"`
+	MTU      int      `json:"mtu,omitempty"`
+	Subnets  []string `json:"subnets,omitempty"`
+	NADs     []string `json:"nads,omitempty"`
+	// Started is whether a controller is running for the network.
+	Started bool `json:"started"`
+	// Deleting is whether the network is no longer wanted while its
+	// controller has not been cleaned up yet.
+	Deleting bool `json:"deleting,omitempty"`
+	// LastSyncTime is the time the network was last synced.
+	LastSyncTime *time.Time `json:"lastSyncTime,omitempty"`
+	// LastSyncError is the error the last sync of the network failed with,
+	// if any.
+	LastSyncError string `json:"lastSyncError,omitempty"`
+}
+
+type networkSyncStatus struct {
+	time time.Time
+	err  error
+}
+
+// managers holds the network managers running in the process.
+var managers = struct {
+	sync.Mutex
+	m map[*networkController]struct{}
+}{
+	m: map[*networkController]struct{}{},
+}
+
+func registerStatus(c *networkController) {
+	managers.Lock()
+	defer managers.Unlock()
+	managers.m[c] = struct{}{}
+}
+
+func unregisterStatus(c *networkController) {
+	managers.Lock()
+	defer managers.Unlock()
+	delete(managers.m, c)
+}
+
+// Status returns the status of the network managers running in the process
+// sorted by name.
+func Status() []ManagerStatus {
+	managers.Lock()
+	controllers := make([]*networkController, 0, len(managers.m))
+	for c := range managers.m {
+		controllers = append(controllers, c)
+	}
+	managers.Unlock()
+
+	statuses := make([]ManagerStatus, 0, len(controllers))
+	for _, c := range controllers {
+		statuses = append(statuses, c.status())
+	}
+	slices.SortFunc(statuses, func(a, b ManagerStatus) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return statuses
+}
+
+// StatusHandler serves the status of the network managers running in the
+// process as JSON.
+func StatusHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			http.Error(w, "unsupported http method", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		if err := json.NewEncoder(w).Encode(Status()); err != nil {
+			klog.Errorf("Failed to encode network manager status: %v", err)
+		}
+	})
+}
+
+func (c *networkController) setSyncStatus(network string, err error) {
+	c.Lock()
+	defer c.Unlock()
+	if c.networks[network] == nil && c.networkControllers[network] == nil {
+		// the network is gone
+		delete(c.syncStatus, network)
+		return
+	}
+	c.syncStatus[network] = networkSyncStatus{time: time.Now(), err: err}
+}
+
+// status returns the status of the networks known to the network manager,
+// either wanted or with a controller still running.
+func (c *networkController) status() ManagerStatus {
+	c.RLock()
+	defer c.RUnlock()
+
+	names := make([]string, 0, len(c.networks))
+	for name := range c.networks {
+		names = append(names, name)
+	}
+	for name := range c.networkControllers {
+		if _, wanted := c.networks[name]; !wanted {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	status := ManagerStatus{
+		Name:     c.managerName,
+		Zone:     c.zone,
+		Node:     c.node,
+		Networks: make([]NetworkStatus, 0, len(names)),
+	}
+	for _, name := range names {
+		var network util.NetInfo = c.networks[name]
+		state := c.networkControllers[name]
+		if network == nil {
+			network = state.controller
+		}
+		networkStatus := NetworkStatus{
+			Name:     name,
+			ID:       network.GetNetworkID(),
+			Topology: network.TopologyType(),
+			Role:     networkRole(network),
+			MTU:      network.MTU(),
+			NADs:     network.GetNADs(),
+		}
+		for _, subnet := range network.Subnets() {
+			networkStatus.Subnets = append(networkStatus.Subnets, subnet.String())
+		}
+		slices.Sort(networkStatus.NADs)
+		if name == types.DefaultNetworkName {
+			// we don't own the lifecycle of the default network controller
+			networkStatus.Started = c.cm.GetDefaultNetworkController() != nil
+		} else if state != nil {
+			networkStatus.Started = !state.stoppedAndDeleting
+			networkStatus.Deleting = state.stoppedAndDeleting || c.networks[name] == nil
+		}
+		if syncStatus, synced := c.syncStatus[name]; synced {
+			networkStatus.LastSyncTime = &syncStatus.time
+			if syncStatus.err != nil {
+				networkStatus.LastSyncError = syncStatus.err.Error()
+			}
+		}
+		status.Networks = append(status.Networks, networkStatus)
+	}
+	return status
+}
+
+func networkRole(network util.NetInfo) string {
+	switch {
+	case network.IsDefault():
+		return types.NetworkRoleDefault
+	case network.IsPrimaryNetwork():
+		return types.NetworkRolePrimary
+	default:
+		return types.NetworkRoleSecondary
+	}
+}
@@ -0,0 +1,113 @@
+package networkmanager
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	cnitypes "github.com/containernetworking/cni/pkg/types"
+	"github.com/onsi/gomega"
+
+	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+func getTestManagerStatus(g gomega.Gomega, handler http.Handler, name string) *ManagerStatus {
+	req := httptest.NewRequest(http.MethodGet, StatusPath, nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	g.Expect(rec.Code).To(gomega.Equal(http.StatusOK))
+	var statuses []ManagerStatus
+	g.Expect(json.Unmarshal(rec.Body.Bytes(), &statuses)).To(gomega.Succeed())
+	for _, status := range statuses {
+		if status.Name == name {
+			return &status
+		}
+	}
+	return nil
+}
+
+func TestStatusHandler(t *testing.T) {
+	g := gomega.NewWithT(t)
+	g.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+	config.OVNKubernetesFeature.EnableNetworkSegmentation = true
+	config.OVNKubernetesFeature.EnableMultiNetwork = true
+
+	tcm := &testControllerManager{
+		controllers: map[string]NetworkController{},
+		defaultNetwork: &testNetworkController{
+			ReconcilableNetInfo: &util.DefaultNetInfo{},
+		},
+	}
+	nc := newNetworkController("status-test", "zone1", "", tcm, nil)
+	handler := StatusHandler()
+
+	g.Expect(nc.Start()).To(gomega.Succeed())
+	g.Expect(getTestManagerStatus(g, handler, "status-test")).ToNot(gomega.BeNil())
+
+	network, err := util.NewNetInfo(&ovncnitypes.NetConf{
+		NetConf:  cnitypes.NetConf{Name: "network1"},
+		Topology: types.Layer3Topology,
+		Role:     types.NetworkRolePrimary,
+		Subnets:  "10.1.0.0/16",
+	})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	mutableNetwork := util.NewMutableNetInfo(network)
+	mutableNetwork.SetNetworkID(2)
+	mutableNetwork.SetNADs("ns1/nad1")
+	nc.EnsureNetwork(mutableNetwork)
+
+	g.Eventually(func(g gomega.Gomega) {
+		status := getTestManagerStatus(g, handler, "status-test")
+		g.Expect(status).ToNot(gomega.BeNil())
+		g.Expect(status.Zone).To(gomega.Equal("zone1"))
+		g.Expect(status.Networks).To(gomega.HaveLen(1))
+		networkStatus := status.Networks[0]
+		g.Expect(networkStatus.Name).To(gomega.Equal("network1"))
+		g.Expect(networkStatus.ID).To(gomega.Equal(2))
+		g.Expect(networkStatus.Topology).To(gomega.Equal(types.Layer3Topology))
+		g.Expect(networkStatus.Role).To(gomega.Equal(types.NetworkRolePrimary))
+		g.Expect(networkStatus.NADs).To(gomega.Equal([]string{"ns1/nad1"}))
+		g.Expect(networkStatus.Subnets).To(gomega.HaveLen(1))
+		g.Expect(networkStatus.Started).To(gomega.BeTrue())
+		g.Expect(networkStatus.LastSyncTime).ToNot(gomega.BeNil())
+		g.Expect(networkStatus.LastSyncError).To(gomega.BeEmpty())
+	}).Should(gomega.Succeed())
+
+	// a network whose controller fails to be created is reported with the
+	// error and not started
+	tcm.Lock()
+	tcm.raiseErrorWhenCreatingController = errors.New("test error")
+	tcm.Unlock()
+	network, err = util.NewNetInfo(&ovncnitypes.NetConf{
+		NetConf:  cnitypes.NetConf{Name: "network2"},
+		Topology: types.Layer2Topology,
+		Subnets:  "10.2.0.0/16",
+	})
+	g.Expect(err).ToNot(gomega.HaveOccurred())
+	nc.EnsureNetwork(util.NewMutableNetInfo(network))
+
+	g.Eventually(func(g gomega.Gomega) {
+		status := getTestManagerStatus(g, handler, "status-test")
+		g.Expect(status).ToNot(gomega.BeNil())
+		g.Expect(status.Networks).To(gomega.HaveLen(2))
+		networkStatus := status.Networks[1]
+		g.Expect(networkStatus.Name).To(gomega.Equal("network2"))
+		g.Expect(networkStatus.Role).To(gomega.Equal(types.NetworkRoleSecondary))
+		g.Expect(networkStatus.Started).To(gomega.BeFalse())
+		g.Expect(networkStatus.LastSyncError).To(gomega.ContainSubstring("test error"))
+	}).Should(gomega.Succeed())
+
+	// stopped network managers are no longer reported
+	nc.Stop()
+	g.Expect(getTestManagerStatus(g, handler, "status-test")).To(gomega.BeNil())
+
+	// only GET is supported
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, StatusPath, nil))
+	g.Expect(rec.Code).To(gomega.Equal(http.StatusMethodNotAllowed))
+}
@@ -0,0 +1,78 @@
+package acllog
+
+import (
+	"sync"
+
+	"github.com/ovn-org/libovsdb/cache"
+	"github.com/ovn-org/libovsdb/model"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+)
+
+// aclIndex indexes the external IDs of the northbound database ACLs by their
+// names, so that the owner of every logged ACL doesn't require a scan of all
+// the ACLs. It is kept up to date by the NB client cache events.
+type aclIndex struct {
+	sync.RWMutex
+	// ACL name => ACL UUID => external IDs, several ACLs may have the same name
+	acls map[string]map[string]map[string]string
+}
+
+var _ cache.EventHandler = &aclIndex{}
+
+func newACLIndex() *aclIndex {
+	return &aclIndex{
+		acls: map[string]map[string]map[string]string{},
+	}
+}
+
+func getNamedACL(m model.Model) *nbdb.ACL {
+	acl, ok := m.(*nbdb.ACL)
+	if !ok || acl.Name == nil {
+		return nil
+	}
+	return acl
+}
+
+func (i *aclIndex) OnAdd(_ string, m model.Model) {
+	acl := getNamedACL(m)
+	if acl == nil {
+		return
+	}
+	i.Lock()
+	defer i.Unlock()
+	if i.acls[*acl.Name] == nil {
+		i.acls[*acl.Name] = map[string]map[string]string{}
+	}
+	i.acls[*acl.Name][acl.UUID] = acl.ExternalIDs
+}
+
+func (i *aclIndex) OnUpdate(table string, old, new model.Model) {
+	i.OnDelete(table, old)
+	i.OnAdd(table, new)
+}
+
+func (i *aclIndex) OnDelete(_ string, m model.Model) {
+	acl := getNamedACL(m)
+	if acl == nil {
+		return
+	}
+	i.Lock()
+	defer i.Unlock()
+	delete(i.acls[*acl.Name], acl.UUID)
+	if len(i.acls[*acl.Name]) == 0 {
+		delete(i.acls, *acl.Name)
+	}
+}
+
+// get returns the external IDs of an ACL with the given name, or nil if there
+// is none. ACLs with the same name have the same owner, unless their names
+// were cropped.
+func (i *aclIndex) get(name string) map[string]string {
+	i.RLock()
+	defer i.RUnlock()
+	for _, externalIDs := range i.acls[name] {
+		return externalIDs
+	}
+	return nil
+}
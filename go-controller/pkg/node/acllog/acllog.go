@@ -0,0 +1,284 @@
+// Package acllog implements the ovnkube-node ACL log sink. It reads the ACL
+// log entries that ovn-controller writes to its log file, maps the ACL names
+// back to the objects that own them and writes the entries to per-namespace
+// JSON log files or to Kubernetes events on the policy objects, so that tenants
+// can see what their policies allow and drop.
+package acllog
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-org/libovsdb/cache"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+)
+
+const (
+	// aclLogModule is the ovn-controller logging module of ACL log entries
+	aclLogModule = "|acl_log("
+	// pollInterval is how often the ovn-controller log is checked for new entries
+	pollInterval = time.Second
+)
+
+// Owner kinds, as reported in the entries
+const (
+	NetworkPolicyKind              = "NetworkPolicy"
+	NamespaceKind                  = "Namespace"
+	EgressFirewallKind             = "EgressFirewall"
+	AdminNetworkPolicyKind         = "AdminNetworkPolicy"
+	BaselineAdminNetworkPolicyKind = "BaselineAdminNetworkPolicy"
+)
+
+// egressFirewallName is the only EgressFirewall name of a namespace that
+// ovn-kubernetes implements
+const egressFirewallName = "default"
+
+// Owner is the object that owns a logged ACL
+type Owner struct {
+	// Kind is one of NetworkPolicy, Namespace (for the default deny ACLs of
+	// the namespaces with network policies), EgressFirewall,
+	// AdminNetworkPolicy or BaselineAdminNetworkPolicy
+	Kind string `json:"kind"`
+	// Namespace is empty for cluster-scoped owners
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Direction is the policy direction of the ACL, Ingress or Egress
+	Direction string `json:"direction,omitempty"`
+	// Rule is the index of the policy rule that created the ACL
+	Rule string `json:"rule,omitempty"`
+}
+
+// Entry is an ACL log entry of ovn-controller
+type Entry struct {
+	Time     string `json:"time"`
+	ACL      string `json:"acl"`
+	Verdict  string `json:"verdict"`
+	Severity string `json:"severity"`
+	// Direction is the ACL pipeline, to-lport or from-lport
+	Direction string `json:"direction"`
+	Protocol  string `json:"protocol,omitempty"`
+	Src       string `json:"src,omitempty"`
+	Dst       string `json:"dst,omitempty"`
+	SrcPort   string `json:"srcPort,omitempty"`
+	DstPort   string `json:"dstPort,omitempty"`
+	Owner     *Owner `json:"owner,omitempty"`
+}
+
+// sink writes ACL log entries to their destination
+type sink interface {
+	write(entry *Entry) error
+	close()
+}
+
+// Controller tails the ovn-controller log and writes its ACL log entries to
+// the sink configured in config.Logging.
+type Controller struct {
+	source string
+	acls   *aclIndex
+	sink   sink
+}
+
+// NewController creates an ACL log sink controller. The ACLs owners are looked
+// up in their external IDs, as indexed by the ACLEventHandler; when an ACL is
+// not indexed, the owner is derived from the ACL name, which may be cropped.
+func NewController(recorder record.EventRecorder) (*Controller, error) {
+	c := &Controller{
+		source: config.Logging.ACLLogSinkSource,
+		acls:   newACLIndex(),
+	}
+	switch config.Logging.ACLLogSink {
+	case config.ACLLogSinkFile:
+		s, err := newFileSink(config.Logging.ACLLogSinkDir)
+		if err != nil {
+			return nil, err
+		}
+		c.sink = s
+	case config.ACLLogSinkEvent:
+		c.sink = newEventSink(recorder, time.Duration(config.Logging.ACLLogSinkEventInterval)*time.Second)
+	default:
+		return nil, fmt.Errorf("unsupported acl log sink %q", config.Logging.ACLLogSink)
+	}
+	return c, nil
+}
+
+// ACLEventHandler returns the handler of the northbound database ACL events
+// that indexes the ACLs owners. It needs to be added to the NB client cache
+// before the ACL table is monitored.
+func (c *Controller) ACLEventHandler() cache.EventHandler {
+	return c.acls
+}
+
+// Run writes the ACL log entries of the ovn-controller log to the sink until
+// stopCh is closed.
+func (c *Controller) Run(stopCh <-chan struct{}) {
+	klog.Infof("Starting ACL log sink from %s", c.source)
+	defer klog.Infof("Stopping ACL log sink")
+	defer c.sink.close()
+
+	tailFile(c.source, pollInterval, stopCh, c.handleLine)
+}
+
+func (c *Controller) handleLine(line string) {
+	entry := parseEntry(line)
+	if entry == nil {
+		return
+	}
+	entry.Owner = c.getOwner(entry.ACL)
+	if entry.Owner == nil {
+		// ACLs of other features are not reported to users
+		klog.V(5).Infof("Skipping ACL log entry of unknown owner: %s", line)
+		return
+	}
+	if err := c.sink.write(entry); err != nil {
+		klog.Errorf("Failed to write ACL log entry of ACL %s: %v", entry.ACL, err)
+	}
+}
+
+// parseEntry parses an ovn-controller ACL log line, like
+//
+//	2024-05-02T10:00:00.123Z|00005|acl_log(ovn_pinctrl0)|INFO|name="NP:ns1:Ingress", verdict=drop, severity=alert, direction=to-lport: tcp,...,nw_src=10.244.1.3,nw_dst=10.244.2.4,...,tp_src=40000,tp_dst=8080
+//
+// It returns nil for any other line.
+func parseEntry(line string) *Entry {
+	if !strings.Contains(line, aclLogModule) {
+		return nil
+	}
+	// timestamp|sequence|module|level|message
+	fields := strings.SplitN(line, "|", 5)
+	if len(fields) != 5 {
+		return nil
+	}
+	message := fields[4]
+	const namePrefix = `name="`
+	if !strings.HasPrefix(message, namePrefix) {
+		return nil
+	}
+	message = message[len(namePrefix):]
+	nameEnd := strings.Index(message, `"`)
+	if nameEnd < 0 {
+		return nil
+	}
+	entry := &Entry{
+		Time: fields[0],
+		ACL:  message[:nameEnd],
+	}
+	message = strings.TrimPrefix(message[nameEnd+1:], ", ")
+	header, flow, _ := strings.Cut(message, ": ")
+	for _, kv := range strings.Split(header, ", ") {
+		key, value, _ := strings.Cut(kv, "=")
+		switch key {
+		case "verdict":
+			entry.Verdict = value
+		case "severity":
+			entry.Severity = value
+		case "direction":
+			entry.Direction = value
+		}
+	}
+	for i, kv := range strings.Split(strings.TrimSpace(flow), ",") {
+		key, value, found := strings.Cut(kv, "=")
+		if !found {
+			if i == 0 {
+				entry.Protocol = key
+			}
+			continue
+		}
+		switch key {
+		case "nw_src", "ipv6_src":
+			entry.Src = value
+		case "nw_dst", "ipv6_dst":
+			entry.Dst = value
+		case "tp_src":
+			entry.SrcPort = value
+		case "tp_dst":
+			entry.DstPort = value
+		}
+	}
+	return entry
+}
+
+func (c *Controller) getOwner(aclName string) *Owner {
+	if externalIDs := c.acls.get(aclName); externalIDs != nil {
+		return ownerFromExternalIDs(externalIDs)
+	}
+	return ownerFromName(aclName)
+}
+
+// ownerFromExternalIDs returns the owner of an ACL from its DbObjectIDs
+func ownerFromExternalIDs(externalIDs map[string]string) *Owner {
+	objectName := externalIDs[libovsdbops.ObjectNameKey.String()]
+	owner := &Owner{
+		Direction: externalIDs[libovsdbops.PolicyDirectionKey.String()],
+		Rule:      externalIDs[libovsdbops.GressIdxKey.String()],
+	}
+	switch externalIDs[libovsdbops.OwnerTypeKey.String()] {
+	case libovsdbops.NetworkPolicyOwnerType:
+		namespace, name, err := libovsdbops.ParseNamespaceNameKey(objectName)
+		if err != nil {
+			return nil
+		}
+		owner.Kind = NetworkPolicyKind
+		owner.Namespace = namespace
+		owner.Name = name
+	case libovsdbops.NetpolNamespaceOwnerType:
+		owner.Kind = NamespaceKind
+		owner.Namespace = objectName
+		owner.Name = objectName
+	case libovsdbops.EgressFirewallOwnerType:
+		owner.Kind = EgressFirewallKind
+		owner.Namespace = objectName
+		owner.Name = egressFirewallName
+		owner.Direction = "Egress"
+		owner.Rule = externalIDs[libovsdbops.RuleIndex.String()]
+	case libovsdbops.AdminNetworkPolicyOwnerType:
+		owner.Kind = AdminNetworkPolicyKind
+		owner.Name = objectName
+	case libovsdbops.BaselineAdminNetworkPolicyOwnerType:
+		owner.Kind = BaselineAdminNetworkPolicyKind
+		owner.Name = objectName
+	default:
+		return nil
+	}
+	return owner
+}
+
+// ownerFromName returns the owner of an ACL from its name, as built by
+// libovsdbutil.GetACLName. The name is cropped to 63 characters, so the last
+// fields may be missing or truncated.
+func ownerFromName(aclName string) *Owner {
+	prefix, rest, found := strings.Cut(aclName, ":")
+	if !found {
+		return nil
+	}
+	fields := strings.Split(rest, ":")
+	field := func(i int) string {
+		if i < len(fields) {
+			return fields[i]
+		}
+		return ""
+	}
+	switch prefix {
+	case "NP":
+		// NP:<namespace>:<direction> or NP:<namespace>:<name>:<direction>:<gress index>
+		if len(fields) == 2 && (fields[1] == "Ingress" || fields[1] == "Egress") {
+			return &Owner{Kind: NamespaceKind, Namespace: fields[0], Name: fields[0], Direction: fields[1]}
+		}
+		return &Owner{Kind: NetworkPolicyKind, Namespace: fields[0], Name: field(1), Direction: field(2), Rule: field(3)}
+	case "EF":
+		// EF:<namespace>:<rule index>
+		return &Owner{Kind: EgressFirewallKind, Namespace: fields[0], Name: egressFirewallName, Direction: "Egress", Rule: field(1)}
+	case "ANP":
+		// ANP:<name>:<direction>:<gress index>
+		return &Owner{Kind: AdminNetworkPolicyKind, Name: fields[0], Direction: field(1), Rule: field(2)}
+	case "BANP":
+		// BANP:<name>:<direction>:<gress index>
+		return &Owner{Kind: BaselineAdminNetworkPolicyKind, Name: fields[0], Direction: field(1), Rule: field(2)}
+	}
+	return nil
+}
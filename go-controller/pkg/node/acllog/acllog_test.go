@@ -0,0 +1,304 @@
+package acllog
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/client-go/tools/record"
+
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+)
+
+const (
+	npDropLine = `2024-05-02T10:00:00.123Z|00005|acl_log(ovn_pinctrl0)|INFO|name="NP:ns1:Ingress", verdict=drop, ` +
+		`severity=alert, direction=to-lport: tcp,vlan_tci=0x0000,dl_src=0a:58:0a:f4:01:01,dl_dst=0a:58:0a:f4:01:03,` +
+		`nw_src=10.244.1.3,nw_dst=10.244.2.4,nw_tos=0,nw_ecn=0,nw_ttl=64,nw_frag=no,tp_src=40000,tp_dst=8080,tcp_flags=syn`
+	efAllowLine = `2024-05-02T10:00:01.123Z|00006|acl_log(ovn_pinctrl0)|INFO|name="EF:ns2:1", verdict=allow, ` +
+		`severity=info, direction=from-lport: icmp6,vlan_tci=0x0000,dl_src=0a:58:0a:f4:01:01,dl_dst=0a:58:0a:f4:01:03,` +
+		`ipv6_src=fd00:10:244:1::3,ipv6_dst=2001:db8::1,ipv6_label=0x00000,nw_tos=0,nw_ecn=0,nw_ttl=64,icmp_type=128,icmp_code=0`
+	anpPassLine = `2024-05-02T10:00:02.123Z|00007|acl_log(ovn_pinctrl0)|INFO|name="ANP:anp1:Egress:2", verdict=pass, ` +
+		`severity=notice, direction=from-lport: udp,nw_src=10.244.1.3,nw_dst=10.96.0.10,tp_src=5353,tp_dst=53`
+)
+
+func TestParseEntry(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want *Entry
+	}{
+		{
+			name: "IPv4 TCP drop",
+			line: npDropLine,
+			want: &Entry{
+				Time:      "2024-05-02T10:00:00.123Z",
+				ACL:       "NP:ns1:Ingress",
+				Verdict:   "drop",
+				Severity:  "alert",
+				Direction: "to-lport",
+				Protocol:  "tcp",
+				Src:       "10.244.1.3",
+				Dst:       "10.244.2.4",
+				SrcPort:   "40000",
+				DstPort:   "8080",
+			},
+		},
+		{
+			name: "IPv6 ICMP allow",
+			line: efAllowLine,
+			want: &Entry{
+				Time:      "2024-05-02T10:00:01.123Z",
+				ACL:       "EF:ns2:1",
+				Verdict:   "allow",
+				Severity:  "info",
+				Direction: "from-lport",
+				Protocol:  "icmp6",
+				Src:       "fd00:10:244:1::3",
+				Dst:       "2001:db8::1",
+			},
+		},
+		{
+			name: "other module",
+			line: `2024-05-02T10:00:00.123Z|00005|binding|INFO|Claiming lport ns1_pod1 for this chassis.`,
+		},
+		{
+			name: "malformed ACL log",
+			line: `2024-05-02T10:00:00.123Z|00005|acl_log(ovn_pinctrl0)|INFO|verdict=drop`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseEntry(tt.line))
+		})
+	}
+}
+
+func TestOwnerFromName(t *testing.T) {
+	tests := []struct {
+		name string
+		acl  string
+		want *Owner
+	}{
+		{
+			name: "network policy",
+			acl:  "NP:ns1:policy1:Ingress:0",
+			want: &Owner{Kind: NetworkPolicyKind, Namespace: "ns1", Name: "policy1", Direction: "Ingress", Rule: "0"},
+		},
+		{
+			name: "cropped network policy",
+			acl:  "NP:ns1:policy1:Egr",
+			want: &Owner{Kind: NetworkPolicyKind, Namespace: "ns1", Name: "policy1", Direction: "Egr"},
+		},
+		{
+			name: "network policy cropped in its name",
+			acl:  "NP:ns1:policypolicy",
+			want: &Owner{Kind: NetworkPolicyKind, Namespace: "ns1", Name: "policypolicy"},
+		},
+		{
+			name: "namespace default deny",
+			acl:  "NP:ns1:Egress",
+			want: &Owner{Kind: NamespaceKind, Namespace: "ns1", Name: "ns1", Direction: "Egress"},
+		},
+		{
+			name: "egress firewall",
+			acl:  "EF:ns2:3",
+			want: &Owner{Kind: EgressFirewallKind, Namespace: "ns2", Name: egressFirewallName, Direction: "Egress", Rule: "3"},
+		},
+		{
+			name: "admin network policy",
+			acl:  "ANP:anp1:Egress:2",
+			want: &Owner{Kind: AdminNetworkPolicyKind, Name: "anp1", Direction: "Egress", Rule: "2"},
+		},
+		{
+			name: "baseline admin network policy",
+			acl:  "BANP:default:Ingress:1",
+			want: &Owner{Kind: BaselineAdminNetworkPolicyKind, Name: "default", Direction: "Ingress", Rule: "1"},
+		},
+		{
+			name: "unknown",
+			acl:  "<unnamed>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ownerFromName(tt.acl))
+		})
+	}
+}
+
+func TestGetOwnerFromIndex(t *testing.T) {
+	// long enough for the ACL name to be cropped
+	policyName := strings.Repeat("policy", 10)
+	buildACL := func(gressIdx string) *nbdb.ACL {
+		dbIDs := libovsdbops.NewDbObjectIDs(libovsdbops.ACLNetworkPolicy, "default-network-controller",
+			map[libovsdbops.ExternalIDKey]string{
+				libovsdbops.ObjectNameKey:         libovsdbops.BuildNamespaceNameKey("ns1", policyName),
+				libovsdbops.PolicyDirectionKey:    string(libovsdbutil.ACLIngress),
+				libovsdbops.GressIdxKey:           gressIdx,
+				libovsdbops.PortPolicyProtocolKey: "tcp",
+				libovsdbops.IpBlockIndexKey:       "-1",
+			})
+		acl := libovsdbutil.BuildACL(dbIDs, 1001, "ip4.src == 10.244.1.3", "allow-related", nil,
+			libovsdbutil.LportIngress)
+		acl.UUID = "acl-" + gressIdx + "-uuid"
+		return acl
+	}
+	acl1 := buildACL("1")
+	// the cropped names of the ACLs of both rules are the same
+	acl2 := buildACL("2")
+	require.Equal(t, *acl1.Name, *acl2.Name)
+
+	c := &Controller{acls: newACLIndex()}
+	c.ACLEventHandler().OnAdd(nbdb.ACLTable, acl1)
+	c.ACLEventHandler().OnAdd(nbdb.ACLTable, acl2)
+	c.ACLEventHandler().OnDelete(nbdb.ACLTable, acl2)
+	assert.Equal(t, &Owner{Kind: NetworkPolicyKind, Namespace: "ns1", Name: policyName, Direction: "Ingress", Rule: "1"},
+		c.getOwner(*acl1.Name))
+	// not indexed, derived from the name
+	assert.Equal(t, &Owner{Kind: EgressFirewallKind, Namespace: "ns2", Name: egressFirewallName, Direction: "Egress", Rule: "1"},
+		c.getOwner("EF:ns2:1"))
+
+	c.ACLEventHandler().OnDelete(nbdb.ACLTable, acl1)
+	assert.Empty(t, c.acls.acls)
+}
+
+func TestFileSink(t *testing.T) {
+	dir := t.TempDir()
+	s, err := newFileSink(dir)
+	require.NoError(t, err)
+	c := &Controller{acls: newACLIndex(), sink: s}
+
+	c.handleLine(npDropLine)
+	c.handleLine(efAllowLine)
+	c.handleLine(anpPassLine)
+	c.handleLine(npDropLine)
+	s.close()
+
+	readEntries := func(fileName string) []*Entry {
+		f, err := os.Open(filepath.Join(dir, fileName))
+		require.NoError(t, err)
+		defer f.Close()
+		var entries []*Entry
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			entry := &Entry{}
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), entry))
+			entries = append(entries, entry)
+		}
+		return entries
+	}
+
+	ns1Entries := readEntries("ns1.log")
+	require.Len(t, ns1Entries, 2)
+	assert.Equal(t, &Owner{Kind: NamespaceKind, Namespace: "ns1", Name: "ns1", Direction: "Ingress"}, ns1Entries[0].Owner)
+	assert.Equal(t, "drop", ns1Entries[0].Verdict)
+	ns2Entries := readEntries("ns2.log")
+	require.Len(t, ns2Entries, 1)
+	assert.Equal(t, EgressFirewallKind, ns2Entries[0].Owner.Kind)
+	clusterEntries := readEntries(clusterScopedLogFile)
+	require.Len(t, clusterEntries, 1)
+	assert.Equal(t, AdminNetworkPolicyKind, clusterEntries[0].Owner.Kind)
+}
+
+func TestEventSink(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	s := newEventSink(recorder, time.Minute)
+	now := time.Now()
+	s.now = func() time.Time { return now }
+	c := &Controller{acls: newACLIndex(), sink: s}
+
+	c.handleLine(npDropLine)
+	c.handleLine(npDropLine)
+	c.handleLine(npDropLine)
+	c.handleLine(anpPassLine)
+	require.Len(t, recorder.Events, 2)
+	assert.Equal(t, "Warning ACLDrop ACL NP:ns1:Ingress dropped tcp packet from 10.244.1.3:40000 to 10.244.2.4:8080",
+		<-recorder.Events)
+	assert.Equal(t, "Normal ACLPass ACL ANP:anp1:Egress:2 passed udp packet from 10.244.1.3:5353 to 10.96.0.10:53",
+		<-recorder.Events)
+
+	// the suppressed entries are reported with the next event of the ACL
+	now = now.Add(30 * time.Second)
+	c.handleLine(npDropLine)
+	now = now.Add(31 * time.Second)
+	c.handleLine(npDropLine)
+	require.Len(t, recorder.Events, 1)
+	assert.Equal(t, "Warning ACLDrop ACL NP:ns1:Ingress dropped tcp packet from 10.244.1.3:40000 to 10.244.2.4:8080 "+
+		"(3 more packets since the last event)", <-recorder.Events)
+
+	// or once the ACL has no more entries
+	c.handleLine(npDropLine)
+	now = now.Add(2 * time.Minute)
+	c.handleLine(anpPassLine)
+	require.Len(t, recorder.Events, 2)
+	assert.Equal(t, "Warning ACLDrop ACL NP:ns1:Ingress dropped 1 more packets", <-recorder.Events)
+	assert.Equal(t, "Normal ACLPass ACL ANP:anp1:Egress:2 passed udp packet from 10.244.1.3:5353 to 10.96.0.10:53",
+		<-recorder.Events)
+}
+
+func TestTailFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ovn-controller.log")
+	appendLines := func(lines ...string) {
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		require.NoError(t, err)
+		defer f.Close()
+		for _, line := range lines {
+			_, err = f.WriteString(line)
+			require.NoError(t, err)
+		}
+	}
+	appendLines("old\n")
+
+	var lock sync.Mutex
+	var lines []string
+	getLines := func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([]string{}, lines...)
+	}
+	stopCh := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		tailFile(path, 10*time.Millisecond, stopCh, func(line string) {
+			lock.Lock()
+			defer lock.Unlock()
+			lines = append(lines, line)
+		})
+	}()
+	defer func() {
+		close(stopCh)
+		wg.Wait()
+	}()
+
+	// wait for the file to be opened, lines already in the file are skipped
+	time.Sleep(50 * time.Millisecond)
+	appendLines("line1\n", "li")
+	assert.Eventually(t, func() bool { return len(getLines()) == 1 }, time.Second, 10*time.Millisecond)
+	appendLines("ne2\n")
+	assert.Eventually(t, func() bool { return len(getLines()) == 2 }, time.Second, 10*time.Millisecond)
+
+	// rotation
+	require.NoError(t, os.Rename(path, path+".1"))
+	appendLines("line3\n")
+	assert.Eventually(t, func() bool { return len(getLines()) == 3 }, time.Second, 10*time.Millisecond)
+
+	// truncation
+	require.NoError(t, os.Truncate(path, 0))
+	time.Sleep(50 * time.Millisecond)
+	appendLines("line4\n")
+	assert.Eventually(t, func() bool { return len(getLines()) == 4 }, time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, []string{"line1", "line2", "line3", "line4"}, getLines())
+}
@@ -0,0 +1,206 @@
+package acllog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	lumberjack "gopkg.in/natefinch/lumberjack.v2"
+
+	corev1 "k8s.io/api/core/v1"
+	knet "k8s.io/api/networking/v1"
+	"k8s.io/client-go/tools/record"
+	anpapi "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	egressfirewallapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
+)
+
+// clusterScopedLogFile is the file of the entries of cluster-scoped owners.
+// Namespace names can't contain underscores, so it can't clash with a
+// namespace log file.
+const clusterScopedLogFile = "_cluster.log"
+
+// fileSink writes the entries as JSON lines to one file per namespace
+type fileSink struct {
+	dir     string
+	writers map[string]*lumberjack.Logger
+}
+
+func newFileSink(dir string) (*fileSink, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create ACL log directory %s: %w", dir, err)
+	}
+	return &fileSink{
+		dir:     dir,
+		writers: map[string]*lumberjack.Logger{},
+	}, nil
+}
+
+func (s *fileSink) write(entry *Entry) error {
+	fileName := clusterScopedLogFile
+	if entry.Owner.Namespace != "" {
+		fileName = entry.Owner.Namespace + ".log"
+	}
+	w, ok := s.writers[fileName]
+	if !ok {
+		w = &lumberjack.Logger{
+			Filename:   filepath.Join(s.dir, fileName),
+			MaxSize:    config.Logging.LogFileMaxSize, // megabytes
+			MaxBackups: config.Logging.LogFileMaxBackups,
+			MaxAge:     config.Logging.LogFileMaxAge, // days
+			Compress:   true,
+		}
+		s.writers[fileName] = w
+	}
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
+
+func (s *fileSink) close() {
+	for _, w := range s.writers {
+		_ = w.Close()
+	}
+}
+
+// eventState tracks the events of a single ACL and verdict
+type eventState struct {
+	acl        string
+	verdict    string
+	owner      *Owner
+	lastEvent  time.Time
+	suppressed int
+}
+
+// eventSink writes the entries as events on the policy objects. It emits at
+// most one event per interval for every ACL and verdict, and reports the
+// entries it suppressed in between.
+type eventSink struct {
+	recorder record.EventRecorder
+	interval time.Duration
+	states   map[string]*eventState
+	lastGC   time.Time
+	// now is meant to be overridden in unit tests
+	now func() time.Time
+}
+
+func newEventSink(recorder record.EventRecorder, interval time.Duration) *eventSink {
+	return &eventSink{
+		recorder: recorder,
+		interval: interval,
+		states:   map[string]*eventState{},
+		now:      time.Now,
+	}
+}
+
+func (s *eventSink) write(entry *Entry) error {
+	now := s.now()
+	s.gc(now)
+
+	key := entry.ACL + "/" + entry.Verdict
+	state, ok := s.states[key]
+	if ok && now.Sub(state.lastEvent) < s.interval {
+		state.suppressed++
+		return nil
+	}
+	message := fmt.Sprintf("ACL %s %s %s packet from %s to %s", entry.ACL, verdictPastTense(entry.Verdict),
+		entry.Protocol, endpoint(entry.Src, entry.SrcPort), endpoint(entry.Dst, entry.DstPort))
+	if ok && state.suppressed > 0 {
+		message += fmt.Sprintf(" (%d more packets since the last event)", state.suppressed)
+	}
+	s.recorder.Event(objectReference(entry.Owner), eventType(entry.Verdict), eventReason(entry.Verdict), message)
+	s.states[key] = &eventState{acl: entry.ACL, verdict: entry.Verdict, owner: entry.Owner, lastEvent: now}
+	return nil
+}
+
+// gc forgets the ACLs without events in the last two intervals, and reports
+// the entries that were suppressed since their last event. Until then, the
+// suppressed entries are reported with the next event of the ACL.
+func (s *eventSink) gc(now time.Time) {
+	if now.Sub(s.lastGC) < s.interval {
+		return
+	}
+	s.lastGC = now
+	for key, state := range s.states {
+		if now.Sub(state.lastEvent) < 2*s.interval {
+			continue
+		}
+		if state.suppressed > 0 {
+			s.recorder.Eventf(objectReference(state.owner), eventType(state.verdict), eventReason(state.verdict),
+				"ACL %s %s %d more packets", state.acl, verdictPastTense(state.verdict), state.suppressed)
+		}
+		delete(s.states, key)
+	}
+}
+
+func (s *eventSink) close() {}
+
+func objectReference(owner *Owner) *corev1.ObjectReference {
+	ref := &corev1.ObjectReference{
+		Kind:      owner.Kind,
+		Namespace: owner.Namespace,
+		Name:      owner.Name,
+	}
+	switch owner.Kind {
+	case NetworkPolicyKind:
+		ref.APIVersion = knet.SchemeGroupVersion.String()
+	case NamespaceKind:
+		// keep the namespace in the reference so that the event is created in
+		// the namespace and visible to its users
+		ref.APIVersion = corev1.SchemeGroupVersion.String()
+	case EgressFirewallKind:
+		ref.APIVersion = egressfirewallapi.SchemeGroupVersion.String()
+	case AdminNetworkPolicyKind, BaselineAdminNetworkPolicyKind:
+		ref.APIVersion = anpapi.SchemeGroupVersion.String()
+	}
+	return ref
+}
+
+func eventType(verdict string) string {
+	if verdict == "drop" || verdict == "reject" {
+		return corev1.EventTypeWarning
+	}
+	return corev1.EventTypeNormal
+}
+
+func eventReason(verdict string) string {
+	switch verdict {
+	case "allow":
+		return "ACLAllow"
+	case "drop":
+		return "ACLDrop"
+	case "reject":
+		return "ACLReject"
+	case "pass":
+		return "ACLPass"
+	}
+	return "ACLLog"
+}
+
+func verdictPastTense(verdict string) string {
+	switch verdict {
+	case "allow":
+		return "allowed"
+	case "drop":
+		return "dropped"
+	case "reject":
+		return "rejected"
+	case "pass":
+		return "passed"
+	}
+	return "logged"
+}
+
+func endpoint(ip, port string) string {
+	if port == "" {
+		return ip
+	}
+	return net.JoinHostPort(ip, port)
+}
@@ -0,0 +1,119 @@
+package acllog
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// openWarningInterval is how often a file that can't be opened is warned about
+const openWarningInterval = time.Minute
+
+// tailFile calls handle with every line appended to the file at path, until
+// stopCh is closed. The file is checked for new lines every interval. Lines
+// that are already in the file on start are skipped. Rotated and truncated
+// files are followed from their beginning.
+func tailFile(path string, interval time.Duration, stopCh <-chan struct{}, handle func(string)) {
+	var f *os.File
+	var reader *bufio.Reader
+	var partial string
+	defer func() {
+		if f != nil {
+			f.Close()
+		}
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	fromEnd := true
+	// time of the last warning about the file that can't be opened
+	var openWarning time.Time
+	for {
+		if f == nil {
+			var err error
+			f, err = openFile(path, fromEnd)
+			if err != nil {
+				if time.Since(openWarning) >= openWarningInterval {
+					klog.Warningf("Failed to open %s, retrying: %v", path, err)
+					openWarning = time.Now()
+				} else {
+					klog.V(5).Infof("Failed to open %s: %v", path, err)
+				}
+			} else {
+				if !openWarning.IsZero() {
+					klog.Infof("Opened %s", path)
+					openWarning = time.Time{}
+				}
+				reader = bufio.NewReader(f)
+				partial = ""
+			}
+			fromEnd = false
+		}
+		if f != nil {
+			for {
+				line, err := reader.ReadString('\n')
+				if err != nil {
+					// keep the incomplete line until the rest of it is written
+					partial += line
+					break
+				}
+				handle(strings.TrimSuffix(partial+line, "\n"))
+				partial = ""
+			}
+			if rotated, truncated := checkFile(f, path); rotated {
+				f.Close()
+				f = nil
+				// the new file may already have lines, read it right away
+				continue
+			} else if truncated {
+				if _, err := f.Seek(0, io.SeekStart); err != nil {
+					klog.Warningf("Failed to seek to the beginning of %s: %v", path, err)
+				}
+				reader.Reset(f)
+				partial = ""
+			}
+		}
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// openFile opens the file at path, at its end when fromEnd is set.
+func openFile(path string, fromEnd bool) (*os.File, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	if fromEnd {
+		if _, err = f.Seek(0, io.SeekEnd); err != nil {
+			klog.Warningf("Failed to seek to the end of %s: %v", path, err)
+		}
+	}
+	return f, nil
+}
+
+// checkFile returns whether the file at path is no longer the open file f, or
+// is shorter than what was read from f
+func checkFile(f *os.File, path string) (rotated, truncated bool) {
+	pathInfo, err := os.Stat(path)
+	if err != nil {
+		// the file was moved away, wait for its replacement
+		return true, false
+	}
+	fileInfo, err := f.Stat()
+	if err != nil || !os.SameFile(pathInfo, fileInfo) {
+		return true, false
+	}
+	offset, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return false, false
+	}
+	return false, pathInfo.Size() < offset
+}
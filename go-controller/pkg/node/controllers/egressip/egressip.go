@@ -8,6 +8,7 @@ import (
 	"net"
 	"net/netip"
 	"reflect"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -659,12 +660,56 @@ func generateRoutesForLink(link netlink.Link, isV6 bool) ([]netlink.Route, error
 	if err != nil {
 		return nil, fmt.Errorf("failed to get routes for link %s: %v", link.Attrs().Name, err)
 	}
+	multipathRoutes, err := getMultipathRoutesForLink(link.Attrs().Index, routeTable, isV6)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get multipath routes for link %s: %v", link.Attrs().Name, err)
+	}
+	linkRoutes = append(linkRoutes, multipathRoutes...)
 	linkRoutes = ensureAtLeastOneDefaultRoute(linkRoutes, link.Attrs().Index, isV6)
 	overwriteRoutesTableID(linkRoutes, util.CalculateRouteTableID(link.Attrs().Index))
 	clearSrcFromRoutes(linkRoutes)
 	return linkRoutes, nil
 }
 
+// getMultipathRoutesForLink returns the multipath routes of the routing table
+// that have next hops through the link, restricted to those next hops. These
+// routes are not listed when filtering by output interface because they don't
+// have one of their own.
+func getMultipathRoutesForLink(linkIndex, routeTable int, isV6 bool) ([]netlink.Route, error) {
+	filterRoute, filterMask := filterRouteByTable(routeTable)
+	routes, err := util.GetNetLinkOps().RouteListFiltered(util.GetIPFamily(isV6), filterRoute, filterMask)
+	if err != nil {
+		return nil, err
+	}
+	var multipathRoutes []netlink.Route
+	for _, route := range routes {
+		if len(route.MultiPath) == 0 {
+			continue
+		}
+		var nextHops []*netlink.NexthopInfo
+		for _, nextHop := range route.MultiPath {
+			if nextHop != nil && nextHop.LinkIndex == linkIndex {
+				nextHops = append(nextHops, nextHop)
+			}
+		}
+		switch len(nextHops) {
+		case 0:
+			continue
+		case 1:
+			// the kernel reports a multipath route with a single next hop as a
+			// regular route
+			route.MultiPath = nil
+			route.LinkIndex = nextHops[0].LinkIndex
+			route.Gw = nextHops[0].Gw
+			route.Flags = nextHops[0].Flags
+		default:
+			route.MultiPath = nextHops
+		}
+		multipathRoutes = append(multipathRoutes, route)
+	}
+	return multipathRoutes, nil
+}
+
 func (c *Controller) deleteRefObjects(name string) {
 	c.referencedObjectsLock.Lock()
 	delete(c.referencedObjects, name)
@@ -763,9 +808,13 @@ func (c *Controller) updateEIP(existing *state, update *config) error {
 		}
 	} else if update != nil && update.eIPConfig != nil && len(update.eIPConfig.routes) > 0 &&
 		existing.eIPConfig != nil && len(existing.eIPConfig.routes) > 0 {
-		// delete delta between existing and update
+		// delete delta between existing and update. Routes of which only the
+		// next hops changed are updated in place when applying the update.
 		routesToDelete := routeDifference(existing.eIPConfig.routes, update.eIPConfig.routes)
 		for _, routeToDelete := range routesToDelete {
+			if findNextHopsUpdate(routeToDelete, update.eIPConfig.routes) != nil {
+				continue
+			}
 			err := c.routeManager.Del(routeToDelete)
 			if err != nil {
 				return fmt.Errorf("failed to delete egress IP route: %w", err)
@@ -804,6 +853,19 @@ func (c *Controller) updateEIP(existing *state, update *config) error {
 		existing.eIPConfig.addr = update.eIPConfig.addr
 		// route manager manages retry
 		for _, routeToAdd := range update.eIPConfig.routes {
+			if existingRoute := findNextHopsUpdate(routeToAdd, existing.eIPConfig.routes); existingRoute != nil {
+				// only the next hops changed: add the new ones before removing
+				// the stale ones so that the route is not flapped
+				if err := c.routeManager.AddNextHops(routeToAdd); err != nil {
+					return err
+				}
+				if staleRoute := routeWithStaleNextHops(*existingRoute, routeToAdd); staleRoute != nil {
+					if err := c.routeManager.DelNextHops(*staleRoute); err != nil {
+						return err
+					}
+				}
+				continue
+			}
 			if err := c.routeManager.Add(routeToAdd); err != nil {
 				return err
 			}
@@ -1344,6 +1406,58 @@ func routeDifference(routesA, routesB []netlink.Route) []netlink.Route {
 	return diff
 }
 
+// findNextHopsUpdate returns the route from routes that only differs from r in
+// its next hops, or nil if there is none.
+func findNextHopsUpdate(r netlink.Route, routes []netlink.Route) *netlink.Route {
+	withoutNextHops := routeWithoutNextHops(r)
+	for i := range routes {
+		if util.RouteEqual(&r, &routes[i]) {
+			continue
+		}
+		candidate := routeWithoutNextHops(routes[i])
+		if util.RouteEqual(&withoutNextHops, &candidate) {
+			return &routes[i]
+		}
+	}
+	return nil
+}
+
+// routeWithStaleNextHops returns a copy of the existing route with only the
+// next hops that are not part of the updated route, or nil if there are none.
+func routeWithStaleNextHops(existing, update netlink.Route) *netlink.Route {
+	updatedNextHops := routeNextHops(update)
+	var staleNextHops []*netlink.NexthopInfo
+	for _, nextHop := range routeNextHops(existing) {
+		if !slices.ContainsFunc(updatedNextHops, func(updated *netlink.NexthopInfo) bool {
+			return updated.LinkIndex == nextHop.LinkIndex && updated.Gw.Equal(nextHop.Gw)
+		}) {
+			staleNextHops = append(staleNextHops, nextHop)
+		}
+	}
+	if len(staleNextHops) == 0 {
+		return nil
+	}
+	staleRoute := routeWithoutNextHops(existing)
+	staleRoute.MultiPath = staleNextHops
+	return &staleRoute
+}
+
+func routeNextHops(r netlink.Route) []*netlink.NexthopInfo {
+	if len(r.MultiPath) > 0 {
+		return r.MultiPath
+	}
+	return []*netlink.NexthopInfo{{LinkIndex: r.LinkIndex, Gw: r.Gw, Flags: r.Flags}}
+}
+
+func routeWithoutNextHops(r netlink.Route) netlink.Route {
+	r.MultiPath = nil
+	r.LinkIndex = 0
+	r.Gw = nil
+	r.Via = nil
+	r.Flags = 0
+	return r
+}
+
 func ensureAtLeastOneDefaultRoute(routes []netlink.Route, linkIndex int, isV6 bool) []netlink.Route {
 	var defaultCIDR *net.IPNet
 	if isV6 {
@@ -1517,6 +1631,13 @@ func filterRouteByLinkTable(linkIndex, tableID int) (*netlink.Route, uint64) {
 		netlink.RT_FILTER_OIF | netlink.RT_FILTER_TABLE
 }
 
+func filterRouteByTable(table int) (*netlink.Route, uint64) {
+	return &netlink.Route{
+			Table: table,
+		},
+		netlink.RT_FILTER_TABLE
+}
+
 func filterRuleByPriority(priority int) (*netlink.Rule, uint64) {
 	return &netlink.Rule{
 			Priority: priority,
@@ -1163,6 +1163,90 @@ var _ = ginkgo.Describe("VRF", func() {
 	})
 })
 
+var _ = ginkgo.Describe("Multipath routes", func() {
+	ginkgo.It("copies the next hops through the link of multipath routes", func() {
+		defer ginkgo.GinkgoRecover()
+		if ovntest.NoRoot() {
+			ginkgo.Skip("Test requires root privileges")
+		}
+		ginkgo.By("setup links")
+		nodeConfig := nodeConfig{routes: []netlink.Route{}, linkConfigs: []linkConfig{
+			{dummyLink1Name, []address{{dummy1IPv4CIDR, false}}},
+			{dummyLink2Name, []address{{dummy2IPv4CIDR, false}}},
+		}}
+		testNS, cleanupNodeFn, err := setupFakeTestNode(nodeConfig)
+		gomega.Expect(err).ShouldNot(gomega.HaveOccurred(), "fake node setup should succeed")
+		defer func() {
+			gomega.Expect(cleanupNodeFn()).ShouldNot(gomega.HaveOccurred())
+		}()
+		ginkgo.By("add multipath route with next hops through both links")
+		_, dst, err := net.ParseCIDR(dummy3IPv4CIDR)
+		gomega.Expect(err).ShouldNot(gomega.HaveOccurred())
+		link1Index := getLinkIndex(dummyLink1Name)
+		multipathRoute := netlink.Route{
+			Dst: dst,
+			MultiPath: []*netlink.NexthopInfo{
+				{LinkIndex: link1Index, Gw: net.ParseIP("5.5.5.1")},
+				{LinkIndex: link1Index, Gw: net.ParseIP("5.5.5.2")},
+				{LinkIndex: getLinkIndex(dummyLink2Name), Gw: net.ParseIP("5.5.10.1")},
+			},
+		}
+		gomega.Expect(testNS.Do(func(ns.NetNS) error {
+			return netlink.RouteAdd(&multipathRoute)
+		})).Should(gomega.Succeed())
+		ginkgo.By("generate routes for the first link")
+		var routes []netlink.Route
+		gomega.Expect(testNS.Do(func(ns.NetNS) error {
+			link, err := netlink.LinkByName(dummyLink1Name)
+			if err != nil {
+				return err
+			}
+			routes, err = generateRoutesForLink(link, false)
+			return err
+		})).Should(gomega.Succeed())
+		var copiedRoute *netlink.Route
+		for i := range routes {
+			if routes[i].Dst != nil && routes[i].Dst.String() == dst.String() {
+				copiedRoute = &routes[i]
+			}
+		}
+		gomega.Expect(copiedRoute).ShouldNot(gomega.BeNil(), "multipath route should be copied")
+		gomega.Expect(copiedRoute.Table).Should(gomega.Equal(util.CalculateRouteTableID(link1Index)))
+		gomega.Expect(copiedRoute.MultiPath).Should(gomega.HaveLen(2))
+		for _, nextHop := range copiedRoute.MultiPath {
+			gomega.Expect(nextHop.LinkIndex).Should(gomega.Equal(link1Index))
+		}
+	})
+
+	ginkgo.It("updates the next hops of routes that only changed next hops", func() {
+		_, dst, err := net.ParseCIDR(dummy3IPv4CIDR)
+		gomega.Expect(err).ShouldNot(gomega.HaveOccurred())
+		linkIndex := getLinkIndex(dummyLink1Name)
+		table := util.CalculateRouteTableID(linkIndex)
+		existing := netlink.Route{Dst: dst, Table: table, MultiPath: []*netlink.NexthopInfo{
+			{LinkIndex: linkIndex, Gw: net.ParseIP("5.5.5.1")},
+			{LinkIndex: linkIndex, Gw: net.ParseIP("5.5.5.2")},
+		}}
+		update := netlink.Route{Dst: dst, Table: table, LinkIndex: linkIndex, Gw: net.ParseIP("5.5.5.2")}
+		other := getDstRoute(linkIndex, dummy1IPv4CIDRNetwork)
+
+		ginkgo.By("finding the route of which only the next hops changed")
+		gomega.Expect(findNextHopsUpdate(update, []netlink.Route{other, existing})).Should(gomega.Equal(&existing))
+		gomega.Expect(findNextHopsUpdate(existing, []netlink.Route{existing})).Should(gomega.BeNil())
+		metricUpdate := update
+		metricUpdate.Priority = 100
+		gomega.Expect(findNextHopsUpdate(metricUpdate, []netlink.Route{existing})).Should(gomega.BeNil())
+
+		ginkgo.By("computing the stale next hops")
+		staleRoute := routeWithStaleNextHops(existing, update)
+		gomega.Expect(staleRoute).ShouldNot(gomega.BeNil())
+		gomega.Expect(staleRoute.Dst).Should(gomega.Equal(dst))
+		gomega.Expect(staleRoute.Table).Should(gomega.Equal(table))
+		gomega.Expect(staleRoute.MultiPath).Should(gomega.Equal([]*netlink.NexthopInfo{{LinkIndex: linkIndex, Gw: net.ParseIP("5.5.5.1")}}))
+		gomega.Expect(routeWithStaleNextHops(update, existing)).Should(gomega.BeNil())
+	})
+})
+
 var _ = ginkgo.DescribeTable("repair node", func(expectedStateFollowingClean []eipConfig,
 	nodeConfigsBeforeRepair nodeConfig, pods []corev1.Pod, namespaces []corev1.Namespace) {
 	// Test using root and a test netns because we want to test between netlink lib
@@ -1531,13 +1615,6 @@ func getRoutesFromTable(testNS ns.NetNS, table, family int) ([]netlink.Route, er
 	return routes, err
 }
 
-func filterRouteByTable(table int) (*netlink.Route, uint64) {
-	return &netlink.Route{
-			Table: table,
-		},
-		netlink.RT_FILTER_TABLE
-}
-
 func newEgressIPMeta(name string) metav1.ObjectMeta {
 	return metav1.ObjectMeta{
 		UID:  types.UID(name),
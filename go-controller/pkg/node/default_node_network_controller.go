@@ -23,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 	utilnet "k8s.io/utils/net"
@@ -37,7 +38,9 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/informer"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/acllog"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/controllers/egressip"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/controllers/egressservice"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/linkmanager"
@@ -1198,11 +1201,16 @@ func (nc *DefaultNodeNetworkController) Start(ctx context.Context) error {
 	if config.HybridOverlay.Enabled {
 		// Not supported with DPUs, enforced in config
 		// TODO(adrianc): Revisit above comment
+		var peerInformer cache.SharedIndexInformer
+		if config.HybridOverlay.ExternalPeers {
+			peerInformer = nc.watchFactory.ExternalOverlayPeerInformer().Informer()
+		}
 		nodeController, err := honode.NewNode(
 			nc.Kube,
 			nc.name,
 			nc.watchFactory.NodeInformer(),
 			nc.watchFactory.LocalPodInformer(),
+			peerInformer,
 			informer.NewDefaultEventHandler,
 			false,
 		)
@@ -1329,6 +1337,12 @@ func (nc *DefaultNodeNetworkController) Start(ctx context.Context) error {
 		ovspinning.Run(nc.stopChan)
 	}()
 
+	if config.Logging.ACLLogSink != config.ACLLogSinkDisabled && config.OvnKubeNode.Mode != types.NodeModeDPUHost {
+		if err = nc.startACLLogSink(); err != nil {
+			return err
+		}
+	}
+
 	klog.Infof("Default node network controller initialized and ready.")
 	return nil
 }
@@ -1340,6 +1354,39 @@ func (nc *DefaultNodeNetworkController) Stop() {
 	nc.wg.Wait()
 }
 
+// startACLLogSink starts writing the ACL log entries of ovn-controller to the
+// configured ACL log sink
+func (nc *DefaultNodeNetworkController) startACLLogSink() error {
+	nbConfig := config.OvnNorth
+	if config.OVNKubernetesFeature.EnableInterconnect {
+		// with interconnect the northbound database of the zone runs on the node
+		nbConfig = config.OvnAuthConfig{
+			Scheme:  config.OvnDBSchemeUnix,
+			Address: "unix:/var/run/ovn/ovnnb_db.sock",
+		}
+	}
+	c, err := acllog.NewController(nc.recorder)
+	if err != nil {
+		return fmt.Errorf("failed to create ACL log sink: %w", err)
+	}
+	// ACL owners are looked up in the northbound database, but they can still
+	// be derived from the ACL names without it
+	nbClient, err := libovsdb.NewACLNBClientWithConfig(nbConfig, nc.stopChan, c.ACLEventHandler())
+	if err != nil {
+		klog.Warningf("Failed to connect to the northbound database for the ACL log sink, "+
+			"ACL owners will be derived from ACL names: %v", err)
+	}
+	nc.wg.Add(1)
+	go func() {
+		defer nc.wg.Done()
+		c.Run(nc.stopChan)
+		if nbClient != nil {
+			nbClient.Close()
+		}
+	}()
+	return nil
+}
+
 func (nc *DefaultNodeNetworkController) startEgressIPHealthCheckingServer(mgmtPort managementport.Interface) error {
 	healthCheckPort := config.OVNKubernetesFeature.EgressIPNodeHealthCheckPort
 	if healthCheckPort == 0 {
@@ -1185,7 +1185,7 @@ OFPT_GET_CONFIG_REPLY (xid=0x4): frags=normal miss_send_len=0`
 		Expect(err).NotTo(HaveOccurred())
 
 		if util.IsNetworkSegmentationSupportEnabled() {
-			err = configureUDNServicesNFTables()
+			err = configureUDNServicesNFTables(mp.GetInterfaceName())
 			Expect(err).NotTo(HaveOccurred())
 		}
 
@@ -99,8 +99,9 @@ const (
 )
 
 // configureUDNServicesNFTables configures the nftables chains, rules, and verdict maps
-// that are used to set packet marks on externally exposed UDN services
-func configureUDNServicesNFTables() error {
+// that are used to set packet marks on externally exposed UDN services. Traffic
+// coming from the management port, through mgmtPortIfName, is not marked.
+func configureUDNServicesNFTables(mgmtPortIfName string) error {
 	nft, err := nodenft.GetNFTablesHelper()
 	if err != nil {
 		return err
@@ -126,7 +127,7 @@ func configureUDNServicesNFTables() error {
 	tx.Add(&knftables.Rule{
 		Chain: nftablesUDNServicePreroutingChain,
 		Rule: knftables.Concat(
-			"iifname", "!=", types.K8sMgmtIntfName,
+			"iifname", "!=", mgmtPortIfName,
 			"jump", nftablesUDNServiceMarkChain,
 		),
 	})
@@ -1901,6 +1902,24 @@ func commonFlows(hostSubnets []*net.IPNet, bridge *bridgeConfiguration) ([]strin
 		dftFlows = append(dftFlows,
 			fmt.Sprintf("cookie=%s, priority=10, table=0, in_port=%s, %s dl_dst=%s, actions=%s",
 				defaultOpenFlowCookie, ofPortPhys, match_vlan, bridgeMacAddress, actions))
+
+		// table 0, address resolution requests and traffic towards the external IPs of a network are sent
+		// to that network only
+		for _, netConfig := range bridge.patchedNetConfigs() {
+			for _, ip := range netConfig.externalIPs {
+				addrResMatch := fmt.Sprintf("arp, arp_tpa=%s", ip)
+				ipMatch := fmt.Sprintf("ip, nw_dst=%s", ip)
+				if utilnet.IsIPv6(ip) {
+					addrResMatch = fmt.Sprintf("icmp6, icmp_type=135, icmp_code=0, nd_target=%s", ip)
+					ipMatch = fmt.Sprintf("ipv6, ipv6_dst=%s", ip)
+				}
+				dftFlows = append(dftFlows,
+					fmt.Sprintf("cookie=%s, priority=106, table=0, in_port=%s, %s %s, actions=output:%s",
+						defaultOpenFlowCookie, ofPortPhys, match_vlan, addrResMatch, netConfig.ofPortPatch),
+					fmt.Sprintf("cookie=%s, priority=105, table=0, in_port=%s, %s %s, actions=output:%s",
+						defaultOpenFlowCookie, ofPortPhys, match_vlan, ipMatch, netConfig.ofPortPatch))
+			}
+		}
 	}
 
 	// table 0, check packets coming from OVN have the correct mac address. Low priority flows that are a catch all
@@ -2555,7 +2574,7 @@ func newNodePortWatcher(
 			}
 		}
 		if util.IsNetworkSegmentationSupportEnabled() {
-			if err := configureUDNServicesNFTables(); err != nil {
+			if err := configureUDNServicesNFTables(nodeIPManager.mgmtPort.GetInterfaceName()); err != nil {
 				return nil, fmt.Errorf("unable to configure UDN nftables: %w", err)
 			}
 		}
@@ -127,6 +127,7 @@ func (b *bridgeConfiguration) addNetworkBridgeConfig(
 			v6MasqIPs:   v6MasqIPs,
 			subnets:     nInfo.Subnets(),
 			nodeSubnets: nodeSubnets,
+			externalIPs: nInfo.ExternalIPs()[b.nodeName],
 		}
 		netConfig.advertised.Store(util.IsPodNetworkAdvertisedAtNode(nInfo, b.nodeName))
 
@@ -191,6 +192,7 @@ type bridgeUDNConfiguration struct {
 	v6MasqIPs   *udn.MasqueradeIPs
 	subnets     []config.CIDRNetworkEntry
 	nodeSubnets []*net.IPNet
+	externalIPs []net.IP
 	advertised  atomic.Bool
 }
 
@@ -204,6 +206,7 @@ func (netConfig *bridgeUDNConfiguration) shallowCopy() *bridgeUDNConfiguration {
 		v6MasqIPs:   netConfig.v6MasqIPs,
 		subnets:     netConfig.subnets,
 		nodeSubnets: netConfig.nodeSubnets,
+		externalIPs: netConfig.externalIPs,
 	}
 	netConfig.advertised.Store(netConfig.advertised.Load())
 	return copy
@@ -381,6 +384,10 @@ func (udng *UserDefinedNetworkGateway) AddNetwork() error {
 		return fmt.Errorf("failed to update ip routes for network %s: %w", udng.GetNetworkName(), err)
 	}
 
+	if err := udng.updateEVPNVXLAN(); err != nil {
+		return fmt.Errorf("failed to update EVPN VXLAN for network %s: %w", udng.GetNetworkName(), err)
+	}
+
 	// add loose mode for rp filter on management port
 	mgmtPortName := util.GetNetworkScopedK8sMgmtHostIntfName(uint(udng.GetNetworkID()))
 	if err := addRPFilterLooseModeForManagementPort(mgmtPortName); err != nil {
@@ -684,8 +691,10 @@ func (udng *UserDefinedNetworkGateway) computeRoutesForUDN(mpLink netlink.Link)
 			Table: udng.vrfTableId,
 		})
 		if udng.NetInfo.TopologyType() == types.Layer3Topology {
+			// subnets of the same IP family might have been appended to the
+			// network, route all of them through the local gateway
 			for _, clusterSubnet := range udng.Subnets() {
-				if clusterSubnet.CIDR.Contains(gwIP.IP) {
+				if utilnet.IsIPv6CIDR(clusterSubnet.CIDR) == utilnet.IsIPv6CIDR(localSubnet) {
 					retVal = append(retVal, netlink.Route{
 						LinkIndex: mpLink.Attrs().Index,
 						Dst:       clusterSubnet.CIDR,
@@ -940,10 +949,18 @@ func (udng *UserDefinedNetworkGateway) doReconcile() error {
 		return fmt.Errorf("error while updating ip rule for UDN %s: %s", udng.GetNetworkName(), err)
 	}
 
+	if err := udng.updateUDNManagementPortAndRoutes(); err != nil {
+		return fmt.Errorf("error while updating management port and routes for UDN %s: %w", udng.GetNetworkName(), err)
+	}
+
 	if err := udng.updateUDNVRFIPRoute(isNetworkAdvertised); err != nil {
 		return fmt.Errorf("error while updating ip route for UDN %s: %s", udng.GetNetworkName(), err)
 	}
 
+	if err := udng.updateEVPNVXLAN(); err != nil {
+		return fmt.Errorf("error while updating EVPN VXLAN for UDN %s: %w", udng.GetNetworkName(), err)
+	}
+
 	// add below OpenFlows based on the gateway mode and whether the network is advertised or not:
 	// table=1, n_packets=0, n_bytes=0, priority=16,ip,nw_dst=128.192.0.2 actions=LOCAL (Both gateway modes)
 	// table=1, n_packets=0, n_bytes=0, priority=15,ip,nw_dst=128.192.0.0/14 actions=output:3 (shared gateway mode)
@@ -981,6 +998,36 @@ func (udng *UserDefinedNetworkGateway) updateUDNVRFIPRules(isNetworkAdvertised b
 	return nil
 }
 
+// updateUDNManagementPortAndRoutes updates the management port MTU and IPs
+// and the routes of the network VRF, as the network MTU and subnets can be
+// updated in place
+func (udng *UserDefinedNetworkGateway) updateUDNManagementPortAndRoutes() error {
+	interfaceName := util.GetNetworkScopedK8sMgmtHostIntfName(uint(udng.GetNetworkID()))
+	stdout, stderr, err := util.RunOVSVsctl("set", "interface", interfaceName,
+		"mtu_request="+fmt.Sprintf("%d", udng.NetInfo.MTU()))
+	if err != nil {
+		return fmt.Errorf("failed to set MTU on management port %s, stdout: %q, stderr: %q, error: %w",
+			interfaceName, stdout, stderr, err)
+	}
+	mplink, err := util.GetNetLinkOps().LinkByName(interfaceName)
+	if err != nil {
+		return fmt.Errorf("failed to get management port link %s: %w", interfaceName, err)
+	}
+	// subnets appended to a layer2 network need a management port IP
+	if err = udng.addUDNManagementPortIPs(mplink); err != nil {
+		return err
+	}
+	routes, err := udng.computeRoutesForUDN(mplink)
+	if err != nil {
+		return fmt.Errorf("failed to compute routes: %w", err)
+	}
+	vrfDeviceName := util.GetNetworkVRFName(udng.NetInfo)
+	if err = udng.vrfManager.AddVRFRoutes(vrfDeviceName, routes); err != nil {
+		return fmt.Errorf("could not add VRF %s routes: %w", vrfDeviceName, err)
+	}
+	return nil
+}
+
 // Add or remove default route from a vrf device based on the network is
 // advertised on its own network or default network
 func (udng *UserDefinedNetworkGateway) updateUDNVRFIPRoute(isNetworkAdvertised bool) error {
@@ -1003,6 +1050,21 @@ func (udng *UserDefinedNetworkGateway) updateUDNVRFIPRoute(isNetworkAdvertised b
 	return nil
 }
 
+// updateEVPNVXLAN wires the network VRF to a VXLAN device with the network L3
+// VNI if the network is advertised through EVPN, or unwires it otherwise
+func (udng *UserDefinedNetworkGateway) updateEVPNVXLAN() error {
+	vrfDeviceName := util.GetNetworkVRFName(udng.NetInfo)
+	vni := udng.GetEVPNVNI()
+	if vni == 0 || !util.IsPodNetworkAdvertisedAtNode(udng.NetInfo, udng.node.Name) {
+		return udng.vrfManager.DeleteVRFVXLAN(vrfDeviceName)
+	}
+	nodeIP, err := util.GetNodePrimaryIP(udng.node)
+	if err != nil {
+		return fmt.Errorf("unable to get primary IP of node %s: %w", udng.node.Name, err)
+	}
+	return udng.vrfManager.AddVRFVXLAN(vrfDeviceName, uint32(vni), net.ParseIP(nodeIP))
+}
+
 func (udng *UserDefinedNetworkGateway) removeDefaultRouteFromVRF() error {
 	vrfDeviceName := util.GetNetworkVRFName(udng.NetInfo)
 	defaultRoute, err := udng.getDefaultRoute(false)
@@ -30,6 +30,7 @@ import (
 	udnfakeclient "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/clientset/versioned/fake"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	factoryMocks "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory/mocks"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/generator/udn"
 	kubemocks "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube/mocks"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/iprulemanager"
@@ -2096,3 +2097,87 @@ func TestUserDefinedNetworkGateway_updateAdvertisedUDNIsolationRules(t *testing.
 		})
 	}
 }
+
+func TestCommonFlowsNetworkExternalIPs(t *testing.T) {
+	const (
+		ofPortPhys      = "1"
+		defaultPatch    = "2"
+		bluePatch       = "3"
+		blueNetworkID   = 3
+		blueExternalIP  = "172.18.0.100"
+		blueExternalIP6 = "fc00:f853:ccd:e793::100"
+	)
+	tests := []struct {
+		desc          string
+		externalIPs   []string
+		expectedFlows []string
+	}{
+		{
+			desc: "network without external IPs",
+		},
+		{
+			desc:        "network with an IPv4 external IP",
+			externalIPs: []string{blueExternalIP},
+			expectedFlows: []string{
+				"cookie=0xdeff105, priority=106, table=0, in_port=1,  arp, arp_tpa=172.18.0.100, actions=output:3",
+				"cookie=0xdeff105, priority=105, table=0, in_port=1,  ip, nw_dst=172.18.0.100, actions=output:3",
+			},
+		},
+		{
+			desc:        "network with dualstack external IPs",
+			externalIPs: []string{blueExternalIP, blueExternalIP6},
+			expectedFlows: []string{
+				"cookie=0xdeff105, priority=106, table=0, in_port=1,  arp, arp_tpa=172.18.0.100, actions=output:3",
+				"cookie=0xdeff105, priority=105, table=0, in_port=1,  ip, nw_dst=172.18.0.100, actions=output:3",
+				"cookie=0xdeff105, priority=106, table=0, in_port=1,  icmp6, icmp_type=135, icmp_code=0, nd_target=fc00:f853:ccd:e793::100, actions=output:3",
+				"cookie=0xdeff105, priority=105, table=0, in_port=1,  ipv6, ipv6_dst=fc00:f853:ccd:e793::100, actions=output:3",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(config.PrepareTestConfig()).To(Succeed())
+			config.IPv4Mode = true
+			config.IPv6Mode = true
+			config.Gateway.Mode = config.GatewayModeShared
+			config.Gateway.V6MasqueradeSubnet = "fd69::/112"
+			config.Gateway.V4MasqueradeSubnet = "169.254.0.0/17"
+
+			v4MasqIPs, err := udn.AllocateV4MasqueradeIPs(blueNetworkID)
+			g.Expect(err).NotTo(HaveOccurred())
+			v6MasqIPs, err := udn.AllocateV6MasqueradeIPs(blueNetworkID)
+			g.Expect(err).NotTo(HaveOccurred())
+			blueNetConfig := &bridgeUDNConfiguration{
+				ofPortPatch: bluePatch,
+				masqCTMark:  "0x3",
+				v4MasqIPs:   v4MasqIPs,
+				v6MasqIPs:   v6MasqIPs,
+			}
+			for _, ip := range tt.externalIPs {
+				blueNetConfig.externalIPs = append(blueNetConfig.externalIPs, net.ParseIP(ip))
+			}
+			bridge := &bridgeConfiguration{
+				ips:        []*net.IPNet{ovntest.MustParseIPNet("172.18.0.2/24"), ovntest.MustParseIPNet("fc00:f853:ccd:e793::2/64")},
+				macAddress: ovntest.MustParseMAC("0a:58:ac:12:00:02"),
+				ofPortPhys: ofPortPhys,
+				ofPortHost: "LOCAL",
+				netConfig: map[string]*bridgeUDNConfiguration{
+					types.DefaultNetworkName: {ofPortPatch: defaultPatch, masqCTMark: ctMarkOVN},
+					"bluenet":                blueNetConfig,
+				},
+			}
+
+			flows, err := commonFlows(nil, bridge)
+			g.Expect(err).NotTo(HaveOccurred())
+			var externalIPFlows []string
+			for _, flow := range flows {
+				if strings.Contains(flow, "priority=105, table=0, in_port="+ofPortPhys+",") ||
+					strings.Contains(flow, "priority=106, table=0, in_port="+ofPortPhys+",") {
+					externalIPFlows = append(externalIPFlows, flow)
+				}
+			}
+			g.Expect(externalIPFlows).To(ConsistOf(tt.expectedFlows))
+		})
+	}
+}
@@ -5,13 +5,17 @@ package managementport
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/containernetworking/plugins/pkg/ns"
 	"github.com/coreos/go-iptables/iptables"
 	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
@@ -38,6 +42,24 @@ const (
 	// traffic packets into OVN via ovn-k8s-mp0. Currently only used for ITP=local traffic.
 	ovnkubeSvcViaMgmPortRT = "7"
 
+	// mgmtPortNetnsDir is where named network namespaces are looked up and
+	// created, consistent with iproute2
+	mgmtPortNetnsDir = "/var/run/netns"
+
+	// mgmtPortHostLinkName is the host end of the veth pair that connects the
+	// host network namespace with an isolated management port. It takes the
+	// place of the management port for host traffic towards the pods.
+	mgmtPortHostLinkName = types.K8sMgmtIntfName + "-h"
+	// mgmtPortNetnsLinkName is the end of that veth pair in the management
+	// port network namespace
+	mgmtPortNetnsLinkName = types.K8sMgmtIntfName + "-n"
+	// mgmtPortHostLinkIPv6 and mgmtPortNetnsLinkIPv6 are the link local
+	// addresses of the ends of that veth pair, used as IPv6 next hops instead
+	// of the management port gateway IP: IPv6 rejects an on-link next hop
+	// that is reachable through a different link, like the management port.
+	mgmtPortHostLinkIPv6  = "fe80::1"
+	mgmtPortNetnsLinkIPv6 = "fe80::2"
+
 	ovsPort         = "ovs"
 	netdevPort      = "netdev"
 	representorPort = "representor"
@@ -109,11 +131,15 @@ func NewManagementPortController(
 	return c, nil
 }
 
-// GetInterfaceName of the management port
+// GetInterfaceName of the management port. For a management port isolated in
+// its own network namespace, this is the host interface that connects to it.
 func (c *managementPortController) GetInterfaceName() string {
 	if c.ports[representorPort] != nil && c.ports[netdevPort] != nil {
 		return types.K8sMgmtIntfName + "_0"
 	}
+	if config.OvnKubeNode.MgmtPortNetns != "" {
+		return mgmtPortHostLinkName
+	}
 	return types.K8sMgmtIntfName
 }
 
@@ -138,7 +164,7 @@ func (c *managementPortController) start(stopChan <-chan struct{}) error {
 	if config.Gateway.NodeportEnable {
 		if config.OvnKubeNode.Mode == types.NodeModeFull {
 			// (TODO): Internal Traffic Policy is not supported in DPU mode
-			if err := initMgmPortRoutingRules(c.cfg.hostSubnets); err != nil {
+			if err := initMgmPortRoutingRules(c.GetInterfaceName(), c.cfg.hostSubnets); err != nil {
 				return err
 			}
 		}
@@ -150,6 +176,9 @@ func (c *managementPortController) start(stopChan <-chan struct{}) error {
 type managementPortOVS struct {
 	cfg          *managementPortConfig
 	routeManager *routemanager.Controller
+	// netnsPath of the network namespace the management port is isolated
+	// in, if any
+	netnsPath string
 }
 
 // newManagementPort creates a new newManagementPort
@@ -157,6 +186,7 @@ func newManagementPortOVS(cfg *managementPortConfig, routeManager *routemanager.
 	return &managementPortOVS{
 		cfg:          cfg,
 		routeManager: routeManager,
+		netnsPath:    getManagementPortNetnsPath(config.OvnKubeNode.MgmtPortNetns),
 	}
 }
 
@@ -179,7 +209,7 @@ func (mp *managementPortOVS) create() error {
 		return fmt.Errorf("failed to add port to br-int: stdout %q, stderr %q, error: %w", stdout, stderr, err)
 	}
 
-	return createPlatformManagementPort(types.K8sMgmtIntfName, mp.cfg, mp.routeManager)
+	return mp.doReconcile()
 }
 
 func (mp *managementPortOVS) reconcilePeriod() time.Duration {
@@ -187,6 +217,9 @@ func (mp *managementPortOVS) reconcilePeriod() time.Duration {
 }
 
 func (mp *managementPortOVS) doReconcile() error {
+	if mp.netnsPath != "" {
+		return createIsolatedPlatformManagementPort(types.K8sMgmtIntfName, mp.netnsPath, mp.cfg, mp.routeManager)
+	}
 	return createPlatformManagementPort(types.K8sMgmtIntfName, mp.cfg, mp.routeManager)
 }
 
@@ -218,7 +251,6 @@ func tearDownManagementPortConfig(link netlink.Link, nft knftables.Interface) er
 
 func setupManagementPortIPFamilyConfig(link netlink.Link, mpcfg *managementPortConfig, cfg *managementPortIPFamilyConfig, routeManager *routemanager.Controller) error {
 	var err error
-	var exists bool
 
 	ifName := link.Attrs().Name
 
@@ -238,7 +270,15 @@ func setupManagementPortIPFamilyConfig(link netlink.Link, mpcfg *managementPortC
 		}
 
 		subnetCopy := *subnet
-		err = routeManager.Add(netlink.Route{LinkIndex: link.Attrs().Index, Gw: cfg.gwIP, Dst: &subnetCopy, MTU: config.Default.RoutableMTU})
+		mpRoute := netlink.Route{LinkIndex: link.Attrs().Index, Gw: cfg.gwIP, Dst: &subnetCopy, MTU: config.Default.RoutableMTU}
+		if routeManager != nil {
+			err = routeManager.Add(mpRoute)
+		} else {
+			// the route manager only handles the host network namespace,
+			// routes of an isolated management port are set directly and
+			// restored on periodic reconciliation
+			err = util.GetNetLinkOps().RouteReplace(&mpRoute)
+		}
 		if err != nil {
 			klog.Warningf("Could not add route entry for subnet %s via gateway %s: %v", subnet, cfg.gwIP, err)
 		}
@@ -249,21 +289,7 @@ func setupManagementPortIPFamilyConfig(link netlink.Link, mpcfg *managementPortC
 	// arrives on OVN Logical Router pipeline with ARP source protocol address set to
 	// K8s Node IP. OVN Logical Router pipeline drops such packets since it expects
 	// source protocol address to be in the Logical Switch's subnet.
-	if exists, err = util.LinkNeighExists(link, cfg.gwIP, mpcfg.gwMAC); err == nil && !exists {
-		klog.Warningf("Missing arp entry for MAC/IP binding (%s/%s) on link %s", mpcfg.gwMAC.String(), cfg.gwIP, types.K8sMgmtIntfName)
-		// LinkNeighExists checks if the mac also matches, but it is possible there is a stale entry
-		// still in the neighbor cache which would prevent add. Therefore execute a delete first if an IP entry exists.
-		if exists, err = util.LinkNeighIPExists(link, cfg.gwIP); err != nil {
-			klog.Warningf("Could not detect if stale IP neighbor entry exists for IP %s, on iface %s: %v", cfg.gwIP.String(), types.K8sMgmtIntfName, err)
-		} else if exists {
-			klog.Warningf("Found stale neighbor entry IP binding (%s) on link %s", cfg.gwIP.String(), types.K8sMgmtIntfName)
-			if err = util.LinkNeighDel(link, cfg.gwIP); err != nil {
-				klog.Warningf("Could not remove remove stale IP neighbor entry for IP %s, on iface %s: %v", cfg.gwIP.String(), types.K8sMgmtIntfName, err)
-			}
-		}
-		err = util.LinkNeighAdd(link, cfg.gwIP, mpcfg.gwMAC)
-	}
-	if err != nil {
+	if err = ensureNeighbor(link, cfg.gwIP, mpcfg.gwMAC); err != nil {
 		return err
 	}
 
@@ -283,6 +309,28 @@ func setupManagementPortIPFamilyConfig(link netlink.Link, mpcfg *managementPortC
 	return nil
 }
 
+// ensureNeighbor ensures a permanent neighbor entry binding ip to mac on link,
+// replacing any stale entry for ip
+func ensureNeighbor(link netlink.Link, ip net.IP, mac net.HardwareAddr) error {
+	ifName := link.Attrs().Name
+	exists, err := util.LinkNeighExists(link, ip, mac)
+	if err == nil && !exists {
+		klog.Warningf("Missing arp entry for MAC/IP binding (%s/%s) on link %s", mac.String(), ip, ifName)
+		// LinkNeighExists checks if the mac also matches, but it is possible there is a stale entry
+		// still in the neighbor cache which would prevent add. Therefore execute a delete first if an IP entry exists.
+		if exists, err = util.LinkNeighIPExists(link, ip); err != nil {
+			klog.Warningf("Could not detect if stale IP neighbor entry exists for IP %s, on iface %s: %v", ip.String(), ifName, err)
+		} else if exists {
+			klog.Warningf("Found stale neighbor entry IP binding (%s) on link %s", ip.String(), ifName)
+			if err = util.LinkNeighDel(link, ip); err != nil {
+				klog.Warningf("Could not remove remove stale IP neighbor entry for IP %s, on iface %s: %v", ip.String(), ifName, err)
+			}
+		}
+		err = util.LinkNeighAdd(link, ip, mac)
+	}
+	return err
+}
+
 func setupManagementPortConfig(link netlink.Link, cfg *managementPortConfig, routeManager *routemanager.Controller) error {
 	var err error
 
@@ -556,6 +604,325 @@ func createPlatformManagementPort(interfaceName string, cfg *managementPortConfi
 	return nil
 }
 
+// createIsolatedPlatformManagementPort moves the management port to the network
+// namespace at netnsPath and configures it there, so that host processes can't
+// bind to or send traffic through it. The host reaches the management port
+// through a veth pair instead, with only the routes towards the pods and the
+// services that need it, and its traffic is SNATed to the management port IP
+// within the network namespace.
+func createIsolatedPlatformManagementPort(interfaceName, netnsPath string, cfg *managementPortConfig, routeManager *routemanager.Controller) error {
+	netns, err := getOrCreateManagementPortNetns(netnsPath)
+	if err != nil {
+		return err
+	}
+	defer netns.Close()
+
+	link, err := util.GetNetLinkOps().LinkByName(interfaceName)
+	if err != nil && !util.GetNetLinkOps().IsLinkNotFoundError(err) {
+		return fmt.Errorf("failed to lookup %s link: %w", interfaceName, err)
+	}
+	if err == nil {
+		// the management port is still in the host network namespace, either
+		// because it was just created or because it was previously not
+		// isolated: clear any configuration left behind before moving it
+		nft, err := nodenft.GetNFTablesHelper()
+		if err != nil {
+			return fmt.Errorf("failed to get nftables: %w", err)
+		}
+		if err := tearDownManagementPortConfig(link, nft); err != nil {
+			return fmt.Errorf("teardown failed: %w", err)
+		}
+		DelLegacyMgtPortIptRules()
+		if err := util.GetNetLinkOps().LinkSetNsFd(link, int(netns.Fd())); err != nil {
+			return fmt.Errorf("failed to move %s link to network namespace %s: %w", interfaceName, netnsPath, err)
+		}
+		klog.Infof("Moved management port %s to network namespace %s", interfaceName, netnsPath)
+	}
+
+	hostLink, err := ensureManagementPortHostLink(netns)
+	if err != nil {
+		return err
+	}
+
+	var netnsLinkMAC net.HardwareAddr
+	err = netns.Do(func(ns.NetNS) error {
+		link, err := util.LinkSetUp(interfaceName)
+		if err != nil {
+			return err
+		}
+		if err := setupManagementPortConfig(link, cfg, nil); err != nil {
+			return err
+		}
+		netnsLink, err := setupManagementPortNetnsLinkConfig(link, hostLink.Attrs().HardwareAddr, cfg)
+		if err != nil {
+			return err
+		}
+		netnsLinkMAC = netnsLink.Attrs().HardwareAddr
+		return setupIsolatedManagementPortNFTChain(interfaceName, cfg)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to configure network namespace %s: %w", netnsPath, err)
+	}
+
+	return setupManagementPortHostLinkConfig(hostLink, netnsLinkMAC, cfg, routeManager)
+}
+
+// ensureManagementPortHostLink returns the host end of the veth pair connecting
+// to the management port network namespace, creating the pair if needed.
+func ensureManagementPortHostLink(netns ns.NetNS) (netlink.Link, error) {
+	hostLink, err := util.GetNetLinkOps().LinkByName(mgmtPortHostLinkName)
+	if err != nil && !util.GetNetLinkOps().IsLinkNotFoundError(err) {
+		return nil, fmt.Errorf("failed to lookup %s link: %w", mgmtPortHostLinkName, err)
+	}
+	if err == nil {
+		var peerFound bool
+		err = netns.Do(func(ns.NetNS) error {
+			_, err := util.GetNetLinkOps().LinkByName(mgmtPortNetnsLinkName)
+			if err != nil && !util.GetNetLinkOps().IsLinkNotFoundError(err) {
+				return err
+			}
+			peerFound = err == nil
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to lookup %s link: %w", mgmtPortNetnsLinkName, err)
+		}
+		if peerFound {
+			return util.LinkSetUp(mgmtPortHostLinkName)
+		}
+		// the peer is not in the management port network namespace, likely
+		// because it was recreated: recreate the pair as well
+		if err := util.GetNetLinkOps().LinkDelete(hostLink); err != nil {
+			return nil, fmt.Errorf("failed to delete stale %s link: %w", mgmtPortHostLinkName, err)
+		}
+	}
+	veth := &netlink.Veth{
+		LinkAttrs: netlink.LinkAttrs{
+			Name: mgmtPortHostLinkName,
+			MTU:  config.Default.MTU,
+		},
+		PeerName:      mgmtPortNetnsLinkName,
+		PeerNamespace: netlink.NsFd(int(netns.Fd())),
+	}
+	if err := util.GetNetLinkOps().LinkAdd(veth); err != nil {
+		return nil, fmt.Errorf("failed to create %s link: %w", mgmtPortHostLinkName, err)
+	}
+	klog.Infof("Created link %s connecting to the management port network namespace", mgmtPortHostLinkName)
+	return util.LinkSetUp(mgmtPortHostLinkName)
+}
+
+// setupManagementPortNetnsLinkConfig configures, within the management port
+// network namespace, the forwarding of host traffic between the veth pair and
+// the management port link. As the veth pair is not numbered, the gateway IP is
+// used as the next hop on either side, bound to the MAC of the other end.
+func setupManagementPortNetnsLinkConfig(link netlink.Link, hostLinkMAC net.HardwareAddr, cfg *managementPortConfig) (netlink.Link, error) {
+	netnsLink, err := util.LinkSetUp(mgmtPortNetnsLinkName)
+	if err != nil {
+		return nil, err
+	}
+	for _, familyCfg := range []*managementPortIPFamilyConfig{cfg.ipv4, cfg.ipv6} {
+		if familyCfg == nil {
+			continue
+		}
+		isIPv6 := utilnet.IsIPv6(familyCfg.gwIP)
+		// services are reached through the management port for
+		// `internalTrafficPolicy: Local` host traffic
+		for _, svcCIDR := range config.Kubernetes.ServiceCIDRs {
+			if utilnet.IsIPv6CIDR(svcCIDR) != isIPv6 {
+				continue
+			}
+			svcCIDRCopy := *svcCIDR
+			route := netlink.Route{LinkIndex: link.Attrs().Index, Gw: familyCfg.gwIP, Dst: &svcCIDRCopy, MTU: config.Default.RoutableMTU}
+			if err := util.GetNetLinkOps().RouteReplace(&route); err != nil {
+				return nil, fmt.Errorf("failed to add route for service CIDR %s on link %s: %w", svcCIDR, link.Attrs().Name, err)
+			}
+		}
+		// everything else goes back to the host
+		defaultCIDR := &net.IPNet{IP: net.IPv4zero, Mask: net.CIDRMask(0, 32)}
+		if isIPv6 {
+			defaultCIDR = &net.IPNet{IP: net.IPv6zero, Mask: net.CIDRMask(0, 128)}
+		}
+		if isIPv6 {
+			if err := ensureLinkLocalAddress(netnsLink, net.ParseIP(mgmtPortNetnsLinkIPv6)); err != nil {
+				return nil, err
+			}
+		}
+		nextHop := isolatedManagementPortNextHop(familyCfg.gwIP, net.ParseIP(mgmtPortHostLinkIPv6))
+		route := netlink.Route{LinkIndex: netnsLink.Attrs().Index, Gw: nextHop, Dst: defaultCIDR, Flags: int(netlink.FLAG_ONLINK)}
+		if err := util.GetNetLinkOps().RouteReplace(&route); err != nil {
+			return nil, fmt.Errorf("failed to add default route on link %s: %w", mgmtPortNetnsLinkName, err)
+		}
+		if err := ensureNeighbor(netnsLink, nextHop, hostLinkMAC); err != nil {
+			return nil, err
+		}
+	}
+
+	// forwarding is disabled by default in a new network namespace
+	sysctls := []string{fmt.Sprintf("net.ipv4.conf.%s.forwarding", mgmtPortNetnsLinkName)}
+	if cfg.ipv6 != nil {
+		sysctls = append(sysctls, "net.ipv6.conf.all.forwarding")
+	}
+	for _, sysctl := range sysctls {
+		stdout, stderr, err := util.RunSysctl("-w", sysctl+"=1")
+		if err != nil || stdout != sysctl+" = 1" {
+			return nil, fmt.Errorf("could not enable forwarding with %s: stdout: %s, stderr: %s, err: %v", sysctl, stdout, stderr, err)
+		}
+	}
+	return netnsLink, nil
+}
+
+// setupIsolatedManagementPortNFTChain sets up the management port SNAT chain
+// within the management port network namespace. All the host traffic sent to
+// the management port is SNATed.
+func setupIsolatedManagementPortNFTChain(interfaceName string, cfg *managementPortConfig) error {
+	nft, err := nodenft.GetNFTablesHelper()
+	if err != nil {
+		return err
+	}
+
+	tx := nft.NewTransaction()
+	tx.Add(&knftables.Table{})
+	tx.Add(&knftables.Chain{
+		Name:     nftMgmtPortChain,
+		Comment:  knftables.PtrTo("OVN SNAT to Management Port"),
+		Type:     knftables.PtrTo(knftables.NATType),
+		Hook:     knftables.PtrTo(knftables.PostroutingHook),
+		Priority: knftables.PtrTo(knftables.SNATPriority),
+	})
+	tx.Flush(&knftables.Chain{
+		Name: nftMgmtPortChain,
+	})
+	tx.Add(&knftables.Rule{
+		Chain: nftMgmtPortChain,
+		Rule: knftables.Concat(
+			"oifname", "!=", interfaceName,
+			"return",
+		),
+	})
+	if cfg.ipv4 != nil {
+		tx.Add(&knftables.Rule{
+			Chain: nftMgmtPortChain,
+			Rule: knftables.Concat(
+				"meta nfproto ipv4",
+				"ip saddr", cfg.ipv4.ifAddr.IP,
+				"return",
+			),
+		})
+		tx.Add(&knftables.Rule{
+			Chain: nftMgmtPortChain,
+			Rule: knftables.Concat(
+				"snat ip to", cfg.ipv4.ifAddr.IP,
+			),
+		})
+	}
+	if cfg.ipv6 != nil {
+		tx.Add(&knftables.Rule{
+			Chain: nftMgmtPortChain,
+			Rule: knftables.Concat(
+				"meta nfproto ipv6",
+				"ip6 saddr", cfg.ipv6.ifAddr.IP,
+				"return",
+			),
+		})
+		tx.Add(&knftables.Rule{
+			Chain: nftMgmtPortChain,
+			Rule: knftables.Concat(
+				"snat ip6 to", cfg.ipv6.ifAddr.IP,
+			),
+		})
+	}
+
+	err = nft.Run(context.TODO(), tx)
+	if err != nil {
+		return fmt.Errorf("could not update nftables rule for management port: %v", err)
+	}
+	return nil
+}
+
+// setupManagementPortHostLinkConfig configures the routes through the host end
+// of the veth pair that take the place of the routes through the management
+// port.
+func setupManagementPortHostLinkConfig(hostLink netlink.Link, netnsLinkMAC net.HardwareAddr, cfg *managementPortConfig, routeManager *routemanager.Controller) error {
+	for _, familyCfg := range []*managementPortIPFamilyConfig{cfg.ipv4, cfg.ipv6} {
+		if familyCfg == nil {
+			continue
+		}
+		if utilnet.IsIPv6(familyCfg.gwIP) {
+			if err := ensureLinkLocalAddress(hostLink, net.ParseIP(mgmtPortHostLinkIPv6)); err != nil {
+				return err
+			}
+		}
+		nextHop := isolatedManagementPortNextHop(familyCfg.gwIP, net.ParseIP(mgmtPortNetnsLinkIPv6))
+		for _, subnet := range familyCfg.clusterSubnets {
+			subnetCopy := *subnet
+			route := netlink.Route{
+				LinkIndex: hostLink.Attrs().Index,
+				Gw:        nextHop,
+				Dst:       &subnetCopy,
+				MTU:       config.Default.RoutableMTU,
+				Flags:     int(netlink.FLAG_ONLINK),
+			}
+			if err := routeManager.Add(route); err != nil {
+				klog.Warningf("Could not add route entry for subnet %s via gateway %s on link %s: %v",
+					subnet, nextHop, mgmtPortHostLinkName, err)
+			}
+		}
+		if err := ensureNeighbor(hostLink, nextHop, netnsLinkMAC); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isolatedManagementPortNextHop returns the next hop through the veth pair
+// connecting to an isolated management port: the management port gateway IP
+// for IPv4, or the link local address of the veth peer for IPv6.
+func isolatedManagementPortNextHop(gwIP, peerLinkLocalIP net.IP) net.IP {
+	if utilnet.IsIPv6(gwIP) {
+		return peerLinkLocalIP
+	}
+	return gwIP
+}
+
+// ensureLinkLocalAddress ensures the IPv6 link local address ip is set on link
+func ensureLinkLocalAddress(link netlink.Link, ip net.IP) error {
+	addr := &net.IPNet{IP: ip, Mask: net.CIDRMask(64, 128)}
+	exists, err := util.LinkAddrExist(link, addr)
+	if err != nil || exists {
+		return err
+	}
+	return util.LinkAddrAdd(link, addr, unix.IFA_F_NODAD, 0, 0)
+}
+
+// getManagementPortNetnsPath returns the path of the management port network
+// namespace, netns being either a path or a name of a network namespace
+func getManagementPortNetnsPath(netns string) string {
+	if netns == "" || filepath.IsAbs(netns) {
+		return netns
+	}
+	return filepath.Join(mgmtPortNetnsDir, netns)
+}
+
+// getOrCreateManagementPortNetns returns the network namespace at netnsPath,
+// creating it through iproute2 if it is a named network namespace that does
+// not exist yet
+func getOrCreateManagementPortNetns(netnsPath string) (ns.NetNS, error) {
+	netns, err := ns.GetNS(netnsPath)
+	if err == nil {
+		return netns, nil
+	}
+	var notExist ns.NSPathNotExistErr
+	if !errors.As(err, &notExist) || filepath.Dir(netnsPath) != mgmtPortNetnsDir {
+		return nil, fmt.Errorf("failed to get management port network namespace %s: %w", netnsPath, err)
+	}
+	stdout, stderr, err := util.RunIP("netns", "add", filepath.Base(netnsPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create management port network namespace %s: stdout: %s, stderr: %s, err: %w",
+			netnsPath, stdout, stderr, err)
+	}
+	return ns.GetNS(netnsPath)
+}
+
 // syncMgmtPortInterface verifies if no other interface configured as management port. This may happen if another
 // interface had been used as management port or Node was running in different mode.
 // If old management port is found, its IP configuration is flushed and interface renamed.
@@ -694,16 +1061,24 @@ func DelLegacyMgtPortIptRules() {
 }
 
 // initMgmPortRoutingRules creates the routing table, routes and rules that
-// let's us forward service traffic to ovn-k8s-mp0 as opposed to the default
-// route towards breth0
-func initMgmPortRoutingRules(hostSubnets []*net.IPNet) error {
+// let's us forward service traffic to ovn-k8s-mp0, through ifName, as opposed
+// to the default route towards breth0
+func initMgmPortRoutingRules(ifName string, hostSubnets []*net.IPNet) error {
 	// create ovnkubeSvcViaMgmPortRT and service route towards ovn-k8s-mp0
 	for _, hostSubnet := range hostSubnets {
 		isIPv6 := utilnet.IsIPv6CIDR(hostSubnet)
-		gatewayIP := util.GetNodeGatewayIfAddr(hostSubnet).IP.String()
+		gatewayIP := util.GetNodeGatewayIfAddr(hostSubnet).IP
+		if ifName == mgmtPortHostLinkName {
+			gatewayIP = isolatedManagementPortNextHop(gatewayIP, net.ParseIP(mgmtPortNetnsLinkIPv6))
+		}
 		for _, svcCIDR := range config.Kubernetes.ServiceCIDRs {
 			if isIPv6 == utilnet.IsIPv6CIDR(svcCIDR) {
-				if stdout, stderr, err := util.RunIP("route", "replace", "table", ovnkubeSvcViaMgmPortRT, svcCIDR.String(), "via", gatewayIP, "dev", types.K8sMgmtIntfName); err != nil {
+				args := []string{"route", "replace", "table", ovnkubeSvcViaMgmPortRT, svcCIDR.String(), "via", gatewayIP.String(), "dev", ifName}
+				if ifName == mgmtPortHostLinkName {
+					// the link towards an isolated management port is not numbered
+					args = append(args, "onlink")
+				}
+				if stdout, stderr, err := util.RunIP(args...); err != nil {
 					return fmt.Errorf("error adding routing table entry into custom routing table: %s: stdout: %s, stderr: %s, err: %v", ovnkubeSvcViaMgmPortRT, stdout, stderr, err)
 				}
 				klog.V(5).Infof("Successfully added route into custom routing table: %s", ovnkubeSvcViaMgmPortRT)
@@ -740,11 +1115,11 @@ func initMgmPortRoutingRules(hostSubnets []*net.IPNet) error {
 	// NOTE: v6 doesn't have rp_filter strict mode block
 	rpFilterLooseMode := "2"
 	// TODO: Convert testing framework to mock golang module utilities. Example:
-	// result, err := sysctl.Sysctl(fmt.Sprintf("net/ipv4/conf/%s/rp_filter", types.K8sMgmtIntfName), rpFilterLooseMode)
-	stdout, stderr, err := util.RunSysctl("-w", fmt.Sprintf("net.ipv4.conf.%s.rp_filter=%s", types.K8sMgmtIntfName, rpFilterLooseMode))
-	if err != nil || stdout != fmt.Sprintf("net.ipv4.conf.%s.rp_filter = %s", types.K8sMgmtIntfName, rpFilterLooseMode) {
+	// result, err := sysctl.Sysctl(fmt.Sprintf("net/ipv4/conf/%s/rp_filter", ifName), rpFilterLooseMode)
+	stdout, stderr, err := util.RunSysctl("-w", fmt.Sprintf("net.ipv4.conf.%s.rp_filter=%s", ifName, rpFilterLooseMode))
+	if err != nil || stdout != fmt.Sprintf("net.ipv4.conf.%s.rp_filter = %s", ifName, rpFilterLooseMode) {
 		return fmt.Errorf("could not set the correct rp_filter value for interface %s: stdout: %v, stderr: %v, err: %v",
-			types.K8sMgmtIntfName, stdout, stderr, err)
+			ifName, stdout, stderr, err)
 	}
 
 	return nil
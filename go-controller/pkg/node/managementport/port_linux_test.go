@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
@@ -321,6 +322,208 @@ func testManagementPort(ctx *cli.Context, fexec *ovntest.FakeExec, testNS ns.Net
 	Expect(fexec.CalledMatchesExpected()).To(BeTrue(), fexec.ErrorDesc)
 }
 
+func testManagementPortIsolated(ctx *cli.Context, fexec *ovntest.FakeExec, testNS, mgmtNS ns.NetNS, mgmtNetns string,
+	configs []managementPortTestConfig, expectedLRPMAC string) {
+	const (
+		nodeName      string = "node1"
+		mgtPort       string = types.K8sMgmtIntfName
+		legacyMgtPort string = types.K8sPrefix + nodeName
+		mtu           string = "1400"
+	)
+
+	mgmtPortMAC := util.IPAddrToHWAddr(net.ParseIP(configs[0].expectedManagementPortIP))
+
+	fexec.AddFakeCmd(&ovntest.ExpectedCmd{
+		Cmd:    "ovs-vsctl --timeout=15 --no-headings --data bare --format csv --columns type,name find Interface name=" + mgtPort,
+		Output: "internal," + mgtPort,
+	})
+	fexec.AddFakeCmd(&ovntest.ExpectedCmd{
+		Cmd:    "ovs-vsctl --timeout=15 --no-headings --data bare --format csv --columns type,name find Interface name=" + mgtPort + "_0",
+		Output: "internal," + mgtPort + "_0",
+	})
+	fexec.AddFakeCmdsNoOutputNoError([]string{
+		"ovs-vsctl --timeout=15 -- --if-exists del-port br-int " + legacyMgtPort + " -- --may-exist add-port br-int " + mgtPort + " -- set interface " + mgtPort + " mac=\"" + mgmtPortMAC.String() + "\"" + " type=internal mtu_request=" + mtu + " external-ids:iface-id=" + legacyMgtPort,
+	})
+	var hasIPv6 bool
+	for _, cfg := range configs {
+		if cfg.family == netlink.FAMILY_V4 {
+			fexec.AddFakeCmd(&ovntest.ExpectedCmd{
+				Cmd:    "sysctl -w net.ipv4.conf.ovn-k8s-mp0.forwarding=1",
+				Output: "net.ipv4.conf.ovn-k8s-mp0.forwarding = 1",
+			})
+		}
+		hasIPv6 = hasIPv6 || cfg.family == netlink.FAMILY_V6
+	}
+	// forwarding between the host link and the management port
+	fexec.AddFakeCmd(&ovntest.ExpectedCmd{
+		Cmd:    "sysctl -w net.ipv4.conf." + mgmtPortNetnsLinkName + ".forwarding=1",
+		Output: "net.ipv4.conf." + mgmtPortNetnsLinkName + ".forwarding = 1",
+	})
+	if hasIPv6 {
+		fexec.AddFakeCmd(&ovntest.ExpectedCmd{
+			Cmd:    "sysctl -w net.ipv6.conf.all.forwarding=1",
+			Output: "net.ipv6.conf.all.forwarding = 1",
+		})
+	}
+
+	err := util.SetExec(fexec)
+	Expect(err).NotTo(HaveOccurred())
+
+	nodeSubnetCIDRs := make([]*net.IPNet, len(configs))
+	mgtPortAddrs := make([]*netlink.Addr, len(configs))
+	for i, cfg := range configs {
+		nodeSubnetCIDRs[i] = cfg.GetNodeSubnetCIDR()
+		mgtPortAddrs[i] = cfg.GetMgtPortAddr()
+	}
+
+	existingNode := corev1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name: nodeName,
+	}}
+	netInfo := &multinetworkmocks.NetInfo{}
+	netInfo.On("GetPodNetworkAdvertisedOnNodeVRFs", nodeName).Return(nil)
+
+	_, err = config.InitConfig(ctx, fexec, nil)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(config.OvnKubeNode.MgmtPortNetns).To(Equal(mgmtNetns))
+
+	wg := &sync.WaitGroup{}
+	rm := routemanager.NewController()
+	stopCh := make(chan struct{})
+	defer func() {
+		close(stopCh)
+		wg.Wait()
+	}()
+	wg.Add(1)
+	go func() {
+		defer GinkgoRecover()
+		defer wg.Done()
+		err := testNS.Do(func(ns.NetNS) error {
+			rm.Run(stopCh, 10*time.Second)
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+	}()
+
+	var netnsLinkMAC string
+	err = mgmtNS.Do(func(ns.NetNS) error {
+		defer GinkgoRecover()
+		// the network namespace is initially empty
+		_, err := netlink.LinkByName(mgmtPortNetnsLinkName)
+		Expect(err).To(HaveOccurred())
+		return nil
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	err = testNS.Do(func(ns.NetNS) error {
+		defer GinkgoRecover()
+
+		mgmtPortController, err := NewManagementPortController(&existingNode, nodeSubnetCIDRs, "", "", rm, netInfo)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mgmtPortController.GetInterfaceName()).To(Equal(mgmtPortHostLinkName))
+		stop := make(chan struct{})
+		err = mgmtPortController.Start(stop)
+		Expect(err).NotTo(HaveOccurred())
+		defer close(stop)
+
+		// the management port is not in the host network namespace anymore
+		_, err = netlink.LinkByName(mgtPort)
+		Expect(err).To(HaveOccurred())
+		return nil
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	err = mgmtNS.Do(func(ns.NetNS) error {
+		defer GinkgoRecover()
+		Eventually(checkMgmtTestPortIpsAndRoutes).WithArguments(configs, mgtPort, mgtPortAddrs, expectedLRPMAC).Should(Succeed())
+		// services are reached through the management port
+		for _, cfg := range configs {
+			checkMgmtTestPortRoutes(Default, []managementPortTestConfig{cfg}, mgtPort, expectedLRPMAC, cfg.serviceCIDR)
+		}
+		netnsLink, err := netlink.LinkByName(mgmtPortNetnsLinkName)
+		Expect(err).NotTo(HaveOccurred())
+		netnsLinkMAC = netnsLink.Attrs().HardwareAddr.String()
+		return nil
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	// the host reaches the pods through the host link
+	err = testNS.Do(func(ns.NetNS) error {
+		defer GinkgoRecover()
+		hostLink, err := netlink.LinkByName(mgmtPortHostLinkName)
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(checkMgmtTestPortRoutes).WithArguments(configs, mgmtPortHostLinkName, netnsLinkMAC).Should(Succeed())
+		err = mgmtNS.Do(func(ns.NetNS) error {
+			defer GinkgoRecover()
+			checkMgmtTestPortRoutes(Default, configs, mgmtPortNetnsLinkName, hostLink.Attrs().HardwareAddr.String(), "default")
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return nil
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	// host traffic is SNATed to the management port IP within the network
+	// namespace; the fake nftables helper is not network namespace aware
+	checkMgmtPortTestNFTables(configs, mgtPort)
+
+	Expect(fexec.CalledMatchesExpected()).To(BeTrue(), fexec.ErrorDesc)
+}
+
+// checkMgmtTestPortRoutes checks that the link has routes to the cluster
+// subnets, or to the provided destinations, through the gateway IP, and that
+// the gateway IP is bound to the expected MAC
+func checkMgmtTestPortRoutes(g Gomega, configs []managementPortTestConfig, linkName, expectedGatewayMAC string, dsts ...string) {
+	link, err := netlink.LinkByName(linkName)
+	g.Expect(err).NotTo(HaveOccurred())
+	for _, cfg := range configs {
+		gatewayIP := ovntest.MustParseIP(cfg.expectedGatewayIP)
+		if cfg.family == netlink.FAMILY_V6 {
+			// the veth pair towards an isolated management port uses link
+			// local IPv6 next hops
+			switch linkName {
+			case mgmtPortHostLinkName:
+				gatewayIP = ovntest.MustParseIP(mgmtPortNetnsLinkIPv6)
+			case mgmtPortNetnsLinkName:
+				gatewayIP = ovntest.MustParseIP(mgmtPortHostLinkIPv6)
+			}
+		}
+		subnets := dsts
+		if len(subnets) == 0 {
+			subnets = []string{cfg.clusterCIDR}
+		}
+		routes, err := netlink.RouteList(link, cfg.family)
+		g.Expect(err).NotTo(HaveOccurred())
+		for _, subnet := range subnets {
+			var foundRoute bool
+			for _, r := range routes {
+				if !r.Gw.Equal(gatewayIP) {
+					continue
+				}
+				if subnet == "default" && (r.Dst == nil || r.Dst.IP.IsUnspecified()) {
+					foundRoute = true
+					break
+				}
+				if r.Dst != nil && r.Dst.String() == subnet {
+					foundRoute = true
+					break
+				}
+			}
+			g.Expect(foundRoute).To(BeTrue(), "did not find expected route to %s on link %s", subnet, linkName)
+		}
+
+		neighbours, err := netlink.NeighList(link.Attrs().Index, cfg.family)
+		g.Expect(err).NotTo(HaveOccurred())
+		var foundNeighbour bool
+		for _, neighbour := range neighbours {
+			if neighbour.IP.Equal(gatewayIP) && neighbour.HardwareAddr.String() == expectedGatewayMAC {
+				foundNeighbour = true
+				break
+			}
+		}
+		g.Expect(foundNeighbour).To(BeTrue(), "did not find expected neighbour %s on link %s", gatewayIP, linkName)
+	}
+}
+
 func testManagementPortDPU(ctx *cli.Context, fexec *ovntest.FakeExec, testNS ns.NetNS,
 	configs []managementPortTestConfig, mgmtPortNetdev string) {
 	const (
@@ -1077,6 +1280,86 @@ var _ = Describe("Management Port tests", func() {
 			})
 		})
 
+		Context("Management Port, ovnkube node mode full, isolated in a network namespace", func() {
+			var mgmtNS ns.NetNS
+
+			BeforeEach(func() {
+				if ovntest.NoRoot() {
+					Skip("Test requires root privileges")
+				}
+				var err error
+				nodenft.SetFakeNFTablesHelper()
+				mgmtNS, err = testutils.NewNS()
+				Expect(err).NotTo(HaveOccurred())
+				err = testNS.Do(func(ns.NetNS) error {
+					defer GinkgoRecover()
+					ovntest.AddLink(types.K8sMgmtIntfName)
+					// IPv4 on-link next hops are validated against the local
+					// routing table, which needs an address to exist
+					_, err := util.LinkSetUp("lo")
+					Expect(err).NotTo(HaveOccurred())
+					return nil
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				if mgmtNS == nil {
+					return
+				}
+				Expect(mgmtNS.Close()).To(Succeed())
+				Expect(testutils.UnmountNS(mgmtNS)).To(Succeed())
+				mgmtNS = nil
+			})
+
+			testIsolated := func(mgmtNetns string) {
+				app.Action = func(ctx *cli.Context) error {
+					testManagementPortIsolated(ctx, fexec, testNS, mgmtNS, mgmtNetns,
+						[]managementPortTestConfig{
+							{
+								family: netlink.FAMILY_V4,
+
+								clusterCIDR: v4clusterCIDR,
+								serviceCIDR: v4serviceCIDR,
+								nodeSubnet:  v4nodeSubnet,
+
+								expectedManagementPortIP: v4mgtPortIP,
+								expectedGatewayIP:        v4gwIP,
+							},
+							{
+								family: netlink.FAMILY_V6,
+
+								clusterCIDR: v6clusterCIDR,
+								serviceCIDR: v6serviceCIDR,
+								nodeSubnet:  v6nodeSubnet,
+
+								expectedManagementPortIP: v6mgtPortIP,
+								expectedGatewayIP:        v6gwIP,
+							},
+						}, v4lrpMAC)
+					return nil
+				}
+				err := app.Run([]string{
+					app.Name,
+					"--cluster-subnets=" + v4clusterCIDR + "," + v6clusterCIDR,
+					"--k8s-service-cidr=" + v4serviceCIDR + "," + v6serviceCIDR,
+					"--ovnkube-node-mgmt-port-netns=" + mgmtNetns,
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			ovntest.OnSupportedPlatformsIt("sets up the management port for dual-stack clusters in the network namespace at a path", func() {
+				testIsolated(mgmtNS.Path())
+			})
+
+			ovntest.OnSupportedPlatformsIt("sets up the management port for dual-stack clusters in a named network namespace", func() {
+				if filepath.Dir(mgmtNS.Path()) != mgmtPortNetnsDir {
+					Skip("Test requires network namespaces to be created in " + mgmtPortNetnsDir)
+				}
+				testIsolated(filepath.Base(mgmtNS.Path()))
+			})
+		})
+
 		Context("Management Port, ovnkube node mode dpu", func() {
 
 			BeforeEach(func() {
@@ -1182,6 +1465,22 @@ var _ = Describe("Management Port tests", func() {
 			Expect(mgmtPortImpl.ports[netdevPort]).To(BeNil())
 			Expect(mgmtPortImpl.ports[representorPort]).To(BeNil())
 		})
+		It("Creates managementPort isolated in a network namespace", func() {
+			config.OvnKubeNode.MgmtPortNetns = "ovn-k8s-mp"
+			mgmtPort, err := NewManagementPortController(node, hostSubnets, netdevName, rep, nil, netInfo)
+			Expect(err).NotTo(HaveOccurred())
+			mgmtPortImpl := mgmtPort.(*managementPortController)
+			Expect(mgmtPortImpl.ports[ovsPort]).ToNot(BeNil())
+			ovsImpl := mgmtPortImpl.ports[ovsPort].(*managementPortOVS)
+			Expect(ovsImpl.netnsPath).To(Equal("/var/run/netns/ovn-k8s-mp"))
+		})
+		It("Creates managementPort isolated in a network namespace given by path", func() {
+			config.OvnKubeNode.MgmtPortNetns = "/proc/1/ns/net"
+			mgmtPort, err := NewManagementPortController(node, hostSubnets, netdevName, rep, nil, netInfo)
+			Expect(err).NotTo(HaveOccurred())
+			ovsImpl := mgmtPort.(*managementPortController).ports[ovsPort].(*managementPortOVS)
+			Expect(ovsImpl.netnsPath).To(Equal("/proc/1/ns/net"))
+		})
 		It("Creates managementPortRepresentor for Ovnkube Node mode dpu", func() {
 			config.OvnKubeNode.Mode = types.NodeModeDPU
 			mgmtPort, err := NewManagementPortController(node, hostSubnets, netdevName, rep, nil, netInfo)
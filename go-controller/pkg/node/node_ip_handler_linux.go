@@ -418,8 +418,9 @@ func (c *addressManager) isValidNodeIP(addr net.IP, linkIndex int) bool {
 		return false
 	}
 
-	if util.IsNetworkSegmentationSupportEnabled() {
-		// check CDN + UDN management ports
+	if util.IsNetworkSegmentationSupportEnabled() || config.OvnKubeNode.MgmtPortNetns != "" {
+		// check CDN + UDN management ports, including the host link towards an
+		// isolated CDN management port
 		if mpLink, err := util.GetNetLinkOps().LinkByIndex(linkIndex); err != nil {
 			klog.Errorf("Unable to determine if link is an OVN management port for address %s and link index %d: %v", addr.String(), linkIndex, err)
 		} else {
@@ -13,30 +13,52 @@ import (
 	"time"
 
 	"github.com/fsnotify/fsnotify"
+	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/sys/unix"
 
 	"k8s.io/klog/v2"
 
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
+// Names of the processes as exposed in metrics
+const (
+	ovsVSwitchd   = "ovs-vswitchd"
+	ovsDBServer   = "ovsdb-server"
+	ovnController = "ovn-controller"
+)
+
+// cpuAffinityMetrics holds the CPUs last exposed in metrics for each group of
+// threads of each process
+var cpuAffinityMetrics = map[string]map[string]string{}
+
 // These variables are meant to be used in unit tests
 var tickDuration time.Duration = 1 * time.Second
 var getOvsVSwitchdPIDFn func() (string, error) = util.GetOvsVSwitchdPID
 var getOvsDBServerPIDFn func() (string, error) = util.GetOvsDBServerPID
+var getOvnControllerPIDFn func() (string, error) = util.GetOvnControllerPID
 var featureEnablerFile string = "/etc/openvswitch/enable_dynamic_cpu_affinity"
 
 // Run monitors OVS daemon's processes (ovs-vswitchd and ovsdb-server) and sets their CPU affinity
 // masks to that of the current process.
 // This feature is enabled by the presence of a non-empty file in the path `/etc/openvswitch/enable_dynamic_cpu_affinity`
+// The file may also hold a policy to pin some ovs-vswitchd threads and ovn-controller to a set of
+// housekeeping CPUs instead, for example:
+//
+//	housekeeping-cpus=0-1,32-33
+//	housekeeping-threads=handler,revalidator,ovn-controller
 func Run(stopCh <-chan struct{}) {
 
 	// The file must be present at startup to enable the feature
-	isFeatureEnabled, err := isFileNotEmpty(featureEnablerFile)
-	if err != nil {
+	isFeatureEnabled, p, err := readPolicy(featureEnablerFile)
+	if err != nil && !isFeatureEnabled {
 		klog.Warningf("Can't start OVS CPU affinity pinning: %v", err)
 		return
 	}
+	if err != nil {
+		klog.Warning(err)
+	}
 
 	if !isFeatureEnabled {
 		klog.Info("OVS CPU affinity pinning disabled")
@@ -76,16 +98,21 @@ func Run(stopCh <-chan struct{}) {
 				continue
 			}
 
-			isFeatureEnabled, err = isFileNotEmpty(featureEnablerFile)
-			if err != nil {
+			enabled, newPolicy, err := readPolicy(featureEnablerFile)
+			if err != nil && !enabled {
 				klog.Warningf("Error while reading [%s]: %v", featureEnablerFile, err)
 				continue
 			}
+			if err != nil {
+				klog.Warning(err)
+			}
+			isFeatureEnabled, p = enabled, newPolicy
 
 			if isFeatureEnabled {
 				klog.Infof("OVS daemon CPU pinning feature enabled")
 			} else {
 				klog.Infof("OVS daemon CPU pinning feature NOT enabled")
+				resetCPUAffinityMetrics()
 			}
 
 		case err, ok := <-fsnotifyErrors:
@@ -101,19 +128,56 @@ func Run(stopCh <-chan struct{}) {
 				continue
 			}
 
-			err := setOvsVSwitchdCPUAffinity()
+			// the CPU affinity of the current process follows the shared CPU
+			// pool of the kubelet CPU manager, read it on every tick to react to
+			// its changes
+			var currentProcessCPUs unix.CPUSet
+			err := unix.SchedGetaffinity(os.Getpid(), &currentProcessCPUs)
+			if err != nil {
+				klog.Warningf("Can't get own CPU affinity: %v", err)
+				continue
+			}
+
+			err = setOvsVSwitchdCPUAffinity(p, currentProcessCPUs)
 			if err != nil {
 				klog.Warningf("Error while aligning ovs-vswitchd CPUs to current process: %v", err)
 			}
 
-			err = setOvsDBServerCPUAffinity()
+			err = setOvsDBServerCPUAffinity(currentProcessCPUs)
 			if err != nil {
 				klog.Warningf("Error while aligning ovsdb-server CPUs to current process: %v", err)
 			}
+
+			if p.pinsOvnController() {
+				err = setOvnControllerCPUAffinity(p, currentProcessCPUs)
+				if err != nil {
+					klog.Warningf("Error while pinning ovn-controller CPUs to housekeeping CPUs: %v", err)
+				}
+			}
 		}
 	}
 }
 
+// updateCPUAffinityMetric exposes the CPUs the given group of threads of the
+// given process are pinned to
+func updateCPUAffinityMetric(process, threads string, cpus unix.CPUSet) {
+	cpuList := printCPUSet(cpus)
+	if cpuAffinityMetrics[process] == nil {
+		cpuAffinityMetrics[process] = map[string]string{}
+	}
+	if cpuAffinityMetrics[process][threads] == cpuList {
+		return
+	}
+	metrics.MetricOVSCPUAffinity.DeletePartialMatch(prometheus.Labels{"process": process, "threads": threads})
+	metrics.MetricOVSCPUAffinity.WithLabelValues(process, threads, cpuList).Set(1)
+	cpuAffinityMetrics[process][threads] = cpuList
+}
+
+func resetCPUAffinityMetrics() {
+	metrics.MetricOVSCPUAffinity.Reset()
+	cpuAffinityMetrics = map[string]map[string]string{}
+}
+
 func createFileWatcherFor(filename string) (*fsnotify.Watcher, error) {
 	fileWatcher, err := fsnotify.NewWatcher()
 	if err != nil {
@@ -141,7 +205,7 @@ func isFileNotEmpty(filename string) (bool, error) {
 	return f.Size() > 0, nil
 }
 
-func setOvsVSwitchdCPUAffinity() error {
+func setOvsVSwitchdCPUAffinity(p *policy, cpus unix.CPUSet) error {
 
 	ovsVSwitchdPID, err := getOvsVSwitchdPIDFn()
 	if err != nil {
@@ -149,10 +213,21 @@ func setOvsVSwitchdCPUAffinity() error {
 	}
 
 	klog.V(5).Infof("Managing ovs-vswitchd[%s] daemon CPU affinity", ovsVSwitchdPID)
-	return setProcessCPUAffinity(ovsVSwitchdPID)
+	if !p.pinsOvsVSwitchdThreads() {
+		return setProcessCPUAffinity(ovsVSwitchd, ovsVSwitchdPID, cpus)
+	}
+
+	housekeepingCPUs := p.getHousekeepingCPUs(cpus)
+	return setProcessThreadsCPUAffinity(ovsVSwitchd, ovsVSwitchdPID, func(threadName string) (string, unix.CPUSet) {
+		group := p.getThreadGroup(threadName)
+		if group == allThreads {
+			return group, cpus
+		}
+		return group, housekeepingCPUs
+	})
 }
 
-func setOvsDBServerCPUAffinity() error {
+func setOvsDBServerCPUAffinity(cpus unix.CPUSet) error {
 
 	ovsDBserverPID, err := getOvsDBServerPIDFn()
 	if err != nil {
@@ -160,21 +235,26 @@ func setOvsDBServerCPUAffinity() error {
 	}
 
 	klog.V(5).Infof("Managing ovsdb-server[%s] daemon CPU affinity", ovsDBserverPID)
-	return setProcessCPUAffinity(ovsDBserverPID)
+	return setProcessCPUAffinity(ovsDBServer, ovsDBserverPID, cpus)
 }
 
-// setProcessCPUAffinity sets the CPU affinity of the given process to the same affinity as the current process
-func setProcessCPUAffinity(targetPIDStr string) error {
+func setOvnControllerCPUAffinity(p *policy, cpus unix.CPUSet) error {
 
-	targetPID, err := strconv.Atoi(targetPIDStr)
+	ovnControllerPID, err := getOvnControllerPIDFn()
 	if err != nil {
-		return fmt.Errorf("can't convert PID[%s] to integer: %w", targetPIDStr, err)
+		return fmt.Errorf("can't retrieve ovn-controller PID: %w", err)
 	}
 
-	var currentProcessCPUs unix.CPUSet
-	err = unix.SchedGetaffinity(os.Getpid(), &currentProcessCPUs)
+	klog.V(5).Infof("Managing ovn-controller[%s] daemon CPU affinity", ovnControllerPID)
+	return setProcessCPUAffinity(ovnController, ovnControllerPID, p.getHousekeepingCPUs(cpus))
+}
+
+// setProcessCPUAffinity sets the CPU affinity of all the threads of the given process to the given CPUs
+func setProcessCPUAffinity(process, targetPIDStr string, cpus unix.CPUSet) error {
+
+	targetPID, err := strconv.Atoi(targetPIDStr)
 	if err != nil {
-		return fmt.Errorf("can't get own CPU affinity")
+		return fmt.Errorf("can't convert PID[%s] to integer: %w", targetPIDStr, err)
 	}
 
 	var targetProcessCPUs unix.CPUSet
@@ -183,25 +263,61 @@ func setProcessCPUAffinity(targetPIDStr string) error {
 		return fmt.Errorf("can't get process (PID:%d) CPU affinity: %w", targetPID, err)
 	}
 
-	if currentProcessCPUs == targetProcessCPUs {
-		klog.V(5).Infof("Process[%d] CPU affinity already match current process's affinity %s", targetPID, printCPUSet(currentProcessCPUs))
+	if cpus == targetProcessCPUs {
+		klog.V(5).Infof("Process[%d] CPU affinity already match expected affinity %s", targetPID, printCPUSet(cpus))
+		updateCPUAffinityMetric(process, allThreads, cpus)
 		return nil
 	}
 
+	klog.Infof("Setting CPU affinity of PID(%d) to %s, was %s", targetPID, printCPUSet(cpus), printCPUSet(targetProcessCPUs))
+	return setProcessThreadsCPUAffinity(process, targetPIDStr, func(string) (string, unix.CPUSet) { return allThreads, cpus })
+}
+
+// setProcessThreadsCPUAffinity sets the CPU affinity of each thread of the given process to the CPUs
+// returned by affinityFn given the thread name, along with the group of threads it belongs to
+func setProcessThreadsCPUAffinity(process, targetPIDStr string, affinityFn func(threadName string) (string, unix.CPUSet)) error {
+
+	targetPID, err := strconv.Atoi(targetPIDStr)
+	if err != nil {
+		return fmt.Errorf("can't convert PID[%s] to integer: %w", targetPIDStr, err)
+	}
+
 	taskIDs, err := getThreadsOfProcess(targetPID)
 	if err != nil {
 		return fmt.Errorf("can't get tasks of PID(%d):%w", targetPID, err)
 	}
 
-	klog.Infof("Setting CPU affinity of PID(%d) (ntasks=%d) to %s, was %s", targetPID, len(taskIDs), printCPUSet(currentProcessCPUs), printCPUSet(targetProcessCPUs))
+	groups := map[string]unix.CPUSet{}
 	for _, taskID := range taskIDs {
-		err = unix.SchedSetaffinity(taskID, &currentProcessCPUs)
+		// The task may have been stopped, don't break the loop and continue setting CPU affinity on other tasks.
+		taskName, err := getThreadName(targetPID, taskID)
+		if err != nil {
+			klog.V(5).Infof("Error while getting name of task(%d) PID(%d): %v", taskID, targetPID, err)
+			continue
+		}
+		group, cpus := affinityFn(taskName)
+		groups[group] = cpus
+
+		var taskCPUs unix.CPUSet
+		err = unix.SchedGetaffinity(taskID, &taskCPUs)
+		if err != nil {
+			klog.V(5).Infof("Error while getting CPU affinity of task(%d) PID(%d): %v", taskID, targetPID, err)
+			continue
+		}
+		if taskCPUs == cpus {
+			continue
+		}
+
+		klog.V(5).Infof("Setting CPU affinity of task(%d) %s PID(%d) to %s, was %s", taskID, taskName, targetPID, printCPUSet(cpus), printCPUSet(taskCPUs))
+		err = unix.SchedSetaffinity(taskID, &cpus)
 		if err != nil {
-			// The task may have been stopped, don't break the loop and continue setting CPU affinity on other tasks.
-			klog.Warningf("Error while setting CPU affinity of task(%d) PID(%d) to %s: %v", taskID, targetPID, printCPUSet(currentProcessCPUs), err)
+			klog.Warningf("Error while setting CPU affinity of task(%d) PID(%d) to %s: %v", taskID, targetPID, printCPUSet(cpus), err)
 		}
 	}
 
+	for group, cpus := range groups {
+		updateCPUAffinityMetric(process, group, cpus)
+	}
 	return nil
 }
 
@@ -251,6 +367,15 @@ func printCPUSet(cpus unix.CPUSet) string {
 	return strings.TrimRight(result.String(), ",")
 }
 
+// getThreadName returns the name of the given thread of the given process
+func getThreadName(pid, taskID int) (string, error) {
+	name, err := os.ReadFile(fmt.Sprintf("/proc/%d/task/%d/comm", pid, taskID))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(name)), nil
+}
+
 // getThreadsOfProcess returns the list of thread IDs of the given process
 func getThreadsOfProcess(pid int) ([]int, error) {
 	taskFolders, err := os.ReadDir(fmt.Sprintf("/proc/%d/task", pid))
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"runtime"
 	"sync"
 	"testing"
@@ -108,6 +109,138 @@ func TestAlignCPUAffinity(t *testing.T) {
 	assertPIDHasSchedAffinity(t, ovsDBPid, tmpCPUset)
 }
 
+func TestPinHousekeepingThreads(t *testing.T) {
+	if runtime.NumCPU() < 2 {
+		t.Skip("Test requires at least 2 CPUs")
+	}
+
+	ovsDBPid, ovsDBStop := mockOvsdbProcess(t)
+	defer ovsDBStop()
+
+	ovsVSwitchdPid, ovsVSwitchdStop := mockOvsVSwitchdProcessBinary(t)
+	defer ovsVSwitchdStop()
+
+	ovnControllerPid, ovnControllerStop := mockOvnControllerProcess(t)
+	defer ovnControllerStop()
+
+	// the fake ovs-vswitchd process names one of its threads handler1
+	var tasks []int
+	var handlerTask int
+	require.Eventually(t, func() bool {
+		var err error
+		tasks, err = getThreadsOfProcess(ovsVSwitchdPid)
+		require.NoError(t, err)
+		for _, task := range tasks {
+			if name, _ := getThreadName(ovsVSwitchdPid, task); name == "handler1" {
+				handlerTask = task
+				return true
+			}
+		}
+		return false
+	}, 5*time.Second, 100*time.Millisecond, "ovs-vswitchd fake process does not have a handler thread")
+
+	defer setTickDuration(20 * time.Millisecond)()
+	defer mockFeatureEnableFile(t, "housekeeping-cpus=0\nhousekeeping-threads=handler,ovn-controller\n")()
+
+	var initialCPUset unix.CPUSet
+	err := unix.SchedGetaffinity(os.Getpid(), &initialCPUset)
+	require.NoError(t, err)
+	defer func() {
+		err = unix.SchedSetaffinity(os.Getpid(), &initialCPUset)
+		assert.NoError(t, err)
+	}()
+
+	var sharedCPUset unix.CPUSet
+	sharedCPUset.Set(0)
+	sharedCPUset.Set(1)
+	err = unix.SchedSetaffinity(os.Getpid(), &sharedCPUset)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	stopCh := make(chan struct{})
+	defer func() {
+		close(stopCh)
+		wg.Wait()
+	}()
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		Run(stopCh)
+	}()
+
+	var housekeepingCPUset unix.CPUSet
+	housekeepingCPUset.Set(0)
+
+	assertTaskHasSchedAffinity(t, handlerTask, housekeepingCPUset)
+	for _, task := range tasks {
+		if task != handlerTask {
+			assertTaskHasSchedAffinity(t, task, sharedCPUset)
+		}
+	}
+	assertPIDHasSchedAffinity(t, ovsDBPid, sharedCPUset)
+	assertPIDHasSchedAffinity(t, ovnControllerPid, housekeepingCPUset)
+
+	// housekeeping CPUs no longer in the shared pool fall back to the shared pool
+	var newSharedCPUset unix.CPUSet
+	newSharedCPUset.Set(1)
+	err = unix.SchedSetaffinity(os.Getpid(), &newSharedCPUset)
+	require.NoError(t, err)
+
+	assertTaskHasSchedAffinity(t, handlerTask, newSharedCPUset)
+	assertPIDHasSchedAffinity(t, ovnControllerPid, newSharedCPUset)
+}
+
+func TestParsePolicy(t *testing.T) {
+	p, err := parsePolicy([]byte("1"))
+	require.NoError(t, err)
+	assert.Nil(t, p.housekeepingCPUs)
+	assert.False(t, p.pinsOvsVSwitchdThreads())
+	assert.False(t, p.pinsOvnController())
+
+	p, err = parsePolicy([]byte("# housekeeping\nhousekeeping-cpus = 0-1,4\n"))
+	require.NoError(t, err)
+	require.NotNil(t, p.housekeepingCPUs)
+	assert.Equal(t, "0-1,4", printCPUSet(*p.housekeepingCPUs))
+	assert.True(t, p.pinsOvsVSwitchdThreads())
+	assert.True(t, p.pinsOvnController())
+	assert.Equal(t, handlerThreads, p.getThreadGroup("handler12"))
+	assert.Equal(t, revalidatorThreads, p.getThreadGroup("revalidator3"))
+	assert.Equal(t, allThreads, p.getThreadGroup("pmd-c02/id:9"))
+	assert.Equal(t, allThreads, p.getThreadGroup("urcu4"))
+
+	p, err = parsePolicy([]byte("housekeeping-cpus=2\nhousekeeping-threads=pmd"))
+	require.NoError(t, err)
+	assert.Equal(t, pmdThreads, p.getThreadGroup("pmd-c02/id:9"))
+	assert.Equal(t, allThreads, p.getThreadGroup("handler12"))
+	assert.False(t, p.pinsOvnController())
+
+	_, err = parsePolicy([]byte("housekeeping-threads=handler"))
+	assert.Error(t, err)
+
+	_, err = parsePolicy([]byte("housekeeping-cpus=2\nhousekeeping-threads=urcu"))
+	assert.Error(t, err)
+
+	_, err = parsePolicy([]byte("housekeeping-cpus=3-1"))
+	assert.Error(t, err)
+}
+
+func TestGetHousekeepingCPUs(t *testing.T) {
+	shared, err := parseCPUSet("0-3")
+	require.NoError(t, err)
+
+	p := &policy{}
+	assert.Equal(t, shared, p.getHousekeepingCPUs(shared))
+
+	housekeeping, err := parseCPUSet("2-5")
+	require.NoError(t, err)
+	p.housekeepingCPUs = &housekeeping
+	assert.Equal(t, "2-3", printCPUSet(p.getHousekeepingCPUs(shared)))
+
+	housekeeping, err = parseCPUSet("4-5")
+	require.NoError(t, err)
+	assert.Equal(t, shared, p.getHousekeepingCPUs(shared))
+}
+
 func TestIsFileNotEmpty(t *testing.T) {
 	defer mockFeatureEnableFile(t, "")()
 
@@ -206,6 +339,51 @@ func mockOvsVSwitchdProcess(t *testing.T) (int, func()) {
 	}
 }
 
+// mockOvsVSwitchdProcessBinary runs the fake ovs-vswitchd process from a binary
+// instead of through `go run`, so that its own threads can be inspected
+func mockOvsVSwitchdProcessBinary(t *testing.T) (int, func()) {
+	t.Helper()
+	binary := filepath.Join(t.TempDir(), "fake_thread_process")
+	out, err := exec.Command("go", "build", "-tags", "testing_ovspinning", "-o", binary, "testdata/fake_thread_process.go").CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	ctx, stopCmd := context.WithCancel(context.Background())
+	cmd := exec.CommandContext(ctx, binary)
+	err = cmd.Start()
+	require.NoError(t, err)
+
+	previousGetter := getOvsVSwitchdPIDFn
+	getOvsVSwitchdPIDFn = func() (string, error) {
+		return fmt.Sprintf("%d", cmd.Process.Pid), nil
+	}
+
+	return cmd.Process.Pid, func() {
+		stopCmd()
+		_ = cmd.Wait()
+		getOvsVSwitchdPIDFn = previousGetter
+	}
+}
+
+func mockOvnControllerProcess(t *testing.T) (int, func()) {
+	t.Helper()
+	ctx, stopCmd := context.WithCancel(context.Background())
+
+	cmd := exec.CommandContext(ctx, "sleep", "10")
+
+	err := cmd.Start()
+	assert.NoError(t, err)
+
+	previousGetter := getOvnControllerPIDFn
+	getOvnControllerPIDFn = func() (string, error) {
+		return fmt.Sprintf("%d", cmd.Process.Pid), nil
+	}
+
+	return cmd.Process.Pid, func() {
+		stopCmd()
+		getOvnControllerPIDFn = previousGetter
+	}
+}
+
 func setTickDuration(d time.Duration) func() {
 	previousValue := tickDuration
 	tickDuration = d
@@ -253,6 +431,17 @@ func assertPIDHasSchedAffinity(t *testing.T, pid int, expectedCPUSet unix.CPUSet
 	}
 }
 
+func assertTaskHasSchedAffinity(t *testing.T, task int, expectedCPUSet unix.CPUSet) {
+	t.Helper()
+	var actual unix.CPUSet
+	assert.Eventually(t, func() bool {
+		err := unix.SchedGetaffinity(task, &actual)
+		assert.NoError(t, err)
+
+		return actual == expectedCPUSet
+	}, time.Second, 10*time.Millisecond, "task[%d] Expected CPUSet %0x != Actual CPUSet %0x", task, expectedCPUSet, actual)
+}
+
 func assertNeverPIDHasSchedAffinity(t *testing.T, pid int, targetCPUSet unix.CPUSet) {
 	t.Helper()
 	var actual unix.CPUSet
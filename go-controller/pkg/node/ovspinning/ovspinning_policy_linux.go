@@ -0,0 +1,206 @@
+//go:build linux
+// +build linux
+
+package ovspinning
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+// Keys of the pinning policy that can be set in the feature enabler file, one
+// `key=value` per line.
+const (
+	// housekeepingCPUsKey is the list of CPUs, in linux CPU list format, the
+	// housekeeping threads are pinned to
+	housekeepingCPUsKey = "housekeeping-cpus"
+	// housekeepingThreadsKey is the comma separated list of thread groups
+	// pinned to the housekeeping CPUs
+	housekeepingThreadsKey = "housekeeping-threads"
+)
+
+// Groups of threads that can be pinned to the housekeeping CPUs. All other
+// threads are aligned to the CPU affinity of the current process.
+const (
+	handlerThreads       = "handler"
+	revalidatorThreads   = "revalidator"
+	pmdThreads           = "pmd"
+	ovnControllerThreads = "ovn-controller"
+
+	// allThreads labels the threads not pinned to the housekeeping CPUs
+	allThreads = "all"
+)
+
+var ovsVSwitchdThreadGroups = []string{handlerThreads, revalidatorThreads, pmdThreads}
+
+var defaultHousekeepingThreads = []string{handlerThreads, revalidatorThreads, ovnControllerThreads}
+
+// policy defines the CPU affinity the OVS and OVN daemon threads are set to
+type policy struct {
+	// housekeepingCPUs the housekeepingThreads are pinned to, nil if not
+	// configured in which case all threads follow the CPU affinity of the
+	// current process
+	housekeepingCPUs *unix.CPUSet
+	// housekeepingThreads is the set of thread groups pinned to the
+	// housekeepingCPUs
+	housekeepingThreads sets.Set[string]
+}
+
+// readPolicy returns whether the feature is enabled, which is the case if the
+// enabler file is not empty, and the policy parsed from its contents. Any
+// content that is not a policy `key=value` line, like the legacy "1", is
+// ignored.
+func readPolicy(filename string) (bool, *policy, error) {
+	isFeatureEnabled, err := isFileNotEmpty(filename)
+	if err != nil || !isFeatureEnabled {
+		return false, nil, err
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return false, nil, fmt.Errorf("can't read file [%s]: %w", filename, err)
+	}
+
+	p, err := parsePolicy(data)
+	if err != nil {
+		return true, &policy{}, fmt.Errorf("invalid CPU pinning policy in [%s], aligning all threads to current process: %w", filename, err)
+	}
+	return true, p, nil
+}
+
+func parsePolicy(data []byte) (*policy, error) {
+	p := &policy{}
+	var threads []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		key, value, found := strings.Cut(line, "=")
+		if !found || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		switch key {
+		case housekeepingCPUsKey:
+			cpus, err := parseCPUSet(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s %q: %w", housekeepingCPUsKey, value, err)
+			}
+			p.housekeepingCPUs = &cpus
+		case housekeepingThreadsKey:
+			threads = strings.Split(value, ",")
+		default:
+			klog.Warningf("Ignoring unknown CPU pinning policy key %q", key)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if p.housekeepingCPUs == nil {
+		if len(threads) > 0 {
+			return nil, fmt.Errorf("%s requires %s", housekeepingThreadsKey, housekeepingCPUsKey)
+		}
+		return p, nil
+	}
+
+	if len(threads) == 0 {
+		threads = defaultHousekeepingThreads
+	}
+	p.housekeepingThreads = sets.New[string]()
+	for _, thread := range threads {
+		thread = strings.TrimSpace(thread)
+		switch thread {
+		case handlerThreads, revalidatorThreads, pmdThreads, ovnControllerThreads:
+			p.housekeepingThreads.Insert(thread)
+		default:
+			return nil, fmt.Errorf("invalid %s %q", housekeepingThreadsKey, thread)
+		}
+	}
+	return p, nil
+}
+
+// getHousekeepingCPUs returns the housekeeping CPUs that are part of the given
+// shared CPUs, which follow the changes made by the kubelet CPU manager as CPUs
+// are allocated exclusively to pods. Falls back to the shared CPUs if none of
+// the housekeeping CPUs are available.
+func (p *policy) getHousekeepingCPUs(shared unix.CPUSet) unix.CPUSet {
+	if p.housekeepingCPUs == nil {
+		return shared
+	}
+	var cpus unix.CPUSet
+	for i := 0; i < len(cpus)*64; i++ {
+		if p.housekeepingCPUs.IsSet(i) && shared.IsSet(i) {
+			cpus.Set(i)
+		}
+	}
+	if cpus.Count() == 0 {
+		klog.V(5).Infof("None of the housekeeping CPUs %s are available in %s", printCPUSet(*p.housekeepingCPUs), printCPUSet(shared))
+		return shared
+	}
+	return cpus
+}
+
+// getThreadGroup returns the group of a thread of ovs-vswitchd given its name
+// if that group is pinned to the housekeeping CPUs, allThreads otherwise. OVS
+// names its threads after their group, e.g. handler12, revalidator3 or
+// pmd-c02/id:9
+func (p *policy) getThreadGroup(threadName string) string {
+	for _, group := range ovsVSwitchdThreadGroups {
+		if p.housekeepingThreads.Has(group) && strings.HasPrefix(threadName, group) {
+			return group
+		}
+	}
+	return allThreads
+}
+
+// pinsOvsVSwitchdThreads returns whether any ovs-vswitchd thread is pinned to
+// the housekeeping CPUs
+func (p *policy) pinsOvsVSwitchdThreads() bool {
+	return p.housekeepingThreads.HasAny(ovsVSwitchdThreadGroups...)
+}
+
+// pinsOvnController returns whether ovn-controller is pinned to the
+// housekeeping CPUs
+func (p *policy) pinsOvnController() bool {
+	return p.housekeepingThreads.Has(ovnControllerThreads)
+}
+
+// parseCPUSet parses a CPU list in canonical linux CPU list format.
+// e.g. 0-5,8,10,12-13
+//
+// See http://man7.org/linux/man-pages/man7/cpuset.7.html#FORMATS
+func parseCPUSet(cpuList string) (unix.CPUSet, error) {
+	var cpus unix.CPUSet
+	maxCPUs := len(cpus) * 64
+	for _, r := range strings.Split(cpuList, ",") {
+		startStr, endStr, isRange := strings.Cut(strings.TrimSpace(r), "-")
+		start, err := strconv.Atoi(startStr)
+		if err != nil {
+			return cpus, fmt.Errorf("invalid CPU %q: %w", startStr, err)
+		}
+		end := start
+		if isRange {
+			end, err = strconv.Atoi(endStr)
+			if err != nil {
+				return cpus, fmt.Errorf("invalid CPU %q: %w", endStr, err)
+			}
+		}
+		if start < 0 || end < start || end >= maxCPUs {
+			return cpus, fmt.Errorf("invalid CPU range %q", r)
+		}
+		for i := start; i <= end; i++ {
+			cpus.Set(i)
+		}
+	}
+	return cpus, nil
+}
@@ -8,9 +8,18 @@ package main
 // spawns a pool of thread by default
 
 import (
+	"os"
+	"runtime"
 	"time"
 )
 
 func main() {
+	// name a dedicated thread like an ovs-vswitchd handler thread
+	go func() {
+		runtime.LockOSThread()
+		_ = os.WriteFile("/proc/thread-self/comm", []byte("handler1"), 0)
+		time.Sleep(100 * time.Second)
+	}()
+
 	time.Sleep(100 * time.Second)
 }
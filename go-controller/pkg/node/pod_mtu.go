@@ -0,0 +1,115 @@
+package node
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/vishvananda/netlink"
+
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	utilerrors "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/errors"
+)
+
+// podNetNSDir is where the container runtimes bind mount the network
+// namespaces of the pods
+var podNetNSDir = "/var/run/netns"
+
+// updatePodInterfacesMTU sets the given MTU on the interfaces the pods running
+// on the node have on the given network, as the MTU of a network can be
+// updated in place: on their OVS interfaces and, for veth pairs, on the pod
+// side interface as well.
+func updatePodInterfacesMTU(netName string, mtu int) error {
+	stdout, stderr, err := util.RunOVSVsctl("--no-heading", "--format=csv", "--data=bare", "--columns=name",
+		"find", "interface", fmt.Sprintf("external_ids:%s=%s", types.NetworkExternalID, netName))
+	if err != nil {
+		return fmt.Errorf("failed to list the pod interfaces of network %s, stdout: %q, stderr: %q, error: %w",
+			netName, stdout, stderr, err)
+	}
+	if stdout == "" {
+		return nil
+	}
+
+	var netNSPaths map[int]string
+	var errs []error
+	for _, ifName := range strings.Split(stdout, "\n") {
+		ifName = strings.TrimSpace(ifName)
+		if ifName == "" {
+			continue
+		}
+		stdout, stderr, err = util.RunOVSVsctl("set", "interface", ifName, fmt.Sprintf("mtu_request=%d", mtu))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to set MTU on interface %s, stdout: %q, stderr: %q, error: %w",
+				ifName, stdout, stderr, err))
+			continue
+		}
+		link, err := util.GetNetLinkOps().LinkByName(ifName)
+		if err != nil {
+			if util.GetNetLinkOps().IsLinkNotFoundError(err) {
+				continue
+			}
+			errs = append(errs, fmt.Errorf("failed to get link %s: %w", ifName, err))
+			continue
+		}
+		if link.Type() != "veth" {
+			// the pod side of VFs and representors is configured by the device
+			// plugins
+			continue
+		}
+		if netNSPaths == nil {
+			netNSPaths = getNetNSPathsByID()
+		}
+		netNSPath, ok := netNSPaths[link.Attrs().NetNsID]
+		if !ok {
+			errs = append(errs, fmt.Errorf("failed to find the network namespace of the peer of interface %s", ifName))
+			continue
+		}
+		if err := setPeerLinkMTU(netNSPath, link.Attrs().ParentIndex, mtu); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set MTU on the peer of interface %s: %w", ifName, err))
+		}
+	}
+	return utilerrors.Join(errs...)
+}
+
+// getNetNSPathsByID returns the paths of the pod network namespaces indexed
+// by their ID in the current network namespace
+func getNetNSPathsByID() map[int]string {
+	netNSPaths := map[int]string{}
+	entries, err := os.ReadDir(podNetNSDir)
+	if err != nil {
+		klog.Warningf("Failed to read the pod network namespaces from %s: %v", podNetNSDir, err)
+		return netNSPaths
+	}
+	for _, entry := range entries {
+		path := filepath.Join(podNetNSDir, entry.Name())
+		netNS, err := ns.GetNS(path)
+		if err != nil {
+			continue
+		}
+		id, err := netlink.GetNetNsIdByFd(int(netNS.Fd()))
+		netNS.Close()
+		if err != nil || id < 0 {
+			continue
+		}
+		netNSPaths[id] = path
+	}
+	return netNSPaths
+}
+
+func setPeerLinkMTU(netNSPath string, peerIndex, mtu int) error {
+	return ns.WithNetNSPath(netNSPath, func(ns.NetNS) error {
+		peer, err := util.GetNetLinkOps().LinkByIndex(peerIndex)
+		if err != nil {
+			return err
+		}
+		if peer.Attrs().MTU == mtu {
+			return nil
+		}
+		return util.GetNetLinkOps().LinkSetMTU(peer, mtu)
+	})
+}
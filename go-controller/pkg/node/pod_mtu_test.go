@@ -0,0 +1,108 @@
+package node
+
+import (
+	"path/filepath"
+
+	"github.com/containernetworking/plugins/pkg/ip"
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/containernetworking/plugins/pkg/testutils"
+	"github.com/vishvananda/netlink"
+
+	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pod interfaces MTU", func() {
+	const findPodInterfacesCmd = "ovs-vsctl --timeout=15 --no-heading --format=csv --data=bare --columns=name find interface external_ids:k8s.ovn.org/network=blue"
+
+	var (
+		fexec           *ovntest.FakeExec
+		hostNS, podNS   ns.NetNS
+		origPodNetNSDir string
+	)
+
+	BeforeEach(func() {
+		if ovntest.NoRoot() {
+			Skip("Test requires root privileges")
+		}
+		fexec = ovntest.NewFakeExec()
+		Expect(util.SetExec(fexec)).To(Succeed())
+
+		var err error
+		hostNS, err = testutils.NewNS()
+		Expect(err).NotTo(HaveOccurred())
+		podNS, err = testutils.NewNS()
+		Expect(err).NotTo(HaveOccurred())
+		origPodNetNSDir = podNetNSDir
+		podNetNSDir = filepath.Dir(podNS.Path())
+
+		err = podNS.Do(func(ns.NetNS) error {
+			_, _, err := ip.SetupVethWithName("eth0", "blue-pod", 1400, "", hostNS)
+			return err
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		if hostNS == nil {
+			return
+		}
+		podNetNSDir = origPodNetNSDir
+		Expect(podNS.Close()).To(Succeed())
+		Expect(testutils.UnmountNS(podNS)).To(Succeed())
+		Expect(hostNS.Close()).To(Succeed())
+		Expect(testutils.UnmountNS(hostNS)).To(Succeed())
+		hostNS = nil
+	})
+
+	linkMTU := func(netNS ns.NetNS, name string) int {
+		var mtu int
+		err := netNS.Do(func(ns.NetNS) error {
+			link, err := netlink.LinkByName(name)
+			if err != nil {
+				return err
+			}
+			mtu = link.Attrs().MTU
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return mtu
+	}
+
+	It("sets the MTU on the OVS interfaces and the pod side of the veth pairs", func() {
+		fexec.AddFakeCmd(&ovntest.ExpectedCmd{
+			Cmd:    findPodInterfacesCmd,
+			Output: "blue-pod\n",
+		})
+		fexec.AddFakeCmdsNoOutputNoError([]string{
+			"ovs-vsctl --timeout=15 set interface blue-pod mtu_request=1300",
+		})
+
+		err := hostNS.Do(func(ns.NetNS) error {
+			return updatePodInterfacesMTU("blue", 1300)
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fexec.CalledMatchesExpected()).To(BeTrue(), fexec.ErrorDesc)
+		Expect(linkMTU(podNS, "eth0")).To(Equal(1300))
+	})
+
+	It("skips the OVS interfaces without a link", func() {
+		fexec.AddFakeCmd(&ovntest.ExpectedCmd{
+			Cmd:    findPodInterfacesCmd,
+			Output: "gone-pod\n",
+		})
+		fexec.AddFakeCmdsNoOutputNoError([]string{
+			"ovs-vsctl --timeout=15 set interface gone-pod mtu_request=1300",
+		})
+
+		err := hostNS.Do(func(ns.NetNS) error {
+			return updatePodInterfacesMTU("blue", 1300)
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fexec.CalledMatchesExpected()).To(BeTrue(), fexec.ErrorDesc)
+		Expect(linkMTU(podNS, "eth0")).To(Equal(1400))
+	})
+})
@@ -96,6 +96,28 @@ func (c *Controller) Del(r netlink.Route) error {
 	return c.delRoute(&r)
 }
 
+// AddNextHops submits a request to add the next hops of the provided route to
+// the managed route with the same priority, prefix and table tuple, creating
+// it if it does not exist yet. The next hops can be provided either through
+// MultiPath or through the LinkIndex, Gw and Via attributes of the route. Any
+// other attribute of an already managed route, like its metrics, is preserved.
+// The resulting route is replaced in the kernel with a single operation such
+// that traffic through the existing next hops is not disrupted.
+func (c *Controller) AddNextHops(r netlink.Route) error {
+	c.Lock()
+	defer c.Unlock()
+	return c.addNextHops(&r)
+}
+
+// DelNextHops submits a request to remove the next hops of the provided route
+// from the managed route with the same priority, prefix and table tuple. The
+// route is deleted once it has no next hops left.
+func (c *Controller) DelNextHops(r netlink.Route) error {
+	c.Lock()
+	defer c.Unlock()
+	return c.delNextHops(&r)
+}
+
 // addRoute attempts to add the route and returns with error
 // if it fails to do so.
 func (c *Controller) addRoute(r *netlink.Route) error {
@@ -138,6 +160,69 @@ func (c *Controller) delRoute(r *netlink.Route) error {
 	return nil
 }
 
+// addNextHops attempts to add the next hops of the route to the managed route
+// and returns with error if it fails to do so.
+func (c *Controller) addNextHops(r *netlink.Route) error {
+	r, err := validateAndNormalizeRoute(r)
+	if err != nil {
+		return err
+	}
+	add := nextHopsFromRoute(r)
+	if len(add) == 0 {
+		return fmt.Errorf("no next hops provided for route %s", r)
+	}
+	o := c.store[keyFromNetlink(r)]
+	if o == nil {
+		return c.addRoute(routeWithNextHops(r, add))
+	}
+	nextHops := nextHopsFromRoute(o)
+	for _, nh := range add {
+		i := slices.IndexFunc(nextHops, func(e *netlink.NexthopInfo) bool { return isSameNextHop(nh, e) })
+		if i < 0 {
+			nextHops = append(nextHops, nh)
+			continue
+		}
+		nextHops[i] = nh
+	}
+	return c.replaceRoute(o, routeWithNextHops(o, nextHops))
+}
+
+// delNextHops attempts to remove the next hops of the route from the managed
+// route and returns with error if it fails to do so.
+func (c *Controller) delNextHops(r *netlink.Route) error {
+	r, err := validateAndNormalizeRoute(r)
+	if err != nil {
+		return err
+	}
+	o := c.store[keyFromNetlink(r)]
+	if o == nil {
+		// not managed - nothing to do
+		return nil
+	}
+	del := nextHopsFromRoute(r)
+	nextHops := slices.DeleteFunc(nextHopsFromRoute(o), func(e *netlink.NexthopInfo) bool {
+		return slices.ContainsFunc(del, func(nh *netlink.NexthopInfo) bool { return isSameNextHop(nh, e) })
+	})
+	if len(nextHops) == 0 {
+		return c.delRoute(o)
+	}
+	return c.replaceRoute(o, routeWithNextHops(o, nextHops))
+}
+
+// replaceRoute replaces the managed route o with r, which must have the same
+// key. Must be called with the controller locked
+func (c *Controller) replaceRoute(o, r *netlink.Route) error {
+	if util.RouteEqual(o, r) {
+		return nil
+	}
+	err := c.netlinkAddRoute(r)
+	if err != nil {
+		return err
+	}
+	c.addRouteToStore(r)
+	return nil
+}
+
 // processNetlinkEvent will check if a deleted route is managed by route manager and if so, determine if a sync is needed
 // to restore any managed routes.
 func (c *Controller) processNetlinkEvent(ru netlink.RouteUpdate) error {
@@ -280,6 +365,67 @@ func (c *Controller) sync() {
 	}
 }
 
+// nextHopsFromRoute returns copies of the next hops of a route, either from
+// its MultiPath or from its own LinkIndex, Gw and Via attributes
+func nextHopsFromRoute(r *netlink.Route) []*netlink.NexthopInfo {
+	if len(r.MultiPath) > 0 {
+		nextHops := make([]*netlink.NexthopInfo, 0, len(r.MultiPath))
+		for _, nh := range r.MultiPath {
+			if nh == nil {
+				continue
+			}
+			nh := *nh
+			nextHops = append(nextHops, &nh)
+		}
+		return nextHops
+	}
+	if r.LinkIndex == 0 && r.Gw == nil && r.Via == nil {
+		return nil
+	}
+	return []*netlink.NexthopInfo{{
+		LinkIndex: r.LinkIndex,
+		Gw:        r.Gw,
+		Via:       r.Via,
+		Flags:     r.Flags,
+		NewDst:    r.NewDst,
+		Encap:     r.Encap,
+	}}
+}
+
+// routeWithNextHops returns a copy of the route with the provided next hops.
+// The kernel reports a multipath route with a single next hop as a route
+// without MultiPath, so in that case the next hop attributes are set on the
+// route itself for it to be compared correctly when syncing.
+func routeWithNextHops(r *netlink.Route, nextHops []*netlink.NexthopInfo) *netlink.Route {
+	route := *r
+	route.MultiPath = nil
+	route.LinkIndex = 0
+	route.Gw = nil
+	route.Via = nil
+	route.Flags = 0
+	route.NewDst = nil
+	route.Encap = nil
+	if len(nextHops) == 1 {
+		route.LinkIndex = nextHops[0].LinkIndex
+		route.Gw = nextHops[0].Gw
+		route.Via = nextHops[0].Via
+		route.Flags = nextHops[0].Flags
+		route.NewDst = nextHops[0].NewDst
+		route.Encap = nextHops[0].Encap
+		return &route
+	}
+	route.MultiPath = nextHops
+	return &route
+}
+
+// isSameNextHop returns whether both next hops go through the same link and
+// gateway, regardless of other attributes like their weight
+func isSameNextHop(l, r *netlink.NexthopInfo) bool {
+	return l.LinkIndex == r.LinkIndex &&
+		l.Gw.Equal(r.Gw) &&
+		(l.Via == r.Via || (l.Via != nil && r.Via != nil && l.Via.Equal(r.Via)))
+}
+
 func subscribeNetlinkRouteEvents(stopCh <-chan struct{}) (bool, chan netlink.RouteUpdate) {
 	routeEventCh := make(chan netlink.RouteUpdate, 20)
 	if err := netlink.RouteSubscribe(routeEventCh, stopCh); err != nil {
@@ -320,12 +466,7 @@ func routePartiallyEqualWantedToExisting(w, e *netlink.Route) bool {
 		equalOrLeftZero(w.Scope, e.Scope, z.Scope) &&
 		equalOrLeftZeroFunc(func(l, r net.IP) bool { return l.Equal(r) }, w.Src, e.Src, z.Src) &&
 		equalOrLeftZeroFunc(func(l, r net.IP) bool { return l.Equal(r) }, w.Gw, e.Gw, z.Gw) &&
-		equalOrLeftZeroFunc(
-			func(l, r []*netlink.NexthopInfo) bool {
-				return slices.EqualFunc(l, r,
-					func(l, r *netlink.NexthopInfo) bool { return l == r || (l != nil && r != nil && l.Equal(*r)) },
-				)
-			}, w.MultiPath, e.MultiPath, z.MultiPath) &&
+		equalOrLeftZeroFunc(nextHopsPartiallyEqualWantedToExisting, w.MultiPath, e.MultiPath, z.MultiPath) &&
 		equalOrLeftZero(w.Protocol, e.Protocol, z.Protocol) &&
 		equalOrLeftZero(w.Family, e.Family, z.Family) &&
 		equalOrLeftZero(w.Type, e.Type, z.Type) &&
@@ -354,6 +495,43 @@ func routePartiallyEqualWantedToExisting(w, e *netlink.Route) bool {
 		equalOrLeftZero(w.FastOpenNoCookie, e.FastOpenNoCookie, z.FastOpenNoCookie)
 }
 
+// nextHopsPartiallyEqualWantedToExisting compares the wanted next hops with the
+// existing next hops regardless of their order, with the same semantics as
+// routePartiallyEqualWantedToExisting for each individual next hop.
+func nextHopsPartiallyEqualWantedToExisting(w, e []*netlink.NexthopInfo) bool {
+	if len(w) != len(e) {
+		return false
+	}
+	var z netlink.NexthopInfo
+	nextHopEqual := func(w, e *netlink.NexthopInfo) bool {
+		if w == nil || e == nil {
+			return w == e
+		}
+		return equalOrLeftZero(w.LinkIndex, e.LinkIndex, z.LinkIndex) &&
+			equalOrLeftZero(w.Hops, e.Hops, z.Hops) &&
+			equalOrLeftZeroFunc(func(l, r net.IP) bool { return l.Equal(r) }, w.Gw, e.Gw, z.Gw) &&
+			equalOrLeftZero(w.Flags, e.Flags, z.Flags) &&
+			equalOrLeftZeroFunc(func(l, r netlink.Destination) bool { return l == r || (l != nil && r != nil && l.Equal(r)) }, w.NewDst, e.NewDst, z.NewDst) &&
+			equalOrLeftZeroFunc(func(l, r netlink.Encap) bool { return l == r || (l != nil && r != nil && l.Equal(r)) }, w.Encap, e.Encap, z.Encap) &&
+			equalOrLeftZeroFunc(func(l, r netlink.Destination) bool { return l == r || (l != nil && r != nil && l.Equal(r)) }, w.Via, e.Via, z.Via)
+	}
+	matched := make([]bool, len(e))
+	for _, wnh := range w {
+		found := false
+		for i, enh := range e {
+			if !matched[i] && nextHopEqual(wnh, enh) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 func isRouteNotFoundError(err error) bool {
 	return strings.Contains(err.Error(), "no such process")
 }
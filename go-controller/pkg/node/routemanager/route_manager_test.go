@@ -45,6 +45,7 @@ var _ = ginkgo.Describe("Route Manager", func() {
 	loIP := net.IPv4(127, 1, 1, 1)
 	loIPDiff := net.IPv4(127, 1, 1, 2)
 	loGWIP := net.IPv4(127, 1, 1, 254)
+	loAltGWIP := net.IPv4(127, 1, 1, 253)
 	customTableID := 1005
 	if ovntest.NoRoot() {
 		defer ginkgo.GinkgoRecover()
@@ -274,6 +275,78 @@ var _ = ginkgo.Describe("Route Manager", func() {
 		})
 	})
 
+	ginkgo.Context("multipath route", func() {
+		ginkgo.It("adds and removes next hops without replacing the others", func() {
+			idx := loLink.Attrs().Index
+			nh1 := &netlink.NexthopInfo{LinkIndex: idx, Gw: loGWIP}
+			nh2 := &netlink.NexthopInfo{LinkIndex: idx, Gw: loAltGWIP}
+			r := netlink.Route{Dst: altSubnet, Table: customTableID, MultiPath: []*netlink.NexthopInfo{nh1}}
+			gomega.Expect(addNextHopsViaManager(rm, testNS, r)).Should(gomega.Succeed())
+			gomega.Eventually(func() bool {
+				return isRouteInTable(testNS, netlink.Route{LinkIndex: idx, Dst: altSubnet, Gw: loGWIP, Table: customTableID}, idx, customTableID)
+			}, time.Second).Should(gomega.BeTrue())
+
+			r.MultiPath = []*netlink.NexthopInfo{nh2}
+			gomega.Expect(addNextHopsViaManager(rm, testNS, r)).Should(gomega.Succeed())
+			multipath := netlink.Route{Dst: altSubnet, Table: customTableID, MultiPath: []*netlink.NexthopInfo{nh2, nh1}}
+			gomega.Eventually(func() bool {
+				return isMultipathRouteInTable(testNS, multipath, customTableID)
+			}, time.Second).Should(gomega.BeTrue())
+
+			r.MultiPath = []*netlink.NexthopInfo{nh1}
+			gomega.Expect(delNextHopsViaManager(rm, testNS, r)).Should(gomega.Succeed())
+			gomega.Eventually(func() bool {
+				return isRouteInTable(testNS, netlink.Route{LinkIndex: idx, Dst: altSubnet, Gw: loAltGWIP, Table: customTableID}, idx, customTableID)
+			}, time.Second).Should(gomega.BeTrue())
+
+			r.MultiPath = []*netlink.NexthopInfo{nh2}
+			gomega.Expect(delNextHopsViaManager(rm, testNS, r)).Should(gomega.Succeed())
+			gomega.Eventually(func() bool {
+				return isMultipathRouteInTable(testNS, netlink.Route{Dst: altSubnet, Table: customTableID}, customTableID)
+			}, time.Second).Should(gomega.BeFalse())
+		})
+
+		ginkgo.It("preserves the metrics of the managed route", func() {
+			idx := loLink.Attrs().Index
+			r := netlink.Route{LinkIndex: idx, Dst: altSubnet, Gw: loGWIP, MTU: loAlternativeMTU, Table: mainTableID, Type: unix.RTN_UNICAST}
+			gomega.Expect(addRouteViaManager(rm, testNS, r)).Should(gomega.Succeed())
+			gomega.Expect(addNextHopsViaManager(rm, testNS, netlink.Route{LinkIndex: idx, Dst: altSubnet, Gw: loAltGWIP})).Should(gomega.Succeed())
+			multipath := netlink.Route{
+				Dst:   altSubnet,
+				MTU:   loAlternativeMTU,
+				Table: mainTableID,
+				Type:  unix.RTN_UNICAST,
+				MultiPath: []*netlink.NexthopInfo{
+					{LinkIndex: idx, Gw: loGWIP},
+					{LinkIndex: idx, Gw: loAltGWIP},
+				},
+			}
+			gomega.Eventually(func() bool {
+				return isMultipathRouteInTable(testNS, multipath, mainTableID)
+			}, time.Second).Should(gomega.BeTrue())
+		})
+
+		ginkgo.It("reapplies managed multipath route that was removed", func() {
+			idx := loLink.Attrs().Index
+			r := netlink.Route{
+				Dst:   altSubnet,
+				Table: customTableID,
+				MultiPath: []*netlink.NexthopInfo{
+					{LinkIndex: idx, Gw: loGWIP},
+					{LinkIndex: idx, Gw: loAltGWIP},
+				},
+			}
+			gomega.Expect(addNextHopsViaManager(rm, testNS, r)).Should(gomega.Succeed())
+			gomega.Eventually(func() bool {
+				return isMultipathRouteInTable(testNS, r, customTableID)
+			}, time.Second).Should(gomega.BeTrue())
+			gomega.Expect(deleteRoutes(testNS, r)).Should(gomega.Succeed())
+			gomega.Eventually(func() bool {
+				return isMultipathRouteInTable(testNS, r, customTableID)
+			}, time.Second).Should(gomega.BeTrue())
+		})
+	})
+
 	ginkgo.Context("runtime sync", func() {
 		ginkgo.It("reapplies managed route that was removed (gw IP, mtu, src IP)", func() {
 			r := netlink.Route{LinkIndex: loLink.Attrs().Index, Gw: loGWIP, Dst: loSubnet, MTU: loMTU, Src: loIP, Table: mainTableID, Type: unix.RTN_UNICAST}
@@ -420,6 +493,33 @@ var _ = ginkgo.Describe("Route Manager", func() {
 	})
 })
 
+var _ = ginkgo.Describe("Route Manager", func() {
+	ginkgo.It("compares next hops regardless of their order", func() {
+		nh1 := &netlink.NexthopInfo{LinkIndex: 1, Gw: ovntest.MustParseIP("10.0.0.1")}
+		nh2 := &netlink.NexthopInfo{LinkIndex: 1, Gw: ovntest.MustParseIP("10.0.0.2")}
+		nh2Weighted := &netlink.NexthopInfo{LinkIndex: 1, Gw: ovntest.MustParseIP("10.0.0.2"), Hops: 2}
+		gomega.Expect(nextHopsPartiallyEqualWantedToExisting([]*netlink.NexthopInfo{nh1, nh2}, []*netlink.NexthopInfo{nh2, nh1})).To(gomega.BeTrue())
+		gomega.Expect(nextHopsPartiallyEqualWantedToExisting([]*netlink.NexthopInfo{nh1, nh2}, []*netlink.NexthopInfo{nh1, nh2Weighted})).To(gomega.BeTrue())
+		gomega.Expect(nextHopsPartiallyEqualWantedToExisting([]*netlink.NexthopInfo{nh1, nh2Weighted}, []*netlink.NexthopInfo{nh1, nh2})).To(gomega.BeFalse())
+		gomega.Expect(nextHopsPartiallyEqualWantedToExisting([]*netlink.NexthopInfo{nh1, nh1}, []*netlink.NexthopInfo{nh1, nh2})).To(gomega.BeFalse())
+		gomega.Expect(nextHopsPartiallyEqualWantedToExisting([]*netlink.NexthopInfo{nh1}, []*netlink.NexthopInfo{nh1, nh2})).To(gomega.BeFalse())
+	})
+
+	ginkgo.It("keeps a single next hop as a regular route", func() {
+		r := netlink.Route{
+			Dst:       ovntest.MustParseIPNet("10.1.0.0/16"),
+			MTU:       1400,
+			MultiPath: []*netlink.NexthopInfo{{LinkIndex: 1, Gw: ovntest.MustParseIP("10.0.0.1")}},
+		}
+		single := routeWithNextHops(&r, nextHopsFromRoute(&r))
+		gomega.Expect(single.MultiPath).To(gomega.BeNil())
+		gomega.Expect(single.LinkIndex).To(gomega.Equal(1))
+		gomega.Expect(single.Gw).To(gomega.Equal(ovntest.MustParseIP("10.0.0.1")))
+		gomega.Expect(single.MTU).To(gomega.Equal(1400))
+		gomega.Expect(nextHopsFromRoute(single)).To(gomega.Equal(r.MultiPath))
+	})
+})
+
 func addRouteViaManager(rm *Controller, targetNS ns.NetNS, r netlink.Route) error {
 	return targetNS.Do(func(ns.NetNS) error { return rm.Add(r) })
 }
@@ -427,6 +527,13 @@ func delRouteViaManager(rm *Controller, targetNS ns.NetNS, r netlink.Route) erro
 	return targetNS.Do(func(ns.NetNS) error { return rm.Del(r) })
 }
 
+func addNextHopsViaManager(rm *Controller, targetNS ns.NetNS, r netlink.Route) error {
+	return targetNS.Do(func(ns.NetNS) error { return rm.AddNextHops(r) })
+}
+func delNextHopsViaManager(rm *Controller, targetNS ns.NetNS, r netlink.Route) error {
+	return targetNS.Do(func(ns.NetNS) error { return rm.DelNextHops(r) })
+}
+
 func addRoute(targetNS ns.NetNS, r netlink.Route) error {
 	return targetNS.Do(func(ns.NetNS) error {
 		return netlink.RouteAdd(&r)
@@ -480,6 +587,26 @@ func isRoutesInTable(targetNs ns.NetNS, expectedRoutes []netlink.Route, linkInde
 	return true
 }
 
+// isMultipathRouteInTable ensures the expected route is present within a table
+// regardless of the links it goes through
+func isMultipathRouteInTable(targetNs ns.NetNS, expectedRoute netlink.Route, table int) bool {
+	var existingRoutes []netlink.Route
+	err := targetNs.Do(func(ns.NetNS) error {
+		var err error
+		existingRoutes, err = netlink.RouteListFiltered(getIPFamily(expectedRoute.Dst.IP), &netlink.Route{Table: table}, netlink.RT_FILTER_TABLE)
+		return err
+	})
+	if err != nil {
+		panic(err.Error())
+	}
+	for _, existingRoute := range existingRoutes {
+		if routePartiallyEqualWantedToExisting(&expectedRoute, &existingRoute) {
+			return true
+		}
+	}
+	return false
+}
+
 func getRouteList(targetNs ns.NetNS, link netlink.Link, ipFamily int) ([]netlink.Route, error) {
 	routesFound := make([]netlink.Route, 0)
 	var err error
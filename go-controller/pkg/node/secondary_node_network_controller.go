@@ -3,6 +3,7 @@ package node
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"sync"
 
 	"k8s.io/klog/v2"
@@ -103,7 +104,8 @@ func (nc *SecondaryNodeNetworkController) Cleanup() error {
 func (nc *SecondaryNodeNetworkController) shouldReconcileNetworkChange(old, new util.NetInfo) bool {
 	wasUDNNetworkAdvertisedAtNode := util.IsPodNetworkAdvertisedAtNode(old, nc.name)
 	isUDNNetworkAdvertisedAtNode := util.IsPodNetworkAdvertisedAtNode(new, nc.name)
-	return wasUDNNetworkAdvertisedAtNode != isUDNNetworkAdvertisedAtNode
+	return wasUDNNetworkAdvertisedAtNode != isUDNNetworkAdvertisedAtNode || old.GetEVPNVNI() != new.GetEVPNVNI() ||
+		old.MTU() != new.MTU() || !reflect.DeepEqual(old.Subnets(), new.Subnets())
 }
 
 // Reconcile function reconciles three entities based on whether UDN network is advertised
@@ -112,6 +114,7 @@ func (nc *SecondaryNodeNetworkController) shouldReconcileNetworkChange(old, new
 // 2. OpenFlows on br-ex bridge to forward traffic to correct ofports
 func (nc *SecondaryNodeNetworkController) Reconcile(netInfo util.NetInfo) error {
 	reconcilePodNetwork := nc.shouldReconcileNetworkChange(nc.ReconcilableNetInfo, netInfo)
+	reconcilePodMTU := nc.MTU() != netInfo.MTU()
 
 	err := util.ReconcileNetInfo(nc.ReconcilableNetInfo, netInfo)
 	if err != nil {
@@ -124,5 +127,11 @@ func (nc *SecondaryNodeNetworkController) Reconcile(netInfo util.NetInfo) error
 		}
 	}
 
+	if reconcilePodMTU && config.OvnKubeNode.Mode != types.NodeModeDPUHost {
+		if err := updatePodInterfacesMTU(nc.GetNetworkName(), nc.MTU()); err != nil {
+			klog.Errorf("Failed to update the MTU of the pods of network %s: %v", nc.GetNetworkName(), err)
+		}
+	}
+
 	return nil
 }
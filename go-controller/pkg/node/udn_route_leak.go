@@ -0,0 +1,351 @@
+package node
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	udnv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	udninformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/informers/externalversions/userdefinednetwork/v1"
+	udnlister "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/listers/userdefinednetwork/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/iprulemanager"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/vrfmanager"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	utilerrors "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/errors"
+)
+
+const (
+	// UDNRouteLeakIPRulePriority is the priority of the ip routing rules used to
+	// leak routes between the default network and the VRF of a user defined
+	// network. It has to be lower than the priority of the l3mdev rule (1000)
+	// so that it is evaluated before the VRF table lookup.
+	UDNRouteLeakIPRulePriority = 995
+	// udnRouteLeakIPRuleMetadata is the metadata of the ip rules managed by
+	// the route leak manager
+	udnRouteLeakIPRuleMetadata = "udn-route-leak"
+	// udnRouteLeakKey is used to request a reconciliation of all route leaks
+	udnRouteLeakKey = "udn-route-leak"
+)
+
+// UDNRouteLeakManager leaks routes into the VRF of primary cluster user
+// defined networks as requested through the routeImports field of
+// ClusterUserDefinedNetworks.
+//
+// Prefixes imported from another user defined network are leaked with routes
+// in the VRF table pointing to the VRF device of the source network. Leaking
+// is one-directional: the subnets of the importing network are not leaked back
+// into the source network, which has to import them as well for the return
+// traffic to be routed. The default network does not have a VRF device and
+// can't import routes, so prefixes imported from it are leaked with ip rules
+// that lookup the main table for traffic from the importing network subnets
+// towards those prefixes, and the VRF table for the return traffic.
+//
+// Prefixes imported from more than one network are ambiguous and are not
+// leaked at all. The route leaks are only programmed in the host VRFs, OVN is
+// left untouched.
+type UDNRouteLeakManager struct {
+	sync.Mutex
+	cudnLister     udnlister.ClusterUserDefinedNetworkLister
+	networkManager networkmanager.Interface
+	vrfManager     *vrfmanager.Controller
+	ruleManager    *iprulemanager.Controller
+	controller     controller.Controller
+
+	// getVRF returns the interface index and routing table of a VRF device
+	getVRF func(name string) (int, int, error)
+
+	// routes leaked into each VRF
+	routes map[string][]netlink.Route
+	// rules leaking routes from the default network
+	rules []netlink.Rule
+}
+
+// NewUDNRouteLeakManager returns a new UDNRouteLeakManager
+func NewUDNRouteLeakManager(cudnInformer udninformer.ClusterUserDefinedNetworkInformer, networkManager networkmanager.Interface,
+	vrfManager *vrfmanager.Controller, ruleManager *iprulemanager.Controller) *UDNRouteLeakManager {
+	m := &UDNRouteLeakManager{
+		cudnLister:     cudnInformer.Lister(),
+		networkManager: networkManager,
+		vrfManager:     vrfManager,
+		ruleManager:    ruleManager,
+		getVRF:         getVRFIndexAndTable,
+		routes:         map[string][]netlink.Route{},
+	}
+	controllerConfig := &controller.ControllerConfig[udnv1.ClusterUserDefinedNetwork]{
+		RateLimiter:    workqueue.NewTypedItemFastSlowRateLimiter[string](time.Second, 5*time.Second, 5),
+		Informer:       cudnInformer.Informer(),
+		Lister:         cudnInformer.Lister().List,
+		ObjNeedsUpdate: routeImportsNeedUpdate,
+		Reconcile:      m.reconcile,
+		Threadiness:    1,
+	}
+	m.controller = controller.NewController[udnv1.ClusterUserDefinedNetwork]("udn-route-leak-manager", controllerConfig)
+	return m
+}
+
+// Start the manager, the VRF and rule managers are expected to be running
+func (m *UDNRouteLeakManager) Start() error {
+	klog.Infof("Starting UDN route leak manager")
+	return controller.Start(m.controller)
+}
+
+// Stop the manager
+func (m *UDNRouteLeakManager) Stop() {
+	controller.Stop(m.controller)
+}
+
+// Reconcile requests route leaks to be reconciled, to be called when networks
+// change
+func (m *UDNRouteLeakManager) Reconcile() {
+	m.controller.Reconcile(udnRouteLeakKey)
+}
+
+func routeImportsNeedUpdate(oldObj, newObj *udnv1.ClusterUserDefinedNetwork) bool {
+	if oldObj == nil {
+		return len(newObj.Spec.RouteImports) > 0
+	}
+	return !reflect.DeepEqual(oldObj.Spec.RouteImports, newObj.Spec.RouteImports)
+}
+
+// reconcile computes route leaks for all networks as a whole, regardless of
+// the key, since leaks of different networks might install the same routes.
+func (m *UDNRouteLeakManager) reconcile(string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	cudns, err := m.cudnLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list cluster user defined networks: %w", err)
+	}
+
+	routes, rules, computeErr := m.computeRouteLeaks(cudns)
+
+	var errs []error
+	if computeErr != nil {
+		errs = append(errs, computeErr)
+	}
+	if err := m.syncRoutes(routes); err != nil {
+		errs = append(errs, err)
+	}
+	if err := m.syncRules(rules); err != nil {
+		errs = append(errs, err)
+	}
+	return utilerrors.Join(errs...)
+}
+
+// computeRouteLeaks returns the routes to leak per VRF and the rules to leak
+// from the default network. Invalid route imports are logged and ignored while
+// errors are returned for networks that are not yet ready on this node.
+func (m *UDNRouteLeakManager) computeRouteLeaks(cudns []*udnv1.ClusterUserDefinedNetwork) (map[string][]netlink.Route, []netlink.Rule, error) {
+	routes := map[string][]netlink.Route{}
+	var rules []netlink.Rule
+	var errs []error
+
+	sort.Slice(cudns, func(i, j int) bool { return cudns[i].Name < cudns[j].Name })
+	for _, cudn := range cudns {
+		if len(cudn.Spec.RouteImports) == 0 {
+			continue
+		}
+		network, vrf, _, vrfTable, err := m.getNetworkVRF(cudn.Name)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to import routes into network of %s: %w", cudn.Name, err))
+			continue
+		}
+		subnets := make([]*net.IPNet, 0, len(network.Subnets()))
+		for _, subnet := range network.Subnets() {
+			subnets = append(subnets, subnet.CIDR)
+		}
+
+		conflicts := util.GetRouteImportConflicts(cudn.Spec.RouteImports)
+		for prefix, networks := range conflicts {
+			klog.Warningf("Ignoring prefix %s imported into network of %s from more than one network: %v", prefix, cudn.Name, networks)
+		}
+
+		for _, routeImport := range cudn.Spec.RouteImports {
+			prefixes, err := parseRouteImportPrefixes(routeImport.Prefixes, subnets)
+			if err != nil {
+				klog.Warningf("Ignoring route import from %s into network of %s: %v", routeImport.Network, cudn.Name, err)
+				continue
+			}
+			prefixes = slices.DeleteFunc(prefixes, func(prefix *net.IPNet) bool {
+				_, conflict := conflicts[prefix.String()]
+				return conflict
+			})
+			if len(prefixes) == 0 {
+				continue
+			}
+
+			if routeImport.Network == types.DefaultNetworkName {
+				for _, prefix := range prefixes {
+					for _, subnet := range subnets {
+						if utilnet.IsIPv6CIDR(prefix) != utilnet.IsIPv6CIDR(subnet) {
+							continue
+						}
+						rules = append(rules,
+							generateIPRuleForRouteLeak(subnet, prefix, unix.RT_TABLE_MAIN),
+							generateIPRuleForRouteLeak(prefix, subnet, vrfTable),
+						)
+					}
+				}
+				continue
+			}
+
+			if routeImport.Network == cudn.Name {
+				klog.Warningf("Ignoring route import into network of %s from itself", cudn.Name)
+				continue
+			}
+			_, _, srcVRFIndex, _, err := m.getNetworkVRF(routeImport.Network)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to import routes from network of %s into network of %s: %w",
+					routeImport.Network, cudn.Name, err))
+				continue
+			}
+			for _, prefix := range prefixes {
+				routes[vrf] = append(routes[vrf], netlink.Route{
+					Dst:       prefix,
+					LinkIndex: srcVRFIndex,
+					Table:     vrfTable,
+				})
+			}
+		}
+	}
+
+	return routes, rules, utilerrors.Join(errs...)
+}
+
+// getNetworkVRF returns the network of a cluster user defined network along
+// with the name, interface index and routing table of its VRF
+func (m *UDNRouteLeakManager) getNetworkVRF(cudnName string) (util.NetInfo, string, int, int, error) {
+	networkName := util.GenerateCUDNNetworkName(cudnName)
+	network := m.networkManager.GetNetwork(networkName)
+	if network == nil {
+		return nil, "", 0, 0, fmt.Errorf("network %s not found", networkName)
+	}
+	if !network.IsPrimaryNetwork() {
+		return nil, "", 0, 0, fmt.Errorf("network %s is not a primary network", networkName)
+	}
+	vrf := util.GetNetworkVRFName(network)
+	index, table, err := m.getVRF(vrf)
+	if err != nil {
+		return nil, "", 0, 0, fmt.Errorf("failed to get VRF %s of network %s: %w", vrf, networkName, err)
+	}
+	return network, vrf, index, table, nil
+}
+
+func (m *UDNRouteLeakManager) syncRoutes(routes map[string][]netlink.Route) error {
+	var errs []error
+	for vrf, vrfRoutes := range routes {
+		if err := m.vrfManager.AddVRFRoutes(vrf, vrfRoutes); err != nil {
+			errs = append(errs, fmt.Errorf("failed to add leaked routes to VRF %s: %w", vrf, err))
+		}
+	}
+
+	for vrf, vrfRoutes := range m.routes {
+		wanted := sets.New[string]()
+		for _, route := range routes[vrf] {
+			wanted.Insert(route.String())
+		}
+		var stale []netlink.Route
+		for _, route := range vrfRoutes {
+			if !wanted.Has(route.String()) {
+				stale = append(stale, route)
+			}
+		}
+		if len(stale) == 0 {
+			continue
+		}
+		if _, _, err := m.getVRF(vrf); err != nil {
+			// the VRF is gone and so are its routes
+			continue
+		}
+		if err := m.vrfManager.DeleteVRFRoutes(vrf, stale); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete leaked routes from VRF %s: %w", vrf, err))
+			// keep track of all of them so that we retry
+			routes[vrf] = append(routes[vrf], stale...)
+		}
+	}
+
+	m.routes = routes
+	return utilerrors.Join(errs...)
+}
+
+func (m *UDNRouteLeakManager) syncRules(rules []netlink.Rule) error {
+	var errs []error
+	wanted := sets.New[string]()
+	for _, rule := range rules {
+		wanted.Insert(rule.String())
+		if err := m.ruleManager.AddWithMetadata(rule, udnRouteLeakIPRuleMetadata); err != nil {
+			errs = append(errs, fmt.Errorf("failed to add route leak ip rule %s: %w", rule.String(), err))
+		}
+	}
+
+	for _, rule := range m.rules {
+		if wanted.Has(rule.String()) {
+			continue
+		}
+		if err := m.ruleManager.Delete(rule); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete route leak ip rule %s: %w", rule.String(), err))
+			// keep track of it so that we retry
+			rules = append(rules, rule)
+		}
+	}
+
+	m.rules = rules
+	return utilerrors.Join(errs...)
+}
+
+func parseRouteImportPrefixes(cidrs []udnv1.CIDR, subnets []*net.IPNet) ([]*net.IPNet, error) {
+	prefixes := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, prefix, err := net.ParseCIDR(string(cidr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid prefix %s: %w", cidr, err)
+		}
+		if overlaps := util.IPNetOverlaps(prefix, subnets...); len(overlaps) > 0 {
+			return nil, fmt.Errorf("prefix %s overlaps with network subnet %s", prefix, overlaps[0])
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	return prefixes, nil
+}
+
+func generateIPRuleForRouteLeak(src, dst *net.IPNet, table int) netlink.Rule {
+	r := *netlink.NewRule()
+	r.Table = table
+	r.Priority = UDNRouteLeakIPRulePriority
+	r.Family = netlink.FAMILY_V4
+	if utilnet.IsIPv6CIDR(dst) {
+		r.Family = netlink.FAMILY_V6
+	}
+	r.Src = src
+	r.Dst = dst
+	return r
+}
+
+func getVRFIndexAndTable(name string) (int, int, error) {
+	link, err := util.GetNetLinkOps().LinkByName(name)
+	if err != nil {
+		return 0, 0, err
+	}
+	vrf, ok := link.(*netlink.Vrf)
+	if !ok {
+		return 0, 0, fmt.Errorf("link %s is not a VRF device", name)
+	}
+	return vrf.Attrs().Index, int(vrf.Table), nil
+}
@@ -0,0 +1,354 @@
+package node
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/containernetworking/plugins/pkg/ip"
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/containernetworking/plugins/pkg/testutils"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	udnv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	udnlister "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/listers/userdefinednetwork/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/routemanager"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/node/vrfmanager"
+	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
+	testnm "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/networkmanager"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UDN route leak manager", func() {
+	type vrfInfo struct {
+		index int
+		table int
+	}
+	var (
+		m    *UDNRouteLeakManager
+		vrfs map[string]vrfInfo
+	)
+
+	newPrimaryNetwork := func(cudnName, subnets string) util.NetInfo {
+		netName := util.GenerateCUDNNetworkName(cudnName)
+		nad := ovntest.GenerateNAD(netName, cudnName, "ns-"+cudnName, types.Layer3Topology, subnets, types.NetworkRolePrimary)
+		netInfo, err := util.ParseNADInfo(nad)
+		Expect(err).NotTo(HaveOccurred())
+		return netInfo
+	}
+
+	newCUDN := func(name string, imports ...udnv1.RouteImport) *udnv1.ClusterUserDefinedNetwork {
+		return &udnv1.ClusterUserDefinedNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       udnv1.ClusterUserDefinedNetworkSpec{RouteImports: imports},
+		}
+	}
+
+	BeforeEach(func() {
+		Expect(config.PrepareTestConfig()).To(Succeed())
+		config.IPv4Mode = true
+		vrfs = map[string]vrfInfo{
+			"blue":   {index: 10, table: 1010},
+			"shared": {index: 11, table: 1011},
+		}
+		m = &UDNRouteLeakManager{
+			networkManager: &testnm.FakeNetworkManager{
+				PrimaryNetworks: map[string]util.NetInfo{
+					"ns-blue":   newPrimaryNetwork("blue", "10.10.0.0/16/24"),
+					"ns-shared": newPrimaryNetwork("shared", "10.20.0.0/16/24"),
+				},
+			},
+			getVRF: func(name string) (int, int, error) {
+				vrf, ok := vrfs[name]
+				if !ok {
+					return 0, 0, fmt.Errorf("VRF %s not found", name)
+				}
+				return vrf.index, vrf.table, nil
+			},
+			routes: map[string][]netlink.Route{},
+		}
+	})
+
+	It("leaks routes between user defined network VRFs in one direction", func() {
+		cudns := []*udnv1.ClusterUserDefinedNetwork{
+			newCUDN("blue", udnv1.RouteImport{Network: "shared", Prefixes: []udnv1.CIDR{"10.20.5.0/24"}}),
+			newCUDN("shared"),
+		}
+		routes, rules, err := m.computeRouteLeaks(cudns)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rules).To(BeEmpty())
+		Expect(routes).To(HaveLen(1))
+		Expect(routes["blue"]).To(ConsistOf(netlink.Route{
+			Dst:       ovntest.MustParseIPNet("10.20.5.0/24"),
+			LinkIndex: 11,
+			Table:     1010,
+		}))
+	})
+
+	It("leaks routes between user defined network VRFs in both directions when both import", func() {
+		cudns := []*udnv1.ClusterUserDefinedNetwork{
+			newCUDN("blue", udnv1.RouteImport{Network: "shared", Prefixes: []udnv1.CIDR{"10.20.5.0/24"}}),
+			newCUDN("shared", udnv1.RouteImport{Network: "blue", Prefixes: []udnv1.CIDR{"10.10.0.0/16"}}),
+		}
+		routes, rules, err := m.computeRouteLeaks(cudns)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rules).To(BeEmpty())
+		Expect(routes).To(HaveLen(2))
+		Expect(routes["blue"]).To(ConsistOf(netlink.Route{
+			Dst:       ovntest.MustParseIPNet("10.20.5.0/24"),
+			LinkIndex: 11,
+			Table:     1010,
+		}))
+		Expect(routes["shared"]).To(ConsistOf(netlink.Route{
+			Dst:       ovntest.MustParseIPNet("10.10.0.0/16"),
+			LinkIndex: 10,
+			Table:     1011,
+		}))
+	})
+
+	It("ignores prefixes imported from more than one network", func() {
+		vrfs["red"] = vrfInfo{index: 12, table: 1012}
+		m.networkManager.(*testnm.FakeNetworkManager).PrimaryNetworks["ns-red"] = newPrimaryNetwork("red", "10.30.0.0/16/24")
+		cudns := []*udnv1.ClusterUserDefinedNetwork{
+			newCUDN("blue",
+				udnv1.RouteImport{Network: "shared", Prefixes: []udnv1.CIDR{"10.50.0.0/24", "10.20.5.0/24"}},
+				udnv1.RouteImport{Network: "red", Prefixes: []udnv1.CIDR{"10.50.0.1/24"}},
+				udnv1.RouteImport{Network: types.DefaultNetworkName, Prefixes: []udnv1.CIDR{"10.50.0.0/24"}},
+			),
+		}
+		routes, rules, err := m.computeRouteLeaks(cudns)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rules).To(BeEmpty())
+		Expect(routes).To(HaveLen(1))
+		Expect(routes["blue"]).To(ConsistOf(netlink.Route{
+			Dst:       ovntest.MustParseIPNet("10.20.5.0/24"),
+			LinkIndex: 11,
+			Table:     1010,
+		}))
+	})
+
+	It("leaks routes from the default network with ip rules", func() {
+		cudns := []*udnv1.ClusterUserDefinedNetwork{
+			newCUDN("blue", udnv1.RouteImport{Network: types.DefaultNetworkName, Prefixes: []udnv1.CIDR{"172.30.0.10/32"}}),
+		}
+		routes, rules, err := m.computeRouteLeaks(cudns)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(routes).To(BeEmpty())
+		Expect(rules).To(ConsistOf(
+			generateIPRuleForRouteLeak(ovntest.MustParseIPNet("10.10.0.0/16"), ovntest.MustParseIPNet("172.30.0.10/32"), unix.RT_TABLE_MAIN),
+			generateIPRuleForRouteLeak(ovntest.MustParseIPNet("172.30.0.10/32"), ovntest.MustParseIPNet("10.10.0.0/16"), 1010),
+		))
+		Expect(rules[0].Priority).To(Equal(UDNRouteLeakIPRulePriority))
+	})
+
+	It("ignores prefixes overlapping with the importing network", func() {
+		cudns := []*udnv1.ClusterUserDefinedNetwork{
+			newCUDN("blue", udnv1.RouteImport{Network: "shared", Prefixes: []udnv1.CIDR{"10.10.1.0/24"}}),
+		}
+		routes, rules, err := m.computeRouteLeaks(cudns)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(routes).To(BeEmpty())
+		Expect(rules).To(BeEmpty())
+	})
+
+	It("fails for networks not ready on the node", func() {
+		delete(vrfs, "shared")
+		cudns := []*udnv1.ClusterUserDefinedNetwork{
+			newCUDN("blue",
+				udnv1.RouteImport{Network: "shared", Prefixes: []udnv1.CIDR{"10.20.5.0/24"}},
+				udnv1.RouteImport{Network: "unknown", Prefixes: []udnv1.CIDR{"10.30.5.0/24"}},
+				udnv1.RouteImport{Network: types.DefaultNetworkName, Prefixes: []udnv1.CIDR{"172.30.0.10/32"}},
+			),
+		}
+		routes, rules, err := m.computeRouteLeaks(cudns)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("VRF shared not found"))
+		Expect(err.Error()).To(ContainSubstring("network cluster_udn_unknown is not a primary network"))
+		Expect(routes).To(BeEmpty())
+		// imports that can be computed are still processed
+		Expect(rules).To(HaveLen(2))
+	})
+})
+
+var _ = Describe("UDN route leak manager with network namespaces", func() {
+	type podNetwork struct {
+		cudn     string
+		subnets  string
+		table    uint32
+		hostVeth string
+		gwIP     string
+		podIP    string
+		podNS    ns.NetNS
+	}
+	var (
+		hostNS      ns.NetNS
+		networks    []*podNetwork
+		m           *UDNRouteLeakManager
+		cudnIndexer cache.Indexer
+	)
+
+	newPrimaryNetwork := func(cudnName, subnets string) util.NetInfo {
+		netName := util.GenerateCUDNNetworkName(cudnName)
+		nad := ovntest.GenerateNAD(netName, cudnName, "ns-"+cudnName, types.Layer3Topology, subnets, types.NetworkRolePrimary)
+		netInfo, err := util.ParseNADInfo(nad)
+		Expect(err).NotTo(HaveOccurred())
+		return netInfo
+	}
+
+	BeforeEach(func() {
+		if ovntest.NoRoot() {
+			Skip("Test requires root privileges")
+		}
+		Expect(config.PrepareTestConfig()).To(Succeed())
+		config.IPv4Mode = true
+
+		var err error
+		hostNS, err = testutils.NewNS()
+		Expect(err).NotTo(HaveOccurred())
+		err = hostNS.Do(func(ns.NetNS) error {
+			vrf := &netlink.Vrf{LinkAttrs: netlink.LinkAttrs{Name: "vrf-probe"}, Table: 4242}
+			if err := netlink.LinkAdd(vrf); err != nil {
+				return err
+			}
+			return netlink.LinkDel(vrf)
+		})
+		if err != nil {
+			Skip("Test requires VRF devices: " + err.Error())
+		}
+
+		networks = []*podNetwork{
+			{cudn: "blue", subnets: "10.10.0.0/16/24", table: 1010, hostVeth: "blue-mp", gwIP: "10.10.0.1/24", podIP: "10.10.0.5/24"},
+			{cudn: "shared", subnets: "10.20.0.0/16/24", table: 1011, hostVeth: "shared-mp", gwIP: "10.20.5.1/24", podIP: "10.20.5.5/24"},
+		}
+		vrfManager := vrfmanager.NewController(routemanager.NewController())
+		primaryNetworks := map[string]util.NetInfo{}
+		for _, network := range networks {
+			primaryNetworks["ns-"+network.cudn] = newPrimaryNetwork(network.cudn, network.subnets)
+			network.podNS, err = testutils.NewNS()
+			Expect(err).NotTo(HaveOccurred())
+			// the pod is wired to the host through a veth enslaved to the
+			// VRF of its network, standing for the management port
+			err = network.podNS.Do(func(ns.NetNS) error {
+				defer GinkgoRecover()
+				_, _, err := ip.SetupVethWithName("eth0", network.hostVeth, 1400, "", hostNS)
+				Expect(err).NotTo(HaveOccurred())
+				link, err := netlink.LinkByName("eth0")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(netlink.AddrAdd(link, &netlink.Addr{IPNet: ovntest.MustParseIPNet(network.podIP)})).To(Succeed())
+				gwIP, _, err := net.ParseCIDR(network.gwIP)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(netlink.RouteAdd(&netlink.Route{Gw: gwIP, LinkIndex: link.Attrs().Index})).To(Succeed())
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			err = hostNS.Do(func(ns.NetNS) error {
+				defer GinkgoRecover()
+				Expect(vrfManager.AddVRF(network.cudn, network.hostVeth, network.table, nil)).To(Succeed())
+				link, err := netlink.LinkByName(network.hostVeth)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(netlink.AddrAdd(link, &netlink.Addr{IPNet: ovntest.MustParseIPNet(network.gwIP)})).To(Succeed())
+				Expect(netlink.LinkSetUp(link)).To(Succeed())
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}
+		err = hostNS.Do(func(ns.NetNS) error {
+			return ip.EnableIP4Forward()
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		cudnIndexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		m = &UDNRouteLeakManager{
+			cudnLister:     udnlister.NewClusterUserDefinedNetworkLister(cudnIndexer),
+			networkManager: &testnm.FakeNetworkManager{PrimaryNetworks: primaryNetworks},
+			vrfManager:     vrfManager,
+			getVRF:         getVRFIndexAndTable,
+			routes:         map[string][]netlink.Route{},
+		}
+	})
+
+	AfterEach(func() {
+		for _, network := range networks {
+			if network.podNS == nil {
+				continue
+			}
+			Expect(network.podNS.Close()).To(Succeed())
+			Expect(testutils.UnmountNS(network.podNS)).To(Succeed())
+		}
+		networks = nil
+		if hostNS != nil {
+			Expect(hostNS.Close()).To(Succeed())
+			Expect(testutils.UnmountNS(hostNS)).To(Succeed())
+			hostNS = nil
+		}
+	})
+
+	setRouteImports := func(cudnName string, imports ...udnv1.RouteImport) {
+		cudn := &udnv1.ClusterUserDefinedNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: cudnName},
+			Spec:       udnv1.ClusterUserDefinedNetworkSpec{RouteImports: imports},
+		}
+		Expect(cudnIndexer.Update(cudn)).To(Succeed())
+		err := hostNS.Do(func(ns.NetNS) error {
+			return m.reconcile(udnRouteLeakKey)
+		})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	// connect opens a TCP connection from the pod of the network at index
+	// from to the pod of the network at index to
+	connect := func(from, to int) error {
+		podIP, _, err := net.ParseCIDR(networks[to].podIP)
+		Expect(err).NotTo(HaveOccurred())
+		var listener net.Listener
+		err = networks[to].podNS.Do(func(ns.NetNS) error {
+			var err error
+			listener, err = net.Listen("tcp", net.JoinHostPort(podIP.String(), "8080"))
+			return err
+		})
+		Expect(err).NotTo(HaveOccurred())
+		defer listener.Close()
+		go func() {
+			conn, err := listener.Accept()
+			if err == nil {
+				conn.Close()
+			}
+		}()
+		return networks[from].podNS.Do(func(ns.NetNS) error {
+			conn, err := net.DialTimeout("tcp", listener.Addr().String(), time.Second)
+			if err != nil {
+				return err
+			}
+			return conn.Close()
+		})
+	}
+
+	ovntest.OnSupportedPlatformsIt("leaks routes between pods of user defined networks once both networks import them", func() {
+		By("not connecting isolated networks")
+		Expect(connect(0, 1)).NotTo(Succeed())
+
+		By("not connecting the pods when only one network imports the other")
+		setRouteImports("blue", udnv1.RouteImport{Network: "shared", Prefixes: []udnv1.CIDR{"10.20.5.0/24"}})
+		Expect(connect(0, 1)).NotTo(Succeed())
+		Expect(connect(1, 0)).NotTo(Succeed())
+
+		By("connecting the pods when both networks import each other")
+		setRouteImports("shared", udnv1.RouteImport{Network: "blue", Prefixes: []udnv1.CIDR{"10.10.0.0/24"}})
+		Expect(connect(0, 1)).To(Succeed())
+		Expect(connect(1, 0)).To(Succeed())
+
+		By("not connecting the pods when a network stops importing the other")
+		setRouteImports("blue")
+		Expect(connect(0, 1)).NotTo(Succeed())
+		Expect(connect(1, 0)).NotTo(Succeed())
+	})
+})
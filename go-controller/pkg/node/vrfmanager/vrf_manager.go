@@ -2,7 +2,9 @@ package vrfmanager
 
 import (
 	"fmt"
+	"net"
 	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -22,6 +24,15 @@ import (
 // extended by another 60 seconds.
 var reconcilePeriod = 60 * time.Second
 
+const (
+	// vxlanPort is the IANA assigned VXLAN UDP port
+	vxlanPort = 4789
+	// bridgeDevicePrefix and vxlanDevicePrefix prefix the names of the bridge
+	// and VXLAN devices a VRF is wired to for EVPN, followed by the VNI
+	bridgeDevicePrefix = "ovnbr"
+	vxlanDevicePrefix  = "ovnvx"
+)
+
 type vrf struct {
 	name  string
 	table uint32
@@ -29,6 +40,30 @@ type vrf struct {
 	// It cannot be changed after VRF creation.
 	managedSlave string
 	routes       []netlink.Route
+	// vxlan is the VXLAN device configuration this VRF is wired to, if any
+	vxlan *vrfVXLAN
+}
+
+// vrfVXLAN is the configuration of the bridge and VXLAN devices a VRF is wired
+// to so that it can be stretched through EVPN route type-5 with an L3 VNI
+type vrfVXLAN struct {
+	vni     uint32
+	localIP net.IP
+}
+
+// vrfRouteKey identifies a route managed for a VRF
+type vrfRouteKey struct {
+	LinkIndex int
+	Dst       string
+	Table     int
+}
+
+func newVRFRouteKey(r netlink.Route) vrfRouteKey {
+	return vrfRouteKey{
+		LinkIndex: r.LinkIndex,
+		Dst:       r.Dst.String(),
+		Table:     r.Table,
+	}
 }
 
 type Controller struct {
@@ -227,6 +262,11 @@ func (vrfm *Controller) sync(vrf vrf) error {
 			}
 		}
 	}
+	if vrf.vxlan != nil {
+		if err = syncVXLAN(vrfLink, vrf.vxlan); err != nil {
+			return fmt.Errorf("failed to sync VXLAN for VRF device %s, err: %w", vrf.name, err)
+		}
+	}
 	// Handover vrf routes into route manager to manage it.
 	for _, route := range vrf.routes {
 		if err = vrfm.routeManager.Add(route); err != nil {
@@ -265,12 +305,12 @@ func (vrfm *Controller) AddVRF(name string, slaveInterface string, table uint32,
 				return fmt.Errorf("VRF Manager: table id mismatch for VRF device %s", name)
 			}
 		} else {
-			vrfDev = vrf{name, table, slaveInterface, routes}
+			vrfDev = vrf{name: name, table: table, managedSlave: slaveInterface, routes: routes}
 		}
 	}
 
 	if err != nil && util.GetNetLinkOps().IsLinkNotFoundError(err) {
-		vrfDev = vrf{name, table, slaveInterface, routes}
+		vrfDev = vrf{name: name, table: table, managedSlave: slaveInterface, routes: routes}
 	} else if err != nil {
 		return fmt.Errorf("failed to retrieve VRF device %s, err: %v", name, err)
 	}
@@ -293,7 +333,21 @@ func (vrfm *Controller) AddVRFRoutes(name string, routes []netlink.Route) error
 		return fmt.Errorf("failed to find VRF %s", name)
 	}
 
-	vrfDev.routes = append(vrfDev.routes, routes...)
+	existingRoutes := map[vrfRouteKey]int{}
+	for i, r := range vrfDev.routes {
+		existingRoutes[newVRFRouteKey(r)] = i
+	}
+	for _, r := range routes {
+		// avoid growing the managed routes when the same routes are re-added
+		// on reconciliation, replace them instead as other attributes like
+		// the MTU might have changed
+		if i, ok := existingRoutes[newVRFRouteKey(r)]; ok {
+			vrfDev.routes[i] = r
+			continue
+		}
+		existingRoutes[newVRFRouteKey(r)] = len(vrfDev.routes)
+		vrfDev.routes = append(vrfDev.routes, r)
+	}
 
 	return vrfm.sync(vrfDev)
 }
@@ -312,30 +366,16 @@ func (vrfm *Controller) DeleteVRFRoutes(name string, routes []netlink.Route) err
 	if !ok {
 		return fmt.Errorf("failed to find VRF %s", name)
 	}
-	type route struct {
-		LinkIndex int
-		Dst       string
-		Table     int
-	}
-	deleteRoutesRequested := sets.New[route]()
+	deleteRoutesRequested := sets.New[vrfRouteKey]()
 	for _, r := range routes {
-		deleteRoutesRequested.Insert(route{
-			LinkIndex: r.LinkIndex,
-			Dst:       r.Dst.String(),
-			Table:     r.Table,
-		})
+		deleteRoutesRequested.Insert(newVRFRouteKey(r))
 	}
 
 	vrf.routes = slices.DeleteFunc(vrf.routes, func(r netlink.Route) bool {
 		if err != nil {
 			return false
 		}
-		delete := deleteRoutesRequested.Has(
-			route{
-				LinkIndex: r.LinkIndex,
-				Dst:       r.Dst.String(),
-				Table:     r.Table,
-			})
+		delete := deleteRoutesRequested.Has(newVRFRouteKey(r))
 		if !delete {
 			return false
 		}
@@ -346,6 +386,59 @@ func (vrfm *Controller) DeleteVRFRoutes(name string, routes []netlink.Route) err
 	return err
 }
 
+// AddVRFVXLAN wires a VRF to a VXLAN device with the provided L3 VNI and local
+// tunnel IP through a bridge device, as required to advertise the VRF through
+// EVPN route type-5.
+func (vrfm *Controller) AddVRFVXLAN(name string, vni uint32, localIP net.IP) error {
+	vrfm.mu.Lock()
+	defer vrfm.mu.Unlock()
+
+	vrfLink, err := util.GetNetLinkOps().LinkByName(name)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve VRF device %s, err: %v", name, err)
+	}
+
+	vrfDev, ok := vrfm.vrfs[vrfLink.Attrs().Index]
+	if !ok {
+		return fmt.Errorf("failed to find VRF %s", name)
+	}
+
+	if vrfDev.vxlan != nil && vrfDev.vxlan.vni != vni {
+		if err = deleteVXLAN(vrfDev.vxlan.vni); err != nil {
+			return fmt.Errorf("failed to delete VXLAN with VNI %d for VRF device %s, err: %w", vrfDev.vxlan.vni, name, err)
+		}
+	}
+	vrfDev.vxlan = &vrfVXLAN{vni: vni, localIP: localIP}
+
+	return vrfm.sync(vrfDev)
+}
+
+// DeleteVRFVXLAN deletes the bridge and VXLAN devices a VRF is wired to, if
+// any.
+func (vrfm *Controller) DeleteVRFVXLAN(name string) error {
+	vrfm.mu.Lock()
+	defer vrfm.mu.Unlock()
+
+	vrfLink, err := util.GetNetLinkOps().LinkByName(name)
+	if util.GetNetLinkOps().IsLinkNotFoundError(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to retrieve VRF device %s, err: %v", name, err)
+	}
+
+	vrfDev, ok := vrfm.vrfs[vrfLink.Attrs().Index]
+	if !ok || vrfDev.vxlan == nil {
+		return nil
+	}
+
+	if err = deleteVXLAN(vrfDev.vxlan.vni); err != nil {
+		return fmt.Errorf("failed to delete VXLAN with VNI %d for VRF device %s, err: %w", vrfDev.vxlan.vni, name, err)
+	}
+	vrfDev.vxlan = nil
+	vrfm.vrfs[vrfLink.Attrs().Index] = vrfDev
+	return nil
+}
+
 // Repair deletes stale VRF device(s) on the host. This helps remove
 // device(s) for which DeleteVRF is never invoked.
 func (vrfm *Controller) Repair(validVRFs sets.Set[string]) error {
@@ -361,7 +454,23 @@ func (vrfm *Controller) repair(validVRFs sets.Set[string]) error {
 		return fmt.Errorf("failed to list links on the node, err: %v", err)
 	}
 
+	validVXLANDevices := sets.New[string]()
+	for _, vrf := range vrfm.vrfs {
+		if vrf.vxlan != nil && validVRFs.Has(vrf.name) {
+			bridgeName, vxlanName := getVXLANDeviceNames(vrf.vxlan.vni)
+			validVXLANDevices.Insert(bridgeName, vxlanName)
+		}
+	}
+
 	for _, link := range links {
+		if isVXLANDevice(link) && !validVXLANDevices.Has(link.Attrs().Name) {
+			err = util.GetNetLinkOps().LinkDelete(link)
+			if err != nil {
+				klog.Errorf("VRF Manager: error deleting stale VXLAN device %s, err: %v", link.Attrs().Name, err)
+			}
+			continue
+		}
+
 		vrf, isVRF := link.(*netlink.Vrf)
 		if !isVRF {
 			// not a vrf device
@@ -414,6 +523,12 @@ func (vrfm *Controller) DeleteVRF(name string) (err error) {
 		}
 	}
 
+	if vrf.vxlan != nil {
+		if err = deleteVXLAN(vrf.vxlan.vni); err != nil {
+			return fmt.Errorf("failed to delete VXLAN with VNI %d for VRF device %s, err: %w", vrf.vxlan.vni, vrf.name, err)
+		}
+	}
+
 	err = vrfm.deleteVRF(vrfLink)
 	if err != nil {
 		return fmt.Errorf("failed to delete VRF device %s, err: %w", vrf.name, err)
@@ -455,3 +570,117 @@ func enslaveInterfaceToVRF(vrfName, ifName string) error {
 	}
 	return nil
 }
+
+// getVXLANDeviceNames returns the names of the bridge and VXLAN devices for
+// the provided VNI
+func getVXLANDeviceNames(vni uint32) (string, string) {
+	return fmt.Sprintf("%s%d", bridgeDevicePrefix, vni), fmt.Sprintf("%s%d", vxlanDevicePrefix, vni)
+}
+
+// isVXLANDevice returns whether the link is a bridge or VXLAN device managed
+// for a VRF
+func isVXLANDevice(link netlink.Link) bool {
+	switch link.(type) {
+	case *netlink.Bridge:
+		return strings.HasPrefix(link.Attrs().Name, bridgeDevicePrefix)
+	case *netlink.Vxlan:
+		return strings.HasPrefix(link.Attrs().Name, vxlanDevicePrefix)
+	}
+	return false
+}
+
+// syncVXLAN ensures that the bridge device exists and is enslaved to the VRF
+// and that the VXLAN device exists and is enslaved to the bridge, as required
+// by FRR to map the VRF to its L3 VNI
+func syncVXLAN(vrfLink netlink.Link, vxlan *vrfVXLAN) error {
+	bridgeName, vxlanName := getVXLANDeviceNames(vxlan.vni)
+	bridgeLink, err := ensureLink(
+		&netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: bridgeName}},
+		func(link netlink.Link) bool {
+			_, isBridge := link.(*netlink.Bridge)
+			return isBridge
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if bridgeLink.Attrs().MasterIndex != vrfLink.Attrs().Index {
+		if err = util.GetNetLinkOps().LinkSetMaster(bridgeLink, vrfLink); err != nil {
+			return fmt.Errorf("failed to enslave bridge device %s to VRF device %s, err: %w", bridgeName, vrfLink.Attrs().Name, err)
+		}
+	}
+
+	vxlanLink, err := ensureLink(
+		&netlink.Vxlan{
+			LinkAttrs: netlink.LinkAttrs{Name: vxlanName},
+			VxlanId:   int(vxlan.vni),
+			SrcAddr:   vxlan.localIP,
+			Port:      vxlanPort,
+			Learning:  false,
+		},
+		func(link netlink.Link) bool {
+			vxlanDev, isVXLAN := link.(*netlink.Vxlan)
+			return isVXLAN && vxlanDev.VxlanId == int(vxlan.vni) && vxlanDev.SrcAddr.Equal(vxlan.localIP) && vxlanDev.Port == vxlanPort
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if vxlanLink.Attrs().MasterIndex != bridgeLink.Attrs().Index {
+		if err = util.GetNetLinkOps().LinkSetMaster(vxlanLink, bridgeLink); err != nil {
+			return fmt.Errorf("failed to enslave VXLAN device %s to bridge device %s, err: %w", vxlanName, bridgeName, err)
+		}
+	}
+
+	for _, link := range []netlink.Link{bridgeLink, vxlanLink} {
+		if link.Attrs().Flags&net.FlagUp == 0 {
+			if err = util.GetNetLinkOps().LinkSetUp(link); err != nil {
+				return fmt.Errorf("failed to set device %s up, err: %w", link.Attrs().Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ensureLink returns the existing link with the same name as the desired link
+// if it matches, otherwise (re)creates it
+func ensureLink(desired netlink.Link, matches func(netlink.Link) bool) (netlink.Link, error) {
+	name := desired.Attrs().Name
+	link, err := util.GetNetLinkOps().LinkByName(name)
+	switch {
+	case err == nil && matches(link):
+		return link, nil
+	case err == nil:
+		klog.Warningf("VRF Manager: found a conflict with existing device %s, recreating it", name)
+		if err = util.GetNetLinkOps().LinkDelete(link); err != nil {
+			return nil, fmt.Errorf("failed to delete existing device %s to recreate, err: %w", name, err)
+		}
+	case !util.GetNetLinkOps().IsLinkNotFoundError(err):
+		return nil, fmt.Errorf("failed to retrieve device %s, err: %w", name, err)
+	}
+	if err = util.GetNetLinkOps().LinkAdd(desired); err != nil {
+		return nil, fmt.Errorf("failed to create device %s, err: %w", name, err)
+	}
+	link, err = util.GetNetLinkOps().LinkByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve device %s, err: %w", name, err)
+	}
+	return link, nil
+}
+
+// deleteVXLAN deletes the bridge and VXLAN devices for the provided VNI
+func deleteVXLAN(vni uint32) error {
+	bridgeName, vxlanName := getVXLANDeviceNames(vni)
+	for _, name := range []string{vxlanName, bridgeName} {
+		link, err := util.GetNetLinkOps().LinkByName(name)
+		if util.GetNetLinkOps().IsLinkNotFoundError(err) {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("failed to retrieve device %s, err: %w", name, err)
+		}
+		if err = util.GetNetLinkOps().LinkDelete(link); err != nil {
+			return fmt.Errorf("failed to delete device %s, err: %w", name, err)
+		}
+	}
+	return nil
+}
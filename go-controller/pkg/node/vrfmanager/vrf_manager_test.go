@@ -2,6 +2,7 @@ package vrfmanager
 
 import (
 	"fmt"
+	"net"
 	"sync"
 	"time"
 
@@ -177,6 +178,22 @@ var _ = ginkgo.Describe("VRF manager", func() {
 			gomega.Expect(err).ShouldNot(gomega.HaveOccurred())
 		})
 	})
+
+	ginkgo.Context("VXLAN devices", func() {
+		ginkgo.It("are identified by type and name prefix", func() {
+			bridgeName, vxlanName := getVXLANDeviceNames(100)
+			gomega.Expect(bridgeName).To(gomega.Equal("ovnbr100"))
+			gomega.Expect(vxlanName).To(gomega.Equal("ovnvx100"))
+			gomega.Expect(isVXLANDevice(&netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: bridgeName}})).To(gomega.BeTrue())
+			gomega.Expect(isVXLANDevice(&netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: vxlanName}})).To(gomega.BeTrue())
+			// the prefix must match the device type
+			gomega.Expect(isVXLANDevice(&netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: vxlanName}})).To(gomega.BeFalse())
+			gomega.Expect(isVXLANDevice(&netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: bridgeName}})).To(gomega.BeFalse())
+			gomega.Expect(isVXLANDevice(&netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: "br-ex"}})).To(gomega.BeFalse())
+			gomega.Expect(isVXLANDevice(&netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: "vxlan0"}})).To(gomega.BeFalse())
+			gomega.Expect(isVXLANDevice(buildVRF(vrfLinkName1))).To(gomega.BeFalse())
+		})
+	})
 })
 
 var _ = ginkgo.Describe("VRF manager tests with a network namespace", func() {
@@ -186,6 +203,9 @@ var _ = ginkgo.Describe("VRF manager tests with a network namespace", func() {
 		wg     *sync.WaitGroup
 	)
 	ginkgo.BeforeEach(func() {
+		if ovntest.NoRoot() {
+			ginkgo.Skip("Test requires root privileges")
+		}
 		var err error
 		testNS, err = testutils.NewNS()
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
@@ -221,10 +241,14 @@ var _ = ginkgo.Describe("VRF manager tests with a network namespace", func() {
 		}()
 	})
 	ginkgo.AfterEach(func() {
+		if testNS == nil {
+			return
+		}
 		close(stopCh)
 		wg.Wait()
 		gomega.Expect(testNS.Close()).To(gomega.Succeed())
 		gomega.Expect(testutils.UnmountNS(testNS)).To(gomega.Succeed())
+		testNS = nil
 		util.ResetRunner()
 	})
 
@@ -239,6 +263,216 @@ var _ = ginkgo.Describe("VRF manager tests with a network namespace", func() {
 		return err
 	}
 
+	// checkVXLAN checks that the VRF is wired to a bridge device, which is
+	// wired to a VXLAN device with the provided VNI and local IP, all up
+	checkVXLAN := func(vrfName string, vni uint32, localIP net.IP) error {
+		return testNS.Do(func(ns.NetNS) error {
+			vrfLink, err := util.GetNetLinkOps().LinkByName(vrfName)
+			if err != nil {
+				return err
+			}
+			bridgeName, vxlanName := getVXLANDeviceNames(vni)
+			bridgeLink, err := util.GetNetLinkOps().LinkByName(bridgeName)
+			if err != nil {
+				return err
+			}
+			if _, ok := bridgeLink.(*netlink.Bridge); !ok {
+				return fmt.Errorf("device %s is not a bridge", bridgeName)
+			}
+			if bridgeLink.Attrs().MasterIndex != vrfLink.Attrs().Index {
+				return fmt.Errorf("bridge device %s is not enslaved to VRF device %s", bridgeName, vrfName)
+			}
+			vxlanLink, err := util.GetNetLinkOps().LinkByName(vxlanName)
+			if err != nil {
+				return err
+			}
+			vxlan, ok := vxlanLink.(*netlink.Vxlan)
+			if !ok {
+				return fmt.Errorf("device %s is not a VXLAN device", vxlanName)
+			}
+			if vxlan.VxlanId != int(vni) || !vxlan.SrcAddr.Equal(localIP) || vxlan.Port != vxlanPort {
+				return fmt.Errorf("VXLAN device %s has unexpected VNI %d, local IP %s or port %d", vxlanName, vxlan.VxlanId, vxlan.SrcAddr, vxlan.Port)
+			}
+			if vxlan.MasterIndex != bridgeLink.Attrs().Index {
+				return fmt.Errorf("VXLAN device %s is not enslaved to bridge device %s", vxlanName, bridgeName)
+			}
+			for _, link := range []netlink.Link{bridgeLink, vxlanLink} {
+				if link.Attrs().Flags&net.FlagUp == 0 {
+					return fmt.Errorf("device %s is not up", link.Attrs().Name)
+				}
+			}
+			return nil
+		})
+	}
+
+	checkNoVXLAN := func(vni uint32) error {
+		return testNS.Do(func(ns.NetNS) error {
+			bridgeName, vxlanName := getVXLANDeviceNames(vni)
+			for _, name := range []string{bridgeName, vxlanName} {
+				_, err := util.GetNetLinkOps().LinkByName(name)
+				if err == nil {
+					return fmt.Errorf("device %s still exists", name)
+				}
+				if !util.GetNetLinkOps().IsLinkNotFoundError(err) {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+
+	ginkgo.It("wires a VRF to bridge and VXLAN devices and keeps them in sync", func() {
+		localIP := net.ParseIP("192.168.1.10")
+		err := testNS.Do(func(ns.NetNS) error {
+			defer ginkgo.GinkgoRecover()
+			gomega.Expect(c.AddVRF(vrfLinkName1, "", 1000, nil)).To(gomega.Succeed())
+			gomega.Expect(c.AddVRFVXLAN(vrfLinkName1, 100, localIP)).To(gomega.Succeed())
+			return nil
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(checkVXLAN(vrfLinkName1, 100, localIP)).To(gomega.Succeed())
+
+		bridgeName, vxlanName := getVXLANDeviceNames(100)
+		err = testNS.Do(func(ns.NetNS) error {
+			defer ginkgo.GinkgoRecover()
+			// the VXLAN device is deleted and the bridge device released
+			// from the VRF
+			vxlanLink, err := util.GetNetLinkOps().LinkByName(vxlanName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(util.GetNetLinkOps().LinkDelete(vxlanLink)).To(gomega.Succeed())
+			bridgeLink, err := util.GetNetLinkOps().LinkByName(bridgeName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(netlink.LinkSetNoMaster(bridgeLink)).To(gomega.Succeed())
+			return nil
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		// reconcile restores both
+		gomega.Eventually(checkVXLAN).WithArguments(vrfLinkName1, uint32(100), localIP).
+			WithTimeout(5 * time.Second).Should(gomega.Succeed())
+
+		err = testNS.Do(func(ns.NetNS) error {
+			defer ginkgo.GinkgoRecover()
+			// a conflicting VXLAN device with the same name is recreated
+			vxlanLink, err := util.GetNetLinkOps().LinkByName(vxlanName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(util.GetNetLinkOps().LinkDelete(vxlanLink)).To(gomega.Succeed())
+			gomega.Expect(util.GetNetLinkOps().LinkAdd(&netlink.Vxlan{
+				LinkAttrs: netlink.LinkAttrs{Name: vxlanName},
+				VxlanId:   999,
+				Port:      vxlanPort,
+			})).To(gomega.Succeed())
+			gomega.Expect(c.reconcile()).To(gomega.Succeed())
+			return nil
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(checkVXLAN(vrfLinkName1, 100, localIP)).To(gomega.Succeed())
+
+		// changing the VNI replaces the devices
+		err = testNS.Do(func(ns.NetNS) error {
+			defer ginkgo.GinkgoRecover()
+			gomega.Expect(c.AddVRFVXLAN(vrfLinkName1, 200, localIP)).To(gomega.Succeed())
+			return nil
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(checkVXLAN(vrfLinkName1, 200, localIP)).To(gomega.Succeed())
+		gomega.Expect(checkNoVXLAN(100)).To(gomega.Succeed())
+
+		// stale VXLAN devices not wired to a VRF are removed
+		staleBridgeName, staleVXLANName := getVXLANDeviceNames(300)
+		err = testNS.Do(func(ns.NetNS) error {
+			defer ginkgo.GinkgoRecover()
+			gomega.Expect(util.GetNetLinkOps().LinkAdd(&netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: staleBridgeName}})).To(gomega.Succeed())
+			gomega.Expect(util.GetNetLinkOps().LinkAdd(&netlink.Vxlan{
+				LinkAttrs: netlink.LinkAttrs{Name: staleVXLANName},
+				VxlanId:   300,
+				Port:      vxlanPort,
+			})).To(gomega.Succeed())
+			gomega.Expect(c.Repair(sets.New(vrfLinkName1))).To(gomega.Succeed())
+			return nil
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(checkNoVXLAN(300)).To(gomega.Succeed())
+		gomega.Expect(checkVXLAN(vrfLinkName1, 200, localIP)).To(gomega.Succeed())
+
+		// deleting the VXLAN configuration removes the devices but keeps the VRF
+		err = testNS.Do(func(ns.NetNS) error {
+			defer ginkgo.GinkgoRecover()
+			gomega.Expect(c.DeleteVRFVXLAN(vrfLinkName1)).To(gomega.Succeed())
+			gomega.Expect(c.reconcile()).To(gomega.Succeed())
+			_, err := util.GetNetLinkOps().LinkByName(vrfLinkName1)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			return nil
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(checkNoVXLAN(200)).To(gomega.Succeed())
+	})
+
+	ginkgo.It("ensures and deletes the bridge and VXLAN devices of a VNI", func() {
+		bridgeName, vxlanName := getVXLANDeviceNames(100)
+		isVNI := func(vni int) func(netlink.Link) bool {
+			return func(link netlink.Link) bool {
+				vxlan, ok := link.(*netlink.Vxlan)
+				return ok && vxlan.VxlanId == vni
+			}
+		}
+		desiredVXLAN := func(vni int) netlink.Link {
+			return &netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: vxlanName}, VxlanId: vni, Port: vxlanPort}
+		}
+		err := testNS.Do(func(ns.NetNS) error {
+			defer ginkgo.GinkgoRecover()
+			bridgeLink, err := ensureLink(&netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: bridgeName}}, func(link netlink.Link) bool {
+				_, ok := link.(*netlink.Bridge)
+				return ok
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(isVXLANDevice(bridgeLink)).To(gomega.BeTrue())
+
+			// created
+			vxlanLink, err := ensureLink(desiredVXLAN(100), isVNI(100))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(isVXLANDevice(vxlanLink)).To(gomega.BeTrue())
+			index := vxlanLink.Attrs().Index
+
+			// kept when it matches
+			vxlanLink, err = ensureLink(desiredVXLAN(100), isVNI(100))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(vxlanLink.Attrs().Index).To(gomega.Equal(index))
+
+			// recreated when it conflicts
+			vxlanLink, err = ensureLink(desiredVXLAN(200), isVNI(200))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(vxlanLink.Attrs().Index).NotTo(gomega.Equal(index))
+			gomega.Expect(vxlanLink.(*netlink.Vxlan).VxlanId).To(gomega.Equal(200))
+
+			gomega.Expect(deleteVXLAN(100)).To(gomega.Succeed())
+			// deleting devices that no longer exist is not an error
+			gomega.Expect(deleteVXLAN(100)).To(gomega.Succeed())
+			return nil
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(checkNoVXLAN(100)).To(gomega.Succeed())
+	})
+
+	ginkgo.It("deletes the bridge and VXLAN devices along with the VRF", func() {
+		localIP := net.ParseIP("192.168.1.10")
+		err := testNS.Do(func(ns.NetNS) error {
+			defer ginkgo.GinkgoRecover()
+			gomega.Expect(c.AddVRF(vrfLinkName2, "", 2000, nil)).To(gomega.Succeed())
+			gomega.Expect(c.AddVRFVXLAN(vrfLinkName2, 100, localIP)).To(gomega.Succeed())
+			return nil
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(checkVXLAN(vrfLinkName2, 100, localIP)).To(gomega.Succeed())
+
+		err = testNS.Do(func(ns.NetNS) error {
+			defer ginkgo.GinkgoRecover()
+			gomega.Expect(c.DeleteVRF(vrfLinkName2)).To(gomega.Succeed())
+			return nil
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(checkNoVXLAN(100)).To(gomega.Succeed())
+	})
+
 	ovntest.OnSupportedPlatformsIt("ensure VRF manager is reconciling configured VRF devices correctly", func() {
 		err := testNS.Do(func(ns.NetNS) error {
 			defer ginkgo.GinkgoRecover()
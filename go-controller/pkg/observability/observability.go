@@ -13,6 +13,7 @@ import (
 	libovsdbclient "github.com/ovn-org/libovsdb/client"
 	"github.com/ovn-org/libovsdb/ovsdb"
 
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 )
@@ -31,6 +32,10 @@ const DefaultObservabilityCollectorSetID = 42
 const maxCollectorID = 255
 const collectorFeaturesExternalID = "sample-features"
 
+// samplingPolicyCollectorFeatures is the features externalID of the collectors
+// created for SamplingPolicies only.
+const samplingPolicyCollectorFeatures = "SamplingPolicy"
+
 // collectorConfig holds the configuration for a collector.
 // It is allowed to set different probabilities for every feature.
 // collectorSetID is used to set up sampling via OVSDB.
@@ -48,6 +53,12 @@ type Manager struct {
 	// multiple nbdb Collectors will be created, one per probability.
 	// getCollectorKey() => collector.UUID
 	dbCollectors map[string]string
+	// getCollectorKey() => collector.ID
+	dbCollectorIDs map[string]int
+	// collector keys used by the global config
+	globalCollectors sets.Set[string]
+	// namespace => collector keys used by the namespace config
+	namespaceCollectors map[string]sets.Set[string]
 	// cleaning up unused collectors may take time and multiple retries, as all referencing samples must be removed first.
 	// Therefore, we need to save state between those retries.
 	// getCollectorKey() => collector.SetID
@@ -57,6 +68,10 @@ type Manager struct {
 	// Only maxCollectorID collectors are allowed, each should have unique ID.
 	// this set is tracking already assigned IDs.
 	takenCollectorIDs sets.Set[int]
+	// sample points are added to the cluster port group of the default network, see RunSamplePoints
+	clusterPortGroupName      string
+	samplePointsSync          chan struct{}
+	samplePointsRetryInterval time.Duration
 }
 
 func NewManager(nbClient libovsdbclient.Client) *Manager {
@@ -64,9 +79,14 @@ func NewManager(nbClient libovsdbclient.Client) *Manager {
 		nbClient:                      nbClient,
 		collectorsLock:                sync.Mutex{},
 		dbCollectors:                  make(map[string]string),
+		dbCollectorIDs:                make(map[string]int),
+		globalCollectors:              sets.New[string](),
+		namespaceCollectors:           make(map[string]sets.Set[string]),
 		unusedCollectors:              make(map[string]int),
 		unusedCollectorsRetryInterval: time.Minute,
 		takenCollectorIDs:             sets.New[int](),
+		samplePointsSync:              make(chan struct{}, 1),
+		samplePointsRetryInterval:     5 * time.Second,
 	}
 }
 
@@ -84,8 +104,15 @@ func (m *Manager) Init() error {
 			libovsdbops.AdminNetworkPolicySample: 100,
 			libovsdbops.MulticastSample:          100,
 			libovsdbops.UDNIsolationSample:       100,
+			libovsdbops.LoadBalancerSample:       100,
+			libovsdbops.NATSample:                100,
+			libovsdbops.RerouteSample:            100,
 		},
 	}
+	if config.OVNKubernetesFeature.EnableSamplingPolicy {
+		// nothing is sampled globally, namespaces are configured with SetNamespaceSampling
+		clear(currentConfig.featuresProbability)
+	}
 
 	return m.initWithConfig(currentConfig)
 }
@@ -120,6 +147,7 @@ func (m *Manager) setDbCollectors() error {
 	for _, collector := range collectors {
 		collectorKey := getCollectorKey(collector.SetID, collector.Probability)
 		m.dbCollectors[collectorKey] = collector.UUID
+		m.dbCollectorIDs[collectorKey] = collector.ID
 		m.takenCollectorIDs.Insert(collector.ID)
 		// all collectors are unused, until we update existing configs
 		m.unusedCollectors[collectorKey] = collector.ID
@@ -163,6 +191,7 @@ func (m *Manager) deleteStaleCollectors() error {
 		}
 		delete(m.unusedCollectors, collectorKey)
 		delete(m.dbCollectors, collectorKey)
+		delete(m.dbCollectorIDs, collectorKey)
 		delete(m.takenCollectorIDs, collectorSetID)
 	}
 	return lastErr
@@ -173,7 +202,12 @@ func (m *Manager) deleteStaleCollectors() error {
 // This is expected, and Cleanup may be retried on the next restart.
 func Cleanup(nbClient libovsdbclient.Client) error {
 	// Do the opposite of init
-	err := libovsdbops.DeleteSamplingAppsWithPredicate(nbClient, func(_ *nbdb.SamplingApp) bool {
+	err := deleteSamplePoints(nbClient)
+	if err != nil {
+		return fmt.Errorf("error deleting sample points: %w", err)
+	}
+
+	err = libovsdbops.DeleteSamplingAppsWithPredicate(nbClient, func(_ *nbdb.SamplingApp) bool {
 		return true
 	})
 	if err != nil {
@@ -258,25 +292,11 @@ func (m *Manager) addCollector(conf *collectorConfig) (map[libovsdbops.SampleFea
 		slices.Sort(features)
 		collectorFeatures := strings.Join(features, ",")
 		if collectorUUID, ok = m.dbCollectors[collectorKey]; !ok {
-			collectorID, err := m.getFreeCollectorID()
-			if err != nil {
-				return sampleFeaturesConfig, err
-			}
-			collector := &nbdb.SampleCollector{
-				ID:          collectorID,
-				SetID:       conf.collectorSetID,
-				Probability: probability,
-				ExternalIDs: map[string]string{
-					collectorFeaturesExternalID: collectorFeatures,
-				},
-			}
-			err = libovsdbops.CreateOrUpdateSampleCollector(m.nbClient, collector)
+			var err error
+			collectorUUID, err = m.createCollector(conf.collectorSetID, probability, collectorFeatures)
 			if err != nil {
 				return sampleFeaturesConfig, err
 			}
-			collectorUUID = collector.UUID
-			m.dbCollectors[collectorKey] = collectorUUID
-			m.takenCollectorIDs.Insert(collectorID)
 		} else {
 			// update collector's features
 			collector := &nbdb.SampleCollector{
@@ -292,6 +312,7 @@ func (m *Manager) addCollector(conf *collectorConfig) (map[libovsdbops.SampleFea
 			// collector is used, remove from unused Collectors
 			delete(m.unusedCollectors, collectorKey)
 		}
+		m.globalCollectors.Insert(collectorKey)
 		for _, feature := range features {
 			sampleFeaturesConfig[feature] = append(sampleFeaturesConfig[feature], collectorUUID)
 		}
@@ -299,6 +320,130 @@ func (m *Manager) addCollector(conf *collectorConfig) (map[libovsdbops.SampleFea
 	return sampleFeaturesConfig, nil
 }
 
+// createCollector must be called with collectorsLock held.
+func (m *Manager) createCollector(collectorSetID, probability int, features string) (string, error) {
+	collectorID, err := m.getFreeCollectorID()
+	if err != nil {
+		return "", err
+	}
+	collector := &nbdb.SampleCollector{
+		ID:          collectorID,
+		SetID:       collectorSetID,
+		Probability: probability,
+		ExternalIDs: map[string]string{
+			collectorFeaturesExternalID: features,
+		},
+	}
+	err = libovsdbops.CreateOrUpdateSampleCollector(m.nbClient, collector)
+	if err != nil {
+		return "", err
+	}
+	collectorKey := getCollectorKey(collectorSetID, probability)
+	m.dbCollectors[collectorKey] = collector.UUID
+	m.dbCollectorIDs[collectorKey] = collectorID
+	m.takenCollectorIDs.Insert(collectorID)
+	return collector.UUID, nil
+}
+
+// ensureNamespaceCollector returns the collector UUID for the given percent
+// probability, creating a new collector if needed. Must be called with
+// collectorsLock held.
+func (m *Manager) ensureNamespaceCollector(percentProbability int, collectorKeys sets.Set[string]) (string, error) {
+	probability := percentToProbability(percentProbability)
+	collectorKey := getCollectorKey(DefaultObservabilityCollectorSetID, probability)
+	collectorUUID, ok := m.dbCollectors[collectorKey]
+	if !ok {
+		var err error
+		collectorUUID, err = m.createCollector(DefaultObservabilityCollectorSetID, probability, samplingPolicyCollectorFeatures)
+		if err != nil {
+			return "", err
+		}
+	}
+	delete(m.unusedCollectors, collectorKey)
+	collectorKeys.Insert(collectorKey)
+	return collectorUUID, nil
+}
+
+// SetNamespaceSampling configures sampling for the db objects of the given
+// namespace, overriding the global config. featuresProbability sets the
+// probability in percent per feature, networkPolicyProbability per network
+// policy name. Probability 0 disables sampling.
+// The db objects of the namespace need to be updated with the SamplingConfig
+// for the change to take effect, then CleanupStaleCollectors may be called.
+func (m *Manager) SetNamespaceSampling(namespace string, featuresProbability map[libovsdbops.SampleFeature]int,
+	networkPolicyProbability map[string]int) error {
+	m.collectorsLock.Lock()
+	defer m.collectorsLock.Unlock()
+	nsConfig := &libovsdbops.NamespaceSamplingConfig{
+		FeatureCollectors:       make(map[libovsdbops.SampleFeature][]string, len(featuresProbability)),
+		NetworkPolicyCollectors: make(map[string][]string, len(networkPolicyProbability)),
+	}
+	collectorKeys := sets.New[string]()
+	for feature, percentProbability := range featuresProbability {
+		nsConfig.FeatureCollectors[feature] = []string{}
+		if percentProbability == 0 {
+			continue
+		}
+		collectorUUID, err := m.ensureNamespaceCollector(percentProbability, collectorKeys)
+		if err != nil {
+			return fmt.Errorf("failed to ensure collector for namespace %s: %w", namespace, err)
+		}
+		nsConfig.FeatureCollectors[feature] = []string{collectorUUID}
+	}
+	for policyName, percentProbability := range networkPolicyProbability {
+		nsConfig.NetworkPolicyCollectors[policyName] = []string{}
+		if percentProbability == 0 {
+			continue
+		}
+		collectorUUID, err := m.ensureNamespaceCollector(percentProbability, collectorKeys)
+		if err != nil {
+			return fmt.Errorf("failed to ensure collector for namespace %s: %w", namespace, err)
+		}
+		nsConfig.NetworkPolicyCollectors[policyName] = []string{collectorUUID}
+	}
+	m.namespaceCollectors[namespace] = collectorKeys
+	m.sampConfig.SetNamespaceSampling(namespace, nsConfig)
+	m.setUnusedCollectors()
+	return nil
+}
+
+// DeleteNamespaceSampling removes the sampling config of the given namespace.
+// The db objects of the namespace need to be updated with the SamplingConfig
+// for the change to take effect, then CleanupStaleCollectors may be called.
+func (m *Manager) DeleteNamespaceSampling(namespace string) {
+	m.collectorsLock.Lock()
+	defer m.collectorsLock.Unlock()
+	delete(m.namespaceCollectors, namespace)
+	m.sampConfig.DeleteNamespaceSampling(namespace)
+	m.setUnusedCollectors()
+}
+
+// setUnusedCollectors marks the collectors that are not used by any config
+// as unused. Must be called with collectorsLock held.
+func (m *Manager) setUnusedCollectors() {
+	for collectorKey := range m.dbCollectors {
+		if m.globalCollectors.Has(collectorKey) {
+			continue
+		}
+		used := false
+		for _, collectorKeys := range m.namespaceCollectors {
+			if collectorKeys.Has(collectorKey) {
+				used = true
+				break
+			}
+		}
+		if !used {
+			m.unusedCollectors[collectorKey] = m.dbCollectorIDs[collectorKey]
+		}
+	}
+}
+
+// CleanupStaleCollectors deletes the collectors that are not used anymore.
+// It will return an error if some samples still reference them.
+func (m *Manager) CleanupStaleCollectors() error {
+	return m.deleteStaleCollectors()
+}
+
 func percentToProbability(percent int) int {
 	return 65535 * percent / 100
 }
@@ -6,6 +6,7 @@ import (
 
 	libovsdbclient "github.com/ovn-org/libovsdb/client"
 
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
@@ -82,7 +83,8 @@ var _ = Describe("Observability Manager", func() {
 				Probability: 65535,
 				ExternalIDs: map[string]string{
 					collectorFeaturesExternalID: strings.Join([]string{libovsdbops.AdminNetworkPolicySample, libovsdbops.EgressFirewallSample,
-						libovsdbops.MulticastSample, libovsdbops.NetworkPolicySample, libovsdbops.UDNIsolationSample}, ","),
+						libovsdbops.LoadBalancerSample, libovsdbops.MulticastSample, libovsdbops.NATSample, libovsdbops.NetworkPolicySample,
+						libovsdbops.RerouteSample, libovsdbops.UDNIsolationSample}, ","),
 				},
 			},
 		}
@@ -373,4 +375,129 @@ var _ = Describe("Observability Manager", func() {
 			Eventually(nbClient, 2*manager.unusedCollectorsRetryInterval).Should(libovsdbtest.HaveData(expectedDB))
 		})
 	})
+
+	When("sampling policies are enabled", func() {
+		const namespace1 = "namespace1"
+		newNetworkPolicyACL := func(namespace, name string) *nbdb.ACL {
+			return &nbdb.ACL{
+				UUID: "acl-" + namespace + "-" + name + "-uuid",
+				ExternalIDs: map[string]string{
+					libovsdbops.OwnerTypeKey.String():  libovsdbops.NetworkPolicyOwnerType,
+					libovsdbops.ObjectNameKey.String(): libovsdbops.BuildNamespaceNameKey(namespace, name),
+					libovsdbops.PrimaryIDKey.String():  namespace + "-" + name,
+				},
+			}
+		}
+		newNamespaceCollector := func(uuid string, id, probability int) *nbdb.SampleCollector {
+			return &nbdb.SampleCollector{
+				UUID:        uuid,
+				ID:          id,
+				SetID:       DefaultObservabilityCollectorSetID,
+				Probability: probability,
+				ExternalIDs: map[string]string{
+					collectorFeaturesExternalID: samplingPolicyCollectorFeatures,
+				},
+			}
+		}
+		setSample := func(acl *nbdb.ACL, collectorUUID string) *nbdb.Sample {
+			sample := &nbdb.Sample{
+				UUID:       acl.UUID + "-sample",
+				Metadata:   int(libovsdbops.GetACLSampleID(acl)),
+				Collectors: []string{collectorUUID},
+			}
+			acl.SampleNew = &sample.UUID
+			acl.SampleEst = &sample.UUID
+			return sample
+		}
+
+		var acl1, acl2, otherNamespaceACL *nbdb.ACL
+		var pg *nbdb.PortGroup
+
+		BeforeEach(func() {
+			config.OVNKubernetesFeature.EnableSamplingPolicy = true
+			acl1 = newNetworkPolicyACL(namespace1, "policy1")
+			acl2 = newNetworkPolicyACL(namespace1, "policy2")
+			otherNamespaceACL = newNetworkPolicyACL("namespace2", "policy1")
+			pg = &nbdb.PortGroup{
+				UUID: "pg-uuid",
+				ACLs: []string{acl1.UUID, acl2.UUID, otherNamespaceACL.UUID},
+			}
+		})
+
+		AfterEach(func() {
+			config.OVNKubernetesFeature.EnableSamplingPolicy = false
+		})
+
+		updateACLs := func() {
+			for _, acl := range []*nbdb.ACL{acl1, acl2, otherNamespaceACL} {
+				err := createOrUpdateACLPreserveUUID(nbClient, manager.SamplingConfig(), acl)
+				Expect(err).NotTo(HaveOccurred())
+			}
+		}
+
+		It("should not sample anything without namespace config", func() {
+			startManager(append(initialDB, acl1, acl2, otherNamespaceACL, pg))
+			updateACLs()
+			// the global collector is not used, and is cleaned up
+			Eventually(nbClient).Should(libovsdbtest.HaveData(append(samplingApps, acl1, acl2, otherNamespaceACL, pg)))
+		})
+
+		It("should sample the ACLs of the configured namespace", func() {
+			startManager(append(samplingApps, acl1, acl2, otherNamespaceACL, pg))
+			err := manager.SetNamespaceSampling(namespace1, map[libovsdbops.SampleFeature]int{
+				libovsdbops.NetworkPolicySample: 50,
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			updateACLs()
+
+			collector := newNamespaceCollector(collectorUUID, 1, 32767)
+			sample1 := setSample(acl1, collector.UUID)
+			sample2 := setSample(acl2, collector.UUID)
+			Eventually(nbClient).Should(libovsdbtest.HaveData(append(samplingApps, collector, sample1, sample2,
+				acl1, acl2, otherNamespaceACL, pg)))
+		})
+
+		It("should sample the configured network policies", func() {
+			startManager(append(samplingApps, acl1, acl2, otherNamespaceACL, pg))
+			err := manager.SetNamespaceSampling(namespace1, map[libovsdbops.SampleFeature]int{
+				libovsdbops.NetworkPolicySample: 50,
+			}, map[string]int{
+				"policy1": 100,
+				"policy2": 0,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			updateACLs()
+
+			collector := newNamespaceCollector(collectorUUID, 2, 65535)
+			// the namespace-wide collector is created, but not used by the network policies
+			unusedCollector := newNamespaceCollector(collectorUUID+"-unused", 1, 32767)
+			sample1 := setSample(acl1, collector.UUID)
+			Eventually(nbClient).Should(libovsdbtest.HaveData(append(samplingApps, collector, unusedCollector, sample1,
+				acl1, acl2, otherNamespaceACL, pg)))
+		})
+
+		It("should cleanup samples and collectors when namespace config is deleted", func() {
+			startManager(append(samplingApps, acl1, acl2, otherNamespaceACL, pg))
+			err := manager.SetNamespaceSampling(namespace1, map[libovsdbops.SampleFeature]int{
+				libovsdbops.NetworkPolicySample: 100,
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			updateACLs()
+			// collector can't be deleted while used
+			Expect(manager.CleanupStaleCollectors()).To(Succeed())
+			collector := newNamespaceCollector(collectorUUID, 1, 65535)
+			sample1 := setSample(acl1, collector.UUID)
+			sample2 := setSample(acl2, collector.UUID)
+			Eventually(nbClient).Should(libovsdbtest.HaveData(append(samplingApps, collector, sample1, sample2,
+				acl1, acl2, otherNamespaceACL, pg)))
+
+			manager.DeleteNamespaceSampling(namespace1)
+			Expect(manager.CleanupStaleCollectors()).NotTo(Succeed())
+			updateACLs()
+			Expect(manager.CleanupStaleCollectors()).To(Succeed())
+			acl1.SampleNew, acl1.SampleEst = nil, nil
+			acl2.SampleNew, acl2.SampleEst = nil, nil
+			Eventually(nbClient).Should(libovsdbtest.HaveData(append(samplingApps, acl1, acl2, otherNamespaceACL, pg)))
+		})
+	})
 })
@@ -0,0 +1,382 @@
+package observability
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
+
+	"github.com/ovn-org/libovsdb/cache"
+	libovsdbclient "github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/model"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+)
+
+// samplePointsControllerName is the owner controller of the sample point ACLs.
+const samplePointsControllerName = "observability"
+
+// egressServiceExternalIDKey and egressServiceICSuffix identify the logical router
+// policies of an egress service, see the egressservice controller.
+const (
+	egressServiceExternalIDKey = "EgressSVC"
+	egressServiceICSuffix      = ":ic"
+)
+
+// Load balancers, NATs and logical router policies or routes can't be sampled by OVN.
+// Their traffic is sampled with sample points instead: pass ACLs of the primary tier,
+// matching the traffic handled by these db objects, on the cluster port group of the
+// default network. Pass ACLs with the lowest priorities of the primary tier don't change
+// how the traffic is handled.
+// Only one ACL of a tier can match a packet, samples are generated for the first packet
+// of the new connections of the most specific sample point:
+// - LoadBalancerSample: the connections DNATed by the load balancers of a service.
+// - NATSample: the connections of the pods SNATed by an egress IP or an egress service.
+// - RerouteSample: the connections of the pods rerouted by an admin policy based external route.
+
+// RunSamplePoints creates the sample points of the sampled features for the db objects
+// of the given default network controller, and updates them when these db objects change.
+func (m *Manager) RunSamplePoints(networkControllerName string, stopCh <-chan struct{}) {
+	m.clusterPortGroupName = libovsdbutil.GetPortGroupName(libovsdbops.NewDbObjectIDs(libovsdbops.PortGroupCluster,
+		networkControllerName, map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey: types.ClusterPortGroupNameBase,
+		}))
+	m.nbClient.Cache().AddEventHandler(&cache.EventHandlerFuncs{
+		AddFunc: func(table string, _ model.Model) {
+			m.onSamplePointsTableEvent(table)
+		},
+		UpdateFunc: func(table string, _, _ model.Model) {
+			m.onSamplePointsTableEvent(table)
+		},
+		DeleteFunc: func(table string, _ model.Model) {
+			m.onSamplePointsTableEvent(table)
+		},
+	})
+	go func() {
+		for {
+			select {
+			case <-stopCh:
+				return
+			case <-m.samplePointsSync:
+				if err := m.syncSamplePoints(); err != nil {
+					klog.Errorf("Failed to sync observability sample points, retrying in %s: %v", m.samplePointsRetryInterval, err)
+					time.AfterFunc(m.samplePointsRetryInterval, m.requestSamplePointsSync)
+				}
+			}
+		}
+	}()
+	m.requestSamplePointsSync()
+}
+
+func (m *Manager) onSamplePointsTableEvent(table string) {
+	switch table {
+	case nbdb.LoadBalancerTable, nbdb.LogicalRouterPolicyTable, nbdb.LogicalRouterStaticRouteTable:
+		m.requestSamplePointsSync()
+	}
+}
+
+// requestSamplePointsSync doesn't block, all the pending requests are handled by the next sync.
+func (m *Manager) requestSamplePointsSync() {
+	select {
+	case m.samplePointsSync <- struct{}{}:
+	default:
+	}
+}
+
+// syncSamplePoints creates or updates the sample points of the sampled features,
+// and deletes the stale ones.
+func (m *Manager) syncSamplePoints() error {
+	var acls []*nbdb.ACL
+	for _, samplePoints := range []struct {
+		feature libovsdbops.SampleFeature
+		get     func() ([]*nbdb.ACL, error)
+	}{
+		{feature: libovsdbops.LoadBalancerSample, get: m.getLoadBalancerSamplePoints},
+		{feature: libovsdbops.NATSample, get: m.getEgressIPSamplePoints},
+		{feature: libovsdbops.NATSample, get: m.getEgressServiceSamplePoints},
+		{feature: libovsdbops.RerouteSample, get: m.getAPBRouteSamplePoints},
+	} {
+		if !m.sampConfig.IsFeatureSampled(samplePoints.feature) {
+			continue
+		}
+		featureACLs, err := samplePoints.get()
+		if err != nil {
+			return fmt.Errorf("failed to get %s sample points: %w", samplePoints.feature, err)
+		}
+		acls = append(acls, featureACLs...)
+	}
+	existingACLs, err := findSamplePointACLs(m.nbClient)
+	if err != nil {
+		return err
+	}
+	desiredIDs := sets.New[string]()
+	for _, acl := range acls {
+		desiredIDs.Insert(acl.ExternalIDs[libovsdbops.PrimaryIDKey.String()])
+	}
+	var staleACLs []*nbdb.ACL
+	for _, acl := range existingACLs {
+		if !desiredIDs.Has(acl.ExternalIDs[libovsdbops.PrimaryIDKey.String()]) {
+			staleACLs = append(staleACLs, acl)
+		}
+	}
+	if len(acls) == 0 && len(staleACLs) == 0 {
+		return nil
+	}
+
+	ops, err := libovsdbops.CreateOrUpdateACLsOps(m.nbClient, nil, m.sampConfig, acls...)
+	if err != nil {
+		return fmt.Errorf("failed to create or update sample point ACLs: %w", err)
+	}
+	ops, err = libovsdbops.AddACLsToPortGroupOps(m.nbClient, ops, m.clusterPortGroupName, acls...)
+	if err != nil {
+		return fmt.Errorf("failed to add sample point ACLs to port group %s: %w", m.clusterPortGroupName, err)
+	}
+	ops, err = libovsdbops.DeleteACLsFromPortGroupOps(m.nbClient, ops, m.clusterPortGroupName, staleACLs...)
+	if err != nil {
+		return fmt.Errorf("failed to delete stale sample point ACLs from port group %s: %w", m.clusterPortGroupName, err)
+	}
+	_, err = libovsdbops.TransactAndCheck(m.nbClient, ops)
+	return err
+}
+
+func findSamplePointACLs(nbClient libovsdbclient.Client) ([]*nbdb.ACL, error) {
+	acls, err := libovsdbops.FindACLsWithPredicate(nbClient, func(acl *nbdb.ACL) bool {
+		return acl.ExternalIDs[libovsdbops.OwnerControllerKey.String()] == samplePointsControllerName
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sample point ACLs: %w", err)
+	}
+	return acls, nil
+}
+
+// deleteSamplePoints deletes all the sample point ACLs, so that their samples
+// don't reference the collectors anymore.
+func deleteSamplePoints(nbClient libovsdbclient.Client) error {
+	acls, err := findSamplePointACLs(nbClient)
+	if err != nil {
+		return err
+	}
+	return libovsdbops.DeleteACLsFromAllPortGroups(nbClient, acls...)
+}
+
+func buildSamplePointACL(objectIDsType *libovsdbops.ObjectIDsType, ids map[libovsdbops.ExternalIDKey]string,
+	priority int, match string) *nbdb.ACL {
+	dbIDs := libovsdbops.NewDbObjectIDs(objectIDsType, samplePointsControllerName, ids)
+	acl := libovsdbutil.BuildACL(dbIDs, priority, match, nbdb.ACLActionPass, nil, libovsdbutil.LportEgressAfterLB)
+	acl.Tier = types.PrimaryACLTier
+	return acl
+}
+
+// getLoadBalancerSamplePoints returns an ACL per service and IP family, matching the
+// connections to the VIPs of the service load balancers.
+func (m *Manager) getLoadBalancerSamplePoints() ([]*nbdb.ACL, error) {
+	lbs, err := libovsdbops.FindLoadBalancersWithPredicate(m.nbClient, func(lb *nbdb.LoadBalancer) bool {
+		return lb.ExternalIDs[types.LoadBalancerKindExternalID] == "Service" && lb.ExternalIDs[types.NetworkExternalID] == ""
+	})
+	if err != nil {
+		return nil, err
+	}
+	// service namespace+name => IP family => VIP matches
+	serviceVIPs := map[string]map[string]sets.Set[string]{}
+	for _, lb := range lbs {
+		namespace, name, found := strings.Cut(lb.ExternalIDs[types.LoadBalancerOwnerExternalID], "/")
+		if !found {
+			continue
+		}
+		protocol := nbdb.LoadBalancerProtocolTCP
+		if lb.Protocol != nil {
+			protocol = *lb.Protocol
+		}
+		for vip := range lb.Vips {
+			ip, port, err := net.SplitHostPort(vip)
+			if err != nil {
+				continue
+			}
+			ipFamily, ctDst := "ip4", "ct_nw_dst"
+			if utilnet.IsIPv6String(ip) {
+				ipFamily, ctDst = "ip6", "ct_ip6_dst"
+			}
+			key := libovsdbops.BuildNamespaceNameKey(namespace, name)
+			if serviceVIPs[key] == nil {
+				serviceVIPs[key] = map[string]sets.Set[string]{}
+			}
+			if serviceVIPs[key][ipFamily] == nil {
+				serviceVIPs[key][ipFamily] = sets.New[string]()
+			}
+			serviceVIPs[key][ipFamily].Insert(fmt.Sprintf("(%s == %s && ct_nw_proto == %d && ct_tp_dst == %s)",
+				ctDst, ip, getProtocolNumber(protocol), port))
+		}
+	}
+	var acls []*nbdb.ACL
+	for key, familyVIPs := range serviceVIPs {
+		for ipFamily, vips := range familyVIPs {
+			match := fmt.Sprintf("ct.new && %s && (%s)", ipFamily, strings.Join(sets.List(vips), " || "))
+			acls = append(acls, buildSamplePointACL(libovsdbops.ACLLoadBalancerSample, map[libovsdbops.ExternalIDKey]string{
+				libovsdbops.ObjectNameKey: key,
+				libovsdbops.IPFamilyKey:   ipFamily,
+			}, types.LoadBalancerSamplePriority, match))
+		}
+	}
+	return acls, nil
+}
+
+// getEgressIPSamplePoints returns an ACL per egress IP and IP family, matching the
+// connections of the pods rerouted by the egress IP.
+func (m *Manager) getEgressIPSamplePoints() ([]*nbdb.ACL, error) {
+	lrps, err := libovsdbops.FindALogicalRouterPoliciesWithPredicate(m.nbClient, types.OVNClusterRouter,
+		func(lrp *nbdb.LogicalRouterPolicy) bool {
+			return lrp.ExternalIDs[libovsdbops.OwnerTypeKey.String()] == libovsdbops.EgressIPOwnerType &&
+				lrp.ExternalIDs[libovsdbops.PriorityKey.String()] == strconv.Itoa(types.EgressIPReroutePriority) &&
+				lrp.ExternalIDs[libovsdbops.NetworkKey.String()] == types.DefaultNetworkName
+		})
+	if err != nil && !errors.Is(err, libovsdbclient.ErrNotFound) {
+		return nil, err
+	}
+	// egress IP name => IP family => pod IPs
+	egressIPPods := map[string]map[string]sets.Set[string]{}
+	for _, lrp := range lrps {
+		// object name is "<egress IP name>_<pod namespace>/<pod name>"
+		name, _, found := strings.Cut(lrp.ExternalIDs[libovsdbops.ObjectNameKey.String()], "_")
+		if !found {
+			continue
+		}
+		addSourceIP(egressIPPods, name, lrp.Match)
+	}
+	return buildSourceSamplePoints(libovsdbops.ACLEgressIPSample, egressIPPods, types.NATSamplePriority), nil
+}
+
+// getEgressServiceSamplePoints returns an ACL per egress service and IP family, matching
+// the connections of the service endpoints rerouted by the egress service.
+func (m *Manager) getEgressServiceSamplePoints() ([]*nbdb.ACL, error) {
+	lrps, err := libovsdbops.FindALogicalRouterPoliciesWithPredicate(m.nbClient, types.OVNClusterRouter,
+		func(lrp *nbdb.LogicalRouterPolicy) bool {
+			return lrp.ExternalIDs[egressServiceExternalIDKey] != "" && lrp.Priority == types.EgressSVCReroutePriority
+		})
+	if err != nil && !errors.Is(err, libovsdbclient.ErrNotFound) {
+		return nil, err
+	}
+	// service namespace+name => IP family => endpoint IPs
+	serviceEndpoints := map[string]map[string]sets.Set[string]{}
+	for _, lrp := range lrps {
+		key := strings.TrimSuffix(lrp.ExternalIDs[egressServiceExternalIDKey], egressServiceICSuffix)
+		namespace, name, found := strings.Cut(key, "/")
+		if !found {
+			continue
+		}
+		addSourceIP(serviceEndpoints, libovsdbops.BuildNamespaceNameKey(namespace, name), lrp.Match)
+	}
+	return buildSourceSamplePoints(libovsdbops.ACLEgressServiceSample, serviceEndpoints, types.NATSamplePriority), nil
+}
+
+// getAPBRouteSamplePoints returns an ACL per next hop, matching the connections
+// of the pods rerouted to it by the gateway routers.
+func (m *Manager) getAPBRouteSamplePoints() ([]*nbdb.ACL, error) {
+	routers, err := libovsdbops.FindLogicalRoutersWithPredicate(m.nbClient, func(router *nbdb.LogicalRouter) bool {
+		return strings.HasPrefix(router.Name, types.GWRouterPrefix)
+	})
+	if err != nil {
+		return nil, err
+	}
+	// next hop => pod IPs
+	nextHopPods := map[string]sets.Set[string]{}
+	for _, router := range routers {
+		routes, err := libovsdbops.GetRouterLogicalRouterStaticRoutesWithPredicate(m.nbClient, router,
+			func(route *nbdb.LogicalRouterStaticRoute) bool {
+				return route.Policy != nil && *route.Policy == nbdb.LogicalRouterStaticRoutePolicySrcIP &&
+					route.Options["ecmp_symmetric_reply"] == "true"
+			})
+		if err != nil {
+			return nil, err
+		}
+		for _, route := range routes {
+			podIP, _, _ := strings.Cut(route.IPPrefix, "/")
+			if nextHopPods[route.Nexthop] == nil {
+				nextHopPods[route.Nexthop] = sets.New[string]()
+			}
+			nextHopPods[route.Nexthop].Insert(podIP)
+		}
+	}
+	var acls []*nbdb.ACL
+	for nextHop, podIPs := range nextHopPods {
+		ipFamily := "ip4"
+		if utilnet.IsIPv6String(nextHop) {
+			ipFamily = "ip6"
+		}
+		acls = append(acls, buildSamplePointACL(libovsdbops.ACLAPBRouteSample, map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey: nextHop,
+		}, types.RerouteSamplePriority, getSourceSamplePointMatch(ipFamily, podIPs)))
+	}
+	return acls, nil
+}
+
+// addSourceIP adds the source IP of the given "ip4.src == <IP>" or "ip6.src == <IP>"
+// logical router policy match to the IPs of the given name.
+func addSourceIP(sourceIPs map[string]map[string]sets.Set[string], name, match string) {
+	fields := strings.Fields(match)
+	if len(fields) != 3 || fields[1] != "==" || net.ParseIP(fields[2]) == nil {
+		return
+	}
+	ipFamily, found := strings.CutSuffix(fields[0], ".src")
+	if !found || (ipFamily != "ip4" && ipFamily != "ip6") {
+		return
+	}
+	if sourceIPs[name] == nil {
+		sourceIPs[name] = map[string]sets.Set[string]{}
+	}
+	if sourceIPs[name][ipFamily] == nil {
+		sourceIPs[name][ipFamily] = sets.New[string]()
+	}
+	sourceIPs[name][ipFamily].Insert(fields[2])
+}
+
+func buildSourceSamplePoints(objectIDsType *libovsdbops.ObjectIDsType, sourceIPs map[string]map[string]sets.Set[string],
+	priority int) []*nbdb.ACL {
+	var acls []*nbdb.ACL
+	for name, familyIPs := range sourceIPs {
+		for ipFamily, ips := range familyIPs {
+			acls = append(acls, buildSamplePointACL(objectIDsType, map[libovsdbops.ExternalIDKey]string{
+				libovsdbops.ObjectNameKey: name,
+				libovsdbops.IPFamilyKey:   ipFamily,
+			}, priority, getSourceSamplePointMatch(ipFamily, ips)))
+		}
+	}
+	return acls
+}
+
+// getSourceSamplePointMatch matches the new connections from the given IPs leaving
+// the cluster subnets.
+func getSourceSamplePointMatch(ipFamily string, ips sets.Set[string]) string {
+	match := fmt.Sprintf("ct.new && %s.src == {%s}", ipFamily, strings.Join(sets.List(ips), ", "))
+	var clusterSubnets []string
+	for _, subnet := range config.Default.ClusterSubnets {
+		if utilnet.IsIPv6CIDR(subnet.CIDR) == (ipFamily == "ip6") {
+			clusterSubnets = append(clusterSubnets, subnet.CIDR.String())
+		}
+	}
+	if len(clusterSubnets) > 0 {
+		slices.Sort(clusterSubnets)
+		match += fmt.Sprintf(" && %s.dst != {%s}", ipFamily, strings.Join(clusterSubnets, ", "))
+	}
+	return match
+}
+
+func getProtocolNumber(protocol nbdb.LoadBalancerProtocol) int {
+	switch protocol {
+	case nbdb.LoadBalancerProtocolUDP:
+		return 17
+	case nbdb.LoadBalancerProtocolSCTP:
+		return 132
+	}
+	return 6
+}
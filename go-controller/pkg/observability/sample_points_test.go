@@ -0,0 +1,223 @@
+package observability
+
+import (
+	libovsdbclient "github.com/ovn-org/libovsdb/client"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
+	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Observability sample points", func() {
+	const (
+		controllerName = "default-network-controller"
+		collectorUUID  = "collector-uuid"
+	)
+
+	var (
+		nbClient        libovsdbclient.Client
+		libovsdbCleanup *libovsdbtest.Context
+		manager         *Manager
+		stopCh          chan struct{}
+		clusterPG       *nbdb.PortGroup
+		lb, udnLB       *nbdb.LoadBalancer
+		eipLRP, esvcLRP *nbdb.LogicalRouterPolicy
+		apbRoute        *nbdb.LogicalRouterStaticRoute
+		clusterRouter   *nbdb.LogicalRouter
+		gwRouter        *nbdb.LogicalRouter
+	)
+
+	startManager := func(data []libovsdbtest.TestData) {
+		var err error
+		nbClient, _, libovsdbCleanup, err = libovsdbtest.NewNBSBTestHarness(libovsdbtest.TestSetup{
+			NBData: data})
+		Expect(err).NotTo(HaveOccurred())
+		manager = NewManager(nbClient)
+		Expect(manager.Init()).To(Succeed())
+		manager.RunSamplePoints(controllerName, stopCh)
+	}
+
+	newSamplePoint := func(uuid string, objectIDsType *libovsdbops.ObjectIDsType, name, ipFamily string,
+		priority int, match string) (*nbdb.ACL, *nbdb.Sample) {
+		ids := map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey: name,
+		}
+		if ipFamily != "" {
+			ids[libovsdbops.IPFamilyKey] = ipFamily
+		}
+		acl := libovsdbutil.BuildACL(libovsdbops.NewDbObjectIDs(objectIDsType, samplePointsControllerName, ids),
+			priority, match, nbdb.ACLActionPass, nil, libovsdbutil.LportEgressAfterLB)
+		acl.Tier = types.PrimaryACLTier
+		acl.UUID = uuid
+		sample := &nbdb.Sample{
+			UUID:       uuid + "-sample",
+			Metadata:   int(libovsdbops.GetACLSampleID(acl)),
+			Collectors: []string{collectorUUID},
+		}
+		acl.SampleNew = &sample.UUID
+		acl.SampleEst = &sample.UUID
+		return acl, sample
+	}
+
+	BeforeEach(func() {
+		Expect(config.PrepareTestConfig()).To(Succeed())
+		config.Default.ClusterSubnets = []config.CIDRNetworkEntry{
+			{CIDR: ovntest.MustParseIPNet("10.128.0.0/14"), HostSubnetLength: 23},
+			{CIDR: ovntest.MustParseIPNet("fd01::/48"), HostSubnetLength: 64},
+		}
+		stopCh = make(chan struct{})
+		clusterPG = &nbdb.PortGroup{
+			UUID: "cluster-pg-uuid",
+			Name: libovsdbutil.GetPortGroupName(libovsdbops.NewDbObjectIDs(libovsdbops.PortGroupCluster, controllerName,
+				map[libovsdbops.ExternalIDKey]string{
+					libovsdbops.ObjectNameKey: types.ClusterPortGroupNameBase,
+				})),
+		}
+		lb = &nbdb.LoadBalancer{
+			UUID:     "lb-uuid",
+			Name:     "Service_ns1/svc1_TCP_cluster",
+			Protocol: &nbdb.LoadBalancerProtocolTCP,
+			Vips: map[string]string{
+				"10.96.0.10:80":        "10.128.0.5:8080",
+				"[fd00:10:96::10]:443": "[fd01::5]:8443",
+			},
+			ExternalIDs: map[string]string{
+				types.LoadBalancerKindExternalID:  "Service",
+				types.LoadBalancerOwnerExternalID: "ns1/svc1",
+			},
+		}
+		udnLB = &nbdb.LoadBalancer{
+			UUID:     "udn-lb-uuid",
+			Name:     "tenant.blue_Service_ns1/svc1_TCP_cluster",
+			Protocol: &nbdb.LoadBalancerProtocolTCP,
+			Vips: map[string]string{
+				"10.96.0.11:80": "10.200.0.5:8080",
+			},
+			ExternalIDs: map[string]string{
+				types.LoadBalancerKindExternalID:  "Service",
+				types.LoadBalancerOwnerExternalID: "ns1/svc1",
+				types.NetworkExternalID:           "tenant-blue",
+			},
+		}
+		eipLRP = &nbdb.LogicalRouterPolicy{
+			UUID:     "eip-lrp-uuid",
+			Priority: types.EgressIPReroutePriority,
+			Match:    "ip4.src == 10.128.0.10",
+			Action:   nbdb.LogicalRouterPolicyActionReroute,
+			Nexthops: []string{"100.64.0.2"},
+			ExternalIDs: libovsdbops.NewDbObjectIDs(libovsdbops.LogicalRouterPolicyEgressIP, controllerName,
+				map[libovsdbops.ExternalIDKey]string{
+					libovsdbops.ObjectNameKey: "eip1_ns1/pod1",
+					libovsdbops.PriorityKey:   "100",
+					libovsdbops.IPFamilyKey:   "ip4",
+					libovsdbops.NetworkKey:    types.DefaultNetworkName,
+				}).GetExternalIDs(),
+		}
+		esvcLRP = &nbdb.LogicalRouterPolicy{
+			UUID:        "esvc-lrp-uuid",
+			Priority:    types.EgressSVCReroutePriority,
+			Match:       "ip6.src == fd01::20",
+			Action:      nbdb.LogicalRouterPolicyActionReroute,
+			Nexthops:    []string{"fd01::2"},
+			ExternalIDs: map[string]string{egressServiceExternalIDKey: "ns2/svc2"},
+		}
+		clusterRouter = &nbdb.LogicalRouter{
+			UUID:     "cluster-router-uuid",
+			Name:     types.OVNClusterRouter,
+			Policies: []string{eipLRP.UUID, esvcLRP.UUID},
+		}
+		apbRoute = &nbdb.LogicalRouterStaticRoute{
+			UUID:     "apb-route-uuid",
+			Policy:   &nbdb.LogicalRouterStaticRoutePolicySrcIP,
+			Options:  map[string]string{"ecmp_symmetric_reply": "true"},
+			Nexthop:  "172.18.0.100",
+			IPPrefix: "10.128.0.30/32",
+		}
+		gwRouter = &nbdb.LogicalRouter{
+			UUID:         "gw-router-uuid",
+			Name:         types.GWRouterPrefix + "node1",
+			StaticRoutes: []string{apbRoute.UUID},
+		}
+	})
+
+	AfterEach(func() {
+		close(stopCh)
+		if libovsdbCleanup != nil {
+			libovsdbCleanup.Cleanup()
+		}
+		Expect(config.PrepareTestConfig()).To(Succeed())
+	})
+
+	It("samples load balancers, NAT and reroutes of the default network", func() {
+		startManager([]libovsdbtest.TestData{clusterPG, lb, udnLB, eipLRP, esvcLRP, clusterRouter, apbRoute, gwRouter})
+
+		lbACL4, lbSample4 := newSamplePoint("lb-acl4-uuid", libovsdbops.ACLLoadBalancerSample, "ns1:svc1", "ip4",
+			types.LoadBalancerSamplePriority,
+			"ct.new && ip4 && ((ct_nw_dst == 10.96.0.10 && ct_nw_proto == 6 && ct_tp_dst == 80))")
+		lbACL6, lbSample6 := newSamplePoint("lb-acl6-uuid", libovsdbops.ACLLoadBalancerSample, "ns1:svc1", "ip6",
+			types.LoadBalancerSamplePriority,
+			"ct.new && ip6 && ((ct_ip6_dst == fd00:10:96::10 && ct_nw_proto == 6 && ct_tp_dst == 443))")
+		eipACL, eipSample := newSamplePoint("eip-acl-uuid", libovsdbops.ACLEgressIPSample, "eip1", "ip4",
+			types.NATSamplePriority, "ct.new && ip4.src == {10.128.0.10} && ip4.dst != {10.128.0.0/14}")
+		esvcACL, esvcSample := newSamplePoint("esvc-acl-uuid", libovsdbops.ACLEgressServiceSample, "ns2:svc2", "ip6",
+			types.NATSamplePriority, "ct.new && ip6.src == {fd01::20} && ip6.dst != {fd01::/48}")
+		apbACL, apbSample := newSamplePoint("apb-acl-uuid", libovsdbops.ACLAPBRouteSample, "172.18.0.100", "",
+			types.RerouteSamplePriority, "ct.new && ip4.src == {10.128.0.30} && ip4.dst != {10.128.0.0/14}")
+		expectedPG := clusterPG.DeepCopy()
+		expectedPG.ACLs = []string{lbACL4.UUID, lbACL6.UUID, eipACL.UUID, esvcACL.UUID, apbACL.UUID}
+		samplingData := []libovsdbtest.TestData{
+			&nbdb.SamplingApp{UUID: "drop-sampling-uuid", ID: DropSamplingID, Type: nbdb.SamplingAppTypeDrop},
+			&nbdb.SamplingApp{UUID: "acl-new-traffic-sampling-uuid", ID: ACLNewTrafficSamplingID, Type: nbdb.SamplingAppTypeACLNew},
+			&nbdb.SamplingApp{UUID: "acl-est-traffic-sampling-uuid", ID: ACLEstTrafficSamplingID, Type: nbdb.SamplingAppTypeACLEst},
+			&nbdb.SampleCollector{
+				UUID:        collectorUUID,
+				ID:          1,
+				SetID:       DefaultObservabilityCollectorSetID,
+				Probability: 65535,
+				ExternalIDs: map[string]string{
+					collectorFeaturesExternalID: "AdminNetworkPolicy,EgressFirewall,LoadBalancer,Multicast,NAT,NetworkPolicy,Reroute,UDNIsolation",
+				},
+			},
+		}
+		dbObjects := []libovsdbtest.TestData{lb, udnLB, eipLRP, esvcLRP, clusterRouter, apbRoute, gwRouter}
+		Eventually(nbClient).Should(libovsdbtest.HaveData(append(append(samplingData, dbObjects...),
+			expectedPG, lbACL4, lbSample4, lbACL6, lbSample6, eipACL, eipSample, esvcACL, esvcSample, apbACL, apbSample)))
+
+		By("deleting the load balancer")
+		Expect(libovsdbops.DeleteLoadBalancers(nbClient, []*nbdb.LoadBalancer{lb})).To(Succeed())
+		dbObjects = []libovsdbtest.TestData{udnLB, eipLRP, esvcLRP, clusterRouter, apbRoute, gwRouter}
+		expectedPG.ACLs = []string{eipACL.UUID, esvcACL.UUID, apbACL.UUID}
+		Eventually(nbClient).Should(libovsdbtest.HaveData(append(append(samplingData, dbObjects...),
+			expectedPG, eipACL, eipSample, esvcACL, esvcSample, apbACL, apbSample)))
+
+		By("cleaning up observability")
+		Expect(Cleanup(nbClient)).To(Succeed())
+		Eventually(nbClient).Should(libovsdbtest.HaveData(append(dbObjects, clusterPG)))
+	})
+
+	It("deletes the sample points of the features that are not sampled", func() {
+		config.OVNKubernetesFeature.EnableSamplingPolicy = true
+		staleACL, _ := newSamplePoint("lb-acl4-uuid", libovsdbops.ACLLoadBalancerSample, "ns1:svc1", "ip4",
+			types.LoadBalancerSamplePriority, "ct.new && ip4 && ((ct_nw_dst == 10.96.0.10))")
+		staleACL.SampleNew, staleACL.SampleEst = nil, nil
+		pg := clusterPG.DeepCopy()
+		pg.ACLs = []string{staleACL.UUID}
+		router := clusterRouter.DeepCopy()
+		router.Policies = []string{eipLRP.UUID}
+		startManager([]libovsdbtest.TestData{pg, staleACL, lb, eipLRP, router})
+
+		samplingApps := []libovsdbtest.TestData{
+			&nbdb.SamplingApp{UUID: "drop-sampling-uuid", ID: DropSamplingID, Type: nbdb.SamplingAppTypeDrop},
+			&nbdb.SamplingApp{UUID: "acl-new-traffic-sampling-uuid", ID: ACLNewTrafficSamplingID, Type: nbdb.SamplingAppTypeACLNew},
+			&nbdb.SamplingApp{UUID: "acl-est-traffic-sampling-uuid", ID: ACLEstTrafficSamplingID, Type: nbdb.SamplingAppTypeACLEst},
+		}
+		Eventually(nbClient).Should(libovsdbtest.HaveData(append(samplingApps, clusterPG, lb, eipLRP, router)))
+	})
+})
@@ -26,6 +26,7 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
 	anpovn "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/admin_network_policy"
+	dnsnameresolver "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/dns_name_resolver"
 	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
@@ -75,11 +76,15 @@ func getANPRulePriority(basePriority, index int32) int32 {
 }
 
 func getDefaultPGForANPSubject(anpName string, portUUIDs []string, acls []*nbdb.ACL, banp bool) *nbdb.PortGroup {
+	return getNetworkPGForANPSubject(DefaultNetworkControllerName, anpName, portUUIDs, acls, banp)
+}
+
+func getNetworkPGForANPSubject(controllerName, anpName string, portUUIDs []string, acls []*nbdb.ACL, banp bool) *nbdb.PortGroup {
 	lsps := []*nbdb.LogicalSwitchPort{}
 	for _, uuid := range portUUIDs {
 		lsps = append(lsps, &nbdb.LogicalSwitchPort{UUID: uuid})
 	}
-	pgDbIDs := anpovn.GetANPPortGroupDbIDs(anpName, banp, DefaultNetworkControllerName)
+	pgDbIDs := anpovn.GetANPPortGroupDbIDs(anpName, banp, controllerName)
 
 	pg := libovsdbutil.BuildPortGroup(
 		pgDbIDs,
@@ -91,6 +96,12 @@ func getDefaultPGForANPSubject(anpName string, portUUIDs []string, acls []*nbdb.
 }
 
 func getANPGressACL(action, anpName, direction string, rulePriority int32,
+	ruleIndex int32, ports *[]anpapi.AdminNetworkPolicyPort,
+	namedPorts map[string][]libovsdbutil.NamedNetworkPolicyPort, banp bool) []*nbdb.ACL {
+	return getNetworkANPGressACL(DefaultNetworkControllerName, action, anpName, direction, rulePriority, ruleIndex, ports, namedPorts, banp)
+}
+
+func getNetworkANPGressACL(controllerName, action, anpName, direction string, rulePriority int32,
 	ruleIndex int32, ports *[]anpapi.AdminNetworkPolicyPort,
 	namedPorts map[string][]libovsdbutil.NamedNetworkPolicyPort, banp bool) []*nbdb.ACL {
 	retACLs := []*nbdb.ACL{}
@@ -106,24 +117,24 @@ func getANPGressACL(action, anpName, direction string, rulePriority int32,
 		acl.Tier = types.DefaultBANPACLTier
 	}
 	acl.ExternalIDs = map[string]string{
-		libovsdbops.OwnerControllerKey.String():    DefaultNetworkControllerName,
+		libovsdbops.OwnerControllerKey.String():    controllerName,
 		libovsdbops.ObjectNameKey.String():         anpName,
 		libovsdbops.GressIdxKey.String():           fmt.Sprintf("%d", ruleIndex),
 		libovsdbops.PolicyDirectionKey.String():    direction,
 		libovsdbops.PortPolicyProtocolKey.String(): "None",
 		libovsdbops.OwnerTypeKey.String():          "AdminNetworkPolicy",
-		libovsdbops.PrimaryIDKey.String():          fmt.Sprintf("%s:AdminNetworkPolicy:%s:%s:%d:None", DefaultNetworkControllerName, anpName, direction, ruleIndex),
+		libovsdbops.PrimaryIDKey.String():          fmt.Sprintf("%s:AdminNetworkPolicy:%s:%s:%d:None", controllerName, anpName, direction, ruleIndex),
 	}
 	acl.Name = ptr.To(fmt.Sprintf("ANP:%s:%s:%d", anpName, direction, ruleIndex)) // tests logic for GetACLName
 	if banp {
 		acl.ExternalIDs[libovsdbops.OwnerTypeKey.String()] = "BaselineAdminNetworkPolicy"
 		acl.ExternalIDs[libovsdbops.PrimaryIDKey.String()] = fmt.Sprintf("%s:BaselineAdminNetworkPolicy:%s:%s:%d:None",
-			DefaultNetworkControllerName, anpName, direction, ruleIndex)
+			controllerName, anpName, direction, ruleIndex)
 		acl.Name = ptr.To(fmt.Sprintf("BANP:%s:%s:%d", anpName, direction, ruleIndex)) // tests logic for GetACLName
 	}
 	acl.UUID = fmt.Sprintf("%s_%s_%d-%f-UUID", anpName, direction, ruleIndex, rand.Float64())
 	// determine ACL match
-	pgName := libovsdbutil.GetPortGroupName(anpovn.GetANPPortGroupDbIDs(anpName, banp, DefaultNetworkControllerName))
+	pgName := libovsdbutil.GetPortGroupName(anpovn.GetANPPortGroupDbIDs(anpName, banp, controllerName))
 	var lPortMatch, l3Match, matchDirection, match string
 	if direction == string(libovsdbutil.ACLIngress) {
 		acl.Direction = nbdb.ACLDirectionToLport
@@ -138,14 +149,14 @@ func getANPGressACL(action, anpName, direction string, rulePriority int32,
 		matchDirection = "dst"
 	}
 	asIndex := anpovn.GetANPPeerAddrSetDbIDs(anpName, direction, fmt.Sprintf("%d", ruleIndex),
-		DefaultNetworkControllerName, banp)
+		controllerName, banp)
 	asv4, asv6 := addressset.GetHashNamesForAS(asIndex)
 	if config.IPv4Mode && config.IPv6Mode {
 		l3Match = fmt.Sprintf("((ip4.%s == $%s || ip6.%s == $%s))", matchDirection, asv4, matchDirection, asv6)
 	} else if config.IPv4Mode {
-		l3Match = fmt.Sprintf("(ip4.%s == $%s)", matchDirection, asv4)
+		l3Match = fmt.Sprintf("((ip4.%s == $%s))", matchDirection, asv4)
 	} else {
-		l3Match = fmt.Sprintf("(ip6.%s == $%s)", matchDirection, asv6)
+		l3Match = fmt.Sprintf("((ip6.%s == $%s))", matchDirection, asv6)
 	}
 	// determine L4 Port match
 	npps := []*libovsdbutil.NetworkPolicyPort{}
@@ -181,10 +192,10 @@ func getANPGressACL(action, anpName, direction string, rulePriority int32,
 		aclCopy.ExternalIDs[libovsdbops.PortPolicyProtocolKey.String()] = protocol
 		aclCopy.Match = match
 		aclCopy.ExternalIDs[libovsdbops.PrimaryIDKey.String()] = fmt.Sprintf("%s:AdminNetworkPolicy:%s:%s:%d:%s",
-			DefaultNetworkControllerName, anpName, direction, ruleIndex, protocol)
+			controllerName, anpName, direction, ruleIndex, protocol)
 		if banp {
 			aclCopy.ExternalIDs[libovsdbops.PrimaryIDKey.String()] = fmt.Sprintf("%s:BaselineAdminNetworkPolicy:%s:%s:%d:%s",
-				DefaultNetworkControllerName, anpName, direction, ruleIndex, protocol)
+				controllerName, anpName, direction, ruleIndex, protocol)
 		}
 		aclCopy.UUID = fmt.Sprintf("%s_%s_%d.%s-%f-UUID", anpName, direction, ruleIndex, protocol, rand.Float64())
 		retACLs = append(retACLs, &aclCopy)
@@ -204,10 +215,10 @@ func getANPGressACL(action, anpName, direction string, rulePriority int32,
 		aclCopy.ExternalIDs[libovsdbops.PortPolicyProtocolKey.String()] = protocol + "-namedPort"
 		aclCopy.Match = match
 		aclCopy.ExternalIDs[libovsdbops.PrimaryIDKey.String()] = fmt.Sprintf("%s:AdminNetworkPolicy:%s:%s:%d:%s",
-			DefaultNetworkControllerName, anpName, direction, ruleIndex, protocol+"-namedPort")
+			controllerName, anpName, direction, ruleIndex, protocol+"-namedPort")
 		if banp {
 			aclCopy.ExternalIDs[libovsdbops.PrimaryIDKey.String()] = fmt.Sprintf("%s:BaselineAdminNetworkPolicy:%s:%s:%d:%s",
-				DefaultNetworkControllerName, anpName, direction, ruleIndex, protocol+"-namedPort")
+				controllerName, anpName, direction, ruleIndex, protocol+"-namedPort")
 		}
 		retACLs = append(retACLs, &aclCopy)
 	}
@@ -235,15 +246,19 @@ func getACLsForANPRulesWithNamedPorts(anp *anpapi.AdminNetworkPolicy, namedIPort
 }
 
 func getACLsForANPRules(anp *anpapi.AdminNetworkPolicy) []*nbdb.ACL {
+	return getNetworkACLsForANPRules(DefaultNetworkControllerName, anp)
+}
+
+func getNetworkACLsForANPRules(controllerName string, anp *anpapi.AdminNetworkPolicy) []*nbdb.ACL {
 	aclResults := []*nbdb.ACL{}
 	ovnBaseANPPriority := getBaseRulePriority(anp.Spec.Priority)
 	for i, ingress := range anp.Spec.Ingress {
-		acls := getANPGressACL(anpovn.GetACLActionForANPRule(ingress.Action), anp.Name, string(libovsdbutil.ACLIngress),
+		acls := getNetworkANPGressACL(controllerName, anpovn.GetACLActionForANPRule(ingress.Action), anp.Name, string(libovsdbutil.ACLIngress),
 			getANPRulePriority(ovnBaseANPPriority, int32(i)), int32(i), ingress.Ports, nil, false)
 		aclResults = append(aclResults, acls...)
 	}
 	for i, egress := range anp.Spec.Egress {
-		acls := getANPGressACL(anpovn.GetACLActionForANPRule(egress.Action), anp.Name, string(libovsdbutil.ACLEgress),
+		acls := getNetworkANPGressACL(controllerName, anpovn.GetACLActionForANPRule(egress.Action), anp.Name, string(libovsdbutil.ACLEgress),
 			getANPRulePriority(ovnBaseANPPriority, int32(i)), int32(i), egress.Ports, nil, false)
 		aclResults = append(aclResults, acls...)
 	}
@@ -251,8 +266,13 @@ func getACLsForANPRules(anp *anpapi.AdminNetworkPolicy) []*nbdb.ACL {
 }
 
 func buildANPAddressSets(anp *anpapi.AdminNetworkPolicy, index int32, ips []string, gressPrefix libovsdbutil.ACLDirection) (*nbdb.AddressSet, *nbdb.AddressSet) {
+	return buildNetworkANPAddressSets(DefaultNetworkControllerName, anp, index, ips, gressPrefix)
+}
+
+func buildNetworkANPAddressSets(controllerName string, anp *anpapi.AdminNetworkPolicy, index int32, ips []string,
+	gressPrefix libovsdbutil.ACLDirection) (*nbdb.AddressSet, *nbdb.AddressSet) {
 	asIndex := anpovn.GetANPPeerAddrSetDbIDs(anp.Name, string(gressPrefix),
-		fmt.Sprintf("%d", index), DefaultNetworkControllerName, false)
+		fmt.Sprintf("%d", index), controllerName, false)
 	return addressset.GetTestDbAddrSets(asIndex, ips)
 }
 
@@ -1947,6 +1967,167 @@ var _ = ginkgo.Describe("OVN ANP Operations", func() {
 			err := app.Run([]string{app.Name})
 			gomega.Expect(err).ToNot(gomega.HaveOccurred())
 		})
+		ginkgo.It("egress domain names peers: should create/update/delete address-sets, acls, port-groups correctly", func() {
+			app.Action = func(*cli.Context) error {
+				anpNamespaceSubject := *newNamespaceWithLabels(anpSubjectNamespaceName, anpLabel)
+				config.IPv4Mode = true
+				config.IPv6Mode = true
+				config.OVNKubernetesFeature.EnableDNSSnooping = true
+				node1 := nodeFor(node1Name, node1IPv4, node1IPv6, node1IPv4Subnet, node1IPv6Subnet, "", "")
+				node1Switch := &nbdb.LogicalSwitch{
+					Name: node1Name,
+					UUID: node1Name + "-UUID",
+				}
+				subjectNSASIPv4, subjectNSASIPv6 := buildNamespaceAddressSets(anpSubjectNamespaceName, []string{})
+				dbSetup := libovsdbtest.TestSetup{
+					NBData: []libovsdbtest.TestData{
+						node1Switch,
+						subjectNSASIPv4,
+						subjectNSASIPv6,
+					},
+				}
+				fakeOVN.startWithDBSetup(dbSetup,
+					&corev1.NamespaceList{
+						Items: []corev1.Namespace{
+							anpNamespaceSubject,
+						},
+					},
+					&corev1.NodeList{
+						Items: []corev1.Node{
+							*node1,
+						},
+					},
+				)
+
+				fakeOVN.controller.zone = node1Name // ensure we set the controller's zone as the node's zone
+				var err error
+				fakeOVN.controller.dnsNameResolver, err = dnsnameresolver.NewSnoopingEgressDNS(fakeOVN.controller.addressSetFactory,
+					DefaultNetworkControllerName, true, fakeOVN.watcher.ServiceCoreInformer().Lister(), "kube-system/kube-dns")
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				err = fakeOVN.controller.WatchNamespaces()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				err = fakeOVN.controller.WatchPods()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				fakeOVN.InitAndRunANPController()
+				fakeOVN.fakeClient.ANPClient.(*anpfake.Clientset).PrependReactor("update", "adminnetworkpolicies", func(action clienttesting.Action) (handled bool, ret runtime.Object, err error) {
+					update := action.(clienttesting.UpdateAction)
+					// Since fake client (NewSimpleClientset) does not differentiate between
+					// an update and updatestatus, updatestatus in tests updates the spec as
+					// well causing race conditions. Thus adding a hack here to ensure update
+					// status is caught and processed by the reactor while update spec is
+					// delegated to the main code for handling
+					if action.GetSubresource() == "status" {
+						klog.Infof("Got an update status action for %v", update.GetObject())
+						return true, update.GetObject(), nil
+					}
+					klog.Infof("Got an update spec action for %v", update.GetObject())
+					return false, update.GetObject(), nil
+				})
+
+				// the ACL of the rule matches the destinations of its peers or of its domain names
+				getDomainNamesACL := func(anp *anpapi.AdminNetworkPolicy, domainNames ...string) *nbdb.ACL {
+					acl := getACLsForANPRules(anp)[0]
+					asv4, asv6 := addressset.GetHashNamesForAS(anpovn.GetANPPeerAddrSetDbIDs(anp.Name,
+						string(libovsdbutil.ACLEgress), "0", DefaultNetworkControllerName, false))
+					peersMatch := fmt.Sprintf("((ip4.dst == $%s || ip6.dst == $%s))", asv4, asv6)
+					var domainNamesMatches []string
+					for _, domainName := range domainNames {
+						dnsASv4, dnsASv6 := addressset.GetHashNamesForAS(
+							dnsnameresolver.GetEgressFirewallDNSAddrSetDbIDs(domainName, DefaultNetworkControllerName))
+						domainNamesMatches = append(domainNamesMatches, fmt.Sprintf("ip4.dst == $%s", dnsASv4), fmt.Sprintf("ip6.dst == $%s", dnsASv6))
+					}
+					acl.Match = strings.Replace(acl.Match, peersMatch,
+						fmt.Sprintf("(%s || (%s))", peersMatch, strings.Join(domainNamesMatches, " || ")), 1)
+					return acl
+				}
+				getDomainNamesAddressSets := func(domainNames ...string) []libovsdbtest.TestData {
+					var addressSets []libovsdbtest.TestData
+					for _, domainName := range domainNames {
+						dnsASv4, dnsASv6 := addressset.GetTestDbAddrSets(
+							dnsnameresolver.GetEgressFirewallDNSAddrSetDbIDs(domainName, DefaultNetworkControllerName), nil)
+						addressSets = append(addressSets, dnsASv4, dnsASv6)
+					}
+					return addressSets
+				}
+
+				ginkgo.By("1. creating an admin network policy with an egress rule that has networks and domain names peers")
+				anpSubject := newANPSubjectObject(
+					&metav1.LabelSelector{
+						MatchLabels: anpLabel,
+					},
+					nil,
+				)
+				egressRules := []anpapi.AdminNetworkPolicyEgressRule{
+					{
+						Name:   "deny-traffic-to-example-from-gryffindor",
+						Action: anpapi.AdminNetworkPolicyRuleActionDeny,
+						To: []anpapi.AdminNetworkPolicyEgressPeer{
+							{
+								Networks: []anpapi.CIDR{"135.10.0.5/32", "2001:db8:abcd:1234:c000::/66"},
+							},
+						},
+					},
+				}
+				anp := newANPObject("harry-potter", 5, anpSubject, []anpapi.AdminNetworkPolicyIngressRule{}, egressRules)
+				anp.Annotations = map[string]string{
+					anpovn.EgressDomainNamesAnnotation: `{"deny-traffic-to-example-from-gryffindor": ["*.Example.com", "www.example.org"]}`,
+				}
+				anp.ResourceVersion = "1"
+				anp, err = fakeOVN.fakeClient.ANPClient.PolicyV1alpha1().AdminNetworkPolicies().Create(context.TODO(), anp, metav1.CreateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				// domain names are tracked as lower case FQDNs with DNS snooping
+				acl := getDomainNamesACL(anp, "*.example.com.", "www.example.org.")
+				pg := getDefaultPGForANPSubject(anp.Name, nil, []*nbdb.ACL{acl}, false)
+				peerASEgressRule0v4, peerASEgressRule0v6 := buildANPAddressSets(anp,
+					0, []string{"135.10.0.5/32", "2001:db8:abcd:1234:c000::/66"}, libovsdbutil.ACLEgress)
+				expectedDatabaseState := []libovsdbtest.TestData{node1Switch, subjectNSASIPv4, subjectNSASIPv6, pg, acl, peerASEgressRule0v4, peerASEgressRule0v6}
+				gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveDataIgnoringUUIDs(
+					append(expectedDatabaseState, getDomainNamesAddressSets("*.example.com.", "www.example.org.")...)))
+
+				ginkgo.By("2. removing a domain name from the egress rule; check if its address-sets are deleted")
+				anp.Annotations[anpovn.EgressDomainNamesAnnotation] = `{"deny-traffic-to-example-from-gryffindor": ["*.example.com"]}`
+				anp.ResourceVersion = "2"
+				anp, err = fakeOVN.fakeClient.ANPClient.PolicyV1alpha1().AdminNetworkPolicies().Update(context.TODO(), anp, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				acl = getDomainNamesACL(anp, "*.example.com.")
+				pg = getDefaultPGForANPSubject(anp.Name, nil, []*nbdb.ACL{acl}, false)
+				expectedDatabaseState = []libovsdbtest.TestData{node1Switch, subjectNSASIPv4, subjectNSASIPv6, pg, acl, peerASEgressRule0v4, peerASEgressRule0v6}
+				gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveDataIgnoringUUIDs(
+					append(expectedDatabaseState, getDomainNamesAddressSets("*.example.com.")...)))
+
+				ginkgo.By("3. removing the annotation; check if the acl only matches the networks peers")
+				delete(anp.Annotations, anpovn.EgressDomainNamesAnnotation)
+				anp.ResourceVersion = "3"
+				anp, err = fakeOVN.fakeClient.ANPClient.PolicyV1alpha1().AdminNetworkPolicies().Update(context.TODO(), anp, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				acl = getACLsForANPRules(anp)[0]
+				pg = getDefaultPGForANPSubject(anp.Name, nil, []*nbdb.ACL{acl}, false)
+				expectedDatabaseState = []libovsdbtest.TestData{node1Switch, subjectNSASIPv4, subjectNSASIPv6, pg, acl, peerASEgressRule0v4, peerASEgressRule0v6}
+				gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveDataIgnoringUUIDs(expectedDatabaseState))
+
+				ginkgo.By("4. adding back a domain name to the egress rule; check if its address-sets are created")
+				anp.Annotations[anpovn.EgressDomainNamesAnnotation] = `{"deny-traffic-to-example-from-gryffindor": ["www.example.org"]}`
+				anp.ResourceVersion = "4"
+				anp, err = fakeOVN.fakeClient.ANPClient.PolicyV1alpha1().AdminNetworkPolicies().Update(context.TODO(), anp, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				acl = getDomainNamesACL(anp, "www.example.org.")
+				pg = getDefaultPGForANPSubject(anp.Name, nil, []*nbdb.ACL{acl}, false)
+				expectedDatabaseState = []libovsdbtest.TestData{node1Switch, subjectNSASIPv4, subjectNSASIPv6, pg, acl, peerASEgressRule0v4, peerASEgressRule0v6}
+				gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveDataIgnoringUUIDs(
+					append(expectedDatabaseState, getDomainNamesAddressSets("www.example.org.")...)))
+
+				ginkgo.By("5. delete the ANP; check if all objects are deleted correctly")
+				err = fakeOVN.fakeClient.ANPClient.PolicyV1alpha1().AdminNetworkPolicies().Delete(context.TODO(), anp.Name, metav1.DeleteOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				expectedDatabaseState = []libovsdbtest.TestData{node1Switch, subjectNSASIPv4, subjectNSASIPv6}
+				gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveDataIgnoringUUIDs(expectedDatabaseState))
+
+				return nil
+			}
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+		})
 	})
 	ginkgo.Context("Multiple ANPs at the same priority", func() {
 		anpSubject := newANPSubjectObject(
@@ -0,0 +1,398 @@
+package ovn
+
+import (
+	"context"
+	"fmt"
+
+	cnitypes "github.com/containernetworking/cni/pkg/types"
+	nettypes "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"github.com/urfave/cli/v2"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	anpapi "sigs.k8s.io/network-policy-api/apis/v1alpha1"
+
+	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	anpovn "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/admin_network_policy"
+	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+var anpNADLabel = map[string]string{"anp": "enabled"}
+
+var _ = ginkgo.Describe("OVN ANP Operations on user defined networks", func() {
+	var (
+		app     *cli.App
+		fakeOVN *FakeOVN
+	)
+
+	const (
+		anpSubjectNamespaceName = "anp-subject-namespace"
+		anpPeerNamespaceName    = "anp-peer-namespace"
+		anpSubjectPodName       = "anp-subject-pod"
+		anpPeerPodName          = "anp-peer-pod"
+		anpSubjectPodV4IP       = "10.128.1.3"
+		anpSubjectPodMAC        = "0a:58:0a:80:01:03"
+		anpPeerPodV4IP          = "10.128.1.4"
+		anpPeerPodMAC           = "0a:58:0a:80:01:04"
+		anpSubjectPodNetworkIP  = "10.100.200.3"
+		anpSubjectPodNetworkMAC = "0a:58:0a:64:c8:03"
+		anpPeerPodNetworkIP     = "10.100.200.4"
+		anpPeerPodNetworkMAC    = "0a:58:0a:64:c8:04"
+		nodeName                = "node1"
+		networkName             = "tenant-blue"
+		nadName                 = "blue"
+		layer3Subnets           = "10.100.0.0/16/24"
+		layer2Subnets           = "10.100.200.0/24"
+		// selects the NADs labeled with anpNADLabel in all the namespaces
+		networkSelectors = `[{"networkSelectionType": "NetworkAttachmentDefinitions",
+			"networkAttachmentDefinitionSelector": {"namespaceSelector": {}, "networkSelector": {"matchLabels": {"anp": "enabled"}}}}]`
+	)
+
+	ginkgo.BeforeEach(func() {
+		// Restore global default values before each testcase
+		gomega.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+		config.OVNKubernetesFeature.EnableAdminNetworkPolicy = true
+		config.OVNKubernetesFeature.EnableMultiNetwork = true
+		config.OVNKubernetesFeature.EnableNetworkSegmentation = true
+		config.OVNKubernetesFeature.EnableMultiNetworkPolicy = true
+		config.OVNKubernetesFeature.EnableInterconnect = true
+		config.IPv4Mode = true
+
+		app = cli.NewApp()
+		app.Name = "test"
+		app.Flags = config.Flags
+
+		fakeOVN = NewFakeOVN(false)
+	})
+
+	ginkgo.AfterEach(func() {
+		fakeOVN.shutdown()
+	})
+
+	// newNetworkNAD returns the NAD of the network in the provided namespace
+	newNetworkNAD := func(namespace, topology, role string) *nettypes.NetworkAttachmentDefinition {
+		subnets := layer2Subnets
+		if topology == types.Layer3Topology {
+			subnets = layer3Subnets
+		}
+		nad, err := newNetworkAttachmentDefinition(namespace, nadName, ovncnitypes.NetConf{
+			NetConf: cnitypes.NetConf{
+				Name: networkName,
+				Type: "ovn-k8s-cni-overlay",
+			},
+			Topology: topology,
+			NADName:  util.GetNADName(namespace, nadName),
+			Subnets:  subnets,
+			Role:     role,
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		nad.Labels = anpNADLabel
+		return nad
+	}
+
+	// newNetworkTPod returns a pod attached to the default network and to the network
+	newNetworkTPod := func(namespace, name, podIP, podMAC, networkIP, networkMAC, role string) testPod {
+		t := newTPod(nodeName, "10.128.1.0/24", "10.128.1.2", "10.128.1.1", name, podIP, podMAC, namespace)
+		if role == types.NetworkRolePrimary {
+			t.networkRole = types.NetworkRoleInfrastructure
+		}
+		t.addNetwork(networkName, util.GetNADName(namespace, nadName), "", "", "", networkIP, networkMAC, role, 0, nil)
+		return t
+	}
+
+	// newNetworkPod returns the pod object of the test pod, only the pods attached to a secondary
+	// network request it with the network selection annotation
+	newNetworkPod := func(t testPod, role string) corev1.Pod {
+		pod := newPod(t.namespace, t.podName, t.nodeName, t.podIP)
+		if role == types.NetworkRoleSecondary {
+			addPodNetwork(pod, t.secondaryPodInfos)
+		}
+		setPodAnnotations(pod, t)
+		return *pod
+	}
+
+	// getNetworkSwitchAndPorts returns the switch of the network with the logical switch ports
+	// of the provided pods, created by the network controller before the policies are applied
+	getNetworkSwitchAndPorts := func(netInfo util.NetInfo, pods ...testPod) []libovsdbtest.TestData {
+		switchName := netInfo.GetNetworkScopedName(types.OVNLayer2Switch)
+		if netInfo.TopologyType() == types.Layer3Topology {
+			switchName = netInfo.GetNetworkScopedName(nodeName)
+		}
+		data := []libovsdbtest.TestData{}
+		lspUUIDs := []string{}
+		for _, pod := range pods {
+			for nad, portInfo := range pod.secondaryPodInfos[networkName].allportInfo {
+				podAddr := fmt.Sprintf("%s %s", portInfo.podMAC, portInfo.podIP)
+				data = append(data, &nbdb.LogicalSwitchPort{
+					UUID:      portInfo.portUUID,
+					Name:      portInfo.portName,
+					Addresses: []string{podAddr},
+					ExternalIDs: map[string]string{
+						"pod":                    "true",
+						"namespace":              pod.namespace,
+						types.NetworkExternalID:  networkName,
+						types.NADExternalID:      nad,
+						types.TopologyExternalID: netInfo.TopologyType(),
+					},
+					PortSecurity: []string{podAddr},
+				})
+				lspUUIDs = append(lspUUIDs, portInfo.portUUID)
+			}
+		}
+		return append(data, &nbdb.LogicalSwitch{
+			UUID:  switchName + "-UUID",
+			Name:  switchName,
+			Ports: lspUUIDs,
+			ExternalIDs: map[string]string{
+				types.NetworkExternalID:     networkName,
+				types.NetworkRoleExternalID: getNetworkRole(netInfo),
+			},
+		})
+	}
+
+	newTestANP := func() *anpapi.AdminNetworkPolicy {
+		anpSubject := newANPSubjectObject(&metav1.LabelSelector{MatchLabels: anpLabel}, nil)
+		return newANPObject("harry-potter", 5, anpSubject,
+			[]anpapi.AdminNetworkPolicyIngressRule{
+				{
+					Name:   "allow-traffic-from-hufflepuff-to-gryffindor",
+					Action: anpapi.AdminNetworkPolicyRuleActionAllow,
+					From: []anpapi.AdminNetworkPolicyIngressPeer{
+						{
+							Namespaces: &metav1.LabelSelector{MatchLabels: peerAllowLabel},
+						},
+					},
+				},
+			},
+			[]anpapi.AdminNetworkPolicyEgressRule{
+				{
+					Name:   "deny-traffic-from-gryffindor-to-hufflepuff",
+					Action: anpapi.AdminNetworkPolicyRuleActionDeny,
+					To: []anpapi.AdminNetworkPolicyEgressPeer{
+						{
+							Namespaces: &metav1.LabelSelector{MatchLabels: peerAllowLabel},
+						},
+					},
+				},
+			},
+		)
+	}
+
+	newTestBANP := func() *anpapi.BaselineAdminNetworkPolicy {
+		banpSubject := newANPSubjectObject(&metav1.LabelSelector{MatchLabels: anpLabel}, nil)
+		return newBANPObject("default", banpSubject,
+			[]anpapi.BaselineAdminNetworkPolicyIngressRule{
+				{
+					Name:   "deny-traffic-from-hufflepuff-to-gryffindor",
+					Action: anpapi.BaselineAdminNetworkPolicyRuleActionDeny,
+					From: []anpapi.AdminNetworkPolicyIngressPeer{
+						{
+							Namespaces: &metav1.LabelSelector{MatchLabels: peerAllowLabel},
+						},
+					},
+				},
+			},
+			[]anpapi.BaselineAdminNetworkPolicyEgressRule{},
+		)
+	}
+
+	// getANPData returns the port group, ACLs and peer address sets of the ANP created by the
+	// controller of the network; only the IPv4 address sets exist in a single stack cluster
+	getANPData := func(controllerName string, anp *anpapi.AdminNetworkPolicy, subjectPortUUIDs, peerIPs []string) []libovsdbtest.TestData {
+		acls := getNetworkACLsForANPRules(controllerName, anp)
+		data := []libovsdbtest.TestData{getNetworkPGForANPSubject(controllerName, anp.Name, subjectPortUUIDs, acls, false)}
+		for _, acl := range acls {
+			data = append(data, acl)
+		}
+		ingressAS, _ := buildNetworkANPAddressSets(controllerName, anp, 0, peerIPs, libovsdbutil.ACLIngress)
+		egressAS, _ := buildNetworkANPAddressSets(controllerName, anp, 0, peerIPs, libovsdbutil.ACLEgress)
+		return append(data, ingressAS, egressAS)
+	}
+
+	getBANPData := func(controllerName string, banp *anpapi.BaselineAdminNetworkPolicy, subjectPortUUIDs, peerIPs []string) []libovsdbtest.TestData {
+		acls := getNetworkACLsForBANPRules(controllerName, banp)
+		data := []libovsdbtest.TestData{getNetworkPGForANPSubject(controllerName, banp.Name, subjectPortUUIDs, acls, true)}
+		for _, acl := range acls {
+			data = append(data, acl)
+		}
+		ingressAS, _ := buildNetworkBANPAddressSets(controllerName, banp, 0, peerIPs, libovsdbutil.ACLIngress)
+		return append(data, ingressAS)
+	}
+
+	// startNetwork starts the network controller of the network with the subject and peer pods attached
+	// to it, and runs the admin network policy controller of the network; it returns the network
+	// switch and ports, and the subject and peer pods
+	startNetwork := func(topology, role string) ([]libovsdbtest.TestData, testPod, testPod) {
+		subjectNAD := newNetworkNAD(anpSubjectNamespaceName, topology, role)
+		peerNAD := newNetworkNAD(anpPeerNamespaceName, topology, role)
+		netInfo, err := util.ParseNADInfo(subjectNAD)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		subjectTPod := newNetworkTPod(anpSubjectNamespaceName, anpSubjectPodName, anpSubjectPodV4IP, anpSubjectPodMAC,
+			anpSubjectPodNetworkIP, anpSubjectPodNetworkMAC, role)
+		peerTPod := newNetworkTPod(anpPeerNamespaceName, anpPeerPodName, anpPeerPodV4IP, anpPeerPodMAC,
+			anpPeerPodNetworkIP, anpPeerPodNetworkMAC, role)
+		networkData := getNetworkSwitchAndPorts(netInfo, subjectTPod, peerTPod)
+
+		fakeOVN.startWithDBSetup(libovsdbtest.TestSetup{NBData: networkData},
+			&corev1.NamespaceList{
+				Items: []corev1.Namespace{
+					*newNamespaceWithLabels(anpSubjectNamespaceName, anpLabel),
+					*newNamespaceWithLabels(anpPeerNamespaceName, peerAllowLabel),
+				},
+			},
+			&corev1.NodeList{
+				Items: []corev1.Node{
+					*newNode(nodeName, "192.168.126.202/24"),
+				},
+			},
+			&corev1.PodList{
+				Items: []corev1.Pod{
+					newNetworkPod(subjectTPod, role),
+					newNetworkPod(peerTPod, role),
+				},
+			},
+			&nettypes.NetworkAttachmentDefinitionList{
+				Items: []nettypes.NetworkAttachmentDefinition{*subjectNAD, *peerNAD},
+			},
+		)
+		fakeOVN.InitAndRunNetworkANPController(networkName)
+		return networkData, subjectTPod, peerTPod
+	}
+
+	ginkgo.DescribeTable("should create the port groups, ACLs and address sets of the network",
+		func(topology, role string, annotations map[string]string) {
+			app.Action = func(*cli.Context) error {
+				networkData, subjectTPod, _ := startNetwork(topology, role)
+				controllerName := getNetworkControllerName(networkName)
+				subjectPortUUIDs := []string{subjectTPod.getNetworkPortInfo(networkName, util.GetNADName(anpSubjectNamespaceName, nadName)).portUUID}
+				peerIPs := []string{anpPeerPodNetworkIP}
+
+				ginkgo.By("1. creating an admin network policy; check its port group contains the network LSP of the subject and its address sets the network IP of the peer")
+				anp := newTestANP()
+				anp.Annotations = annotations
+				anp, err := fakeOVN.fakeClient.ANPClient.PolicyV1alpha1().AdminNetworkPolicies().Create(context.TODO(), anp, metav1.CreateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				expectedDatabaseState := append(networkData, getANPData(controllerName, anp, subjectPortUUIDs, peerIPs)...)
+				gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveData(expectedDatabaseState))
+
+				ginkgo.By("2. creating a baseline admin network policy; check it is also applied to the network")
+				banp := newTestBANP()
+				banp.Annotations = annotations
+				banp, err = fakeOVN.fakeClient.ANPClient.PolicyV1alpha1().BaselineAdminNetworkPolicies().Create(context.TODO(), banp, metav1.CreateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				expectedDatabaseState = append(expectedDatabaseState, getBANPData(controllerName, banp, subjectPortUUIDs, peerIPs)...)
+				gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveData(expectedDatabaseState))
+
+				ginkgo.By("3. deleting the policies; check their port groups, ACLs and address sets are removed from the network")
+				err = fakeOVN.fakeClient.ANPClient.PolicyV1alpha1().AdminNetworkPolicies().Delete(context.TODO(), anp.Name, metav1.DeleteOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				err = fakeOVN.fakeClient.ANPClient.PolicyV1alpha1().BaselineAdminNetworkPolicies().Delete(context.TODO(), banp.Name, metav1.DeleteOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveData(networkData))
+
+				return nil
+			}
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		},
+		ginkgo.Entry("on a primary layer3 user defined network", types.Layer3Topology, types.NetworkRolePrimary, nil),
+		ginkgo.Entry("on a primary layer2 user defined network", types.Layer2Topology, types.NetworkRolePrimary, nil),
+		ginkgo.Entry("on a selected secondary layer3 network", types.Layer3Topology, types.NetworkRoleSecondary,
+			map[string]string{anpovn.NetworkSelectorsAnnotation: networkSelectors}),
+		ginkgo.Entry("on a selected secondary layer2 network", types.Layer2Topology, types.NetworkRoleSecondary,
+			map[string]string{anpovn.NetworkSelectorsAnnotation: networkSelectors}),
+	)
+
+	ginkgo.It("should remove the policies from a secondary network they stop selecting", func() {
+		app.Action = func(*cli.Context) error {
+			networkData, subjectTPod, _ := startNetwork(types.Layer2Topology, types.NetworkRoleSecondary)
+			controllerName := getNetworkControllerName(networkName)
+			subjectPortUUIDs := []string{subjectTPod.getNetworkPortInfo(networkName, util.GetNADName(anpSubjectNamespaceName, nadName)).portUUID}
+			peerIPs := []string{anpPeerPodNetworkIP}
+
+			ginkgo.By("1. creating an admin network policy that doesn't select any secondary network; check it is not applied to the network")
+			anp := newTestANP()
+			anp, err := fakeOVN.fakeClient.ANPClient.PolicyV1alpha1().AdminNetworkPolicies().Create(context.TODO(), anp, metav1.CreateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Consistently(fakeOVN.nbClient).Should(libovsdbtest.HaveData(networkData))
+
+			ginkgo.By("2. selecting the NADs of the network; check the policy is applied to the network")
+			anp.Annotations = map[string]string{anpovn.NetworkSelectorsAnnotation: networkSelectors}
+			anp.ResourceVersion = "2"
+			anp, err = fakeOVN.fakeClient.ANPClient.PolicyV1alpha1().AdminNetworkPolicies().Update(context.TODO(), anp, metav1.UpdateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			expectedDatabaseState := append(networkData, getANPData(controllerName, anp, subjectPortUUIDs, peerIPs)...)
+			gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveData(expectedDatabaseState))
+
+			ginkgo.By("3. removing the label of the NADs; check the policy is removed from the network")
+			for _, namespace := range []string{anpSubjectNamespaceName, anpPeerNamespaceName} {
+				nad, err := fakeOVN.fakeClient.NetworkAttchDefClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(namespace).Get(
+					context.TODO(), nadName, metav1.GetOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				nad.Labels = nil
+				nad.ResourceVersion = "2"
+				_, err = fakeOVN.fakeClient.NetworkAttchDefClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(namespace).Update(
+					context.TODO(), nad, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			}
+			gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveData(networkData))
+
+			ginkgo.By("4. selecting all the NADs; check the policy is applied to the network again")
+			anp.Annotations = map[string]string{anpovn.NetworkSelectorsAnnotation: `[{"networkSelectionType": "NetworkAttachmentDefinitions",
+				"networkAttachmentDefinitionSelector": {"namespaceSelector": {}, "networkSelector": {}}}]`}
+			anp.ResourceVersion = "3"
+			anp, err = fakeOVN.fakeClient.ANPClient.PolicyV1alpha1().AdminNetworkPolicies().Update(context.TODO(), anp, metav1.UpdateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveData(expectedDatabaseState))
+
+			ginkgo.By("5. removing the network selectors annotation; check the policy is removed from the network")
+			anp.Annotations = nil
+			anp.ResourceVersion = "4"
+			_, err = fakeOVN.fakeClient.ANPClient.PolicyV1alpha1().AdminNetworkPolicies().Update(context.TODO(), anp, metav1.UpdateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveData(networkData))
+
+			return nil
+		}
+		err := app.Run([]string{app.Name})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	ginkgo.It("should remove the policies of a deleted network", func() {
+		app.Action = func(*cli.Context) error {
+			networkData, subjectTPod, _ := startNetwork(types.Layer2Topology, types.NetworkRolePrimary)
+			controllerName := getNetworkControllerName(networkName)
+			subjectPortUUIDs := []string{subjectTPod.getNetworkPortInfo(networkName, util.GetNADName(anpSubjectNamespaceName, nadName)).portUUID}
+			peerIPs := []string{anpPeerPodNetworkIP}
+
+			ginkgo.By("1. creating an admin network policy and a baseline admin network policy; check they are applied to the network")
+			anp, err := fakeOVN.fakeClient.ANPClient.PolicyV1alpha1().AdminNetworkPolicies().Create(context.TODO(), newTestANP(), metav1.CreateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			banp, err := fakeOVN.fakeClient.ANPClient.PolicyV1alpha1().BaselineAdminNetworkPolicies().Create(context.TODO(), newTestBANP(), metav1.CreateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			expectedDatabaseState := append(networkData, getANPData(controllerName, anp, subjectPortUUIDs, peerIPs)...)
+			expectedDatabaseState = append(expectedDatabaseState, getBANPData(controllerName, banp, subjectPortUUIDs, peerIPs)...)
+			gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveData(expectedDatabaseState))
+
+			ginkgo.By("2. deleting the network; check the port groups, ACLs and address sets of the policies are removed along with it")
+			networkController := fakeOVN.fullSecondaryL2Controllers[networkName]
+			networkController.Stop()
+			// the controller is stopped already, don't stop it again on shutdown
+			delete(fakeOVN.secondaryControllers, networkName)
+			gomega.Expect(networkController.Cleanup()).To(gomega.Succeed())
+			gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveData([]libovsdbtest.TestData{}))
+
+			return nil
+		}
+		err := app.Run([]string{app.Name})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+})
@@ -38,7 +38,9 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/observability"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
+	anpcontroller "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/admin_network_policy"
 	nqoscontroller "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/network_qos"
+	dnsnameresolver "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/dns_name_resolver"
 	lsm "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/logical_switch_manager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/routeimport"
 	zoneic "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/zone_interconnect"
@@ -187,17 +189,23 @@ type BaseNetworkController struct {
 
 	// Controller used for programming OVN for Network QoS
 	nqosController *nqoscontroller.Controller
+
+	// Controller used for programming OVN for Admin Network Policy
+	anpController *anpcontroller.Controller
 }
 
 func (oc *BaseNetworkController) reconcile(netInfo util.NetInfo, setNodeFailed func(string)) error {
 	// gather some information first
 	var err error
 	var retryNodes []*corev1.Node
+	// the MTU and subnets can be updated in place, in which case the topology
+	// of every node needs to be reconfigured
+	configChanged := oc.MTU() != netInfo.MTU() || !reflect.DeepEqual(oc.Subnets(), netInfo.Subnets())
 	oc.localZoneNodes.Range(func(key, _ any) bool {
 		nodeName := key.(string)
 		wasAdvertised := util.IsPodNetworkAdvertisedAtNode(oc, nodeName)
 		isAdvertised := util.IsPodNetworkAdvertisedAtNode(netInfo, nodeName)
-		if wasAdvertised == isAdvertised {
+		if wasAdvertised == isAdvertised && !configChanged {
 			// noop
 			return true
 		}
@@ -239,6 +247,13 @@ func (oc *BaseNetworkController) reconcile(netInfo util.NetInfo, setNodeFailed f
 		oc.retryNodes.RequestRetryObjs()
 	}
 
+	if reconcilePendingPods && oc.IsolatesNamespaces() {
+		// the namespaces of the network might have changed
+		if err := oc.syncNamespaceIsolation(); err != nil {
+			klog.Errorf("Failed to sync namespace isolation for network %s: %v", oc.GetNetworkName(), err)
+		}
+	}
+
 	if reconcilePendingPods {
 		if err := ovnretry.RequeuePendingPods(oc.watchFactory, oc.GetNetInfo(), oc.retryPods); err != nil {
 			klog.Errorf("Failed to requeue pending pods for network %s: %v", oc.GetNetworkName(), err)
@@ -1097,6 +1112,34 @@ func (bnc *BaseNetworkController) newNetworkQoSController() error {
 	return err
 }
 
+func (bnc *BaseNetworkController) newANPController(dnsNameResolver dnsnameresolver.DNSNameResolver) error {
+	var err error
+	var nadInformer nadinformerv1.NetworkAttachmentDefinitionInformer
+
+	if config.OVNKubernetesFeature.EnableMultiNetwork {
+		nadInformer = bnc.watchFactory.NADInformer()
+	}
+	bnc.anpController, err = anpcontroller.NewController(
+		bnc.controllerName,
+		bnc.ReconcilableNetInfo.GetNetInfo(),
+		bnc.nbClient,
+		bnc.kube.ANPClient,
+		bnc.watchFactory.ANPInformer(),
+		bnc.watchFactory.BANPInformer(),
+		bnc.watchFactory.NamespaceCoreInformer(),
+		bnc.watchFactory.PodCoreInformer(),
+		bnc.watchFactory.NodeCoreInformer(),
+		nadInformer,
+		bnc.addressSetFactory,
+		dnsNameResolver,
+		bnc.isPodScheduledinLocalZone,
+		bnc.zone,
+		bnc.recorder,
+		bnc.observManager,
+	)
+	return err
+}
+
 func initLoadBalancerGroups(nbClient libovsdbclient.Client, netInfo util.NetInfo) (
 	clusterLoadBalancerGroupUUID, switchLoadBalancerGroupUUID, routerLoadBalancerGroupUUID string, err error) {
 
@@ -2,7 +2,6 @@ package ovn
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
@@ -73,9 +72,11 @@ func (bnc *BaseNetworkController) shouldWatchNamespaces() bool {
 	// - The network is the default network.
 	// - The network is primary, and network segmentation is enabled.
 	// - The network is secondary, and multi NetworkPolicies are enabled.
+	// - The network isolates its namespaces.
 	return bnc.IsDefault() ||
 		bnc.IsPrimaryNetwork() && util.IsNetworkSegmentationSupportEnabled() ||
-		bnc.IsSecondary() && util.IsMultiNetworkPoliciesSupportEnabled()
+		bnc.IsSecondary() && util.IsMultiNetworkPoliciesSupportEnabled() ||
+		bnc.IsolatesNamespaces()
 }
 
 // WatchNamespaces starts the watching of namespace resource and calls
@@ -105,6 +106,7 @@ func (bnc *BaseNetworkController) WatchNamespaces() error {
 // *) If the provided annotation cannot be unmarshaled: Disable both Deny and Allow logging. Return an error.
 // *) Valid values for "allow" and "deny" are  "alert", "warning", "notice", "info", "debug", "".
 // *) Invalid values will return an error, and logging will be disabled for the respective key.
+// *) A positive "rateLimit" overrides the cluster wide ACL logging rate limit, invalid values return an error.
 // *) In the following special cases, nsInfo.aclLogging.Deny and nsInfo.aclLogging.Allow. will both be reset to ""
 //
 //	without logging an error, meaning that logging will be switched off:
@@ -116,44 +118,21 @@ func (bnc *BaseNetworkController) WatchNamespaces() error {
 //
 //	annotation, then assume that this key should be disabled by setting its nsInfo value to "".
 func (bnc *BaseNetworkController) aclLoggingUpdateNsInfo(annotation string, nsInfo *namespaceInfo) error {
-	var aclLevels libovsdbutil.ACLLoggingLevels
-	var errors []error
-
-	// If the annotation is "" or "{}", use empty strings. Otherwise, parse the annotation.
-	if annotation != "" && annotation != "{}" {
-		err := json.Unmarshal([]byte(annotation), &aclLevels)
-		if err != nil {
-			// Disable Allow and Deny logging to ensure idempotency.
-			nsInfo.aclLogging.Allow = ""
-			nsInfo.aclLogging.Deny = ""
-			return fmt.Errorf("could not unmarshal namespace ACL annotation '%s', disabling logging, err: %q",
-				annotation, err)
+	aclLevels, err := libovsdbutil.ParseACLLoggingLevels(annotation, "namespace")
+	// pass logging only applies to admin network policies
+	nsInfo.aclLogging = libovsdbutil.ACLLoggingLevels{
+		Allow:     aclLevels.Allow,
+		Deny:      aclLevels.Deny,
+		RateLimit: aclLevels.RateLimit,
+	}
+	if nsInfo.aclLogging.RateLimit > 0 {
+		if meterErr := libovsdbutil.EnsureACLLoggingMeter(bnc.nbClient, &nsInfo.aclLogging); meterErr != nil {
+			// fall back to the default ACL logging meter
+			nsInfo.aclLogging.RateLimit = 0
+			err = utilerrors.Join(err, meterErr)
 		}
 	}
-
-	// Valid log levels are the various preestablished levels or the empty string.
-	validLogLevels := sets.NewString(nbdb.ACLSeverityAlert, nbdb.ACLSeverityWarning, nbdb.ACLSeverityNotice,
-		nbdb.ACLSeverityInfo, nbdb.ACLSeverityDebug, "")
-
-	// Set Deny logging.
-	if validLogLevels.Has(aclLevels.Deny) {
-		nsInfo.aclLogging.Deny = aclLevels.Deny
-	} else {
-		errors = append(errors, fmt.Errorf("disabling deny logging due to invalid deny annotation. "+
-			"%q is not a valid log severity", aclLevels.Deny))
-		nsInfo.aclLogging.Deny = ""
-	}
-
-	// Set Allow logging.
-	if validLogLevels.Has(aclLevels.Allow) {
-		nsInfo.aclLogging.Allow = aclLevels.Allow
-	} else {
-		errors = append(errors, fmt.Errorf("disabling allow logging due to an invalid allow annotation. "+
-			"%q is not a valid log severity", aclLevels.Allow))
-		nsInfo.aclLogging.Allow = ""
-	}
-
-	return utilerrors.Join(errors...)
+	return err
 }
 
 // This function implements the main body of work of syncNamespaces.
@@ -272,7 +251,8 @@ func (bnc *BaseNetworkController) ensureNamespaceLockedCommon(ns string, readOnl
 			return nil, nil, fmt.Errorf("failed to create address set for namespace: %s, error: %v", ns, err)
 		}
 
-		// namespace port groups are only used by egress firewall and multicast for now
+		// namespace port groups are only used by egress firewall, multicast and
+		// namespace isolation for now
 		if bnc.needNamespacedPortGroup() {
 			portGroupName, err := bnc.createNamespacePortGroup(ns)
 			if err != nil {
@@ -332,8 +312,9 @@ func (bnc *BaseNetworkController) ensureNamespaceLockedCommon(ns string, readOnl
 }
 
 func (bnc *BaseNetworkController) needNamespacedPortGroup() bool {
-	// namespace port groups are only used by egress firewall and multicast for now
-	return bnc.multicastSupport || config.OVNKubernetesFeature.EnableEgressFirewall
+	// namespace port groups are only used by egress firewall, multicast and
+	// namespace isolation for now
+	return bnc.multicastSupport || config.OVNKubernetesFeature.EnableEgressFirewall || bnc.IsolatesNamespaces()
 }
 
 func (bnc *BaseNetworkController) configureNamespaceCommon(nsInfo *namespaceInfo, ns *corev1.Namespace) error {
@@ -46,6 +46,17 @@ func TestBaseNetworkController_shouldWatchNamespaces(t *testing.T) {
 			enableMultiNetPolicies: true,
 			expectedReturn:         true,
 		},
+		{
+			name: "should watch namespaces for secondary network isolating namespaces",
+			netCfg: &ovntypes.NetConf{
+				NetConf:           cnitypes.NetConf{Name: "secondary"},
+				Topology:          types.Layer3Topology,
+				Role:              types.NetworkRoleSecondary,
+				Subnets:           "192.168.0.0/16",
+				IsolateNamespaces: true,
+			},
+			expectedReturn: true,
+		},
 		{
 			name: "should not watch namespaces for primary network when network segmentation is disabled",
 			netCfg: &ovntypes.NetConf{
@@ -176,6 +176,11 @@ type networkPolicy struct {
 	localPods sync.Map
 
 	portGroupName string
+	// aclLogging is set when the network policy has its own ACL logging annotation, it then overrides the
+	// namespace ACL logging for the network policy ACLs. Default deny ACLs are shared by all the network
+	// policies of a namespace and always follow the namespace ACL logging.
+	// It is only set on network policy creation.
+	aclLogging *libovsdbutil.ACLLoggingLevels
 	// this is a signal for related event handlers that they are/should be stopped.
 	// it will be set to true before any networkPolicy infrastructure is deleted,
 	// therefore every handler can either do its work and be sure all required resources are there,
@@ -201,6 +206,14 @@ func NewNetworkPolicy(policy *knet.NetworkPolicy) *networkPolicy {
 	return np
 }
 
+// getACLLogging returns the ACL logging levels of the network policy ACLs given the namespace ones.
+func (np *networkPolicy) getACLLogging(nsACLLogging *libovsdbutil.ACLLoggingLevels) *libovsdbutil.ACLLoggingLevels {
+	if np.aclLogging != nil {
+		return np.aclLogging
+	}
+	return nsACLLogging
+}
+
 func (bnc *BaseNetworkController) syncNetworkPolicies(networkPolicies []interface{}) error {
 	expectedPolicies := make(map[string]map[string]bool)
 	for _, npInterface := range networkPolicies {
@@ -308,6 +321,11 @@ func (bnc *BaseNetworkController) syncNetworkPoliciesCommon(expectedPolicies map
 	if err := libovsdbops.DeletePortGroupsWithPredicate(bnc.nbClient, p); err != nil {
 		return fmt.Errorf("cannot find default deny NetworkPolicy port groups: %v", err)
 	}
+
+	// stale policies may have been the last users of their ACL logging meters
+	if err := libovsdbutil.DeleteUnusedACLLoggingMeters(bnc.nbClient); err != nil {
+		klog.Warningf("Failed to delete unused ACL logging meters: %v", err)
+	}
 	return nil
 }
 
@@ -489,6 +507,7 @@ func (bnc *BaseNetworkController) updateACLLoggingForPolicy(np *networkPolicy, a
 	if np.deleted {
 		return nil
 	}
+	aclLogging = np.getACLLogging(aclLogging)
 
 	// Predicate for given network policy ACLs
 	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.ACLNetworkPolicy, bnc.controllerName, map[libovsdbops.ExternalIDKey]string{
@@ -979,9 +998,18 @@ func (bnc *BaseNetworkController) createNetworkPolicy(policy *knet.NetworkPolicy
 		// now we have a new np stored in bnc.networkPolicies
 		var err error
 
-		if aclLogging.Deny != "" || aclLogging.Allow != "" {
-			klog.Infof("ACL logging for network policy %s in namespace %s set to deny=%s, allow=%s",
-				policy.Name, policy.Namespace, aclLogging.Deny, aclLogging.Allow)
+		if annotation, ok := policy.Annotations[util.AclLoggingAnnotation]; ok {
+			var parseErr error
+			np.aclLogging, parseErr = libovsdbutil.ParseACLLoggingLevels(annotation, "network policy")
+			if parseErr != nil {
+				klog.Warningf("Network policy %s: ACL logging contained malformed annotation, "+
+					"ACL logging is set to allow=%s, err: %q", npKey, np.aclLogging.Allow, parseErr)
+			}
+		}
+		policyACLLogging := np.getACLLogging(aclLogging)
+		if aclLogging.Deny != "" || policyACLLogging.Allow != "" {
+			klog.Infof("ACL logging for network policy %s in namespace %s set to deny=%s, allow=%s, rate limit=%d",
+				policy.Name, policy.Namespace, aclLogging.Deny, policyACLLogging.Allow, policyACLLogging.RateLimit)
 		}
 
 		// 2. Build gress policies, create addressSets for peers
@@ -1046,6 +1074,7 @@ func (bnc *BaseNetworkController) createNetworkPolicy(policy *knet.NetworkPolicy
 			}
 		}
 		klog.Infof("Policy %s added to peer address sets %v", npKey, np.peerAddressSets)
+		bnc.recordNamedReturnPortsEvent(policy, np)
 
 		// 3. Add policy to default deny port group
 		// Pods are not added to default deny port groups yet, this is just a preparation step
@@ -1061,7 +1090,13 @@ func (bnc *BaseNetworkController) createNetworkPolicy(policy *knet.NetworkPolicy
 		np.portGroupName = libovsdbutil.GetPortGroupName(pgDbIDs)
 		ops := []ovsdb.Operation{}
 
-		acls := bnc.buildNetworkPolicyACLs(np, aclLogging)
+		if np.aclLogging != nil && np.aclLogging.RateLimit > 0 {
+			ops, err = libovsdbutil.CreateOrUpdateACLLoggingMeterOps(bnc.nbClient, ops, np.aclLogging)
+			if err != nil {
+				return fmt.Errorf("failed to create ACL logging meter ops: %v", err)
+			}
+		}
+		acls := bnc.buildNetworkPolicyACLs(np, policyACLLogging)
 		ops, err = libovsdbops.CreateOrUpdateACLsOps(bnc.nbClient, ops, bnc.GetSamplingConfig(), acls...)
 		if err != nil {
 			return fmt.Errorf("failed to create ACL ops: %v", err)
@@ -1166,6 +1201,25 @@ func (bnc *BaseNetworkController) setupGressPolicy(np *networkPolicy, gp *gressP
 	return nil, nil
 }
 
+// recordNamedReturnPortsEvent posts a warning event on a stateless network policy
+// when the return traffic from some of its named ports is not allowed, as the return
+// ACLs can't be built for ports that are not resolved.
+func (bnc *BaseNetworkController) recordNamedReturnPortsEvent(policy *knet.NetworkPolicy, np *networkPolicy) {
+	namedPorts := sets.New[string]()
+	for _, gp := range np.ingressPolicies {
+		namedPorts.Insert(gp.namedReturnPorts...)
+	}
+	for _, gp := range np.egressPolicies {
+		namedPorts.Insert(gp.namedReturnPorts...)
+	}
+	if namedPorts.Len() == 0 {
+		return
+	}
+	bnc.recorder.Eventf(policy, corev1.EventTypeWarning, "NamedPortReturnTrafficNotAllowed",
+		"The return traffic from named ports %v of stateless network policy %s is not allowed, named ports are not resolved",
+		sets.List(namedPorts), getPolicyKey(policy))
+}
+
 // addNetworkPolicy creates and applies OVN ACLs to pod logical switch
 // ports from Kubernetes NetworkPolicy objects using OVN Port Groups
 // if addNetworkPolicy fails, create or delete operation can be retried
@@ -1240,7 +1294,7 @@ func (bnc *BaseNetworkController) addNetworkPolicy(policy *knet.NetworkPolicy) e
 	// 4. check if namespace information related to network policy has changed,
 	// network policy only reacts to namespace update ACL log level.
 	// Run handleNetPolNamespaceUpdate sequence, but only for 1 newly added policy.
-	if nsInfo.aclLogging.Deny != aclLogging.Deny {
+	if nsInfo.aclLogging.Deny != aclLogging.Deny || nsInfo.aclLogging.RateLimit != aclLogging.RateLimit {
 		if err = bnc.updateACLLoggingForDefaultACLs(policy.Namespace, nsInfo); err != nil {
 			return fmt.Errorf("network policy %s failed to be created: update default deny ACLs failed: %v", npKey, err)
 		} else {
@@ -1248,7 +1302,7 @@ func (bnc *BaseNetworkController) addNetworkPolicy(policy *knet.NetworkPolicy) e
 				npKey, nsInfo.aclLogging.Deny, nsInfo.aclLogging.Allow)
 		}
 	}
-	if nsInfo.aclLogging.Allow != aclLogging.Allow {
+	if nsInfo.aclLogging.Allow != aclLogging.Allow || nsInfo.aclLogging.RateLimit != aclLogging.RateLimit {
 		if err = bnc.updateACLLoggingForPolicy(np, &nsInfo.aclLogging); err != nil {
 			return fmt.Errorf("network policy %s failed to be created: update policy ACLs failed: %v", npKey, err)
 		} else {
@@ -1291,24 +1345,34 @@ func (bnc *BaseNetworkController) deleteNetworkPolicy(policy *knet.NetworkPolicy
 		}()
 	}
 	// First lock and update namespace
+	var aclLogging libovsdbutil.ACLLoggingLevels
 	nsInfo, nsUnlock := bnc.getNamespaceLocked(policy.Namespace, false)
 	if nsInfo != nil {
 		// unsubscribe from namespace events
 		delete(nsInfo.relatedNetworkPolicies, npKey)
+		aclLogging = nsInfo.aclLogging
 		nsUnlock()
 	}
 	// Next cleanup network policy
+	usedACLLoggingMeter := false
 	err := bnc.networkPolicies.DoWithLock(npKey, func(npKey string) error {
 		np, ok := bnc.networkPolicies.Load(npKey)
 		if !ok {
 			klog.Infof("Deleting policy %s that is already deleted", npKey)
 			return nil
 		}
+		usedACLLoggingMeter = np.getACLLogging(&aclLogging).RateLimit > 0
 		if err := bnc.cleanupNetworkPolicy(np); err != nil {
 			return fmt.Errorf("deleting policy %s failed: %v", npKey, err)
 		}
 		return nil
 	})
+	if err == nil && usedACLLoggingMeter {
+		// the policy may have been the last user of its ACL logging meter
+		if meterErr := libovsdbutil.DeleteUnusedACLLoggingMeters(bnc.nbClient); meterErr != nil {
+			klog.Warningf("Failed to delete unused ACL logging meters after deleting policy %s: %v", npKey, meterErr)
+		}
+	}
 	return err
 }
 
@@ -1465,6 +1529,7 @@ func (bnc *BaseNetworkController) peerNamespaceUpdate(np *networkPolicy, gp *gre
 	if np.deleted {
 		return nil
 	}
+	aclLogging = np.getACLLogging(aclLogging)
 	// buildLocalPodACLs is safe for concurrent use, see function comment for details
 	acls, deletedACLs := gp.buildLocalPodACLs(np.portGroupName, aclLogging)
 	ops, err := libovsdbops.CreateOrUpdateACLsOps(bnc.nbClient, nil, bnc.GetSamplingConfig(), acls...)
@@ -372,8 +372,7 @@ func (bsnc *BaseSecondaryNetworkController) addLogicalPortToNetworkForNAD(pod *c
 		}
 	}
 
-	if bsnc.doesNetworkRequireIPAM() &&
-		(util.IsMultiNetworkPoliciesSupportEnabled() || (util.IsNetworkSegmentationSupportEnabled() && bsnc.IsPrimaryNetwork())) {
+	if bsnc.tracksPodsInNamespaces() {
 		// Ensure the namespace/nsInfo exists
 		portUUID := ""
 		if lsp != nil {
@@ -456,10 +455,7 @@ func (bsnc *BaseSecondaryNetworkController) removePodForSecondaryNetwork(pod *co
 
 		// handle remote pod clean up but only do this one time
 		if !hasLogicalPort && !alreadyProcessed {
-			if bsnc.doesNetworkRequireIPAM() &&
-				// address set is for network policy only. So either multi network policy is enabled or network
-				// segmentation, and it is a primary UDN (regular netpol)
-				(util.IsMultiNetworkPoliciesSupportEnabled() || (util.IsNetworkSegmentationSupportEnabled() && bsnc.IsPrimaryNetwork())) {
+			if bsnc.tracksPodsInNamespaces() {
 				return bsnc.removeRemoteZonePodFromNamespaceAddressSet(pod)
 			}
 
@@ -483,6 +479,13 @@ func (bsnc *BaseSecondaryNetworkController) removePodForSecondaryNetwork(pod *co
 			return err
 		}
 
+		if bsnc.ServesDHCP() && !kubevirt.IsPodOwnedByVirtualMachine(pod) {
+			if err := kubevirt.DeletePodDHCPOptions(bsnc.controllerName, bsnc.nbClient, pod); err != nil {
+				return fmt.Errorf("failed to delete DHCP options of pod %s/%s on network %s: %w",
+					pod.Namespace, pod.Name, bsnc.GetNetworkName(), err)
+			}
+		}
+
 		// do not release IP address if this controller does not handle IP allocation
 		if !bsnc.allocatesPodAnnotation() {
 			continue
@@ -522,6 +525,18 @@ func (bsnc *BaseSecondaryNetworkController) removePodForSecondaryNetwork(pod *co
 	return nil
 }
 
+// tracksPodsInNamespaces returns whether the pods of the network have to be
+// added to the address set and port group of their namespace.
+func (bsnc *BaseSecondaryNetworkController) tracksPodsInNamespaces() bool {
+	// address sets are for network policy and namespace isolation only. So
+	// either multi network policy is enabled, or network segmentation and it
+	// is a primary UDN (regular netpol), or the network isolates namespaces.
+	return bsnc.doesNetworkRequireIPAM() &&
+		(util.IsMultiNetworkPoliciesSupportEnabled() ||
+			(util.IsNetworkSegmentationSupportEnabled() && bsnc.IsPrimaryNetwork()) ||
+			bsnc.IsolatesNamespaces())
+}
+
 // hasIPAMClaim determines whether a pod's IPAM is being handled by IPAMClaim CR.
 // pod passed should already be validated as having a network connection to nadName
 func (bsnc *BaseSecondaryNetworkController) hasIPAMClaim(pod *corev1.Pod, nadNamespacedName string) (bool, error) {
@@ -840,11 +855,6 @@ func (bsnc *BaseSecondaryNetworkController) buildUDNEgressSNAT(localPodSubnets [
 func (bsnc *BaseSecondaryNetworkController) ensureDHCP(pod *corev1.Pod, podAnnotation *util.PodAnnotation, lsp *nbdb.LogicalSwitchPort) error {
 	opts := []kubevirt.DHCPConfigsOpt{}
 
-	ipv4DNSServer, ipv6DNSServer, err := kubevirt.RetrieveDNSServiceClusterIPs(bsnc.watchFactory)
-	if err != nil {
-		return err
-	}
-
 	ipv4Gateway, _ := util.MatchFirstIPFamily(false /*ipv4*/, podAnnotation.Gateways)
 	if ipv4Gateway != nil {
 		opts = append(opts, kubevirt.WithIPv4Router(ipv4Gateway.String()))
@@ -854,9 +864,19 @@ func (bsnc *BaseSecondaryNetworkController) ensureDHCP(pod *corev1.Pod, podAnnot
 		opts = append(opts, kubevirt.WithIPv4MTU(bsnc.MTU()))
 	}
 
-	opts = append(opts, kubevirt.WithIPv4DNSServer(ipv4DNSServer), kubevirt.WithIPv6DNSServer(ipv6DNSServer))
+	// the cluster DNS service is only reachable through the primary network
+	if bsnc.IsPrimaryNetwork() {
+		ipv4DNSServer, ipv6DNSServer, err := kubevirt.RetrieveDNSServiceClusterIPs(bsnc.watchFactory)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, kubevirt.WithIPv4DNSServer(ipv4DNSServer), kubevirt.WithIPv6DNSServer(ipv6DNSServer))
+	}
 
-	return kubevirt.EnsureDHCPOptionsForLSP(bsnc.controllerName, bsnc.nbClient, pod, podAnnotation.IPs, lsp, opts...)
+	if kubevirt.IsPodOwnedByVirtualMachine(pod) {
+		return kubevirt.EnsureDHCPOptionsForLSP(bsnc.controllerName, bsnc.nbClient, pod, podAnnotation.IPs, lsp, opts...)
+	}
+	return kubevirt.EnsureDHCPOptionsForPodLSP(bsnc.controllerName, bsnc.nbClient, pod, podAnnotation.IPs, lsp, opts...)
 }
 
 func getMasqueradeManagementIPSNATMatch(dstMac string) string {
@@ -864,7 +884,11 @@ func getMasqueradeManagementIPSNATMatch(dstMac string) string {
 }
 
 func (bsnc *BaseSecondaryNetworkController) requireDHCP(pod *corev1.Pod) bool {
-	// Configure DHCP only for kubevirt VMs layer2 primary udn with subnets
+	// Configure DHCP for every pod of layer2 networks serving DHCP
+	if bsnc.TopologyType() == types.Layer2Topology && bsnc.ServesDHCP() {
+		return true
+	}
+	// Otherwise configure DHCP only for kubevirt VMs layer2 primary udn with subnets
 	return kubevirt.IsPodOwnedByVirtualMachine(pod) &&
 		util.IsNetworkSegmentationSupportEnabled() &&
 		bsnc.IsPrimaryNetwork() &&
@@ -3,6 +3,7 @@ package ovn
 import (
 	"fmt"
 	"net"
+	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
@@ -68,6 +69,14 @@ func (oc *BaseSecondaryLayer2NetworkController) cleanup() error {
 		return fmt.Errorf("failed to get ops for deleting switches of network %s: %v", netName, err)
 	}
 
+	// delete the router sending the IPv6 router advertisements of the network,
+	// its port goes along
+	ops, err = libovsdbops.DeleteLogicalRouterOps(oc.nbClient, ops,
+		&nbdb.LogicalRouter{Name: oc.GetNetworkScopedName(types.OVNLayer2RARouter)})
+	if err != nil {
+		return fmt.Errorf("failed to get ops for deleting the router advertisements router of network %s: %v", netName, err)
+	}
+
 	ops, err = cleanupPolicyLogicalEntities(oc.nbClient, ops, oc.controllerName)
 	if err != nil {
 		return err
@@ -94,6 +103,16 @@ func (oc *BaseSecondaryLayer2NetworkController) cleanup() error {
 		return fmt.Errorf("failed to deleting switches of network %s: %v", netName, err)
 	}
 
+	// the DHCP options served to the pods of the network are not referenced
+	// by the switches anymore
+	err = libovsdbops.DeleteDHCPOptionsWithPredicate(oc.nbClient,
+		func(item *nbdb.DHCPOptions) bool {
+			return item.ExternalIDs[libovsdbops.OwnerControllerKey.String()] == oc.controllerName
+		})
+	if err != nil {
+		return fmt.Errorf("failed to delete DHCP options of network %s: %v", netName, err)
+	}
+
 	return nil
 }
 
@@ -137,6 +156,21 @@ func (oc *BaseSecondaryLayer2NetworkController) run() error {
 		}
 	}
 
+	// start Admin Network Policy controller if feature is enabled, the policies always apply to
+	// the primary networks while secondary networks have to be selected by the policies
+	if config.OVNKubernetesFeature.EnableAdminNetworkPolicy && (oc.IsPrimaryNetwork() || util.IsMultiNetworkPoliciesSupportEnabled()) {
+		err := oc.newANPController(nil)
+		if err != nil {
+			return fmt.Errorf("unable to create admin network policy controller, err: %w", err)
+		}
+		oc.wg.Add(1)
+		go func() {
+			defer oc.wg.Done()
+			// Until we have scale issues in future let's spawn only one thread
+			oc.anpController.Run(1, oc.stopChan)
+		}()
+	}
+
 	// start NetworkQoS controller if feature is enabled
 	if config.OVNKubernetesFeature.EnableNetworkQoS {
 		err := oc.newNetworkQoSController()
@@ -179,6 +213,14 @@ func (oc *BaseSecondaryLayer2NetworkController) initializeLogicalSwitch(switchNa
 		}
 	}
 
+	if len(oc.TrunkVlans()) > 0 {
+		// let the pods send and receive VLAN tagged traffic
+		if logicalSwitch.OtherConfig == nil {
+			logicalSwitch.OtherConfig = map[string]string{}
+		}
+		logicalSwitch.OtherConfig["vlan-passthru"] = "true"
+	}
+
 	if oc.isLayer2Interconnect() {
 		err := oc.zoneICHandler.AddTransitSwitchConfig(&logicalSwitch)
 		if err != nil {
@@ -202,6 +244,94 @@ func (oc *BaseSecondaryLayer2NetworkController) initializeLogicalSwitch(switchNa
 	return &logicalSwitch, nil
 }
 
+// ensureRARouter creates the router sending the IPv6 router advertisements of
+// a secondary network serving DHCP, which tell the pods to get their IPv6
+// addresses with DHCPv6 and which prefixes are on-link. The router port uses
+// the subnet-router anycast address of the network subnets, which the IP
+// allocator never hands out to the pods. Every zone has its own copy of the
+// router, attached to its copy of the switch.
+func (oc *BaseSecondaryLayer2NetworkController) ensureRARouter(switchName string) error {
+	var networks []string
+	var routerMAC net.HardwareAddr
+	for _, subnet := range oc.Subnets() {
+		if !utilnet.IsIPv6CIDR(subnet.CIDR) {
+			continue
+		}
+		if routerMAC == nil {
+			routerMAC = util.IPAddrToHWAddr(subnet.CIDR.IP)
+		}
+		networks = append(networks, subnet.CIDR.String())
+	}
+	if len(networks) == 0 {
+		return fmt.Errorf("network %s has no IPv6 subnet to advertise", oc.GetNetworkName())
+	}
+
+	routerName := oc.GetNetworkScopedName(types.OVNLayer2RARouter)
+	logicalRouter := nbdb.LogicalRouter{
+		Name:        routerName,
+		ExternalIDs: util.GenerateExternalIDsForSwitchOrRouter(oc.GetNetInfo()),
+	}
+	if err := libovsdbops.CreateOrUpdateLogicalRouter(oc.nbClient, &logicalRouter, &logicalRouter.ExternalIDs); err != nil {
+		return fmt.Errorf("failed to create logical router %s: %v", routerName, err)
+	}
+
+	routerPortName := types.RouterToSwitchPrefix + switchName
+	logicalRouterPort := nbdb.LogicalRouterPort{
+		Name:          routerPortName,
+		MAC:           routerMAC.String(),
+		Networks:      networks,
+		Ipv6RaConfigs: layer2IPv6RAConfigs(oc.GetNetInfo()),
+		ExternalIDs: map[string]string{
+			types.NetworkExternalID:  oc.GetNetworkName(),
+			types.TopologyExternalID: oc.TopologyType(),
+		},
+	}
+	err := libovsdbops.CreateOrUpdateLogicalRouterPort(oc.nbClient, &logicalRouter, &logicalRouterPort, nil,
+		&logicalRouterPort.MAC, &logicalRouterPort.Networks, &logicalRouterPort.Ipv6RaConfigs, &logicalRouterPort.ExternalIDs)
+	if err != nil {
+		return fmt.Errorf("failed to create port %s on router %s: %v", routerPortName, routerName, err)
+	}
+
+	logicalSwitchPort := nbdb.LogicalSwitchPort{
+		Name:      types.SwitchToRouterPrefix + switchName,
+		Type:      "router",
+		Addresses: []string{"router"},
+		Options: map[string]string{
+			"router-port": routerPortName,
+		},
+		ExternalIDs: map[string]string{
+			types.NetworkExternalID:  oc.GetNetworkName(),
+			types.TopologyExternalID: oc.TopologyType(),
+		},
+	}
+	if oc.isLayer2Interconnect() {
+		// the tunnel keys of the switch ports are allocated cluster wide, use
+		// the one reserved for this port, which every zone shares
+		logicalSwitchPort.Options["requested-tnl-key"] = strconv.Itoa(types.Layer2RARouterPortTunnelKey)
+	}
+	logicalSwitch := nbdb.LogicalSwitch{Name: switchName}
+	if err = libovsdbops.CreateOrUpdateLogicalSwitchPortsOnSwitch(oc.nbClient, &logicalSwitch, &logicalSwitchPort); err != nil {
+		return fmt.Errorf("failed to create port %s on switch %s: %v", logicalSwitchPort.Name, switchName, err)
+	}
+	return nil
+}
+
+// layer2IPv6RAConfigs returns the configuration of the IPv6 router
+// advertisements sent by the router ports attached to a layer2 network switch
+func layer2IPv6RAConfigs(netInfo util.NetInfo) map[string]string {
+	raConfigs := map[string]string{
+		"address_mode":      "dhcpv6_stateful",
+		"send_periodic":     "true",
+		"max_interval":      "900", // 15 minutes
+		"min_interval":      "300", // 5 minutes
+		"router_preference": "LOW", // The static gateway configured by CNI is MEDIUM, so make this SLOW so it has less effect for pods
+	}
+	if netInfo.MTU() > 0 {
+		raConfigs["mtu"] = fmt.Sprintf("%d", netInfo.MTU())
+	}
+	return raConfigs
+}
+
 func (oc *BaseSecondaryLayer2NetworkController) addUpdateNodeEvent(node *corev1.Node) error {
 	if oc.isLocalZoneNode(node) {
 		return oc.addUpdateLocalNodeEvent(node)
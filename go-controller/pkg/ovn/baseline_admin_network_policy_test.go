@@ -63,14 +63,18 @@ func getACLsForBANPRulesWithNamedPorts(banp *anpapi.BaselineAdminNetworkPolicy,
 }
 
 func getACLsForBANPRules(banp *anpapi.BaselineAdminNetworkPolicy) []*nbdb.ACL {
+	return getNetworkACLsForBANPRules(DefaultNetworkControllerName, banp)
+}
+
+func getNetworkACLsForBANPRules(controllerName string, banp *anpapi.BaselineAdminNetworkPolicy) []*nbdb.ACL {
 	aclResults := []*nbdb.ACL{}
 	for i, ingress := range banp.Spec.Ingress {
-		acls := getANPGressACL(anpovn.GetACLActionForBANPRule(ingress.Action), banp.Name, string(libovsdbutil.ACLIngress),
+		acls := getNetworkANPGressACL(controllerName, anpovn.GetACLActionForBANPRule(ingress.Action), banp.Name, string(libovsdbutil.ACLIngress),
 			getBANPRulePriority(int32(i)), int32(i), ingress.Ports, nil, true)
 		aclResults = append(aclResults, acls...)
 	}
 	for i, egress := range banp.Spec.Egress {
-		acls := getANPGressACL(anpovn.GetACLActionForBANPRule(egress.Action), banp.Name, string(libovsdbutil.ACLEgress),
+		acls := getNetworkANPGressACL(controllerName, anpovn.GetACLActionForBANPRule(egress.Action), banp.Name, string(libovsdbutil.ACLEgress),
 			getBANPRulePriority(int32(i)), int32(i), egress.Ports, nil, true)
 		aclResults = append(aclResults, acls...)
 	}
@@ -78,8 +82,13 @@ func getACLsForBANPRules(banp *anpapi.BaselineAdminNetworkPolicy) []*nbdb.ACL {
 }
 
 func buildBANPAddressSets(banp *anpapi.BaselineAdminNetworkPolicy, index int32, ips []string, gressPrefix libovsdbutil.ACLDirection) (*nbdb.AddressSet, *nbdb.AddressSet) {
+	return buildNetworkBANPAddressSets(DefaultNetworkControllerName, banp, index, ips, gressPrefix)
+}
+
+func buildNetworkBANPAddressSets(controllerName string, banp *anpapi.BaselineAdminNetworkPolicy, index int32, ips []string,
+	gressPrefix libovsdbutil.ACLDirection) (*nbdb.AddressSet, *nbdb.AddressSet) {
 	asIndex := anpovn.GetANPPeerAddrSetDbIDs(banp.Name, string(gressPrefix),
-		fmt.Sprintf("%d", index), DefaultNetworkControllerName, true)
+		fmt.Sprintf("%d", index), controllerName, true)
 	return addressset.GetTestDbAddrSets(asIndex, ips)
 }
 
@@ -74,8 +74,22 @@ func (c *Controller) syncAdminNetworkPolicy(key string) error {
 	if err != nil && !apierrors.IsNotFound(err) {
 		return err
 	}
+	if anp != nil {
+		appliesToNetwork, err := c.policyAppliesToNetwork(anp.Annotations)
+		if err != nil {
+			// we can ignore the error if status update doesn't succeed; best effort
+			_ = c.updateANPStatusToNotReady(anp.Name, err.Error())
+			// we don't want to retry for this error since it needs manual intervention from users,
+			// but the policy must not stay applied to this network in the meantime
+			return c.clearAdminNetworkPolicy(anpName)
+		}
+		if !appliesToNetwork {
+			klog.V(5).Infof("Admin Network Policy %s doesn't apply to network %s", anpName, c.GetNetworkName())
+			anp = nil
+		}
+	}
 	if anp == nil {
-		// it was deleted; let's clear up all the related resources to that
+		// it was deleted or doesn't apply to this network; let's clear up all the related resources to that
 		err = c.clearAdminNetworkPolicy(anpName)
 		if err != nil {
 			return err
@@ -107,7 +121,7 @@ func (c *Controller) ensureAdminNetworkPolicy(anp *anpapi.AdminNetworkPolicy) er
 	// supports upto 1000. The 0 (highest) corresponds to 30,000 in OVN world and
 	// 99 (lowest) corresponds to 20,100 in OVN world
 	if anp.Spec.Priority > ovnkSupportedPriorityUpperBound {
-		c.eventRecorder.Eventf(&corev1.ObjectReference{
+		c.emitEventf(&corev1.ObjectReference{
 			Kind: "AdminNetworkPolicy",
 			Name: anp.Name,
 		}, corev1.EventTypeWarning, ANPWithUnsupportedPriorityEvent, "This ANP %s has an unsupported priority %d; "+
@@ -136,6 +150,11 @@ func (c *Controller) ensureAdminNetworkPolicy(anp *anpapi.AdminNetworkPolicy) er
 	if err != nil {
 		return fmt.Errorf("unable to convert peers to addresses for anp %s: %v", desiredANPState.name, err)
 	}
+	err = c.addDomainNames(desiredANPState, false)
+	if err != nil {
+		c.releaseDomainNames(desiredANPState, currentANPState, false)
+		return fmt.Errorf("unable to resolve domain names for anp %s: %v", desiredANPState.name, err)
+	}
 	atLeastOneRuleUpdated := false
 	desiredACLs := c.convertANPRulesToACLs(desiredANPState, currentANPState, portGroupName, &atLeastOneRuleUpdated, false)
 
@@ -146,6 +165,7 @@ func (c *Controller) ensureAdminNetworkPolicy(anp *anpapi.AdminNetworkPolicy) er
 		// 5) Update the ANP caches to store all the created things if transact was successful
 		err = c.createNewANP(desiredANPState, desiredACLs, desiredPorts, false)
 		if err != nil {
+			c.releaseDomainNames(desiredANPState, nil, false)
 			return fmt.Errorf("failed to create ANP %s: %v", desiredANPState.name, err)
 		}
 		// If an ANP is created at the same priority as another one, let us trigger an event before
@@ -154,7 +174,7 @@ func (c *Controller) ensureAdminNetworkPolicy(anp *anpapi.AdminNetworkPolicy) er
 		if existingName, loaded := c.anpPriorityMap[desiredANPState.anpPriority]; loaded && existingName != anp.Name {
 			klog.Warningf("Warning against attempting to add ANP %s with priority %d when at least one other ANP %s, "+
 				"exists with the same priority", anp.Name, anp.Spec.Priority, existingName)
-			c.eventRecorder.Eventf(&corev1.ObjectReference{
+			c.emitEventf(&corev1.ObjectReference{
 				Kind: "AdminNetworkPolicy",
 				Name: anp.Name,
 			}, corev1.EventTypeWarning, ANPWithDuplicatePriorityEvent, "This ANP %s has a conflicting priority with ANP %s:"+
@@ -167,7 +187,9 @@ func (c *Controller) ensureAdminNetworkPolicy(anp *anpapi.AdminNetworkPolicy) er
 		}
 		// since transact was successful we can finally populate the cache
 		c.anpCache[anp.Name] = desiredANPState
-		metrics.IncrementANPCount()
+		if !c.IsSecondary() {
+			metrics.IncrementANPCount()
+		}
 		return nil
 	}
 	// ANP state existed in the cache, which means its either an ANP update or pod/namespace add/update/delete
@@ -175,8 +197,11 @@ func (c *Controller) ensureAdminNetworkPolicy(anp *anpapi.AdminNetworkPolicy) er
 	hasPriorityChanged := (currentANPState.anpPriority != desiredANPState.anpPriority)
 	err = c.updateExistingANP(currentANPState, desiredANPState, atLeastOneRuleUpdated, hasPriorityChanged, false, desiredACLs)
 	if err != nil {
+		c.releaseDomainNames(desiredANPState, currentANPState, false)
 		return fmt.Errorf("failed to update ANP %s: %v", desiredANPState.name, err)
 	}
+	// the ACLs don't reference the domain names removed from the ANP anymore
+	c.releaseDomainNames(currentANPState, desiredANPState, false)
 	// We also need to update c.anpPriorityMap cache if this ANP was stored in it
 	if hasPriorityChanged {
 		klog.V(3).Infof("Deleting and re-adding correct priority (old %d, new %d) from anpPriorityMap for %s",
@@ -188,7 +213,7 @@ func (c *Controller) ensureAdminNetworkPolicy(anp *anpapi.AdminNetworkPolicy) er
 		if existingName, loaded := c.anpPriorityMap[desiredANPState.anpPriority]; loaded && existingName != desiredANPState.name {
 			klog.Warningf("Warning against attempting to update ANP %s with priority %d when at least one other ANP %s, "+
 				"exists with the same priority", desiredANPState.name, anp.Spec.Priority, existingName)
-			c.eventRecorder.Eventf(&corev1.ObjectReference{
+			c.emitEventf(&corev1.ObjectReference{
 				Kind: "AdminNetworkPolicy",
 				Name: anp.Name,
 			}, corev1.EventTypeWarning, ANPWithDuplicatePriorityEvent, "This ANP %s has a conflicting priority with ANP %s:"+
@@ -235,7 +260,8 @@ func (c *Controller) convertANPRulesToACLs(desiredANPState, currentANPState *adm
 			!*atLeastOneRuleUpdated &&
 			(egressRule.action != currentANPState.egressRules[i].action ||
 				!reflect.DeepEqual(egressRule.ports, currentANPState.egressRules[i].ports) ||
-				!reflect.DeepEqual(egressRule.namedPorts, currentANPState.egressRules[i].namedPorts)) {
+				!reflect.DeepEqual(egressRule.namedPorts, currentANPState.egressRules[i].namedPorts) ||
+				!reflect.DeepEqual(egressRule.domainNames, currentANPState.egressRules[i].domainNames)) {
 			klog.V(3).Infof("ANP %s's egress rule %s/%d at priority %d was updated", desiredANPState.name, egressRule.name, i, egressRule.priority)
 			*atLeastOneRuleUpdated = true
 		}
@@ -251,6 +277,10 @@ func (c *Controller) convertANPRuleToACL(rule *gressRule, pgName, anpName string
 	// create match based on direction and address-set name
 	asIndex := GetANPPeerAddrSetDbIDs(anpName, rule.gressPrefix, fmt.Sprintf("%d", rule.gressIndex), c.controllerName, isBanp)
 	l3Match := constructMatchFromAddressSet(rule.gressPrefix, asIndex)
+	if len(rule.domainNames) > 0 {
+		// the destinations the domain names resolve to are matched on top of the peers
+		l3Match = fmt.Sprintf("(%s || %s)", l3Match, constructMatchFromDomainNames(rule.domainNames, c.controllerName))
+	}
 	// create match based on rule type (ingress/egress) and port-group
 	lportMatch := libovsdbutil.GetACLMatch(pgName, "", libovsdbutil.ACLDirection(rule.gressPrefix))
 	var match string
@@ -354,7 +384,7 @@ func (c *Controller) expandRulePeers(rule *gressRule) error {
 				if util.PodWantsHostNetwork(pod) || util.PodCompleted(pod) || !util.PodScheduled(pod) {
 					continue
 				}
-				podIPs, err := util.GetPodIPsOfNetwork(pod, &util.DefaultNetInfo{})
+				podIPs, err := util.GetPodIPsOfNetwork(pod, c.NetInfo)
 				if err != nil {
 					if errors.Is(err, util.ErrNoPodIPFound) {
 						// we ignore podIPsNotFound error here because onANPPodUpdate
@@ -362,7 +392,11 @@ func (c *Controller) expandRulePeers(rule *gressRule) error {
 						// move on to next item in the loop
 						continue
 					}
-					return err // we won't hit this TBH because the only error that GetPodIPsOfNetwork returns is podIPsNotFound
+					return err
+				}
+				if len(podIPs) == 0 {
+					// pod is not attached to this (secondary) network or is not annotated yet
+					continue
 				}
 				rule.peerAddresses.Insert(util.StringSlice(podIPs)...)
 				podCache.Insert(pod.Name)
@@ -447,32 +481,46 @@ func (c *Controller) convertANPSubjectToLSPs(anp *adminNetworkPolicyState) ([]*n
 			if util.PodWantsHostNetwork(pod) || util.PodCompleted(pod) || !util.PodScheduled(pod) || !c.isPodScheduledinLocalZone(pod) {
 				continue
 			}
-			logicalPortName := util.GetLogicalPortName(pod.Namespace, pod.Name)
-			lsp := &nbdb.LogicalSwitchPort{Name: logicalPortName}
-			lsp, err = libovsdbops.GetLogicalSwitchPort(c.nbClient, lsp)
+			logicalPortNames, err := c.getPodLogicalPortNames(pod)
 			if err != nil {
-				if errors.Is(err, libovsdbclient.ErrNotFound) {
-					// NOTE(tssurya): Danger of doing this is if there is time gap between pod being annotated with chosen IP
-					// and pod's LSP being created, then we might setup the policies only after pod goes into running state
-					// thus causing little bit of outage
-					// we ignore ErrNotFound error here because onANPPodUpdate (pod.status.Running)
-					// will take care of this; no need to add nil podIPs to slice...
-					// move on to next item in the loop
-					// If not we are going to have many such pods if ANP and pods are created at the same time thus causing
-					// ANP create to fail on a single pod add failure
-					continue
+				return nil, fmt.Errorf("error getting logical switch ports of pod %s/%s on network %s: %w",
+					pod.Namespace, pod.Name, c.GetNetworkName(), err)
+			}
+			podLSPs := make([]*nbdb.LogicalSwitchPort, 0, len(logicalPortNames))
+			for _, logicalPortName := range logicalPortNames {
+				lsp := &nbdb.LogicalSwitchPort{Name: logicalPortName}
+				lsp, err = libovsdbops.GetLogicalSwitchPort(c.nbClient, lsp)
+				if err != nil {
+					if errors.Is(err, libovsdbclient.ErrNotFound) {
+						// NOTE(tssurya): Danger of doing this is if there is time gap between pod being annotated with chosen IP
+						// and pod's LSP being created, then we might setup the policies only after pod goes into running state
+						// thus causing little bit of outage
+						// we ignore ErrNotFound error here because onANPPodUpdate (pod.status.Running)
+						// will take care of this; no need to add nil podIPs to slice...
+						// move on to next item in the loop
+						// If not we are going to have many such pods if ANP and pods are created at the same time thus causing
+						// ANP create to fail on a single pod add failure
+						continue
+					}
+					return nil, fmt.Errorf("error retrieving logical switch port with name %s "+
+						" from libovsdb cache: %w", logicalPortName, err)
 				}
-				return nil, fmt.Errorf("error retrieving logical switch port with name %s "+
-					" from libovsdb cache: %w", logicalPortName, err)
+				podLSPs = append(podLSPs, lsp)
+			}
+			if len(podLSPs) == 0 {
+				// pod is not attached to this (secondary) network or its LSP is not created yet
+				continue
+			}
+			for _, lsp := range podLSPs {
+				lsports = append(lsports, lsp)
+				anp.subject.podPorts.Insert(lsp.UUID)
 			}
-			lsports = append(lsports, lsp)
-			anp.subject.podPorts.Insert(lsp.UUID)
 			podCache.Insert(pod.Name)
 			if len(namedPortMatchingRulesIndexes) == 0 {
 				continue
 			}
 			// we need to collect podIP:cPort information
-			podIPs, err := util.GetPodIPsOfNetwork(pod, &util.DefaultNetInfo{})
+			podIPs, err := util.GetPodIPsOfNetwork(pod, c.NetInfo)
 			if err != nil {
 				if errors.Is(err, util.ErrNoPodIPFound) {
 					// we ignore podIPsNotFound error here because onANPPodUpdate
@@ -480,7 +528,7 @@ func (c *Controller) convertANPSubjectToLSPs(anp *adminNetworkPolicyState) ([]*n
 					// move on to next item in the loop
 					continue
 				}
-				return nil, err // we won't hit this TBH because the only error that GetPodIPsOfNetwork returns is podIPsNotFound
+				return nil, err
 			}
 			for _, container := range pod.Spec.Containers {
 				for _, port := range container.Ports { // this loop is/might get expensive
@@ -532,22 +580,37 @@ func (c *Controller) clearAdminNetworkPolicy(anpName string) error {
 	if err != nil {
 		return fmt.Errorf("unable to delete PG %s for ANP %s: %w", portGroupName, anp.name, err)
 	}
+	if anp.aclLoggingParams.RateLimit > 0 {
+		c.deleteUnusedACLLoggingMeters()
+	}
 	// remove address-sets that were created for the peers of each rule fpr the whole ANP
 	// do this after ACLs are gone so that there is no lingering references
 	err = c.clearASForPeers(anp.name, libovsdbops.AddressSetAdminNetworkPolicy)
 	if err != nil {
 		return fmt.Errorf("failed to delete address-sets for ANP %s/%d: %w", anp.name, anp.anpPriority, err)
 	}
+	c.releaseDomainNames(anp, nil, false)
 	// we can delete the object from the cache now.
 	if existingName, loaded := c.anpPriorityMap[anp.anpPriority]; loaded && existingName == anpName {
 		delete(c.anpPriorityMap, anp.anpPriority)
 	}
 	delete(c.anpCache, anpName)
-	metrics.DecrementANPCount()
+	if !c.IsSecondary() {
+		metrics.DecrementANPCount()
+	}
 
 	return nil
 }
 
+// deleteUnusedACLLoggingMeters deletes the per-rate ACL logging meters that
+// were only used by deleted or updated policies. It only warns on failure, the
+// meters are garbage-collected again on the next policy deletion or restart.
+func (c *Controller) deleteUnusedACLLoggingMeters() {
+	if err := libovsdbutil.DeleteUnusedACLLoggingMeters(c.nbClient); err != nil {
+		klog.Warningf("Failed to delete unused ACL logging meters: %v", err)
+	}
+}
+
 // clearASForPeers takes the externalID objectIDs and uses them to delete all the address-sets
 // that were owned by anpName
 func (c *Controller) clearASForPeers(anpName string, idType *libovsdbops.ObjectIDsType) error {
@@ -573,6 +636,12 @@ func (c *Controller) createNewANP(desiredANPState *adminNetworkPolicyState, desi
 		return fmt.Errorf("failed to create address-sets, %v", err)
 	}
 	ops = append(ops, addrSetOps...)
+	if desiredANPState.aclLoggingParams.RateLimit > 0 {
+		ops, err = libovsdbutil.CreateOrUpdateACLLoggingMeterOps(c.nbClient, ops, desiredANPState.aclLoggingParams)
+		if err != nil {
+			return fmt.Errorf("failed to create ACL logging meter ops: %v", err)
+		}
+	}
 	ops, err = libovsdbops.CreateOrUpdateACLsOps(c.nbClient, ops, c.GetSamplingConfig(), desiredACLs...)
 	if err != nil {
 		return fmt.Errorf("failed to create ACL ops: %v", err)
@@ -658,7 +727,8 @@ func (c *Controller) updateExistingANP(currentANPState, desiredANPState *adminNe
 		ops = append(ops, addrOps...)
 	}
 	hasACLLoggingParamsChanged := currentANPState.aclLoggingParams.Allow != desiredANPState.aclLoggingParams.Allow ||
-		currentANPState.aclLoggingParams.Deny != desiredANPState.aclLoggingParams.Deny
+		currentANPState.aclLoggingParams.Deny != desiredANPState.aclLoggingParams.Deny ||
+		currentANPState.aclLoggingParams.RateLimit != desiredANPState.aclLoggingParams.RateLimit
 	if !isBanp {
 		hasACLLoggingParamsChanged = hasACLLoggingParamsChanged || currentANPState.aclLoggingParams.Pass != desiredANPState.aclLoggingParams.Pass
 	}
@@ -670,9 +740,15 @@ func (c *Controller) updateExistingANP(currentANPState, desiredANPState *adminNe
 	// (1) fullPeerRecompute=true which means the rules were of different lengths (involved deletion or appending of gress rules)
 	// (2) atLeastOneRuleUpdated=true which means the gress rules were of same lengths but action or ports changed on at least one rule
 	// (3) hasPriorityChanged=true which means we should update acl.Priority for every ACL
-	// (4) hasACLLoggingParamsChanged=true which means we should update acl.Severity/acl.Log for every ACL
+	// (4) hasACLLoggingParamsChanged=true which means we should update acl.Severity/acl.Log/acl.Meter for every ACL
 	if fullPeerRecompute || atLeastOneRuleUpdated || hasPriorityChanged || hasACLLoggingParamsChanged {
 		klog.V(3).Infof("ANP %s with priority %d was updated", desiredANPState.name, desiredANPState.anpPriority)
+		if desiredANPState.aclLoggingParams.RateLimit > 0 {
+			ops, err = libovsdbutil.CreateOrUpdateACLLoggingMeterOps(c.nbClient, ops, desiredANPState.aclLoggingParams)
+			if err != nil {
+				return fmt.Errorf("failed to create ACL logging meter ops for anp %s: %v", desiredANPState.name, err)
+			}
+		}
 		// now update the acls to the desired ones
 		ops, err = libovsdbops.CreateOrUpdateACLsOps(c.nbClient, ops, c.GetSamplingConfig(), desiredACLs...)
 		if err != nil {
@@ -701,6 +777,10 @@ func (c *Controller) updateExistingANP(currentANPState, desiredANPState *adminNe
 	if err != nil {
 		return fmt.Errorf("failed to run ovsdb txn to update ANP %s: %v", desiredANPState.name, err)
 	}
+	if currentANPState.aclLoggingParams.RateLimit > 0 &&
+		currentANPState.aclLoggingParams.RateLimit != desiredANPState.aclLoggingParams.RateLimit {
+		c.deleteUnusedACLLoggingMeters()
+	}
 	return nil
 }
 
@@ -6,6 +6,10 @@ import (
 	"sync"
 	"time"
 
+	nadv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	nadinformerv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/client/informers/externalversions/k8s.cni.cncf.io/v1"
+	nadlisterv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/client/listers/k8s.cni.cncf.io/v1"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -27,6 +31,7 @@ import (
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/observability"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
+	dnsnameresolver "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/dns_name_resolver"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
@@ -45,6 +50,8 @@ type Controller struct {
 	// name of the controller that starts the ANP controller
 	// (values are default-network-controller, secondary-network-controller etc..)
 	controllerName string
+	// network of the pods whose logical switch ports and IPs are used by this controller
+	util.NetInfo
 	sync.RWMutex
 	anpClientSet anpclientset.Interface
 
@@ -53,6 +60,9 @@ type Controller struct {
 	eventRecorder record.EventRecorder
 	// An address set factory that creates address sets
 	addressSetFactory addressset.AddressSetFactory
+	// dnsNameResolver resolves the domain names of the egress rules, it is only set
+	// for the networks supporting the EgressDomainNamesAnnotation
+	dnsNameResolver dnsnameresolver.DNSNameResolver
 	// pass in the isPodScheduledinLocalZone util from bnc - used only to determine
 	// what zones the pods are in.
 	// isPodScheduledinLocalZone returns whether the provided pod is in a zone local to the zone controller
@@ -99,6 +109,14 @@ type Controller struct {
 	anpNodeLister corev1listers.NodeLister
 	anpNodeSynced cache.InformerSynced
 	anpNodeQueue  workqueue.TypedRateLimitingInterface[string]
+	// nad lister, only set for the secondary networks that can be selected by the
+	// NetworkSelectorsAnnotation when multi-network is enabled
+	nadLister nadlisterv1.NetworkAttachmentDefinitionLister
+	nadSynced cache.InformerSynced
+
+	// registrations of the event handlers on the shared informers, removed when
+	// the controller is stopped since user defined networks come and go
+	handlerRegistrations map[cache.SharedIndexInformer]cache.ResourceEventHandlerRegistration
 
 	observManager *observability.Manager
 }
@@ -106,6 +124,7 @@ type Controller struct {
 // NewController returns a new *Controller.
 func NewController(
 	controllerName string,
+	netInfo util.NetInfo,
 	nbClient libovsdbclient.Client,
 	anpClient anpclientset.Interface,
 	anpInformer anpinformer.AdminNetworkPolicyInformer,
@@ -113,7 +132,9 @@ func NewController(
 	namespaceInformer corev1informers.NamespaceInformer,
 	podInformer corev1informers.PodInformer,
 	nodeInformer corev1informers.NodeInformer,
+	nadInformer nadinformerv1.NetworkAttachmentDefinitionInformer,
 	addressSetFactory addressset.AddressSetFactory,
+	dnsNameResolver dnsnameresolver.DNSNameResolver,
 	isPodScheduledinLocalZone func(*corev1.Pod) bool,
 	zone string,
 	recorder record.EventRecorder,
@@ -121,15 +142,18 @@ func NewController(
 
 	c := &Controller{
 		controllerName:            controllerName,
+		NetInfo:                   netInfo,
 		nbClient:                  nbClient,
 		anpClientSet:              anpClient,
 		addressSetFactory:         addressSetFactory,
+		dnsNameResolver:           dnsNameResolver,
 		isPodScheduledinLocalZone: isPodScheduledinLocalZone,
 		zone:                      zone,
 		anpCache:                  make(map[string]*adminNetworkPolicyState),
 		anpPriorityMap:            make(map[int32]string),
 		banpCache:                 &adminNetworkPolicyState{}, // safe to initialise pointer to empty struct than nil
 		observManager:             observManager,
+		handlerRegistrations:      make(map[cache.SharedIndexInformer]cache.ResourceEventHandlerRegistration),
 	}
 
 	klog.V(5).Info("Setting up event handlers for Admin Network Policy")
@@ -140,7 +164,7 @@ func NewController(
 		workqueue.NewTypedItemFastSlowRateLimiter[string](1*time.Second, 5*time.Second, 5),
 		workqueue.TypedRateLimitingQueueConfig[string]{Name: "adminNetworkPolicy"},
 	)
-	_, err := anpInformer.Informer().AddEventHandler(factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
+	err := c.addEventHandler(anpInformer.Informer(), factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.onANPAdd,
 		UpdateFunc: c.onANPUpdate,
 		DeleteFunc: c.onANPDelete,
@@ -158,7 +182,7 @@ func NewController(
 		workqueue.NewTypedItemFastSlowRateLimiter[string](1*time.Second, 5*time.Second, 5),
 		workqueue.TypedRateLimitingQueueConfig[string]{Name: "baselineAdminNetworkPolicy"},
 	)
-	_, err = banpInformer.Informer().AddEventHandler(factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
+	err = c.addEventHandler(banpInformer.Informer(), factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.onBANPAdd,
 		UpdateFunc: c.onBANPUpdate,
 		DeleteFunc: c.onBANPDelete,
@@ -174,7 +198,7 @@ func NewController(
 		workqueue.NewTypedItemFastSlowRateLimiter[string](1*time.Second, 5*time.Second, 5),
 		workqueue.TypedRateLimitingQueueConfig[string]{Name: "anpNamespaces"},
 	)
-	_, err = namespaceInformer.Informer().AddEventHandler(factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
+	err = c.addEventHandler(namespaceInformer.Informer(), factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.onANPNamespaceAdd,
 		UpdateFunc: c.onANPNamespaceUpdate,
 		DeleteFunc: c.onANPNamespaceDelete,
@@ -190,7 +214,7 @@ func NewController(
 		workqueue.NewTypedItemFastSlowRateLimiter[string](1*time.Second, 5*time.Second, 5),
 		workqueue.TypedRateLimitingQueueConfig[string]{Name: "anpPods"},
 	)
-	_, err = podInformer.Informer().AddEventHandler(factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
+	err = c.addEventHandler(podInformer.Informer(), factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.onANPPodAdd,
 		UpdateFunc: c.onANPPodUpdate,
 		DeleteFunc: c.onANPPodDelete,
@@ -206,7 +230,7 @@ func NewController(
 		workqueue.NewTypedItemFastSlowRateLimiter[string](1*time.Second, 5*time.Second, 5),
 		workqueue.TypedRateLimitingQueueConfig[string]{Name: "anpNodes"},
 	)
-	_, err = nodeInformer.Informer().AddEventHandler(factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
+	err = c.addEventHandler(nodeInformer.Informer(), factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.onANPNodeAdd,
 		UpdateFunc: c.onANPNodeUpdate,
 		DeleteFunc: c.onANPNodeDelete,
@@ -215,11 +239,45 @@ func NewController(
 		return nil, fmt.Errorf("could not add Event Handler for node Informer during admin network policy controller initialization, %w", err)
 	}
 
+	if nadInformer != nil && c.IsSecondary() && !c.IsPrimaryNetwork() {
+		klog.V(5).Infof("Setting up event handlers for NADs in Admin Network Policy controller for network %s", c.GetNetworkName())
+		c.nadLister = nadInformer.Lister()
+		c.nadSynced = nadInformer.Informer().HasSynced
+		err = c.addEventHandler(nadInformer.Informer(), factory.WithUpdateHandlingForObjReplace(cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.onANPNADAdd,
+			UpdateFunc: c.onANPNADUpdate,
+			DeleteFunc: c.onANPNADDelete,
+		}))
+		if err != nil {
+			return nil, fmt.Errorf("could not add Event Handler for NAD Informer during admin network policy controller initialization, %w", err)
+		}
+	}
+
 	c.eventRecorder = recorder
 
 	return c, nil
 }
 
+// addEventHandler adds the handler to the provided shared informer and keeps its
+// registration to be able to remove it when the controller is stopped.
+func (c *Controller) addEventHandler(informer cache.SharedIndexInformer, handler cache.ResourceEventHandler) error {
+	registration, err := informer.AddEventHandler(handler)
+	if err != nil {
+		return err
+	}
+	c.handlerRegistrations[informer] = registration
+	return nil
+}
+
+// removeEventHandlers removes the handlers of this controller from the shared informers
+func (c *Controller) removeEventHandlers() {
+	for informer, registration := range c.handlerRegistrations {
+		if err := informer.RemoveEventHandler(registration); err != nil {
+			klog.Errorf("Failed to remove event handler of controller %s: %v", c.controllerName, err)
+		}
+	}
+}
+
 // Run will not return until stopCh is closed. workers determines how many
 // objects (pods, namespaces, anps, banps) will be handled in parallel.
 func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
@@ -234,6 +292,13 @@ func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
 		klog.Errorf("Error syncing caches for admin network policy and baseline admin network policy")
 		return
 	}
+	if c.nadSynced != nil {
+		klog.V(5).Info("Waiting for net-attach-def informer cache to sync")
+		if !util.WaitForInformerCacheSyncWithTimeout(c.controllerName, stopCh, c.nadSynced) {
+			utilruntime.HandleError(fmt.Errorf("timed out waiting for net-attach-def informer cache to sync"))
+			return
+		}
+	}
 
 	klog.Infof("Repairing Admin Network Policies")
 	// Run the repair function at startup so that we synchronize KAPI and OVNDBs
@@ -245,6 +310,7 @@ func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
 	if err != nil {
 		klog.Errorf("Failed to repair Baseline Admin Network Policy: %v", err)
 	}
+	c.deleteUnusedACLLoggingMeters()
 
 	wg := &sync.WaitGroup{}
 	// Start the workers after the repair loop to avoid races
@@ -302,7 +368,10 @@ func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
 			}, time.Second, stopCh)
 		}()
 	}
-	c.setupMetricsCollector()
+	// the rule count metrics are cluster wide, only the default network controller reports them
+	if !c.IsSecondary() {
+		c.setupMetricsCollector()
+	}
 
 	<-stopCh
 
@@ -312,7 +381,10 @@ func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
 	c.anpNamespaceQueue.ShutDown()
 	c.anpPodQueue.ShutDown()
 	c.anpNodeQueue.ShutDown()
-	c.teardownMetricsCollector()
+	c.removeEventHandlers()
+	if !c.IsSecondary() {
+		c.teardownMetricsCollector()
+	}
 	wg.Wait()
 }
 
@@ -370,7 +442,9 @@ func (c *Controller) onANPUpdate(oldObj, newObj interface{}) {
 	}
 	oldANPACLAnnotation := oldANP.Annotations[util.AclLoggingAnnotation]
 	newANPACLAnnotation := newANP.Annotations[util.AclLoggingAnnotation]
-	if reflect.DeepEqual(oldANP.Spec, newANP.Spec) && oldANPACLAnnotation == newANPACLAnnotation {
+	if reflect.DeepEqual(oldANP.Spec, newANP.Spec) && oldANPACLAnnotation == newANPACLAnnotation &&
+		oldANP.Annotations[NetworkSelectorsAnnotation] == newANP.Annotations[NetworkSelectorsAnnotation] &&
+		oldANP.Annotations[EgressDomainNamesAnnotation] == newANP.Annotations[EgressDomainNamesAnnotation] {
 		return
 	}
 	key, err := cache.MetaNamespaceKeyFunc(newObj)
@@ -418,7 +492,9 @@ func (c *Controller) onBANPUpdate(oldObj, newObj interface{}) {
 	}
 	oldBANPACLAnnotation := oldBANP.Annotations[util.AclLoggingAnnotation]
 	newBANPACLAnnotation := newBANP.Annotations[util.AclLoggingAnnotation]
-	if reflect.DeepEqual(oldBANP.Spec, newBANP.Spec) && oldBANPACLAnnotation == newBANPACLAnnotation {
+	if reflect.DeepEqual(oldBANP.Spec, newBANP.Spec) && oldBANPACLAnnotation == newBANPACLAnnotation &&
+		oldBANP.Annotations[NetworkSelectorsAnnotation] == newBANP.Annotations[NetworkSelectorsAnnotation] &&
+		oldBANP.Annotations[EgressDomainNamesAnnotation] == newBANP.Annotations[EgressDomainNamesAnnotation] {
 		return
 	}
 
@@ -515,8 +591,8 @@ func (c *Controller) onANPPodUpdate(oldObj, newObj interface{}) {
 	// zones. Rest of the cases we may return
 	oldPodLabels := labels.Set(oldPod.Labels)
 	newPodLabels := labels.Set(newPod.Labels)
-	oldPodIPs, _ := util.GetPodIPsOfNetwork(oldPod, &util.DefaultNetInfo{})
-	newPodIPs, _ := util.GetPodIPsOfNetwork(newPod, &util.DefaultNetInfo{})
+	oldPodIPs, _ := util.GetPodIPsOfNetwork(oldPod, c.NetInfo)
+	newPodIPs, _ := util.GetPodIPsOfNetwork(newPod, c.NetInfo)
 	oldPodRunning := util.PodRunning(oldPod)
 	newPodRunning := util.PodRunning(newPod)
 	oldPodCompleted := util.PodCompleted(oldPod)
@@ -597,6 +673,46 @@ func (c *Controller) onANPNodeDelete(obj interface{}) {
 	c.anpNodeQueue.Add(key)
 }
 
+// onANPNADAdd requeues all the policies if the NAD belongs to the network of this controller,
+// since the policies may now select this network.
+func (c *Controller) onANPNADAdd(obj interface{}) {
+	nad := obj.(*nadv1.NetworkAttachmentDefinition)
+	if !c.HasNAD(util.GetNADName(nad.Namespace, nad.Name)) {
+		return
+	}
+	klog.V(5).Infof("Adding NAD %s/%s in Admin Network Policy controller for network %s", nad.Namespace, nad.Name, c.GetNetworkName())
+	c.requeueAllPolicies()
+}
+
+// onANPNADUpdate requeues all the policies if the labels of a NAD of the network of this
+// controller have changed, since the policies may start or stop selecting this network.
+func (c *Controller) onANPNADUpdate(oldObj, newObj interface{}) {
+	oldNAD := oldObj.(*nadv1.NetworkAttachmentDefinition)
+	newNAD := newObj.(*nadv1.NetworkAttachmentDefinition)
+	if oldNAD.ResourceVersion == newNAD.ResourceVersion ||
+		labels.Equals(oldNAD.Labels, newNAD.Labels) ||
+		!c.HasNAD(util.GetNADName(newNAD.Namespace, newNAD.Name)) {
+		return
+	}
+	klog.V(5).Infof("Updating NAD %s/%s in Admin Network Policy controller for network %s: nadLabels %v",
+		newNAD.Namespace, newNAD.Name, c.GetNetworkName(), newNAD.Labels)
+	c.requeueAllPolicies()
+}
+
+// onANPNADDelete requeues all the policies, the NAD may have been the one selected by them.
+func (c *Controller) onANPNADDelete(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
+		return
+	}
+	if !c.HasNAD(key) {
+		return
+	}
+	klog.V(5).Infof("Deleting NAD %s in Admin Network Policy controller for network %s", key, c.GetNetworkName())
+	c.requeueAllPolicies()
+}
+
 func (c *Controller) GetSamplingConfig() *libovsdbops.SamplingConfig {
 	if c.observManager != nil {
 		return c.observManager.SamplingConfig()
@@ -0,0 +1,162 @@
+package adminnetworkpolicy
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
+	dnsnameresolver "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/dns_name_resolver"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// EgressDomainNamesAnnotation adds domain name peers to the egress rules of an (Baseline)Admin
+// Network Policy. Its value is a JSON object mapping the names of the egress rules to the
+// domain names they also apply to, e.g.
+// {"deny-example": ["*.example.com", "www.example.org"]}
+// The domain names are resolved by the DNS name resolver of the egress firewalls, so the
+// addresses follow the TTLs of the DNS records. Wildcard domain names match the names with
+// exactly one more label and are only supported with DNS snooping.
+const EgressDomainNamesAnnotation = "k8s.ovn.org/egress-domain-names"
+
+// getEgressDomainNames parses the EgressDomainNamesAnnotation of a policy and returns the
+// sorted domain names of each of the egress rules it sets.
+func getEgressDomainNames(annotations map[string]string) (map[string][]string, error) {
+	annotation, ok := annotations[EgressDomainNamesAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	var ruleDomainNames map[string][]string
+	if err := json.Unmarshal([]byte(annotation), &ruleDomainNames); err != nil {
+		return nil, fmt.Errorf("failed to parse %s annotation: %w", EgressDomainNamesAnnotation, err)
+	}
+	for ruleName, domainNames := range ruleDomainNames {
+		if ruleName == "" {
+			return nil, fmt.Errorf("invalid %s annotation: empty egress rule name", EgressDomainNamesAnnotation)
+		}
+		names := sets.New[string]()
+		for _, domainName := range domainNames {
+			if util.IsWildcard(domainName) && !config.OVNKubernetesFeature.EnableDNSSnooping {
+				return nil, fmt.Errorf("invalid %s annotation: wildcard domain name %s of egress rule %s requires DNS snooping",
+					EgressDomainNamesAnnotation, domainName, ruleName)
+			}
+			if !util.IsValidDNSName(domainName) {
+				return nil, fmt.Errorf("invalid %s annotation: invalid domain name %s of egress rule %s",
+					EgressDomainNamesAnnotation, domainName, ruleName)
+			}
+			// track the domain names the same way as the egress firewalls do, so that they
+			// share the address sets of the same domain names
+			if util.IsWildcardDNSNameSupported() {
+				domainName = util.LowerCaseFQDN(domainName)
+			}
+			names.Insert(domainName)
+		}
+		ruleDomainNames[ruleName] = sets.List(names)
+	}
+	return ruleDomainNames, nil
+}
+
+// setEgressRuleDomainNames sets the domain names of the EgressDomainNamesAnnotation on the
+// egress rules they belong to.
+func setEgressRuleDomainNames(egressRules []*gressRule, annotations map[string]string) error {
+	ruleDomainNames, err := getEgressDomainNames(annotations)
+	if err != nil {
+		return err
+	}
+	for ruleName, domainNames := range ruleDomainNames {
+		found := false
+		for _, rule := range egressRules {
+			if rule.name == ruleName {
+				rule.domainNames = domainNames
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("invalid %s annotation: egress rule %s not found", EgressDomainNamesAnnotation, ruleName)
+		}
+	}
+	return nil
+}
+
+// getDomainNames returns the domain names used by the egress rules of the policy.
+func (anp *adminNetworkPolicyState) getDomainNames() sets.Set[string] {
+	domainNames := sets.New[string]()
+	for _, rule := range anp.egressRules {
+		domainNames.Insert(rule.domainNames...)
+	}
+	return domainNames
+}
+
+// getDomainNameOwner returns the key the DNS name resolver tracks a domain name of a policy
+// with, which can't clash with the namespaces of the egress firewalls. Each domain name has
+// its own key, so that the domain names no longer used by a policy are released one by one.
+func getDomainNameOwner(policyName, domainName string, isBanp bool) string {
+	kind := "AdminNetworkPolicy"
+	if isBanp {
+		kind = "BaselineAdminNetworkPolicy"
+	}
+	return fmt.Sprintf("%s:%s:%s", kind, policyName, domainName)
+}
+
+// addDomainNames adds the domain names used by the policy to the DNS name resolver, which
+// creates their address sets and keeps them updated with the addresses they resolve to.
+func (c *Controller) addDomainNames(anp *adminNetworkPolicyState, isBanp bool) error {
+	domainNames := anp.getDomainNames()
+	if domainNames.Len() == 0 {
+		return nil
+	}
+	if c.dnsNameResolver == nil {
+		// only the default network resolves the domain names, and not through
+		// the DNSNameResolver resources
+		return fmt.Errorf("egress domain names are not supported on network %s with the current DNS name resolution",
+			c.GetNetworkName())
+	}
+	for _, domainName := range sets.List(domainNames) {
+		if _, err := c.dnsNameResolver.Add(getDomainNameOwner(anp.name, domainName, isBanp), domainName); err != nil {
+			return fmt.Errorf("failed to add domain name %s: %w", domainName, err)
+		}
+	}
+	return nil
+}
+
+// releaseDomainNames releases the domain names used by the policy state anp which are not
+// used by the policy state keep, if any. The DNS name resolver destroys the address sets of
+// the domain names that are no longer used, so this must be done once the ACLs referencing
+// them are gone. The resolver forgets the domain names of the policy even when it fails to
+// destroy their address sets, so there is no point in retrying: the stale address sets are
+// removed on restart.
+func (c *Controller) releaseDomainNames(anp, keep *adminNetworkPolicyState, isBanp bool) {
+	if c.dnsNameResolver == nil || anp == nil {
+		return
+	}
+	domainNames := anp.getDomainNames()
+	if keep != nil {
+		domainNames = domainNames.Difference(keep.getDomainNames())
+	}
+	for domainName := range domainNames {
+		if err := c.dnsNameResolver.Delete(getDomainNameOwner(anp.name, domainName, isBanp)); err != nil {
+			klog.Warningf("Failed to release domain name %s of policy %s: %v", domainName, anp.name, err)
+		}
+	}
+}
+
+// constructMatchFromDomainNames returns the L3Match of the destinations the domain names of an
+// egress rule resolve to.
+func constructMatchFromDomainNames(domainNames []string, controller string) string {
+	var matches []string
+	for _, domainName := range domainNames {
+		hashedAddressSetNameIPv4, hashedAddressSetNameIPv6 := addressset.GetHashNamesForAS(
+			dnsnameresolver.GetEgressFirewallDNSAddrSetDbIDs(domainName, controller))
+		if config.IPv4Mode {
+			matches = append(matches, fmt.Sprintf("ip4.dst == $%s", hashedAddressSetNameIPv4))
+		}
+		if config.IPv6Mode {
+			matches = append(matches, fmt.Sprintf("ip6.dst == $%s", hashedAddressSetNameIPv6))
+		}
+	}
+	return fmt.Sprintf("(%s)", strings.Join(matches, " || "))
+}
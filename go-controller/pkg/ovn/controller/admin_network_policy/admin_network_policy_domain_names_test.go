@@ -0,0 +1,86 @@
+package adminnetworkpolicy
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+)
+
+func TestSetEgressRuleDomainNames(t *testing.T) {
+	tests := []struct {
+		name        string
+		dnsSnooping bool
+		annotations map[string]string
+		expected    map[string][]string
+		err         bool
+	}{
+		{
+			name:     "no annotation",
+			expected: map[string][]string{"deny-example": nil, "allow-example": nil},
+		},
+		{
+			name: "domain names of one of the rules",
+			annotations: map[string]string{
+				EgressDomainNamesAnnotation: `{"deny-example": ["www.example.org", "www.example.com", "www.example.org"]}`,
+			},
+			expected: map[string][]string{"deny-example": {"www.example.com", "www.example.org"}, "allow-example": nil},
+		},
+		{
+			name:        "wildcard domain names are tracked as lower case FQDNs with DNS snooping",
+			dnsSnooping: true,
+			annotations: map[string]string{
+				EgressDomainNamesAnnotation: `{"deny-example": ["*.Example.com"], "allow-example": ["www.example.org"]}`,
+			},
+			expected: map[string][]string{"deny-example": {"*.example.com."}, "allow-example": {"www.example.org."}},
+		},
+		{
+			name: "wildcard domain names require DNS snooping",
+			annotations: map[string]string{
+				EgressDomainNamesAnnotation: `{"deny-example": ["*.example.com"]}`,
+			},
+			err: true,
+		},
+		{
+			name: "invalid domain name",
+			annotations: map[string]string{
+				EgressDomainNamesAnnotation: `{"deny-example": ["www.exa_mple.com"]}`,
+			},
+			err: true,
+		},
+		{
+			name: "unknown rule",
+			annotations: map[string]string{
+				EgressDomainNamesAnnotation: `{"pass-example": ["www.example.com"]}`,
+			},
+			err: true,
+		},
+		{
+			name: "invalid JSON",
+			annotations: map[string]string{
+				EgressDomainNamesAnnotation: `["www.example.com"]`,
+			},
+			err: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			g.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+			config.OVNKubernetesFeature.EnableDNSSnooping = tt.dnsSnooping
+			egressRules := []*gressRule{{name: "deny-example"}, {name: "allow-example"}}
+			err := setEgressRuleDomainNames(egressRules, tt.annotations)
+			if tt.err {
+				g.Expect(err).To(gomega.HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			domainNames := map[string][]string{}
+			for _, rule := range egressRules {
+				domainNames[rule.name] = rule.domainNames
+			}
+			g.Expect(domainNames).To(gomega.Equal(tt.expected))
+		})
+	}
+}
@@ -83,6 +83,13 @@ func (c *Controller) syncAdminNetworkPolicyNamespace(key string) error {
 		c.clearNamespaceForANP(name, banpObj, c.banpQueue)
 		return nil
 	}
+	// the labels of a namespace of the NADs of a secondary network may change the networks selected
+	// by the policies, they all have to be reconciled
+	if c.nadLister != nil && c.networkHasNADsInNamespace(name) {
+		klog.V(4).Infof("Namespace %s has NADs of network %s, requeuing all policies...", name, c.GetNetworkName())
+		c.requeueAllPolicies()
+		return nil
+	}
 	// case (i)/(ii)
 	for _, anp := range existingANPs {
 		anpObj, loaded := c.anpCache[anp.Name]
@@ -0,0 +1,198 @@
+package adminnetworkpolicy
+
+import (
+	"encoding/json"
+	"fmt"
+
+	nadv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+
+	crdtypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/types"
+	udnv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// NetworkSelectorsAnnotation selects the secondary networks an (Baseline)Admin Network Policy
+// applies to. Its value is a JSON list of network selectors, e.g.
+// [{"networkSelectionType": "NetworkAttachmentDefinitions",
+// "networkAttachmentDefinitionSelector": {"namespaceSelector": {}, "networkSelector": {"matchLabels": {"anp": "true"}}}}]
+// The policies always apply to the pods' primary network (default network or primary user
+// defined network), so this annotation is only used by the secondary network controllers.
+const NetworkSelectorsAnnotation = "k8s.ovn.org/network-selectors"
+
+var cudnController = udnv1.SchemeGroupVersion.WithKind("ClusterUserDefinedNetwork")
+
+// policyAppliesToNetwork returns whether the policy with the provided annotations applies to
+// the network of this controller. The policies always apply to the primary networks, while
+// secondary networks have to be selected with the NetworkSelectorsAnnotation.
+func (c *Controller) policyAppliesToNetwork(annotations map[string]string) (bool, error) {
+	if !c.IsSecondary() || c.IsPrimaryNetwork() {
+		return true, nil
+	}
+	annotation, ok := annotations[NetworkSelectorsAnnotation]
+	if !ok || c.nadLister == nil {
+		return false, nil
+	}
+	var networkSelectors crdtypes.NetworkSelectors
+	if err := json.Unmarshal([]byte(annotation), &networkSelectors); err != nil {
+		return false, fmt.Errorf("failed to parse %s annotation: %w", NetworkSelectorsAnnotation, err)
+	}
+	for _, networkSelector := range networkSelectors {
+		selected, err := c.networkSelectedBy(networkSelector)
+		if err != nil {
+			return false, fmt.Errorf("invalid %s annotation: %w", NetworkSelectorsAnnotation, err)
+		}
+		if selected {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// networkSelectedBy returns whether the provided network selector selects one of the NADs
+// of the (secondary) network of this controller.
+func (c *Controller) networkSelectedBy(networkSelector crdtypes.NetworkSelector) (bool, error) {
+	var selectedNADs []*nadv1.NetworkAttachmentDefinition
+	var err error
+	switch networkSelector.NetworkSelectionType {
+	case crdtypes.DefaultNetwork, crdtypes.PrimaryUserDefinedNetworks:
+		// primary networks are always selected, nothing to do for a secondary network
+		return false, nil
+	case crdtypes.SecondaryUserDefinedNetworks:
+		if networkSelector.SecondaryUserDefinedNetworkSelector == nil {
+			return false, fmt.Errorf("empty secondary user defined network selector")
+		}
+		selectedNADs, err = c.getNetAttachDefsBySelectors(&networkSelector.SecondaryUserDefinedNetworkSelector.NamespaceSelector,
+			&networkSelector.SecondaryUserDefinedNetworkSelector.NetworkSelector)
+	case crdtypes.ClusterUserDefinedNetworks:
+		if networkSelector.ClusterUserDefinedNetworkSelector == nil {
+			return false, fmt.Errorf("empty cluster user defined network selector")
+		}
+		var nads []*nadv1.NetworkAttachmentDefinition
+		nads, err = c.getNetAttachDefsBySelectors(nil, &networkSelector.ClusterUserDefinedNetworkSelector.NetworkSelector)
+		for _, nad := range nads {
+			// check this NAD is controlled by a CUDN
+			controller := metav1.GetControllerOfNoCopy(nad)
+			if controller != nil && controller.Kind == cudnController.Kind && controller.APIVersion == cudnController.GroupVersion().String() {
+				selectedNADs = append(selectedNADs, nad)
+			}
+		}
+	case crdtypes.NetworkAttachmentDefinitions:
+		if networkSelector.NetworkAttachmentDefinitionSelector == nil {
+			return false, fmt.Errorf("empty network attachment definition selector")
+		}
+		selectedNADs, err = c.getNetAttachDefsBySelectors(&networkSelector.NetworkAttachmentDefinitionSelector.NamespaceSelector,
+			&networkSelector.NetworkAttachmentDefinitionSelector.NetworkSelector)
+	default:
+		return false, fmt.Errorf("unsupported network selection type %s", networkSelector.NetworkSelectionType)
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, nad := range selectedNADs {
+		if c.HasNAD(util.GetNADName(nad.Namespace, nad.Name)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// getNetAttachDefsBySelectors returns the NADs matching nadSelector in the namespaces matching namespaceSelector.
+// If namespaceSelector is nil, the NADs of all the namespaces are considered.
+func (c *Controller) getNetAttachDefsBySelectors(namespaceSelector, nadSelector *metav1.LabelSelector) ([]*nadv1.NetworkAttachmentDefinition, error) {
+	nadSel, err := metav1.LabelSelectorAsSelector(nadSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid network selector %v: %v", nadSelector.String(), err)
+	}
+	if namespaceSelector == nil {
+		return c.nadLister.List(nadSel)
+	}
+	nsSelector, err := metav1.LabelSelectorAsSelector(namespaceSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid namespace selector %v: %v", namespaceSelector.String(), err)
+	}
+	namespaces, err := c.anpNamespaceLister.List(nsSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %v", err)
+	}
+	var selectedNADs []*nadv1.NetworkAttachmentDefinition
+	for _, namespace := range namespaces {
+		nads, err := c.nadLister.NetworkAttachmentDefinitions(namespace.Name).List(nadSel)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list NADs in namespace %s: %v", namespace.Name, err)
+		}
+		selectedNADs = append(selectedNADs, nads...)
+	}
+	return selectedNADs, nil
+}
+
+// networkHasNADsInNamespace returns whether the network of this controller has a NAD in the provided namespace
+func (c *Controller) networkHasNADsInNamespace(namespace string) bool {
+	for _, nadNamespace := range c.GetNADNamespaces() {
+		if nadNamespace == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// getPodLogicalPortNames returns the names of the logical switch ports of the provided pod on the
+// network of this controller. A pod can be attached more than once to a secondary network.
+func (c *Controller) getPodLogicalPortNames(pod *corev1.Pod) ([]string, error) {
+	if !c.IsSecondary() {
+		return []string{util.GetLogicalPortName(pod.Namespace, pod.Name)}, nil
+	}
+	nadNames, err := util.PodNadNames(pod, c.NetInfo)
+	if err != nil {
+		return nil, err
+	}
+	portNames := make([]string, 0, len(nadNames))
+	for _, nadName := range nadNames {
+		portNames = append(portNames, util.GetSecondaryNetworkLogicalPortName(pod.Namespace, pod.Name, nadName))
+	}
+	return portNames, nil
+}
+
+// requeueAllPolicies queues all the (Baseline)Admin Network Policies for processing, it is used when
+// the set of networks selected by the policies may have changed.
+func (c *Controller) requeueAllPolicies() {
+	anps, err := c.anpLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("Failed to list Admin Network Policies for network %s: %v", c.GetNetworkName(), err)
+	}
+	for _, anp := range anps {
+		c.anpQueue.Add(anp.Name)
+	}
+	banps, err := c.banpLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("Failed to list Baseline Admin Network Policies for network %s: %v", c.GetNetworkName(), err)
+	}
+	for _, banp := range banps {
+		c.banpQueue.Add(banp.Name)
+	}
+}
+
+// emitEventf records an event for a policy. The events don't depend on the network, so only
+// the default network controller records them, the other networks would only duplicate them.
+func (c *Controller) emitEventf(object *corev1.ObjectReference, eventType, reason, messageFmt string, args ...interface{}) {
+	if c.IsSecondary() {
+		return
+	}
+	c.eventRecorder.Eventf(object, eventType, reason, messageFmt, args...)
+}
+
+// getStatusConditionType returns the status condition type of this controller; the network name
+// is added to the zone name for the user defined networks so that each network has its own row.
+func (c *Controller) getStatusConditionType() string {
+	return policyReadyStatusType + c.GetNetworkScopedName(c.zone)
+}
+
+// getStatusFieldManager returns the server-side apply field manager of this controller, which
+// has to be unique across the networks of a zone for their conditions not to overwrite each other.
+func (c *Controller) getStatusFieldManager() string {
+	return c.GetNetworkScopedName(c.zone)
+}
@@ -0,0 +1,207 @@
+package adminnetworkpolicy
+
+import (
+	"testing"
+
+	nadv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	nadlisterv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/client/listers/k8s.cni.cncf.io/v1"
+	"github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	ovntesting "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+func newNetworkTestController(t *testing.T, nad *nadv1.NetworkAttachmentDefinition, namespaces ...*corev1.Namespace) *Controller {
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+	config.IPv4Mode = true
+	config.OVNKubernetesFeature.EnableMultiNetwork = true
+	config.OVNKubernetesFeature.EnableNetworkSegmentation = true
+	netInfo := util.NetInfo(&util.DefaultNetInfo{})
+	nadIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	if nad != nil {
+		immutableNetInfo, err := util.ParseNADInfo(nad)
+		g.Expect(err).ToNot(gomega.HaveOccurred())
+		mutableNetInfo := util.NewMutableNetInfo(immutableNetInfo)
+		mutableNetInfo.AddNADs(util.GetNADName(nad.Namespace, nad.Name))
+		netInfo = mutableNetInfo
+		g.Expect(nadIndexer.Add(nad)).To(gomega.Succeed())
+	}
+	namespaceIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, namespace := range namespaces {
+		g.Expect(namespaceIndexer.Add(namespace)).To(gomega.Succeed())
+	}
+	return &Controller{
+		NetInfo:            netInfo,
+		zone:               "targaryen",
+		nadLister:          nadlisterv1.NewNetworkAttachmentDefinitionLister(nadIndexer),
+		anpNamespaceLister: corev1listers.NewNamespaceLister(namespaceIndexer),
+	}
+}
+
+func TestPolicyAppliesToNetwork(t *testing.T) {
+	secondaryNAD := ovntesting.GenerateNAD("blue", "blue", "dragonstone", types.Layer2Topology, "10.100.0.0/16", types.NetworkRoleSecondary)
+	secondaryNAD.Labels = map[string]string{"anp": "true"}
+	cudnNAD := ovntesting.GenerateNAD("cluster.udn.green", "green", "dragonstone", types.Layer2Topology, "10.200.0.0/16", types.NetworkRoleSecondary)
+	cudnNAD.Labels = map[string]string{"anp": "true"}
+	cudnNAD.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(&metav1.ObjectMeta{Name: "green"}, cudnController)}
+	primaryNAD := ovntesting.GenerateNAD("red", "red", "dragonstone", types.Layer3Topology, "10.150.0.0/16/24", types.NetworkRolePrimary)
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "dragonstone", Labels: map[string]string{"house": "targaryen"}}}
+
+	tests := []struct {
+		name        string
+		nad         *nadv1.NetworkAttachmentDefinition
+		annotations map[string]string
+		expected    bool
+		err         bool
+	}{
+		{
+			name:     "default network: policies always apply",
+			expected: true,
+		},
+		{
+			name:     "primary user defined network: policies always apply",
+			nad:      primaryNAD,
+			expected: true,
+		},
+		{
+			name:     "secondary network without annotation: policies don't apply",
+			nad:      secondaryNAD,
+			expected: false,
+		},
+		{
+			name: "secondary network selected by network attachment definition selector",
+			nad:  secondaryNAD,
+			annotations: map[string]string{
+				NetworkSelectorsAnnotation: `[{"networkSelectionType": "NetworkAttachmentDefinitions",
+					"networkAttachmentDefinitionSelector": {"namespaceSelector": {"matchLabels": {"house": "targaryen"}}, "networkSelector": {"matchLabels": {"anp": "true"}}}}]`,
+			},
+			expected: true,
+		},
+		{
+			name: "secondary network not selected by namespace selector",
+			nad:  secondaryNAD,
+			annotations: map[string]string{
+				NetworkSelectorsAnnotation: `[{"networkSelectionType": "NetworkAttachmentDefinitions",
+					"networkAttachmentDefinitionSelector": {"namespaceSelector": {"matchLabels": {"house": "stark"}}, "networkSelector": {}}}]`,
+			},
+			expected: false,
+		},
+		{
+			name: "secondary network not controlled by a cluster user defined network",
+			nad:  secondaryNAD,
+			annotations: map[string]string{
+				NetworkSelectorsAnnotation: `[{"networkSelectionType": "ClusterUserDefinedNetworks",
+					"clusterUserDefinedNetworkSelector": {"networkSelector": {"matchLabels": {"anp": "true"}}}}]`,
+			},
+			expected: false,
+		},
+		{
+			name: "secondary network selected by cluster user defined network selector",
+			nad:  cudnNAD,
+			annotations: map[string]string{
+				NetworkSelectorsAnnotation: `[{"networkSelectionType": "ClusterUserDefinedNetworks",
+					"clusterUserDefinedNetworkSelector": {"networkSelector": {"matchLabels": {"anp": "true"}}}}]`,
+			},
+			expected: true,
+		},
+		{
+			name: "secondary network with primary network selectors only",
+			nad:  secondaryNAD,
+			annotations: map[string]string{
+				NetworkSelectorsAnnotation: `[{"networkSelectionType": "DefaultNetwork"}, {"networkSelectionType": "PrimaryUserDefinedNetworks"}]`,
+			},
+			expected: false,
+		},
+		{
+			name: "secondary network with invalid annotation",
+			nad:  secondaryNAD,
+			annotations: map[string]string{
+				NetworkSelectorsAnnotation: `{"networkSelectionType": "NetworkAttachmentDefinitions"}`,
+			},
+			err: true,
+		},
+		{
+			name: "secondary network with empty selector",
+			nad:  secondaryNAD,
+			annotations: map[string]string{
+				NetworkSelectorsAnnotation: `[{"networkSelectionType": "NetworkAttachmentDefinitions"}]`,
+			},
+			err: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			c := newNetworkTestController(t, tt.nad, namespace)
+			applies, err := c.policyAppliesToNetwork(tt.annotations)
+			if tt.err {
+				g.Expect(err).To(gomega.HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(applies).To(gomega.Equal(tt.expected))
+		})
+	}
+}
+
+func TestGetPodLogicalPortNamesAndStatus(t *testing.T) {
+	primaryNAD := ovntesting.GenerateNAD("tenant-red", "red", "dragonstone", types.Layer3Topology, "10.150.0.0/16/24", types.NetworkRolePrimary)
+	secondaryNAD := ovntesting.GenerateNAD("tenant-blue", "blue", "dragonstone", types.Layer2Topology, "10.100.0.0/16", types.NetworkRoleSecondary)
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "daenerys",
+			Namespace: "dragonstone",
+			Annotations: map[string]string{
+				nadv1.NetworkAttachmentAnnot: "blue",
+			},
+		},
+	}
+
+	tests := []struct {
+		name                  string
+		nad                   *nadv1.NetworkAttachmentDefinition
+		expectedPorts         []string
+		expectedConditionType string
+		expectedFieldManager  string
+	}{
+		{
+			name:                  "default network",
+			expectedPorts:         []string{"dragonstone_daenerys"},
+			expectedConditionType: "Ready-In-Zone-targaryen",
+			expectedFieldManager:  "targaryen",
+		},
+		{
+			name:                  "primary user defined network",
+			nad:                   primaryNAD,
+			expectedPorts:         []string{"dragonstone.red_dragonstone_daenerys"},
+			expectedConditionType: "Ready-In-Zone-tenant.red_targaryen",
+			expectedFieldManager:  "tenant.red_targaryen",
+		},
+		{
+			name:                  "secondary network",
+			nad:                   secondaryNAD,
+			expectedPorts:         []string{"dragonstone.blue_dragonstone_daenerys"},
+			expectedConditionType: "Ready-In-Zone-tenant.blue_targaryen",
+			expectedFieldManager:  "tenant.blue_targaryen",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			c := newNetworkTestController(t, tt.nad)
+			ports, err := c.getPodLogicalPortNames(pod)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(ports).To(gomega.Equal(tt.expectedPorts))
+			g.Expect(c.getStatusConditionType()).To(gomega.Equal(tt.expectedConditionType))
+			g.Expect(c.getStatusFieldManager()).To(gomega.Equal(tt.expectedFieldManager))
+		})
+	}
+}
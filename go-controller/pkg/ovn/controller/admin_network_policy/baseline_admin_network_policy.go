@@ -62,8 +62,22 @@ func (c *Controller) syncBaselineAdminNetworkPolicy(key string) error {
 		return err
 	}
 
+	if banp != nil {
+		appliesToNetwork, err := c.policyAppliesToNetwork(banp.Annotations)
+		if err != nil {
+			// we can ignore the error if status update doesn't succeed; best effort
+			_ = c.updateBANPStatusToNotReady(banp.Name, err.Error())
+			// we don't want to retry for this error since it needs manual intervention from users,
+			// but the policy must not stay applied to this network in the meantime
+			return c.clearBaselineAdminNetworkPolicy(banpName)
+		}
+		if !appliesToNetwork {
+			klog.V(5).Infof("Baseline Admin Network Policy %s doesn't apply to network %s", banpName, c.GetNetworkName())
+			banp = nil
+		}
+	}
 	if banp == nil {
-		// it was deleted; let's clear up all the related resources to that
+		// it was deleted or doesn't apply to this network; let's clear up all the related resources to that
 		err = c.clearBaselineAdminNetworkPolicy(banpName)
 		if err != nil {
 			return err
@@ -101,15 +115,21 @@ func (c *Controller) clearBaselineAdminNetworkPolicy(banpName string) error {
 	if err != nil {
 		return fmt.Errorf("unable to delete PG %s for BANP %s: %w", portGroupName, banp.name, err)
 	}
+	if banp.aclLoggingParams.RateLimit > 0 {
+		c.deleteUnusedACLLoggingMeters()
+	}
 	// remove address-sets that were created for the peers of each rule fpr the whole ANP
 	// do this after ACLs are gone so that there is no lingering references
 	err = c.clearASForPeers(banp.name, libovsdbops.AddressSetBaselineAdminNetworkPolicy)
 	if err != nil {
 		return fmt.Errorf("failed to delete address-sets for BANP %s: %w", banp.name, err)
 	}
+	c.releaseDomainNames(banp, nil, true)
 	// we can delete the object from the cache now (set the cache back to empty value).
 	c.banpCache = &adminNetworkPolicyState{}
-	metrics.DecrementBANPCount()
+	if !c.IsSecondary() {
+		metrics.DecrementBANPCount()
+	}
 
 	return nil
 }
@@ -137,6 +157,11 @@ func (c *Controller) ensureBaselineAdminNetworkPolicy(banp *anpapi.BaselineAdmin
 	if err != nil {
 		return fmt.Errorf("unable to convert peers to addresses for banp %s: %v", desiredBANPState.name, err)
 	}
+	err = c.addDomainNames(desiredBANPState, true)
+	if err != nil {
+		c.releaseDomainNames(desiredBANPState, currentBANPState, true)
+		return fmt.Errorf("unable to resolve domain names for banp %s: %v", desiredBANPState.name, err)
+	}
 	atLeastOneRuleUpdated := false
 	desiredACLs := c.convertANPRulesToACLs(desiredBANPState, currentBANPState, portGroupName, &atLeastOneRuleUpdated, true)
 
@@ -149,19 +174,25 @@ func (c *Controller) ensureBaselineAdminNetworkPolicy(banp *anpapi.BaselineAdmin
 		// 6) Update the ANP caches to store all the created things if transact was successful
 		err = c.createNewANP(desiredBANPState, desiredACLs, desiredPorts, true)
 		if err != nil {
+			c.releaseDomainNames(desiredBANPState, nil, true)
 			return fmt.Errorf("failed to create BANP %s: %v", desiredBANPState.name, err)
 		}
 		// since transact was successful we can finally populate the cache
 		c.banpCache = desiredBANPState
-		metrics.IncrementBANPCount()
+		if !c.IsSecondary() {
+			metrics.IncrementBANPCount()
+		}
 		return nil
 	}
 	// BANP state existed in the cache, which means its either a BANP update or pod/namespace add/update/delete
 	klog.V(5).Infof("Baseline Admin network policy %s was found in cache...Syncing it", currentBANPState.name)
 	err = c.updateExistingANP(currentBANPState, desiredBANPState, atLeastOneRuleUpdated, false, true, desiredACLs)
 	if err != nil {
+		c.releaseDomainNames(desiredBANPState, currentBANPState, true)
 		return fmt.Errorf("failed to update ANP %s: %v", desiredBANPState.name, err)
 	}
+	// the ACLs don't reference the domain names removed from the BANP anymore
+	c.releaseDomainNames(currentBANPState, desiredBANPState, true)
 	// since transact was successful we can finally replace the currentBANPState in the cache with the latest desired one
 	c.banpCache = desiredBANPState
 	return nil
@@ -36,6 +36,10 @@ func (c *Controller) repairAdminNetworkPolicies() error {
 	}
 	existingANPs := map[string]*anpapi.AdminNetworkPolicy{}
 	for _, anp := range anps {
+		// the policies that don't apply to this network are stale too
+		if appliesToNetwork, err := c.policyAppliesToNetwork(anp.Annotations); err != nil || !appliesToNetwork {
+			continue
+		}
 		existingANPs[anp.Name] = anp
 	}
 
@@ -100,6 +104,10 @@ func (c *Controller) repairBaselineAdminNetworkPolicy() error {
 	}
 	existingBANPs := map[string]*anpapi.BaselineAdminNetworkPolicy{}
 	for _, banp := range banps {
+		// the policies that don't apply to this network are stale too
+		if appliesToNetwork, err := c.policyAppliesToNetwork(banp.Annotations); err != nil || !appliesToNetwork {
+			continue
+		}
 		existingBANPs[banp.Name] = banp
 	}
 
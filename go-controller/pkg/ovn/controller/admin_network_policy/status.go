@@ -15,6 +15,8 @@ import (
 // creating one row per zone in the metav1.Condition array
 // NOTE: On every update of ANP, related pods and namespaces - if anything goes wrong this
 // this status type flaps between true and false. Users can use this to narrow down the malfunctioning zone
+// The controllers of the user defined networks scope the zone name with the network name, thus creating
+// one row per zone and network, e.g. Ready-In-Zone-tenant.blue_ovn-worker for the network tenant-blue.
 // (DANGER): If this feature is used at 500-1000 node scale, then that many status rows will be created
 // and a 1000 controllers will be updating the same policy CRD  ¯\_(ツ)_/¯. However we are using server-side apply
 // which should ease the scale issues. We have tested at 120 nodes and status works fine at that scale.
@@ -50,7 +52,7 @@ const (
 // Each zone's ovnkube-controller will call this, hence let's update status using server-side-apply
 func (c *Controller) updateANPStatusToReady(anpName string) error {
 	readyCondition := metav1.Condition{
-		Type:    policyReadyStatusType + c.zone,
+		Type:    c.getStatusConditionType(),
 		Status:  metav1.ConditionTrue,
 		Reason:  policyReadyReason,
 		Message: "Setting up OVN DB plumbing was successful",
@@ -60,7 +62,7 @@ func (c *Controller) updateANPStatusToReady(anpName string) error {
 		return fmt.Errorf("unable to update the status of ANP %s, err: %v", anpName, err)
 	}
 	klog.V(5).Infof("Patched the status of ANP %v with condition type %v/%v",
-		anpName, policyReadyStatusType+c.zone, metav1.ConditionTrue)
+		anpName, c.getStatusConditionType(), metav1.ConditionTrue)
 	return nil
 }
 
@@ -74,7 +76,7 @@ func (c *Controller) updateANPStatusToNotReady(anpName, message string) error {
 		message = message[:32766]
 	}
 	notReadyCondition := metav1.Condition{
-		Type:    policyReadyStatusType + c.zone,
+		Type:    c.getStatusConditionType(),
 		Status:  metav1.ConditionFalse,
 		Reason:  policyNotReadyReason,
 		Message: message,
@@ -84,7 +86,7 @@ func (c *Controller) updateANPStatusToNotReady(anpName, message string) error {
 		return fmt.Errorf("unable update the status of ANP %s, err: %v", anpName, err)
 	}
 	klog.V(3).Infof("Patched the status of ANP %v with condition type %v/%v and reason %s/%s",
-		anpName, policyReadyStatusType+c.zone, metav1.ConditionFalse, policyNotReadyReason, message)
+		anpName, c.getStatusConditionType(), metav1.ConditionFalse, policyNotReadyReason, message)
 	return nil
 }
 
@@ -108,7 +110,7 @@ func (c *Controller) updateANPZoneStatusCondition(newCondition metav1.Condition,
 	applyObj := anpapiapply.AdminNetworkPolicy(anpName).
 		WithStatus(anpapiapply.AdminNetworkPolicyStatus().WithConditions(newCondition))
 	_, err = c.anpClientSet.PolicyV1alpha1().AdminNetworkPolicies().
-		ApplyStatus(context.TODO(), applyObj, metav1.ApplyOptions{FieldManager: c.zone, Force: true})
+		ApplyStatus(context.TODO(), applyObj, metav1.ApplyOptions{FieldManager: c.getStatusFieldManager(), Force: true})
 	return err
 }
 
@@ -116,7 +118,7 @@ func (c *Controller) updateANPZoneStatusCondition(newCondition metav1.Condition,
 // Each zone's ovnkube-controller will call this, hence let's update status using server-side-apply
 func (c *Controller) updateBANPStatusToReady(banpName string) error {
 	readyCondition := metav1.Condition{
-		Type:    policyReadyStatusType + c.zone,
+		Type:    c.getStatusConditionType(),
 		Status:  metav1.ConditionTrue,
 		Reason:  policyReadyReason,
 		Message: "Setting up OVN DB plumbing was successful",
@@ -126,7 +128,7 @@ func (c *Controller) updateBANPStatusToReady(banpName string) error {
 		return fmt.Errorf("unable to update the status of BANP %s, err: %v", banpName, err)
 	}
 	klog.V(5).Infof("Patched the status of BANP %v with condition type %v/%v",
-		banpName, policyReadyStatusType+c.zone, metav1.ConditionTrue)
+		banpName, c.getStatusConditionType(), metav1.ConditionTrue)
 	return nil
 }
 
@@ -137,7 +139,7 @@ func (c *Controller) updateBANPStatusToReady(banpName string) error {
 // this ANP instead of having to manually check logs across zones
 func (c *Controller) updateBANPStatusToNotReady(banpName, message string) error {
 	notReadyCondition := metav1.Condition{
-		Type:    policyReadyStatusType + c.zone,
+		Type:    c.getStatusConditionType(),
 		Status:  metav1.ConditionFalse,
 		Reason:  policyNotReadyReason,
 		Message: message,
@@ -147,7 +149,7 @@ func (c *Controller) updateBANPStatusToNotReady(banpName, message string) error
 		return fmt.Errorf("unable update the status of BANP %s, err: %v", banpName, err)
 	}
 	klog.V(3).Infof("Patched the status of BANP %v with condition type %v/%v and reason %s",
-		banpName, policyReadyStatusType+c.zone, metav1.ConditionFalse, policyNotReadyReason)
+		banpName, c.getStatusConditionType(), metav1.ConditionFalse, policyNotReadyReason)
 	return nil
 }
 
@@ -171,6 +173,6 @@ func (c *Controller) updateBANPZoneStatusCondition(newCondition metav1.Condition
 	applyObj := anpapiapply.BaselineAdminNetworkPolicy(banpName).
 		WithStatus(anpapiapply.BaselineAdminNetworkPolicyStatus().WithConditions(newCondition))
 	_, err = c.anpClientSet.PolicyV1alpha1().BaselineAdminNetworkPolicies().
-		ApplyStatus(context.TODO(), applyObj, metav1.ApplyOptions{FieldManager: c.zone, Force: true})
+		ApplyStatus(context.TODO(), applyObj, metav1.ApplyOptions{FieldManager: c.getStatusFieldManager(), Force: true})
 	return err
 }
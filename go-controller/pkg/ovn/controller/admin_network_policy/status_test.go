@@ -119,6 +119,7 @@ func newANPControllerWithDBSetup(dbSetup libovsdbtest.TestSetup, initANPs anpapi
 	recorder := record.NewFakeRecorder(10)
 	controller, err := NewController(
 		"default-network-controller",
+		&util.DefaultNetInfo{},
 		nbClient,
 		fakeClient.ANPClient,
 		watcher.ANPInformer(),
@@ -126,7 +127,9 @@ func newANPControllerWithDBSetup(dbSetup libovsdbtest.TestSetup, initANPs anpapi
 		watcher.NamespaceCoreInformer(),
 		watcher.PodCoreInformer(),
 		watcher.NodeCoreInformer(),
+		nil,
 		addressSetFactory,
+		nil,
 		nil, // we don't care about pods in this test
 		"targaryen",
 		recorder,
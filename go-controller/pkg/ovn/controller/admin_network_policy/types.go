@@ -69,6 +69,9 @@ type gressRule struct {
 	ports  []*libovsdbutil.NetworkPolicyPort
 	// all the peerAddresses of the peer entities (podIPs, nodeIPs, CIDR ranges) selected by this ANP Rule
 	peerAddresses sets.Set[string]
+	// sorted domain names set on this egress rule by the EgressDomainNamesAnnotation,
+	// their addresses are kept in the address sets of the DNS name resolver
+	domainNames []string
 	// saves NamedPort representation;
 	// key is the name of the Port
 	// value is an array of possible representations of this port (relevance wrt to rule, peers)
@@ -131,13 +134,17 @@ func newAdminNetworkPolicyState(raw *anpapi.AdminNetworkPolicy) (*adminNetworkPo
 		}
 		anp.egressRules = append(anp.egressRules, anpRule)
 	}
+	if err := setEgressRuleDomainNames(anp.egressRules, raw.Annotations); err != nil {
+		err = fmt.Errorf("cannot set egress domain names in ANP %s: %w", raw.Name, err)
+		errs = append(errs, err)
+	}
 	anp.aclLoggingParams, err = getACLLoggingLevelsForANP(raw.Annotations)
 	if err != nil {
 		err = fmt.Errorf("cannot parse ANP ACL logging annotation, disabling it for ANP %s: %w", raw.Name, err)
 		errs = append(errs, err)
 	}
-	klog.V(5).Infof("Logging parameters for ANP %s are Allow=%s/Deny=%s/Pass=%s", raw.Name,
-		anp.aclLoggingParams.Allow, anp.aclLoggingParams.Deny, anp.aclLoggingParams.Pass)
+	klog.V(5).Infof("Logging parameters for ANP %s are Allow=%s/Deny=%s/Pass=%s/RateLimit=%d", raw.Name,
+		anp.aclLoggingParams.Allow, anp.aclLoggingParams.Deny, anp.aclLoggingParams.Pass, anp.aclLoggingParams.RateLimit)
 	return anp, utilerrors.Join(errs...)
 }
 
@@ -388,13 +395,17 @@ func newBaselineAdminNetworkPolicyState(raw *anpapi.BaselineAdminNetworkPolicy)
 		}
 		banp.egressRules = append(banp.egressRules, banpRule)
 	}
+	if err := setEgressRuleDomainNames(banp.egressRules, raw.Annotations); err != nil {
+		err = fmt.Errorf("cannot set egress domain names in BANP %s: %w", raw.Name, err)
+		errs = append(errs, err)
+	}
 	banp.aclLoggingParams, err = getACLLoggingLevelsForANP(raw.Annotations)
 	if err != nil {
 		err = fmt.Errorf("cannot parse BANP ACL logging annotation, disabling it for BANP %s: %w", raw.Name, err)
 		errs = append(errs, err)
 	}
-	klog.V(5).Infof("Logging parameters for BANP %s are Allow=%s/Deny=%s", raw.Name,
-		banp.aclLoggingParams.Allow, banp.aclLoggingParams.Deny)
+	klog.V(5).Infof("Logging parameters for BANP %s are Allow=%s/Deny=%s/RateLimit=%d", raw.Name,
+		banp.aclLoggingParams.Allow, banp.aclLoggingParams.Deny, banp.aclLoggingParams.RateLimit)
 	return banp, utilerrors.Join(errs...)
 }
 
@@ -1,14 +1,12 @@
 package adminnetworkpolicy
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
 	"sort"
 
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/util/sets"
 	utilnet "k8s.io/utils/net"
 	anpapi "sigs.k8s.io/network-policy-api/apis/v1alpha1"
 
@@ -18,7 +16,6 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
-	utilerrors "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/errors"
 )
 
 var ErrorANPPriorityUnsupported = errors.New("OVNK only supports priority ranges 0-99")
@@ -130,52 +127,11 @@ func constructMatchFromAddressSet(gressPrefix string, addrSetIndex *libovsdbops.
 // if parsed values are correct, then it returns those aclLogLevels
 // if annotation is not set or parsed values are incorrect/invalid, then it returns empty aclLogLevels which implies logging is disabled
 func getACLLoggingLevelsForANP(annotations map[string]string) (*libovsdbutil.ACLLoggingLevels, error) {
-	aclLogLevels := &libovsdbutil.ACLLoggingLevels{
-		Allow: "", Deny: "", Pass: "",
-	}
 	annotation, ok := annotations[util.AclLoggingAnnotation]
 	if !ok {
-		return aclLogLevels, nil
-	}
-	// If the annotation is "" or "{}", use empty strings. Otherwise, parse the annotation.
-	if annotation != "" && annotation != "{}" {
-		err := json.Unmarshal([]byte(annotation), aclLogLevels)
-		if err != nil {
-			// Disable Allow, Deny, Pass logging to ensure idempotency.
-			aclLogLevels.Allow = ""
-			aclLogLevels.Deny = ""
-			aclLogLevels.Pass = ""
-			return aclLogLevels, fmt.Errorf("could not unmarshal ANP ACL annotation '%s', disabling logging, err: %q",
-				annotation, err)
-		}
-	}
-
-	// Valid log levels are the various preestablished levels or the empty string.
-	validLogLevels := sets.NewString(nbdb.ACLSeverityAlert, nbdb.ACLSeverityWarning, nbdb.ACLSeverityNotice,
-		nbdb.ACLSeverityInfo, nbdb.ACLSeverityDebug, "")
-	var errs []error
-	// Ensure value parsed is valid
-	// Set Deny logging.
-	if !validLogLevels.Has(aclLogLevels.Deny) {
-		errs = append(errs, fmt.Errorf("disabling deny logging due to an invalid deny annotation. "+
-			"%q is not a valid log severity", aclLogLevels.Deny))
-		aclLogLevels.Deny = ""
-	}
-
-	// Set Allow logging.
-	if !validLogLevels.Has(aclLogLevels.Allow) {
-		errs = append(errs, fmt.Errorf("disabling allow logging due to an invalid allow annotation. "+
-			"%q is not a valid log severity", aclLogLevels.Allow))
-		aclLogLevels.Allow = ""
-	}
-
-	// Set Pass logging.
-	if !validLogLevels.Has(aclLogLevels.Pass) {
-		errs = append(errs, fmt.Errorf("disabling pass logging due to an invalid pass annotation. "+
-			"%q is not a valid log severity", aclLogLevels.Pass))
-		aclLogLevels.Pass = ""
+		return &libovsdbutil.ACLLoggingLevels{}, nil
 	}
-	return aclLogLevels, utilerrors.Join(errs...)
+	return libovsdbutil.ParseACLLoggingLevels(annotation, "ANP")
 }
 
 // convertPodIPContainerPortToNNPP converts the given pod container port and podIPs into a list (max 2 for dualstack)
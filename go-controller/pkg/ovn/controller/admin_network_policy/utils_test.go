@@ -117,6 +117,26 @@ func TestGetACLLoggingLevelsForANP(t *testing.T) {
 			},
 			err: "disabling pass logging due to an invalid pass annotation",
 		},
+		{
+			name: "correctly filled rate limit annotation: logging enabled with its own rate limit",
+			annotations: map[string]string{
+				util.AclLoggingAnnotation: fmt.Sprintf(`{ "deny": "%s", "allow": "%s", "rateLimit": 10 }`, nbdb.ACLSeverityNotice, nbdb.ACLSeverityInfo),
+			},
+			expected: &libovsdbutil.ACLLoggingLevels{
+				Allow: "info", Deny: "notice", Pass: "", RateLimit: 10,
+			},
+			err: "",
+		},
+		{
+			name: "incorrectly filled rate limit annotation: logging enabled with the default rate limit",
+			annotations: map[string]string{
+				util.AclLoggingAnnotation: fmt.Sprintf(`{ "deny": "%s", "allow": "%s", "rateLimit": -10 }`, nbdb.ACLSeverityNotice, nbdb.ACLSeverityInfo),
+			},
+			expected: &libovsdbutil.ACLLoggingLevels{
+				Allow: "info", Deny: "notice", Pass: "",
+			},
+			err: "using the default rate limit due to an invalid rate limit annotation",
+		},
 	}
 
 	for i, tt := range tests {
@@ -0,0 +1,379 @@
+package samplingpolicy
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	knet "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	networkinginformers "k8s.io/client-go/informers/networking/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	libovsdbclient "github.com/ovn-org/libovsdb/client"
+
+	samplingpolicyv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/samplingpolicy/v1"
+	samplingpolicyinformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/samplingpolicy/v1/apis/informers/externalversions/samplingpolicy/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/observability"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// Controller translates SamplingPolicies into the per-namespace sampling config
+// of the observability Manager, and updates the samples of the namespace ACLs.
+// Every namespace is handled as a single key, as all SamplingPolicies of a
+// namespace are merged into one config.
+type Controller struct {
+	nbClient               libovsdbclient.Client
+	observManager          *observability.Manager
+	samplingPolicyInformer samplingpolicyinformer.SamplingPolicyInformer
+	networkPolicyInformer  networkinginformers.NetworkPolicyInformer
+	podInformer            coreinformers.PodInformer
+	queue                  workqueue.TypedRateLimitingInterface[string]
+}
+
+// NewController creates a new SamplingPolicy controller. Single worker only for
+// processing events.
+func NewController(nbClient libovsdbclient.Client, observManager *observability.Manager,
+	samplingPolicyInformer samplingpolicyinformer.SamplingPolicyInformer,
+	networkPolicyInformer networkinginformers.NetworkPolicyInformer, podInformer coreinformers.PodInformer) *Controller {
+	return &Controller{
+		nbClient:               nbClient,
+		observManager:          observManager,
+		samplingPolicyInformer: samplingPolicyInformer,
+		networkPolicyInformer:  networkPolicyInformer,
+		podInformer:            podInformer,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.NewTypedItemFastSlowRateLimiter[string](time.Second, 5*time.Second, 5),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "samplingpolicy"},
+		),
+	}
+}
+
+// Run adds event handlers and starts a single worker. It will block until stop
+// channel is closed.
+func (c *Controller) Run(stopCh <-chan struct{}) error {
+	defer c.queue.ShutDown()
+	klog.Info("Waiting for SamplingPolicy controller informers to sync")
+	if ok := cache.WaitForCacheSync(stopCh, c.samplingPolicyInformer.Informer().HasSynced,
+		c.networkPolicyInformer.Informer().HasSynced, c.podInformer.Informer().HasSynced); !ok {
+		return nil
+	}
+	var handlers []cache.ResourceEventHandlerRegistration
+	for _, h := range []struct {
+		informer cache.SharedIndexInformer
+		handler  cache.ResourceEventHandler
+	}{
+		{
+			informer: c.samplingPolicyInformer.Informer(),
+			handler: cache.ResourceEventHandlerFuncs{
+				AddFunc:    c.enqueueNamespace,
+				UpdateFunc: func(_, newObj interface{}) { c.enqueueNamespace(newObj) },
+				DeleteFunc: c.enqueueNamespace,
+			},
+		},
+		{
+			informer: c.networkPolicyInformer.Informer(),
+			handler: cache.ResourceEventHandlerFuncs{
+				AddFunc:    c.enqueueSampledNamespace,
+				UpdateFunc: c.onNetworkPolicyUpdate,
+				DeleteFunc: c.enqueueSampledNamespace,
+			},
+		},
+		{
+			informer: c.podInformer.Informer(),
+			handler: cache.ResourceEventHandlerFuncs{
+				AddFunc:    c.enqueueSampledNamespace,
+				UpdateFunc: c.onPodUpdate,
+				DeleteFunc: c.enqueueSampledNamespace,
+			},
+		},
+	} {
+		handler, err := h.informer.AddEventHandler(factory.WithUpdateHandlingForObjReplace(h.handler))
+		if err != nil {
+			return fmt.Errorf("failed to add event handler: %v", err)
+		}
+		handlers = append(handlers, handler)
+	}
+	klog.Info("Performing full resync")
+	if err := c.fullResync(); err != nil {
+		return fmt.Errorf("failed to run SamplingPolicy controller because repairing failed: %v", err)
+	}
+	klog.Info("Waiting for handlers to sync")
+	for _, handler := range handlers {
+		if ok := cache.WaitForCacheSync(stopCh, handler.HasSynced); !ok {
+			return nil
+		}
+	}
+	defer klog.Info("SamplingPolicy controller ended")
+	klog.Info("Starting worker")
+	go wait.Until(c.worker, time.Second, stopCh)
+	<-stopCh
+	return nil
+}
+
+// fullResync makes sure the namespaces that had their samples configured by a
+// previous run, but don't have SamplingPolicies anymore, are cleaned up.
+func (c *Controller) fullResync() error {
+	acls, err := libovsdbops.FindACLsWithPredicate(c.nbClient, func(acl *nbdb.ACL) bool {
+		namespace, _ := libovsdbops.GetACLSampleNamespace(acl)
+		return namespace != "" && acl.SampleNew != nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find sampled ACLs: %v", err)
+	}
+	for _, acl := range acls {
+		namespace, _ := libovsdbops.GetACLSampleNamespace(acl)
+		c.queue.Add(namespace)
+	}
+	return nil
+}
+
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, done := c.queue.Get()
+	if done {
+		return false
+	}
+	defer c.queue.Done(key)
+	err := c.syncNamespace(key)
+	c.handleErr(err, key)
+	return true
+}
+
+func (c *Controller) handleErr(err error, key string) {
+	if err == nil {
+		c.queue.Forget(key)
+		return
+	}
+	if c.queue.NumRequeues(key) < 15 {
+		klog.V(2).Infof("Error syncing sampling for namespace %s, retrying: %v", key, err)
+		c.queue.AddRateLimited(key)
+		return
+	}
+	klog.Warningf("Dropping sampling for namespace %s out of the queue: %v", key, err)
+	c.queue.Forget(key)
+	utilruntime.HandleError(err)
+}
+
+func (c *Controller) syncNamespace(namespace string) error {
+	samplingPolicies, err := c.samplingPolicyInformer.Lister().SamplingPolicies(namespace).List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list SamplingPolicies: %v", err)
+	}
+	if len(samplingPolicies) == 0 {
+		klog.V(5).Infof("Deleting sampling config for namespace %s", namespace)
+		c.observManager.DeleteNamespaceSampling(namespace)
+	} else {
+		featuresProbability, networkPolicyProbability, err := c.getNamespaceSampling(namespace, samplingPolicies)
+		if err != nil {
+			return err
+		}
+		klog.V(5).Infof("Setting sampling config for namespace %s: features %v, network policies %v",
+			namespace, featuresProbability, networkPolicyProbability)
+		if err = c.observManager.SetNamespaceSampling(namespace, featuresProbability, networkPolicyProbability); err != nil {
+			return err
+		}
+	}
+	if err = c.updateNamespaceACLs(namespace); err != nil {
+		return err
+	}
+	if err = c.observManager.CleanupStaleCollectors(); err != nil {
+		// collectors may still be used by the namespaces that are not synced yet
+		klog.V(5).Infof("Failed to cleanup stale collectors: %v", err)
+	}
+	return nil
+}
+
+// getNamespaceSampling merges the SamplingPolicies of a namespace, the highest
+// probability wins. Network policies only get their own probability when a
+// SamplingPolicy with a non-empty pod selector samples them, in which case
+// every network policy of the namespace is set explicitly and only the ones
+// that select none but the sampled pods are sampled. The namespace-wide
+// NetworkPolicy probability then only applies to the default deny ACLs, that
+// are shared by all the isolated pods and only sampled with an empty selector.
+func (c *Controller) getNamespaceSampling(namespace string, samplingPolicies []*samplingpolicyv1.SamplingPolicy) (
+	map[libovsdbops.SampleFeature]int, map[string]int, error) {
+	featuresProbability := map[libovsdbops.SampleFeature]int{}
+	var networkPolicyProbability map[string]int
+	// namespace-wide probability for the network policies
+	allNetworkPoliciesProbability := 0
+	var networkPolicies []*knet.NetworkPolicy
+	var pods []*corev1.Pod
+	for _, samplingPolicy := range samplingPolicies {
+		probability := int(samplingPolicy.Spec.Probability)
+		if probability == 0 {
+			// not defaulted by the api server, e.g. on older CRD versions
+			probability = 100
+		}
+		podSelector, err := metav1.LabelSelectorAsSelector(&samplingPolicy.Spec.PodSelector)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid pod selector in SamplingPolicy %s/%s: %v", namespace, samplingPolicy.Name, err)
+		}
+		for _, feature := range samplingPolicy.Spec.Features {
+			sampleFeature := libovsdbops.SampleFeature(feature)
+			if podSelector.Empty() {
+				featuresProbability[sampleFeature] = max(featuresProbability[sampleFeature], probability)
+				if feature == samplingpolicyv1.NetworkPolicyFeature {
+					allNetworkPoliciesProbability = max(allNetworkPoliciesProbability, probability)
+				}
+				continue
+			}
+			if feature != samplingpolicyv1.NetworkPolicyFeature {
+				// rejected by the CRD validation, the other features are per
+				// namespace and can't be narrowed down to the selected pods
+				klog.Warningf("Ignoring feature %s of SamplingPolicy %s/%s, it can't be sampled for a pod selector",
+					feature, namespace, samplingPolicy.Name)
+				continue
+			}
+			// don't fall back to the global config for the default deny ACLs
+			featuresProbability[sampleFeature] = max(featuresProbability[sampleFeature], 0)
+			if networkPolicyProbability == nil {
+				networkPolicyProbability = map[string]int{}
+				networkPolicies, err = c.networkPolicyInformer.Lister().NetworkPolicies(namespace).List(labels.Everything())
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to list network policies: %v", err)
+				}
+				pods, err = c.podInformer.Lister().Pods(namespace).List(labels.Everything())
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to list pods: %v", err)
+				}
+			}
+			for _, networkPolicy := range networkPolicies {
+				selected, err := selectsOnlySelectedPods(podSelector, &networkPolicy.Spec.PodSelector, pods)
+				if err != nil {
+					return nil, nil, fmt.Errorf("invalid pod selector in network policy %s/%s: %v", namespace, networkPolicy.Name, err)
+				}
+				if selected {
+					networkPolicyProbability[networkPolicy.Name] = max(networkPolicyProbability[networkPolicy.Name], probability)
+				}
+			}
+		}
+	}
+	if networkPolicyProbability != nil {
+		for _, networkPolicy := range networkPolicies {
+			networkPolicyProbability[networkPolicy.Name] = max(networkPolicyProbability[networkPolicy.Name], allNetworkPoliciesProbability)
+		}
+	}
+	return featuresProbability, networkPolicyProbability, nil
+}
+
+// selectsOnlySelectedPods returns true if the network policy selects at least
+// one running pod, and all of its running pods are selected by the
+// SamplingPolicy. The ACLs of a network policy apply to all the pods it
+// selects, sampling any other one would sample pods that were not asked for.
+func selectsOnlySelectedPods(podSelector labels.Selector, networkPolicySelector *metav1.LabelSelector, pods []*corev1.Pod) (bool, error) {
+	npSelector, err := metav1.LabelSelectorAsSelector(networkPolicySelector)
+	if err != nil {
+		return false, err
+	}
+	selected := false
+	for _, pod := range pods {
+		if util.PodCompleted(pod) {
+			continue
+		}
+		podLabels := labels.Set(pod.Labels)
+		if !npSelector.Matches(podLabels) {
+			continue
+		}
+		if !podSelector.Matches(podLabels) {
+			return false, nil
+		}
+		selected = true
+	}
+	return selected, nil
+}
+
+// updateNamespaceACLs updates the samples of all ACLs owned by the namespace
+// with the current sampling config.
+func (c *Controller) updateNamespaceACLs(namespace string) error {
+	acls, err := libovsdbops.FindACLsWithPredicate(c.nbClient, func(acl *nbdb.ACL) bool {
+		aclNamespace, _ := libovsdbops.GetACLSampleNamespace(acl)
+		return aclNamespace == namespace
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find ACLs for namespace %s: %v", namespace, err)
+	}
+	if len(acls) == 0 {
+		return nil
+	}
+	ops, err := libovsdbops.UpdateACLsSampleOps(c.nbClient, nil, c.observManager.SamplingConfig(), acls...)
+	if err != nil {
+		return fmt.Errorf("failed to get update ACL samples ops for namespace %s: %v", namespace, err)
+	}
+	_, err = libovsdbops.TransactAndCheck(c.nbClient, ops)
+	if err != nil {
+		return fmt.Errorf("failed to update ACL samples for namespace %s: %v", namespace, err)
+	}
+	return nil
+}
+
+func (c *Controller) enqueueNamespace(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
+		return
+	}
+	namespace, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't split key %s: %v", key, err))
+		return
+	}
+	c.queue.Add(namespace)
+}
+
+// enqueueSampledNamespace only enqueues the namespace of the object if it has
+// SamplingPolicies.
+func (c *Controller) enqueueSampledNamespace(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
+		return
+	}
+	namespace, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't split key %s: %v", key, err))
+		return
+	}
+	samplingPolicies, err := c.samplingPolicyInformer.Lister().SamplingPolicies(namespace).List(labels.Everything())
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("failed to list SamplingPolicies in namespace %s: %v", namespace, err))
+		return
+	}
+	if len(samplingPolicies) > 0 {
+		c.queue.Add(namespace)
+	}
+}
+
+func (c *Controller) onNetworkPolicyUpdate(oldObj, newObj interface{}) {
+	oldNetworkPolicy := oldObj.(*knet.NetworkPolicy)
+	newNetworkPolicy := newObj.(*knet.NetworkPolicy)
+	// only the pod selector affects the sampled network policies
+	if reflect.DeepEqual(oldNetworkPolicy.Spec.PodSelector, newNetworkPolicy.Spec.PodSelector) {
+		return
+	}
+	c.enqueueSampledNamespace(newObj)
+}
+
+func (c *Controller) onPodUpdate(oldObj, newObj interface{}) {
+	oldPod := oldObj.(*corev1.Pod)
+	newPod := newObj.(*corev1.Pod)
+	// only labels and completion affect the selected network policies
+	if labels.Equals(oldPod.Labels, newPod.Labels) && util.PodCompleted(oldPod) == util.PodCompleted(newPod) {
+		return
+	}
+	c.enqueueSampledNamespace(newObj)
+}
@@ -0,0 +1,303 @@
+package samplingpolicy
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	knet "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	libovsdbclient "github.com/ovn-org/libovsdb/client"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	samplingpolicyv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/samplingpolicy/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/observability"
+	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const namespace = "namespace1"
+
+func newPod(name, app string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    map[string]string{"app": app},
+		},
+	}
+}
+
+func newNetworkPolicy(name, app string) *knet.NetworkPolicy {
+	return &knet.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: knet.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": app}},
+		},
+	}
+}
+
+func newSamplingPolicy(name string, podSelector metav1.LabelSelector, probability int32,
+	features ...samplingpolicyv1.SamplingFeature) *samplingpolicyv1.SamplingPolicy {
+	return &samplingpolicyv1.SamplingPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: samplingpolicyv1.SamplingPolicySpec{
+			PodSelector: podSelector,
+			Features:    features,
+			Probability: probability,
+		},
+	}
+}
+
+func newACL(ownerType, objectName string) *nbdb.ACL {
+	return &nbdb.ACL{
+		UUID: objectName + "-" + ownerType + "-uuid",
+		ExternalIDs: map[string]string{
+			libovsdbops.OwnerTypeKey.String():  ownerType,
+			libovsdbops.ObjectNameKey.String(): objectName,
+			libovsdbops.PrimaryIDKey.String():  ownerType + ":" + objectName,
+		},
+	}
+}
+
+// getSampleProbabilities returns "<object name>/<owner type>" => sample collector probability of
+// the sampled ACLs.
+func getSampleProbabilities(nbClient libovsdbclient.Client) (map[string]int, error) {
+	acls, err := libovsdbops.FindACLsWithPredicate(nbClient, func(acl *nbdb.ACL) bool {
+		return acl.SampleNew != nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	probabilities := map[string]int{}
+	for _, acl := range acls {
+		sample, err := libovsdbops.GetSample(nbClient, &nbdb.Sample{UUID: *acl.SampleNew})
+		if err != nil {
+			return nil, err
+		}
+		collectors, err := libovsdbops.FindSampleCollectorWithPredicate(nbClient, func(collector *nbdb.SampleCollector) bool {
+			return collector.UUID == sample.Collectors[0]
+		})
+		if err != nil {
+			return nil, err
+		}
+		probabilities[acl.ExternalIDs[libovsdbops.ObjectNameKey.String()]+"/"+
+			acl.ExternalIDs[libovsdbops.OwnerTypeKey.String()]] = collectors[0].Probability
+	}
+	return probabilities, nil
+}
+
+func TestSamplingPolicyController(t *testing.T) {
+	webPolicy := libovsdbops.BuildNamespaceNameKey(namespace, "web")
+	dbPolicy := libovsdbops.BuildNamespaceNameKey(namespace, "db")
+	allPodsPolicy := libovsdbops.BuildNamespaceNameKey(namespace, "all")
+	otherPolicy := libovsdbops.BuildNamespaceNameKey("namespace2", "web")
+	webPolicyKey := webPolicy + "/" + libovsdbops.NetworkPolicyOwnerType
+	dbPolicyKey := dbPolicy + "/" + libovsdbops.NetworkPolicyOwnerType
+	allPodsPolicyKey := allPodsPolicy + "/" + libovsdbops.NetworkPolicyOwnerType
+	defaultDenyKey := namespace + "/" + libovsdbops.NetpolNamespaceOwnerType
+	egressFirewallKey := namespace + "/" + libovsdbops.EgressFirewallOwnerType
+	webSelector := metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+
+	tests := []struct {
+		name                  string
+		samplingPolicies      []*samplingpolicyv1.SamplingPolicy
+		expectedProbabilities map[string]int
+	}{
+		{
+			name:                  "no sampling policies",
+			expectedProbabilities: map[string]int{},
+		},
+		{
+			name: "namespace-wide sampling policy",
+			samplingPolicies: []*samplingpolicyv1.SamplingPolicy{
+				newSamplingPolicy("sp1", metav1.LabelSelector{}, 100,
+					samplingpolicyv1.NetworkPolicyFeature, samplingpolicyv1.EgressFirewallFeature),
+			},
+			expectedProbabilities: map[string]int{
+				webPolicyKey:      65535,
+				dbPolicyKey:       65535,
+				allPodsPolicyKey:  65535,
+				defaultDenyKey:    65535,
+				egressFirewallKey: 65535,
+			},
+		},
+		{
+			name: "pod selector only samples network policies selecting none but the selected pods",
+			samplingPolicies: []*samplingpolicyv1.SamplingPolicy{
+				newSamplingPolicy("sp1", webSelector, 50, samplingpolicyv1.NetworkPolicyFeature),
+			},
+			expectedProbabilities: map[string]int{
+				webPolicyKey: 32767,
+			},
+		},
+		{
+			name: "pod selector ignores per namespace features",
+			samplingPolicies: []*samplingpolicyv1.SamplingPolicy{
+				newSamplingPolicy("sp1", webSelector, 50,
+					samplingpolicyv1.NetworkPolicyFeature, samplingpolicyv1.EgressFirewallFeature),
+			},
+			expectedProbabilities: map[string]int{
+				webPolicyKey: 32767,
+			},
+		},
+		{
+			name: "highest probability wins",
+			samplingPolicies: []*samplingpolicyv1.SamplingPolicy{
+				newSamplingPolicy("sp1", webSelector, 100, samplingpolicyv1.NetworkPolicyFeature),
+				newSamplingPolicy("sp2", metav1.LabelSelector{}, 50,
+					samplingpolicyv1.NetworkPolicyFeature, samplingpolicyv1.EgressFirewallFeature),
+			},
+			expectedProbabilities: map[string]int{
+				webPolicyKey:      65535,
+				dbPolicyKey:       32767,
+				allPodsPolicyKey:  32767,
+				defaultDenyKey:    32767,
+				egressFirewallKey: 32767,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			config.OVNKubernetesFeature.EnableObservability = true
+			config.OVNKubernetesFeature.EnableSamplingPolicy = true
+			defer func() {
+				config.OVNKubernetesFeature.EnableObservability = false
+				config.OVNKubernetesFeature.EnableSamplingPolicy = false
+			}()
+
+			acls := []*nbdb.ACL{
+				newACL(libovsdbops.NetworkPolicyOwnerType, webPolicy),
+				newACL(libovsdbops.NetworkPolicyOwnerType, dbPolicy),
+				newACL(libovsdbops.NetworkPolicyOwnerType, allPodsPolicy),
+				newACL(libovsdbops.NetworkPolicyOwnerType, otherPolicy),
+				newACL(libovsdbops.NetpolNamespaceOwnerType, namespace),
+				newACL(libovsdbops.EgressFirewallOwnerType, namespace),
+			}
+			pg := &nbdb.PortGroup{UUID: "pg-uuid"}
+			initialDB := []libovsdbtest.TestData{pg}
+			for _, acl := range acls {
+				pg.ACLs = append(pg.ACLs, acl.UUID)
+				initialDB = append(initialDB, acl)
+			}
+			nbClient, cleanup, err := libovsdbtest.NewNBTestHarness(libovsdbtest.TestSetup{NBData: initialDB}, nil)
+			if err != nil {
+				t.Fatalf("failed to create new NB test harness: %v", err)
+			}
+			defer cleanup.Cleanup()
+			observManager := observability.NewManager(nbClient)
+			if err = observManager.Init(); err != nil {
+				t.Fatalf("failed to init observability manager: %v", err)
+			}
+
+			ovnClient := util.GetOVNClientset(
+				newPod("web", "web"),
+				newPod("db", "db"),
+				newNetworkPolicy("web", "web"),
+				newNetworkPolicy("db", "db"),
+				&knet.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "all", Namespace: namespace}},
+			).GetOVNKubeControllerClientset()
+			watchFactory, err := factory.NewOVNKubeControllerWatchFactory(ovnClient)
+			if err != nil {
+				t.Fatalf("failed to create new OVN kube controller watch factory: %v", err)
+			}
+			if err = watchFactory.Start(); err != nil {
+				t.Fatalf("failed to start watch factory: %v", err)
+			}
+			defer watchFactory.Shutdown()
+
+			c := NewController(nbClient, observManager, watchFactory.SamplingPolicyInformer(),
+				watchFactory.NetworkPolicyCoreInformer(), watchFactory.PodCoreInformer())
+			stopCh := make(chan struct{})
+			wg := &sync.WaitGroup{}
+			wg.Add(1)
+			go func() {
+				if err := c.Run(stopCh); err != nil {
+					t.Logf("Run() controller failed: %v", err)
+				}
+				wg.Done()
+			}()
+			defer func() {
+				close(stopCh)
+				wg.Wait()
+			}()
+
+			for _, samplingPolicy := range tt.samplingPolicies {
+				_, err = ovnClient.SamplingPolicyClient.K8sV1().SamplingPolicies(namespace).Create(context.Background(),
+					samplingPolicy, metav1.CreateOptions{})
+				if err != nil {
+					t.Fatalf("failed to create SamplingPolicy: %v", err)
+				}
+			}
+			g.Eventually(func() (map[string]int, error) {
+				return getSampleProbabilities(nbClient)
+			}).WithTimeout(10 * time.Second).Should(gomega.Equal(tt.expectedProbabilities))
+			g.Consistently(func() (map[string]int, error) {
+				return getSampleProbabilities(nbClient)
+			}).WithTimeout(time.Second).Should(gomega.Equal(tt.expectedProbabilities))
+
+			if len(tt.samplingPolicies) == 0 {
+				return
+			}
+			t.Logf("update the db network policy to select the web pod")
+			_, err = ovnClient.KubeClient.NetworkingV1().NetworkPolicies(namespace).Update(context.Background(),
+				newNetworkPolicy("db", "web"), metav1.UpdateOptions{})
+			if err != nil {
+				t.Fatalf("failed to update network policy: %v", err)
+			}
+			g.Eventually(func() (map[string]int, error) {
+				return getSampleProbabilities(nbClient)
+			}).WithTimeout(10 * time.Second).Should(gomega.HaveKeyWithValue(dbPolicyKey, tt.expectedProbabilities[webPolicyKey]))
+
+			t.Logf("relabel the web pod, network policies that don't select any sampled pod are not sampled")
+			_, err = ovnClient.KubeClient.CoreV1().Pods(namespace).Update(context.Background(),
+				newPod("web", "frontend"), metav1.UpdateOptions{})
+			if err != nil {
+				t.Fatalf("failed to update pod: %v", err)
+			}
+			// no pod is selected by the network policies anymore, they fall back
+			// to the namespace-wide probability of the unselected db policy
+			g.Eventually(func() (map[string]int, error) {
+				probabilities, err := getSampleProbabilities(nbClient)
+				if err != nil {
+					return nil, err
+				}
+				return map[string]int{webPolicyKey: probabilities[webPolicyKey], dbPolicyKey: probabilities[dbPolicyKey]}, nil
+			}).WithTimeout(10 * time.Second).Should(gomega.Equal(map[string]int{
+				webPolicyKey: tt.expectedProbabilities[dbPolicyKey],
+				dbPolicyKey:  tt.expectedProbabilities[dbPolicyKey],
+			}))
+
+			t.Logf("delete all SamplingPolicies, nothing should be sampled")
+			for _, samplingPolicy := range tt.samplingPolicies {
+				err = ovnClient.SamplingPolicyClient.K8sV1().SamplingPolicies(namespace).Delete(context.Background(),
+					samplingPolicy.Name, metav1.DeleteOptions{})
+				if err != nil {
+					t.Fatalf("failed to delete SamplingPolicy: %v", err)
+				}
+			}
+			g.Eventually(func() (map[string]int, error) {
+				return getSampleProbabilities(nbClient)
+			}).WithTimeout(10 * time.Second).Should(gomega.BeEmpty())
+			g.Eventually(func() ([]*nbdb.SampleCollector, error) {
+				return libovsdbops.ListSampleCollectors(nbClient)
+			}).WithTimeout(10 * time.Second).Should(gomega.BeEmpty())
+		})
+	}
+}
@@ -255,6 +255,9 @@ func (nt *nodeTracker) updateNode(node *corev1.Node) {
 		ip := net.ParseIP(ipStr)
 		hostAddressesIPs = append(hostAddressesIPs, ip)
 	}
+	// NodePort services of a network are also reachable on the external IPs
+	// of the network on the node
+	hostAddressesIPs = append(hostAddressesIPs, nt.netInfo.ExternalIPs()[node.Name]...)
 
 	nt.updateNodeInfo(
 		node.Name,
@@ -27,7 +27,6 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/observability"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
-	anpcontroller "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/admin_network_policy"
 	apbroutecontroller "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/apbroute"
 	egresssvc "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/egressservice"
 	svccontroller "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/controller/services"
@@ -99,9 +98,6 @@ type DefaultNetworkController struct {
 
 	// Controller used to handle egress services
 	egressSvcController *egresssvc.Controller
-	// Controller used for programming OVN for Admin Network Policy
-	anpController *anpcontroller.Controller
-
 	// Controller used to handle the admin policy based external route resources
 	apbExternalRouteController *apbroutecontroller.ExternalGatewayMasterController
 
@@ -434,19 +430,6 @@ func (oc *DefaultNetworkController) run(_ context.Context) error {
 		return err
 	}
 
-	if config.OVNKubernetesFeature.EnableAdminNetworkPolicy {
-		err := oc.newANPController()
-		if err != nil {
-			return fmt.Errorf("unable to create admin network policy controller, err: %v", err)
-		}
-		oc.wg.Add(1)
-		go func() {
-			defer oc.wg.Done()
-			// Until we have scale issues in future let's spawn only one thread
-			oc.anpController.Run(1, oc.stopChan)
-		}()
-	}
-
 	// WatchNetworkPolicy depends on WatchPods and WatchNamespaces
 	if err := WithSyncDurationMetric("network policy", oc.WatchNetworkPolicy); err != nil {
 		return err
@@ -477,25 +460,19 @@ func (oc *DefaultNetworkController) run(_ context.Context) error {
 		}
 	}
 
-	if config.OVNKubernetesFeature.EnableEgressFirewall {
-		var err error
-		// If DNSNameResolver is enabled, then initialize dnsNameResolver to ExternalEgressDNS
-		// for maintaining the address sets corresponding to the DNS names and start watching
-		// DNSNameResolver resources. Otherwise initialize dnsNameResolver to EgressDNS.
-		if config.OVNKubernetesFeature.EnableDNSNameResolver {
-			oc.dnsNameResolver, err = dnsnameresolver.NewExternalEgressDNS(oc.addressSetFactory, oc.controllerName, true,
-				oc.watchFactory.DNSNameResolverInformer().Informer(), oc.watchFactory.EgressFirewallInformer().Lister())
-		} else {
-			oc.dnsNameResolver, err = dnsnameresolver.NewEgressDNS(oc.addressSetFactory, oc.controllerName, oc.stopChan, egressFirewallDNSDefaultDuration)
-		}
-		if err != nil {
-			return err
-		}
-		err = oc.dnsNameResolver.Run()
-		if err != nil {
+	// The egress firewalls and the egress domain names of the admin network
+	// policies share the DNS name resolver, the latter only when the DNS names
+	// are not resolved through the DNSNameResolver resources, which are created
+	// for the egress firewalls.
+	if config.OVNKubernetesFeature.EnableEgressFirewall ||
+		(config.OVNKubernetesFeature.EnableAdminNetworkPolicy && !config.OVNKubernetesFeature.EnableDNSNameResolver) {
+		if err := oc.initDNSNameResolver(); err != nil {
 			return err
 		}
-		err = WithSyncDurationMetric("egress firewall", oc.WatchEgressFirewall)
+	}
+
+	if config.OVNKubernetesFeature.EnableEgressFirewall {
+		err := WithSyncDurationMetric("egress firewall", oc.WatchEgressFirewall)
 		if err != nil {
 			return err
 		}
@@ -506,6 +483,25 @@ func (oc *DefaultNetworkController) run(_ context.Context) error {
 		}
 	}
 
+	// The admin network policy controller is started after the egress firewall
+	// sync, which deletes the DNS address sets not referenced by any ACL yet
+	if config.OVNKubernetesFeature.EnableAdminNetworkPolicy {
+		var dnsNameResolver dnsnameresolver.DNSNameResolver
+		if !config.OVNKubernetesFeature.EnableDNSNameResolver {
+			dnsNameResolver = oc.dnsNameResolver
+		}
+		err := oc.newANPController(dnsNameResolver)
+		if err != nil {
+			return fmt.Errorf("unable to create admin network policy controller, err: %v", err)
+		}
+		oc.wg.Add(1)
+		go func() {
+			defer oc.wg.Done()
+			// Until we have scale issues in future let's spawn only one thread
+			oc.anpController.Run(1, oc.stopChan)
+		}()
+	}
+
 	if config.OVNKubernetesFeature.EnableEgressQoS {
 		err := oc.initEgressQoSController(
 			oc.watchFactory.EgressQoSInformer(),
@@ -585,6 +581,39 @@ func (oc *DefaultNetworkController) run(_ context.Context) error {
 	return nil
 }
 
+// initDNSNameResolver initializes and runs the DNS name resolver maintaining the
+// address sets of the DNS names used by the egress firewalls and the admin
+// network policies.
+func (oc *DefaultNetworkController) initDNSNameResolver() error {
+	var err error
+	// If DNSNameResolver is enabled, then initialize dnsNameResolver to ExternalEgressDNS
+	// for maintaining the address sets corresponding to the DNS names and start watching
+	// DNSNameResolver resources. If DNS snooping is enabled, then initialize dnsNameResolver
+	// to SnoopingEgressDNS for maintaining the address sets from the DNS responses sent to
+	// the local pods. Otherwise initialize dnsNameResolver to EgressDNS.
+	if config.OVNKubernetesFeature.EnableDNSNameResolver {
+		oc.dnsNameResolver, err = dnsnameresolver.NewExternalEgressDNS(oc.addressSetFactory, oc.controllerName, true,
+			oc.watchFactory.DNSNameResolverInformer().Informer(), oc.watchFactory.EgressFirewallInformer().Lister())
+	} else if config.OVNKubernetesFeature.EnableDNSSnooping {
+		oc.dnsNameResolver, err = dnsnameresolver.NewSnoopingEgressDNS(oc.addressSetFactory, oc.controllerName, true,
+			oc.watchFactory.ServiceCoreInformer().Lister(), config.OVNKubernetesFeature.DNSSnoopingServices)
+	} else {
+		oc.dnsNameResolver, err = dnsnameresolver.NewEgressDNS(oc.addressSetFactory, oc.controllerName, oc.stopChan, egressFirewallDNSDefaultDuration)
+	}
+	if err != nil {
+		return err
+	}
+	err = oc.dnsNameResolver.Run()
+	if err != nil {
+		return err
+	}
+	if !config.OVNKubernetesFeature.EnableEgressFirewall {
+		// the egress firewall sync deletes the stale address sets otherwise
+		return oc.dnsNameResolver.DeleteStaleAddrSets(oc.nbClient)
+	}
+	return nil
+}
+
 func (oc *DefaultNetworkController) Reconcile(netInfo util.NetInfo) error {
 	return oc.BaseNetworkController.reconcile(
 		netInfo,
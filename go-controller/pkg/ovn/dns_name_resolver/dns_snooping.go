@@ -0,0 +1,355 @@
+package dnsnameresolver
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	libovsdbclient "github.com/ovn-org/libovsdb/client"
+
+	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	// dnsSnoopingAddressGracePeriod is added to the TTL of the snooped
+	// addresses, so that the connections opened right before the expiry of a
+	// DNS record are not interrupted.
+	dnsSnoopingAddressGracePeriod = 5 * time.Minute
+	// dnsSnoopingExpiryInterval is how often the expired addresses are removed
+	// from the address sets.
+	dnsSnoopingExpiryInterval = 10 * time.Second
+)
+
+// dnsResponseCapture reads the DNS responses sent to the local pods.
+type dnsResponseCapture interface {
+	// read returns the source IP and the DNS payload of the next response.
+	read() (net.IP, []byte, error)
+	close() error
+}
+
+// SnoopingEgressDNS keeps track of DNS names and the corresponding IP addresses.
+// For each DNS name, an address set is allocated and the address set is
+// kept updated with the IP addresses found in the DNS responses that the
+// trusted DNS services send to the local pods. Wildcard DNS names match the
+// names with exactly one more label. The addresses are removed from the address
+// set when their TTL expires, and the address set is destroyed whenever the DNS
+// name is no longer used in any namespace.
+type SnoopingEgressDNS struct {
+	dnsTracker dnsTracker
+	// namesLock protects names.
+	namesLock sync.Mutex
+	// names maps the DNS names used in the EgressFirewall rules to the
+	// snooped IP addresses and their expiry.
+	names map[string]map[string]time.Time
+	// services are the namespaced names of the trusted DNS services.
+	services      []string
+	serviceLister corev1listers.ServiceLister
+	capture       dnsResponseCapture
+	stopChan      chan struct{}
+	wg            sync.WaitGroup
+	// now is meant to be overridden in unit tests
+	now func() time.Time
+}
+
+var _ DNSNameResolver = &SnoopingEgressDNS{}
+
+// NewSnoopingEgressDNS initializes and returns a new SnoopingEgressDNS instance.
+// services is the comma-separated list of the namespaced names of the trusted
+// DNS services.
+func NewSnoopingEgressDNS(
+	addressSetFactory addressset.AddressSetFactory,
+	controllerName string,
+	ignoreClusterSubnet bool,
+	serviceLister corev1listers.ServiceLister,
+	services string,
+) (*SnoopingEgressDNS, error) {
+	if addressSetFactory == nil {
+		return nil, fmt.Errorf("error creating SnoopingEgressDNS, addressSetFactory is nil")
+	}
+	snoopEgDNS := &SnoopingEgressDNS{
+		dnsTracker:    newDNSTracker(addressSetFactory, controllerName, ignoreClusterSubnet),
+		names:         make(map[string]map[string]time.Time),
+		serviceLister: serviceLister,
+		stopChan:      make(chan struct{}),
+		now:           time.Now,
+	}
+	snoopEgDNS.dnsTracker.deleteUnused = true
+	for _, service := range strings.Split(services, ",") {
+		service = strings.TrimSpace(service)
+		if service == "" {
+			continue
+		}
+		if _, _, err := cache.SplitMetaNamespaceKey(service); err != nil {
+			return nil, fmt.Errorf("error creating SnoopingEgressDNS, invalid DNS service %q: %v", service, err)
+		}
+		snoopEgDNS.services = append(snoopEgDNS.services, service)
+	}
+	return snoopEgDNS, nil
+}
+
+// Add adds the namespace to the set of namespaces where the DNS name is used in the
+// EgressFirewall rules. It also returns the address set corresponding to the DNS name.
+// The address set is empty until a response for the DNS name is snooped.
+func (snoopEgDNS *SnoopingEgressDNS) Add(namespace, dnsName string) (addressset.AddressSet, error) {
+	snoopEgDNS.namesLock.Lock()
+	defer snoopEgDNS.namesLock.Unlock()
+
+	addressSet, err := snoopEgDNS.dnsTracker.addNamespace(namespace, dnsName)
+	if err != nil {
+		return nil, err
+	}
+	if _, exists := snoopEgDNS.names[dnsName]; !exists {
+		snoopEgDNS.names[dnsName] = make(map[string]time.Time)
+	}
+	return addressSet, nil
+}
+
+// Delete removes the namespace from the set of namespaces where the DNS name is used in
+// the EgressFirewall rules, and forgets the DNS names which are no longer used.
+func (snoopEgDNS *SnoopingEgressDNS) Delete(namespace string) error {
+	snoopEgDNS.namesLock.Lock()
+	defer snoopEgDNS.namesLock.Unlock()
+
+	if err := snoopEgDNS.dnsTracker.deleteNamespace(namespace); err != nil {
+		return err
+	}
+	for dnsName := range snoopEgDNS.names {
+		if !snoopEgDNS.dnsTracker.hasDNSName(dnsName) {
+			delete(snoopEgDNS.names, dnsName)
+		}
+	}
+	return nil
+}
+
+// Run starts snooping the DNS responses sent to the local pods.
+func (snoopEgDNS *SnoopingEgressDNS) Run() error {
+	capture, err := newDNSResponseCapture()
+	if err != nil {
+		return fmt.Errorf("failed to start snooping DNS responses: %w", err)
+	}
+	snoopEgDNS.capture = capture
+
+	klog.Infof("Starting snooping DNS responses of services %v", snoopEgDNS.services)
+	snoopEgDNS.wg.Add(2)
+	go func() {
+		defer snoopEgDNS.wg.Done()
+		for {
+			src, payload, err := capture.read()
+			if err != nil {
+				select {
+				case <-snoopEgDNS.stopChan:
+					return
+				default:
+				}
+				if errors.Is(err, net.ErrClosed) {
+					return
+				}
+				klog.Errorf("Failed to read DNS response: %v", err)
+				continue
+			}
+			snoopEgDNS.handleResponse(src, payload)
+		}
+	}()
+	go func() {
+		defer snoopEgDNS.wg.Done()
+		ticker := time.NewTicker(dnsSnoopingExpiryInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-snoopEgDNS.stopChan:
+				return
+			case <-ticker.C:
+				snoopEgDNS.removeExpiredAddresses()
+			}
+		}
+	}()
+	return nil
+}
+
+// Shutdown stops snooping the DNS responses.
+func (snoopEgDNS *SnoopingEgressDNS) Shutdown() {
+	close(snoopEgDNS.stopChan)
+	if snoopEgDNS.capture != nil {
+		if err := snoopEgDNS.capture.close(); err != nil {
+			klog.Warningf("Failed to stop snooping DNS responses: %v", err)
+		}
+	}
+	snoopEgDNS.wg.Wait()
+}
+
+// DeleteStaleAddrSets deletes all the address sets related to EgressFirewall DNS rules which are not
+// referenced by any acl.
+func (snoopEgDNS *SnoopingEgressDNS) DeleteStaleAddrSets(nbClient libovsdbclient.Client) error {
+	return snoopEgDNS.dnsTracker.deleteStaleAddressSets(nbClient)
+}
+
+// handleResponse adds the addresses of the DNS response payload, sent from src,
+// to the address sets of the matching DNS names.
+func (snoopEgDNS *SnoopingEgressDNS) handleResponse(src net.IP, payload []byte) {
+	if !snoopEgDNS.isTrustedSource(src) {
+		klog.V(5).Infof("Ignoring DNS response from untrusted source %s", src)
+		return
+	}
+	msg := &dns.Msg{}
+	if err := msg.Unpack(payload); err != nil {
+		klog.V(5).Infof("Ignoring invalid DNS response from %s: %v", src, err)
+		return
+	}
+	if !msg.Response || msg.Rcode != dns.RcodeSuccess {
+		return
+	}
+	resolved := resolvedAddresses(msg)
+	if len(resolved) == 0 {
+		return
+	}
+
+	now := snoopEgDNS.now()
+	snoopEgDNS.namesLock.Lock()
+	defer snoopEgDNS.namesLock.Unlock()
+	for dnsName, addresses := range snoopEgDNS.names {
+		changed := false
+		for name, nameAddresses := range resolved {
+			if !dnsNameMatches(dnsName, name) {
+				continue
+			}
+			for addr, ttl := range nameAddresses {
+				expiry := now.Add(ttl + dnsSnoopingAddressGracePeriod)
+				if current, exists := addresses[addr]; !exists {
+					changed = true
+				} else if !current.Before(expiry) {
+					continue
+				}
+				addresses[addr] = expiry
+			}
+		}
+		if changed {
+			snoopEgDNS.setAddresses(dnsName, addresses)
+		}
+	}
+}
+
+// removeExpiredAddresses removes the addresses whose TTL expired from the
+// address sets of the DNS names.
+func (snoopEgDNS *SnoopingEgressDNS) removeExpiredAddresses() {
+	now := snoopEgDNS.now()
+	snoopEgDNS.namesLock.Lock()
+	defer snoopEgDNS.namesLock.Unlock()
+	for dnsName, addresses := range snoopEgDNS.names {
+		changed := false
+		for addr, expiry := range addresses {
+			if now.After(expiry) {
+				delete(addresses, addr)
+				changed = true
+			}
+		}
+		if changed {
+			snoopEgDNS.setAddresses(dnsName, addresses)
+		}
+	}
+}
+
+func (snoopEgDNS *SnoopingEgressDNS) setAddresses(dnsName string, addresses map[string]time.Time) {
+	ips := make([]string, 0, len(addresses))
+	for addr := range addresses {
+		ips = append(ips, addr)
+	}
+	if err := snoopEgDNS.dnsTracker.setDNSName(dnsName, ips); err != nil {
+		klog.Errorf("Failed to update addresses of DNS name %s: %v", dnsName, err)
+	}
+}
+
+// isTrustedSource returns whether src is a cluster IP of a trusted DNS service.
+// The responses of the DNS services reach the pods with the cluster IP as
+// source, which the pods can't spoof since it is enforced by the port security.
+func (snoopEgDNS *SnoopingEgressDNS) isTrustedSource(src net.IP) bool {
+	for _, key := range snoopEgDNS.services {
+		namespace, name, _ := cache.SplitMetaNamespaceKey(key)
+		service, err := snoopEgDNS.serviceLister.Services(namespace).Get(name)
+		if err != nil {
+			continue
+		}
+		for _, clusterIP := range util.GetClusterIPs(service) {
+			if net.ParseIP(clusterIP).Equal(src) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// resolvedAddresses returns the addresses and their TTL of the question name of
+// the DNS response and of the aliases it resolves through, in lower case fully
+// qualified domain names.
+func resolvedAddresses(msg *dns.Msg) map[string]map[string]time.Duration {
+	if len(msg.Question) != 1 {
+		return nil
+	}
+	// follow the CNAME chain of the question name
+	names := []string{strings.ToLower(msg.Question[0].Name)}
+	ttl := time.Duration(-1)
+	for i := 0; i < len(names) && i <= len(msg.Answer); i++ {
+		for _, rr := range msg.Answer {
+			cname, ok := rr.(*dns.CNAME)
+			if ok && strings.EqualFold(cname.Hdr.Name, names[i]) {
+				names = append(names, strings.ToLower(cname.Target))
+				if cnameTTL := time.Duration(cname.Hdr.Ttl) * time.Second; ttl < 0 || cnameTTL < ttl {
+					ttl = cnameTTL
+				}
+				break
+			}
+		}
+	}
+
+	addresses := map[string]time.Duration{}
+	for _, rr := range msg.Answer {
+		var ip net.IP
+		switch record := rr.(type) {
+		case *dns.A:
+			ip = record.A
+		case *dns.AAAA:
+			ip = record.AAAA
+		default:
+			continue
+		}
+		if !strings.EqualFold(rr.Header().Name, names[len(names)-1]) {
+			continue
+		}
+		// the addresses of the aliases expire with the shortest TTL of the chain
+		recordTTL := time.Duration(rr.Header().Ttl) * time.Second
+		if ttl >= 0 && ttl < recordTTL {
+			recordTTL = ttl
+		}
+		addresses[ip.String()] = recordTTL
+	}
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	resolved := make(map[string]map[string]time.Duration, len(names))
+	for _, name := range names {
+		resolved[name] = addresses
+	}
+	return resolved
+}
+
+// dnsNameMatches returns whether the lower case fully qualified domain name
+// matches the DNS name of an EgressFirewall rule. A wildcard DNS name matches
+// the names with exactly one more label.
+func dnsNameMatches(dnsName, name string) bool {
+	if !util.IsWildcard(dnsName) {
+		return dnsName == name
+	}
+	suffix := dnsName[1:]
+	label, found := strings.CutSuffix(name, suffix)
+	return found && label != "" && !strings.Contains(label, ".")
+}
@@ -0,0 +1,125 @@
+//go:build linux
+// +build linux
+
+package dnsnameresolver
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/mdlayher/packet"
+	"golang.org/x/net/bpf"
+	"golang.org/x/sys/unix"
+)
+
+const dnsPort = 53
+
+// packetDNSResponseCapture reads the DNS responses that are sent out of any
+// interface of the node, which includes the responses that OVS delivers to the
+// local pods after they passed the OVN pipeline.
+type packetDNSResponseCapture struct {
+	conn *packet.Conn
+	buf  []byte
+}
+
+func newDNSResponseCapture() (dnsResponseCapture, error) {
+	filter, err := bpf.Assemble(dnsResponseFilter())
+	if err != nil {
+		return nil, fmt.Errorf("failed to assemble DNS response filter: %w", err)
+	}
+	// interface index 0 captures the packets of all the interfaces
+	conn, err := packet.Listen(&net.Interface{Index: 0}, packet.Datagram, unix.ETH_P_ALL, &packet.Config{Filter: filter})
+	if err != nil {
+		return nil, err
+	}
+	return &packetDNSResponseCapture{
+		conn: conn,
+		buf:  make([]byte, 65535),
+	}, nil
+}
+
+func (c *packetDNSResponseCapture) read() (net.IP, []byte, error) {
+	for {
+		n, _, err := c.conn.ReadFrom(c.buf)
+		if err != nil {
+			return nil, nil, err
+		}
+		src, payload := parseUDPPacket(c.buf[:n])
+		if payload != nil {
+			return src, payload, nil
+		}
+	}
+}
+
+func (c *packetDNSResponseCapture) close() error {
+	return c.conn.Close()
+}
+
+// parseUDPPacket returns the source IP and the payload of an IPv4 or IPv6 UDP
+// packet, or a nil payload if the packet can't be parsed.
+func parseUDPPacket(data []byte) (net.IP, []byte) {
+	var firstLayer gopacket.LayerType
+	switch data[0] >> 4 {
+	case 4:
+		firstLayer = layers.LayerTypeIPv4
+	case 6:
+		firstLayer = layers.LayerTypeIPv6
+	default:
+		return nil, nil
+	}
+	p := gopacket.NewPacket(data, firstLayer, gopacket.DecodeOptions{Lazy: true, NoCopy: true})
+	udp, ok := p.Layer(layers.LayerTypeUDP).(*layers.UDP)
+	if !ok {
+		return nil, nil
+	}
+	var src net.IP
+	switch ip := p.NetworkLayer().(type) {
+	case *layers.IPv4:
+		src = ip.SrcIP
+	case *layers.IPv6:
+		src = ip.SrcIP
+	}
+	return src, udp.Payload
+}
+
+// dnsResponseFilter returns the BPF filter of the outgoing DNS responses.
+func dnsResponseFilter() []bpf.Instruction {
+	return append([]bpf.Instruction{
+		bpf.LoadExtension{Num: bpf.ExtType},
+		bpf.JumpIf{Cond: bpf.JumpEqual, Val: unix.PACKET_OUTGOING, SkipTrue: 1},
+		bpf.RetConstant{Val: 0},
+	}, udpSourcePortFilter(dnsPort)...)
+}
+
+// udpSourcePortFilter returns the BPF filter of the IPv4 and IPv6 UDP packets
+// from port, starting at their network header. Non-first IPv4 fragments and
+// IPv6 packets with extension headers are dropped.
+func udpSourcePortFilter(port uint32) []bpf.Instruction {
+	const (
+		accept = 15
+		drop   = 16
+	)
+	return []bpf.Instruction{
+		/* 0 */ bpf.LoadAbsolute{Off: 0, Size: 1},
+		/* 1 */ bpf.ALUOpConstant{Op: bpf.ALUOpAnd, Val: 0xf0},
+		/* 2 */ bpf.JumpIf{Cond: bpf.JumpEqual, Val: 0x40, SkipFalse: 10 - 3},
+		// IPv4
+		/* 3 */ bpf.LoadAbsolute{Off: 9, Size: 1},
+		/* 4 */ bpf.JumpIf{Cond: bpf.JumpNotEqual, Val: unix.IPPROTO_UDP, SkipTrue: drop - 5},
+		/* 5 */ bpf.LoadAbsolute{Off: 6, Size: 2},
+		/* 6 */ bpf.JumpIf{Cond: bpf.JumpBitsSet, Val: 0x1fff, SkipTrue: drop - 7},
+		/* 7 */ bpf.LoadMemShift{Off: 0},
+		/* 8 */ bpf.LoadIndirect{Off: 0, Size: 2},
+		/* 9 */ bpf.JumpIf{Cond: bpf.JumpEqual, Val: port, SkipTrue: accept - 10, SkipFalse: drop - 10},
+		// IPv6
+		/* 10 */ bpf.JumpIf{Cond: bpf.JumpNotEqual, Val: 0x60, SkipTrue: drop - 11},
+		/* 11 */ bpf.LoadAbsolute{Off: 6, Size: 1},
+		/* 12 */ bpf.JumpIf{Cond: bpf.JumpNotEqual, Val: unix.IPPROTO_UDP, SkipTrue: drop - 13},
+		/* 13 */ bpf.LoadAbsolute{Off: 40, Size: 2},
+		/* 14 */ bpf.JumpIf{Cond: bpf.JumpNotEqual, Val: port, SkipTrue: drop - 15},
+		/* accept */ bpf.RetConstant{Val: 65535},
+		/* drop */ bpf.RetConstant{Val: 0},
+	}
+}
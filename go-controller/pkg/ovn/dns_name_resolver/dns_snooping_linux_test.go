@@ -0,0 +1,61 @@
+//go:build linux
+// +build linux
+
+package dnsnameresolver
+
+import (
+	"net"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"golang.org/x/net/bpf"
+)
+
+func newPacket(src net.IP, transport gopacket.SerializableLayer, payload []byte) []byte {
+	var network gopacket.SerializableLayer
+	var protocol layers.IPProtocol
+	switch transport.(type) {
+	case *layers.UDP:
+		protocol = layers.IPProtocolUDP
+	case *layers.TCP:
+		protocol = layers.IPProtocolTCP
+	}
+	if src.To4() != nil {
+		network = &layers.IPv4{Version: 4, TTL: 64, Protocol: protocol, SrcIP: src, DstIP: net.ParseIP("10.128.0.5")}
+	} else {
+		network = &layers.IPv6{Version: 6, HopLimit: 64, NextHeader: protocol, SrcIP: src, DstIP: net.ParseIP("fd00::5")}
+	}
+	transport.(interface {
+		SetNetworkLayerForChecksum(gopacket.NetworkLayer) error
+	}).SetNetworkLayerForChecksum(network.(gopacket.NetworkLayer))
+	buf := gopacket.NewSerializeBuffer()
+	err := gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true},
+		network, transport, gopacket.Payload(payload))
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	return buf.Bytes()
+}
+
+var _ = ginkgo.Describe("Egress Firewall Snooping DNS capture", func() {
+	ginkgo.DescribeTable("filters the UDP packets from the DNS port",
+		func(src string, transport gopacket.SerializableLayer, accepted bool) {
+			vm, err := bpf.NewVM(udpSourcePortFilter(dnsPort))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			packet := newPacket(net.ParseIP(src), transport, []byte("payload"))
+			n, err := vm.Run(packet)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(n > 0).To(gomega.Equal(accepted))
+			if accepted {
+				parsedSrc, payload := parseUDPPacket(packet)
+				gomega.Expect(parsedSrc.String()).To(gomega.Equal(src))
+				gomega.Expect(payload).To(gomega.Equal([]byte("payload")))
+			}
+		},
+		ginkgo.Entry("IPv4 UDP from port 53", "172.30.0.10", &layers.UDP{SrcPort: 53, DstPort: 40000}, true),
+		ginkgo.Entry("IPv4 UDP from another port", "172.30.0.10", &layers.UDP{SrcPort: 54, DstPort: 53}, false),
+		ginkgo.Entry("IPv4 TCP from port 53", "172.30.0.10", &layers.TCP{SrcPort: 53, DstPort: 40000}, false),
+		ginkgo.Entry("IPv6 UDP from port 53", "fd00::10", &layers.UDP{SrcPort: 53, DstPort: 40000}, true),
+		ginkgo.Entry("IPv6 UDP from another port", "fd00::10", &layers.UDP{SrcPort: 54, DstPort: 53}, false),
+	)
+})
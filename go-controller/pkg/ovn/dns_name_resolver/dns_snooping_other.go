@@ -0,0 +1,12 @@
+//go:build !linux
+// +build !linux
+
+package dnsnameresolver
+
+import (
+	"fmt"
+)
+
+func newDNSResponseCapture() (dnsResponseCapture, error) {
+	return nil, fmt.Errorf("snooping DNS responses is not supported on this platform")
+}
@@ -0,0 +1,152 @@
+package dnsnameresolver
+
+import (
+	"net"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
+)
+
+func newDNSResponse(question string, answers ...string) []byte {
+	msg := &dns.Msg{}
+	msg.SetQuestion(question, dns.TypeA)
+	msg.Response = true
+	for _, answer := range answers {
+		rr, err := dns.NewRR(answer)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		msg.Answer = append(msg.Answer, rr)
+	}
+	payload, err := msg.Pack()
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	return payload
+}
+
+func expectSnoopedAddresses(snoopEgDNS *SnoopingEgressDNS, dnsName string, expectedAddresses []string) {
+	resolvedName, exists := snoopEgDNS.dnsTracker.dnsNames[dnsName]
+	gomega.Expect(exists).To(gomega.BeTrue())
+	v4, v6 := resolvedName.dnsAddressSet.GetAddresses()
+	gomega.Expect(append(v4, v6...)).To(gomega.ConsistOf(expectedAddresses))
+}
+
+var _ = ginkgo.Describe("Egress Firewall Snooping DNS Operations", func() {
+	var (
+		snoopEgDNS            *SnoopingEgressDNS
+		fakeAddressSetFactory *addressset.FakeAddressSetFactory
+		now                   time.Time
+		_, clusterSubnet, _   = net.ParseCIDR("10.128.0.0/14")
+		dnsServiceIP          = net.ParseIP("172.30.0.10")
+	)
+
+	const (
+		dnsName   = "www.example.com."
+		namespace = "namespace1"
+	)
+
+	ginkgo.BeforeEach(func() {
+		config.OVNKubernetesFeature.EnableEgressFirewall = true
+		config.OVNKubernetesFeature.EnableDNSSnooping = true
+		config.IPv4Mode = true
+		config.IPv6Mode = true
+		config.Default.ClusterSubnets = []config.CIDRNetworkEntry{{CIDR: clusterSubnet}}
+
+		indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		err := indexer.Add(&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "kube-dns", Namespace: "kube-system"},
+			Spec:       corev1.ServiceSpec{ClusterIP: dnsServiceIP.String(), ClusterIPs: []string{dnsServiceIP.String()}},
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		fakeAddressSetFactory = addressset.NewFakeAddressSetFactory(DefaultNetworkControllerName)
+		snoopEgDNS, err = NewSnoopingEgressDNS(fakeAddressSetFactory, DefaultNetworkControllerName, true,
+			corev1listers.NewServiceLister(indexer), "kube-system/kube-dns")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		now = time.Now()
+		snoopEgDNS.now = func() time.Time { return now }
+	})
+
+	ginkgo.It("adds the addresses of the responses of the trusted DNS services", func() {
+		_, err := snoopEgDNS.Add(namespace, dnsName)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		fakeAddressSetFactory.ExpectEmptyAddressSet(GetEgressFirewallDNSAddrSetDbIDs(dnsName, DefaultNetworkControllerName))
+
+		snoopEgDNS.handleResponse(dnsServiceIP, newDNSResponse(dnsName, "www.example.com. 30 IN A 1.1.1.1",
+			"www.example.com. 30 IN AAAA 2001::1", "www.example.com. 30 IN A 10.128.0.5"))
+		expectSnoopedAddresses(snoopEgDNS, dnsName, []string{"1.1.1.1", "2001::1"})
+
+		snoopEgDNS.handleResponse(dnsServiceIP, newDNSResponse(dnsName, "www.example.com. 30 IN A 2.2.2.2"))
+		expectSnoopedAddresses(snoopEgDNS, dnsName, []string{"1.1.1.1", "2001::1", "2.2.2.2"})
+	})
+
+	ginkgo.It("ignores the responses of untrusted sources", func() {
+		_, err := snoopEgDNS.Add(namespace, dnsName)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		snoopEgDNS.handleResponse(net.ParseIP("8.8.8.8"), newDNSResponse(dnsName, "www.example.com. 30 IN A 1.1.1.1"))
+		expectSnoopedAddresses(snoopEgDNS, dnsName, []string{})
+	})
+
+	ginkgo.It("matches wildcard DNS names and CNAME chains", func() {
+		wildcardDNSName := "*.example.com."
+		_, err := snoopEgDNS.Add(namespace, wildcardDNSName)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		_, err = snoopEgDNS.Add(namespace, "cdn.example.net.")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		snoopEgDNS.handleResponse(dnsServiceIP, newDNSResponse("WWW.example.com.",
+			"WWW.example.com. 30 IN CNAME cdn.example.net.", "cdn.example.net. 30 IN A 1.1.1.1"))
+		expectSnoopedAddresses(snoopEgDNS, wildcardDNSName, []string{"1.1.1.1"})
+		expectSnoopedAddresses(snoopEgDNS, "cdn.example.net.", []string{"1.1.1.1"})
+
+		// the wildcard matches exactly one label
+		snoopEgDNS.handleResponse(dnsServiceIP, newDNSResponse("a.b.example.com.", "a.b.example.com. 30 IN A 2.2.2.2"))
+		snoopEgDNS.handleResponse(dnsServiceIP, newDNSResponse("example.com.", "example.com. 30 IN A 3.3.3.3"))
+		expectSnoopedAddresses(snoopEgDNS, wildcardDNSName, []string{"1.1.1.1"})
+	})
+
+	ginkgo.It("removes the addresses when their TTL expires", func() {
+		_, err := snoopEgDNS.Add(namespace, dnsName)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		snoopEgDNS.handleResponse(dnsServiceIP, newDNSResponse(dnsName, "www.example.com. 30 IN A 1.1.1.1"))
+		now = now.Add(time.Minute)
+		snoopEgDNS.handleResponse(dnsServiceIP, newDNSResponse(dnsName, "www.example.com. 30 IN A 2.2.2.2"))
+
+		now = now.Add(dnsSnoopingAddressGracePeriod)
+		snoopEgDNS.removeExpiredAddresses()
+		expectSnoopedAddresses(snoopEgDNS, dnsName, []string{"2.2.2.2"})
+
+		now = now.Add(time.Minute)
+		snoopEgDNS.removeExpiredAddresses()
+		expectSnoopedAddresses(snoopEgDNS, dnsName, []string{})
+	})
+
+	ginkgo.It("destroys the address set when the DNS name is no longer used", func() {
+		_, err := snoopEgDNS.Add(namespace, dnsName)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		_, err = snoopEgDNS.Add("namespace2", dnsName)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		err = snoopEgDNS.Delete(namespace)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		fakeAddressSetFactory.ExpectEmptyAddressSet(GetEgressFirewallDNSAddrSetDbIDs(dnsName, DefaultNetworkControllerName))
+
+		err = snoopEgDNS.Delete("namespace2")
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		fakeAddressSetFactory.EventuallyExpectNoAddressSet(GetEgressFirewallDNSAddrSetDbIDs(dnsName, DefaultNetworkControllerName))
+		gomega.Expect(snoopEgDNS.names).To(gomega.BeEmpty())
+
+		// responses of the names that are no longer used are ignored
+		snoopEgDNS.handleResponse(dnsServiceIP, newDNSResponse(dnsName, "www.example.com. 30 IN A 1.1.1.1"))
+		fakeAddressSetFactory.EventuallyExpectNoAddressSet(GetEgressFirewallDNSAddrSetDbIDs(dnsName, DefaultNetworkControllerName))
+	})
+})
@@ -33,6 +33,10 @@ type dnsTracker struct {
 	// ignoreClusterSubnet indicates whether to ignore IP addresses matching
 	// the cluster subnet.
 	ignoreClusterSubnet bool
+	// deleteUnused indicates whether the address set of a DNS name is deleted
+	// as soon as the DNS name is no longer used in any namespace, instead of
+	// waiting for the deletion of the DNS name.
+	deleteUnused bool
 }
 
 // dnsResolvedName contains details about a DNS resolved name.
@@ -72,25 +76,7 @@ func (dnsTracker *dnsTracker) addDNSName(dnsName string, addresses []string) err
 
 	// Update the address set of the DNS name with the IPs. The IPs which don't match the ip mode
 	// and those which match the cluster subnet, if ignoreClusterSubnet is set to true, are ignored.
-	if dnsTracker.ignoreClusterSubnet {
-		// Ignore ips from clusterSubnet, since this subnet shouldn't be affected by egress firewall.
-		filteredIPs := []string{}
-		for _, addr := range addresses {
-			ignoreIP := false
-
-			for _, clusterSubnet := range config.Default.ClusterSubnets {
-				if clusterSubnet.CIDR.Contains(net.ParseIP(addr)) {
-					ignoreIP = true
-					break
-				}
-			}
-
-			if !ignoreIP {
-				filteredIPs = append(filteredIPs, addr)
-			}
-		}
-		addresses = filteredIPs
-	}
+	addresses = dnsTracker.filterAddresses(addresses)
 
 	if err := resolvedName.dnsAddressSet.AddAddresses(addresses); err != nil {
 		return fmt.Errorf("cannot add IPs to AddressSet for DNS name %s: %v", dnsName, err)
@@ -99,6 +85,59 @@ func (dnsTracker *dnsTracker) addDNSName(dnsName string, addresses []string) err
 	return nil
 }
 
+// setDNSName is called whenever the addresses of a DNS name are needed to be
+// replaced. Unlike addDNSName, it ignores the DNS names which are not used in
+// any namespace.
+func (dnsTracker *dnsTracker) setDNSName(dnsName string, addresses []string) error {
+	dnsTracker.dnsLock.Lock()
+	defer dnsTracker.dnsLock.Unlock()
+
+	resolvedName, exists := dnsTracker.dnsNames[dnsName]
+	if !exists {
+		return nil
+	}
+
+	if err := resolvedName.dnsAddressSet.SetAddresses(dnsTracker.filterAddresses(addresses)); err != nil {
+		return fmt.Errorf("cannot set IPs of AddressSet for DNS name %s: %v", dnsName, err)
+	}
+
+	return nil
+}
+
+// hasDNSName returns whether the DNS name is tracked.
+func (dnsTracker *dnsTracker) hasDNSName(dnsName string) bool {
+	dnsTracker.dnsLock.Lock()
+	defer dnsTracker.dnsLock.Unlock()
+
+	_, exists := dnsTracker.dnsNames[dnsName]
+	return exists
+}
+
+// filterAddresses ignores the addresses matching the cluster subnet if
+// ignoreClusterSubnet is set to true, since this subnet shouldn't be affected
+// by egress firewall.
+func (dnsTracker *dnsTracker) filterAddresses(addresses []string) []string {
+	if !dnsTracker.ignoreClusterSubnet {
+		return addresses
+	}
+	filteredIPs := []string{}
+	for _, addr := range addresses {
+		ignoreIP := false
+
+		for _, clusterSubnet := range config.Default.ClusterSubnets {
+			if clusterSubnet.CIDR.Contains(net.ParseIP(addr)) {
+				ignoreIP = true
+				break
+			}
+		}
+
+		if !ignoreIP {
+			filteredIPs = append(filteredIPs, addr)
+		}
+	}
+	return filteredIPs
+}
+
 // deleteDNSName is called whenever a DNS name is needed to be deleted.
 func (dnsTracker *dnsTracker) deleteDNSName(dnsName string) error {
 	dnsTracker.dnsLock.Lock()
@@ -173,7 +212,7 @@ func (dnsTracker *dnsTracker) deleteNamespace(namespace string) error {
 		// Delete the namespace from the set of namespaces.
 		resolvedName.namespaces.Delete(namespace)
 
-		if resolvedName.namespaces.Len() == 0 && resolvedName.deleted {
+		if resolvedName.namespaces.Len() == 0 && (resolvedName.deleted || dnsTracker.deleteUnused) {
 			err := dnsTracker.deleteResolvedName(dnsName, resolvedName)
 			if err != nil {
 				return err
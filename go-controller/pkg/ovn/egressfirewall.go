@@ -420,10 +420,9 @@ func (oc *DefaultNetworkController) addEgressFirewallRules(ef *egressFirewall, p
 		} else if len(rule.to.dnsName) > 0 {
 			// rule based on DNS NAME
 			dnsName := rule.to.dnsName
-			// If DNSNameResolver is enabled, then use the egressFirewallExternalDNS to get the address
-			// set corresponding to the DNS name, otherwise use the egressFirewallDNS
-			// to get the address set.
-			if config.OVNKubernetesFeature.EnableDNSNameResolver {
+			// If DNSNameResolver or DNS snooping is enabled, then the DNS names are tracked as
+			// lower case fully qualified domain names, otherwise as they are in the rule.
+			if util.IsWildcardDNSNameSupported() {
 				// Convert the DNS name to lower case fully qualified domain name.
 				dnsName = util.LowerCaseFQDN(rule.to.dnsName)
 			}
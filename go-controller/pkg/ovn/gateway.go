@@ -440,16 +440,7 @@ func (gw *GatewayManager) GatewayInit(
 		}
 		_, isNetIPv6 := gw.netInfo.IPMode()
 		if gw.netInfo.TopologyType() == types.Layer2Topology && isNetIPv6 && config.IPv6Mode {
-			logicalRouterPort.Ipv6RaConfigs = map[string]string{
-				"address_mode":      "dhcpv6_stateful",
-				"send_periodic":     "true",
-				"max_interval":      "900", // 15 minutes
-				"min_interval":      "300", // 5 minutes
-				"router_preference": "LOW", // The static gateway configured by CNI is MEDIUM, so make this SLOW so it has less effect for pods
-			}
-			if gw.netInfo.MTU() > 0 {
-				logicalRouterPort.Ipv6RaConfigs["mtu"] = fmt.Sprintf("%d", gw.netInfo.MTU())
-			}
+			logicalRouterPort.Ipv6RaConfigs = layer2IPv6RAConfigs(gw.netInfo)
 		}
 	}
 
@@ -792,8 +783,15 @@ func (gw *GatewayManager) GatewayInit(
 	if (!config.Gateway.DisableSNATMultipleGWs || gw.netInfo.IsPrimaryNetwork()) && !gw.isRoutingAdvertised(nodeName) {
 		// Default SNAT rules. DisableSNATMultipleGWs=false in LGW (traffic egresses via mp0) always.
 		// We are not checking for gateway mode to be shared explicitly to reduce topology differences.
+		// The network external IPs of the node, if any, take precedence
+		// over the node IPs so that the traffic of the network can be told
+		// apart outside of the cluster.
+		networkExternalIPs := gw.netInfo.ExternalIPs()[nodeName]
 		for _, entry := range clusterIPSubnet {
-			externalIP, err := util.MatchIPFamily(utilnet.IsIPv6CIDR(entry), externalIPs)
+			externalIP, err := util.MatchIPFamily(utilnet.IsIPv6CIDR(entry), networkExternalIPs)
+			if err != nil {
+				externalIP, err = util.MatchIPFamily(utilnet.IsIPv6CIDR(entry), externalIPs)
+			}
 			if err != nil {
 				return fmt.Errorf("failed to create default SNAT rules for gateway router %s: %v",
 					gatewayRouter, err)
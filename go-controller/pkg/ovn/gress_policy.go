@@ -9,8 +9,11 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	knet "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog/v2"
 	utilnet "k8s.io/utils/net"
 
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
@@ -22,6 +25,9 @@ import (
 const (
 	// emptyIdx is used to create ACL for gressPolicy that doesn't have ipBlocks
 	emptyIdx = -1
+	// returnACLDirectionSuffix is appended to the policy direction of the ACLs
+	// that allow the return traffic of stateless network policies
+	returnACLDirectionSuffix = "Return"
 )
 
 type gressPolicy struct {
@@ -46,11 +52,18 @@ type gressPolicy struct {
 	// portPolicies represents all the ports to which traffic is allowed for
 	// the rule in question.
 	portPolicies []*libovsdbutil.NetworkPolicyPort
+	// returnPortPolicies are the portPolicies the return traffic is allowed from,
+	// named ports are not resolved and are left out
+	returnPortPolicies []*libovsdbutil.NetworkPolicyPort
+	// namedReturnPorts are the named ports the return traffic is not allowed from
+	namedReturnPorts []string
 
 	ipBlocks []*knet.IPBlock
 
 	// set to true for stateless network policies (stateless acls), otherwise set to false
 	isNetPolStateless bool
+	// set to true to also build the acls of the return traffic of stateless network policies
+	hasReturnACLs bool
 
 	// supported IP mode
 	ipv4Mode bool
@@ -70,6 +83,7 @@ func newGressPolicy(policyType knet.PolicyType, idx int, namespace, name, contro
 		peerV6AddressSets: &sync.Map{},
 		portPolicies:      make([]*libovsdbutil.NetworkPolicyPort, 0),
 		isNetPolStateless: isNetPolStateless,
+		hasReturnACLs:     isNetPolStateless && config.OVNKubernetesFeature.EnableStatelessNetPolReturnACLs,
 		ipv4Mode:          ipv4Mode,
 		ipv6Mode:          ipv6Mode,
 	}
@@ -115,6 +129,18 @@ func (gp *gressPolicy) addPortPolicy(portJSON *knet.NetworkPolicyPort) {
 		pp = libovsdbutil.GetNetworkPolicyPort(protocol, 0, 0)
 	}
 	gp.portPolicies = append(gp.portPolicies, pp)
+	if !gp.hasReturnACLs {
+		return
+	}
+	if portJSON.Port != nil && portJSON.Port.Type == intstr.String {
+		// named ports are not resolved, allowing the return traffic from them would
+		// allow it from all the ports of their protocol
+		klog.Warningf("Stateless network policy %s/%s %s rule %d: the return traffic from named port %q is not allowed",
+			gp.policyNamespace, gp.policyName, strings.ToLower(string(gp.policyType)), gp.idx, portJSON.Port.StrVal)
+		gp.namedReturnPorts = append(gp.namedReturnPorts, portJSON.Port.StrVal)
+		return
+	}
+	gp.returnPortPolicies = append(gp.returnPortPolicies, pp)
 }
 
 func (gp *gressPolicy) addIPBlock(ipblockJSON *knet.IPBlock) {
@@ -123,18 +149,14 @@ func (gp *gressPolicy) addIPBlock(ipblockJSON *knet.IPBlock) {
 
 // getL3MatchFromAddressSet may return empty string, which means that there are no address sets selected for giver
 // gressPolicy at the time, and acl should not be created.
-func (gp *gressPolicy) getL3MatchFromAddressSet() string {
+// direction is the L3 field of the peers, "src" or "dst".
+func (gp *gressPolicy) getL3MatchFromAddressSet(direction string) string {
 	v4AddressSets := syncMapToSortedList(gp.peerV4AddressSets)
 	v6AddressSets := syncMapToSortedList(gp.peerV6AddressSets)
 
 	// We sort address slice,
 	// Hence we'll be constructing the sorted address set string here
-	var direction, v4Match, v6Match, match string
-	if gp.policyType == knet.PolicyTypeIngress {
-		direction = "src"
-	} else {
-		direction = "dst"
-	}
+	var v4Match, v6Match, match string
 
 	//  At this point there will be address sets in one or both of them.
 	//  Contents in both address sets mean dual stack, else one will be empty because we will only populate
@@ -167,13 +189,9 @@ func (gp *gressPolicy) allIPsMatch() string {
 	}
 }
 
-func (gp *gressPolicy) getMatchFromIPBlock(lportMatch, l4Match string) []string {
-	var direction string
-	if gp.policyType == knet.PolicyTypeIngress {
-		direction = "src"
-	} else {
-		direction = "dst"
-	}
+// getMatchFromIPBlock returns the matches of the ipBlocks, direction is the L3 field
+// of the peers, "src" or "dst".
+func (gp *gressPolicy) getMatchFromIPBlock(direction, lportMatch, l4Match string) []string {
 	var matchStrings []string
 	var matchStr, ipVersion string
 	for _, ipBlock := range gp.ipBlocks {
@@ -265,24 +283,59 @@ func (gp *gressPolicy) isEmpty() bool {
 // since creation, or are safe for concurrent use like peerVXAddressSets
 func (gp *gressPolicy) buildLocalPodACLs(portGroupName string, aclLogging *libovsdbutil.ACLLoggingLevels) (createdACLs []*nbdb.ACL,
 	skippedACLs []*nbdb.ACL) {
-	var lportMatch string
-	if gp.policyType == knet.PolicyTypeIngress {
+	createdACLs, skippedACLs = gp.buildGressACLs(portGroupName, aclLogging, false)
+	if gp.hasReturnACLs {
+		// stateless acls don't allow the return traffic, allow it with the mirrored acls
+		returnCreatedACLs, returnSkippedACLs := gp.buildGressACLs(portGroupName, aclLogging, true)
+		createdACLs = append(createdACLs, returnCreatedACLs...)
+		skippedACLs = append(skippedACLs, returnSkippedACLs...)
+	}
+	return
+}
+
+// buildGressACLs builds the ACLs of the gress policy's rules, or of their return
+// traffic when returnTraffic is set. The return ACLs swap the peers and the local
+// pods, and match the ports of the rules as source ports, in the opposite pipeline.
+func (gp *gressPolicy) buildGressACLs(portGroupName string, aclLogging *libovsdbutil.ACLLoggingLevels,
+	returnTraffic bool) (createdACLs []*nbdb.ACL, skippedACLs []*nbdb.ACL) {
+	// traffic to the local pods is ingress traffic, or the return traffic of egress traffic
+	toLocalPods := (gp.policyType == knet.PolicyTypeIngress) != returnTraffic
+	var lportMatch, peerDirection string
+	if toLocalPods {
 		lportMatch = fmt.Sprintf("outport == @%s", portGroupName)
+		peerDirection = "src"
 	} else {
 		lportMatch = fmt.Sprintf("inport == @%s", portGroupName)
+		peerDirection = "dst"
 	}
 	action := nbdb.ACLActionAllowRelated
 	if gp.isNetPolStateless {
 		action = nbdb.ACLActionAllowStateless
 	}
-	for protocol, l4Match := range libovsdbutil.GetL4MatchesFromNetworkPolicyPorts(gp.portPolicies) {
+	aclPipeline := gp.aclPipeline
+	getACLDbIDs := gp.getNetpolACLDbIDs
+	l4Matches := libovsdbutil.GetL4MatchesFromNetworkPolicyPorts(gp.portPolicies)
+	if returnTraffic {
+		if toLocalPods {
+			aclPipeline = libovsdbutil.PolicyTypeToAclPipeline(knet.PolicyTypeIngress)
+		} else {
+			aclPipeline = libovsdbutil.PolicyTypeToAclPipeline(knet.PolicyTypeEgress)
+		}
+		getACLDbIDs = gp.getNetpolReturnACLDbIDs
+		if len(gp.returnPortPolicies) == 0 && len(gp.namedReturnPorts) > 0 {
+			// only named ports, no return traffic is allowed
+			return nil, nil
+		}
+		l4Matches = libovsdbutil.GetReturnL4MatchesFromNetworkPolicyPorts(gp.returnPortPolicies)
+	}
+	for protocol, l4Match := range l4Matches {
 		if len(gp.ipBlocks) > 0 {
 			// Add ACL allow rule for IPBlock CIDR
-			ipBlockMatches := gp.getMatchFromIPBlock(lportMatch, l4Match)
+			ipBlockMatches := gp.getMatchFromIPBlock(peerDirection, lportMatch, l4Match)
 			for ipBlockIdx, ipBlockMatch := range ipBlockMatches {
-				aclIDs := gp.getNetpolACLDbIDs(ipBlockIdx, protocol)
+				aclIDs := getACLDbIDs(ipBlockIdx, protocol)
 				acl := libovsdbutil.BuildACL(aclIDs, types.DefaultAllowPriority, ipBlockMatch, action,
-					aclLogging, gp.aclPipeline)
+					aclLogging, aclPipeline)
 				createdACLs = append(createdACLs, acl)
 			}
 		}
@@ -293,7 +346,7 @@ func (gp *gressPolicy) buildLocalPodACLs(portGroupName string, aclLogging *libov
 			if gp.isEmpty() {
 				l3Match = gp.allIPsMatch()
 			} else {
-				l3Match = gp.getL3MatchFromAddressSet()
+				l3Match = gp.getL3MatchFromAddressSet(peerDirection)
 			}
 
 			if l4Match == libovsdbutil.UnspecifiedL4Match {
@@ -301,9 +354,9 @@ func (gp *gressPolicy) buildLocalPodACLs(portGroupName string, aclLogging *libov
 			} else {
 				addrSetMatch = fmt.Sprintf("%s && %s && %s", l3Match, l4Match, lportMatch)
 			}
-			aclIDs := gp.getNetpolACLDbIDs(emptyIdx, protocol)
+			aclIDs := getACLDbIDs(emptyIdx, protocol)
 			acl := libovsdbutil.BuildACL(aclIDs, types.DefaultAllowPriority, addrSetMatch, action,
-				aclLogging, gp.aclPipeline)
+				aclLogging, aclPipeline)
 			if l3Match == "" {
 				// if l3Match is empty, then no address sets are selected for a given gressPolicy.
 				// fortunately l3 match is not a part of externalIDs, that means that we can find
@@ -339,3 +392,12 @@ func (gp *gressPolicy) getNetpolACLDbIDs(ipBlockIdx int, protocol string) *libov
 			libovsdbops.PortPolicyProtocolKey: protocol,
 		})
 }
+
+// getNetpolReturnACLDbIDs returns the ids of the ACLs that allow the return traffic of
+// stateless network policies. They only differ from the gress policy ACL ids by their
+// policy direction, e.g. IngressReturn.
+func (gp *gressPolicy) getNetpolReturnACLDbIDs(ipBlockIdx int, protocol string) *libovsdbops.DbObjectIDs {
+	return gp.getNetpolACLDbIDs(ipBlockIdx, protocol).AddIDs(map[libovsdbops.ExternalIDKey]string{
+		libovsdbops.PolicyDirectionKey: string(gp.policyType) + returnACLDirectionSuffix,
+	})
+}
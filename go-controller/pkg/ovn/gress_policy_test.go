@@ -6,7 +6,11 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	knet "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
@@ -118,7 +122,59 @@ func TestGetMatchFromIPBlock(t *testing.T) {
 		for _, ipBlock := range tc.ipBlocks {
 			gressPolicy.addIPBlock(ipBlock)
 		}
-		output := gressPolicy.getMatchFromIPBlock(tc.lportMatch, tc.l4Match)
+		output := gressPolicy.getMatchFromIPBlock("src", tc.lportMatch, tc.l4Match)
 		assert.Equal(t, tc.expected, output)
 	}
 }
+
+func TestBuildReturnACLsNamedPorts(t *testing.T) {
+	config.IPv4Mode = true
+	config.OVNKubernetesFeature.EnableStatelessNetPolReturnACLs = true
+	defer func() {
+		config.IPv4Mode = false
+		config.OVNKubernetesFeature.EnableStatelessNetPolReturnACLs = false
+	}()
+	namedPort := intstr.FromString("http")
+	port := intstr.FromInt32(8080)
+	testcases := []struct {
+		desc     string
+		ports    []knet.NetworkPolicyPort
+		expected []string
+	}{
+		{
+			desc:     "port number",
+			ports:    []knet.NetworkPolicyPort{{Port: &port}},
+			expected: []string{"ip4 && tcp && tcp.src==8080 && inport == @pg"},
+		},
+		{
+			desc:     "named port only",
+			ports:    []knet.NetworkPolicyPort{{Port: &namedPort}},
+			expected: nil,
+		},
+		{
+			desc:     "named port and port number",
+			ports:    []knet.NetworkPolicyPort{{Port: &namedPort}, {Port: &port}},
+			expected: []string{"ip4 && tcp && tcp.src==8080 && inport == @pg"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.desc, func(t *testing.T) {
+			gressPolicy := newGressPolicy(knet.PolicyTypeIngress, 0, "testing", "test",
+				DefaultNetworkControllerName, true, &util.DefaultNetInfo{})
+			for _, policyPort := range tc.ports {
+				gressPolicy.addPortPolicy(&policyPort)
+			}
+			createdACLs, _ := gressPolicy.buildLocalPodACLs("pg", &libovsdbutil.ACLLoggingLevels{})
+			var returnMatches []string
+			for _, acl := range createdACLs {
+				if acl.ExternalIDs[libovsdbops.PolicyDirectionKey.String()] == string(knet.PolicyTypeIngress)+returnACLDirectionSuffix {
+					returnMatches = append(returnMatches, acl.Match)
+				}
+			}
+			assert.Equal(t, tc.expected, returnMatches)
+			// the named ports still allow all the ports of their protocol to the local pods
+			assert.Len(t, createdACLs, 1+len(tc.expected))
+		})
+	}
+}
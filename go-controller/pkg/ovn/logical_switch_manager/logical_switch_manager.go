@@ -44,23 +44,39 @@ func NewL2SwitchManager() *LogicalSwitchManager {
 // switch manager for L2 primary networks.
 // A user defined primary network auto-reserves the .1 and .2 IP addresses,
 // which are required for egressing the cluster over this user defined network.
+// Pods get a single IP per IP family, even if subnets of the same family were
+// appended to the network.
 func NewL2SwitchManagerForUserDefinedPrimaryNetwork() *LogicalSwitchManager {
-	return NewLogicalSwitchManager()
+	return &LogicalSwitchManager{
+		allocator:  subnet.NewPerFamilyAllocator(),
+		reserveIPs: true,
+	}
 }
 
 // AddOrUpdateSwitch adds/updates a switch to the logical switch manager for subnet
 // and IPAM management.
 func (manager *LogicalSwitchManager) AddOrUpdateSwitch(switchName string, hostSubnets []*net.IPNet, excludeSubnets ...*net.IPNet) error {
-	if manager.reserveIPs {
-		for _, hostSubnet := range hostSubnets {
-			for _, ip := range []*net.IPNet{util.GetNodeGatewayIfAddr(hostSubnet), util.GetNodeManagementIfAddr(hostSubnet)} {
-				excludeSubnets = append(excludeSubnets,
-					&net.IPNet{IP: ip.IP, Mask: util.GetIPFullMask(ip.IP)},
-				)
-			}
+	return manager.allocator.AddOrUpdateSubnet(switchName, hostSubnets, manager.getExcludeSubnets(hostSubnets, excludeSubnets)...)
+}
+
+// AppendSwitchSubnets adds host subnets to a switch of the logical switch
+// manager, keeping the IPs already allocated from its other subnets.
+func (manager *LogicalSwitchManager) AppendSwitchSubnets(switchName string, hostSubnets []*net.IPNet, excludeSubnets ...*net.IPNet) error {
+	return manager.allocator.AppendSubnets(switchName, hostSubnets, manager.getExcludeSubnets(hostSubnets, excludeSubnets)...)
+}
+
+func (manager *LogicalSwitchManager) getExcludeSubnets(hostSubnets, excludeSubnets []*net.IPNet) []*net.IPNet {
+	if !manager.reserveIPs {
+		return excludeSubnets
+	}
+	for _, hostSubnet := range hostSubnets {
+		for _, ip := range []*net.IPNet{util.GetNodeGatewayIfAddr(hostSubnet), util.GetNodeManagementIfAddr(hostSubnet)} {
+			excludeSubnets = append(excludeSubnets,
+				&net.IPNet{IP: ip.IP, Mask: util.GetIPFullMask(ip.IP)},
+			)
 		}
 	}
-	return manager.allocator.AddOrUpdateSubnet(switchName, hostSubnets, excludeSubnets...)
+	return excludeSubnets
 }
 
 // AddNoHostSubnetSwitch adds/updates a switch without any host subnets
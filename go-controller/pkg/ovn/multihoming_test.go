@@ -16,6 +16,8 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kubevirt"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
 	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
@@ -167,6 +169,16 @@ func (em *secondaryNetworkExpectationMachine) expectedLogicalSwitchesAndPortsWit
 				if ocInfo.bnc.isLayer2Interconnect() {
 					lsp.Options["requested-tnl-key"] = "1" // hardcode this for now.
 				}
+				if ocInfo.bnc.TopologyType() == ovntypes.Layer2Topology && ocInfo.bnc.ServesDHCP() {
+					var router string
+					if ocInfo.bnc.isLayer2Interconnect() {
+						// on IC, the pod annotations set by the tests feature the network gateway
+						router = util.GetNodeGatewayIfAddr(subnet).IP.String()
+					}
+					dhcpOptions := newExpectedPodDHCPv4Options(ocInfo.bnc.controllerName, pod, portInfo, router, ocInfo.bnc.MTU())
+					lsp.Dhcpv4Options = &dhcpOptions.UUID
+					data = append(data, dhcpOptions)
+				}
 				data = append(data, lsp)
 
 				switch ocInfo.bnc.TopologyType() {
@@ -339,6 +351,34 @@ func newExpectedSwitchPort(lspUUID string, portName string, podAddr string, pod
 	}
 }
 
+func newExpectedPodDHCPv4Options(controllerName string, pod testPod, portInfo portInfo, router string, mtu int) *nbdb.DHCPOptions {
+	podIP := testing.MustParseIPNet(fmt.Sprintf("%s/%d", portInfo.podIP, portInfo.prefixLen))
+	cidr := (&net.IPNet{IP: podIP.IP.Mask(podIP.Mask), Mask: podIP.Mask}).String()
+	dbIDs := libovsdbops.NewDbObjectIDs(libovsdbops.PodDHCPOptions, controllerName,
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey: pod.namespace + "/" + pod.podName,
+			libovsdbops.CIDRKey:       cidr,
+		})
+	externalIDs := dbIDs.GetExternalIDs()
+	externalIDs[kubevirt.OvnZoneExternalIDKey] = kubevirt.OvnLocalZone
+	dhcpOptions := &nbdb.DHCPOptions{
+		UUID: portInfo.portName + "-dhcpv4-UUID",
+		Cidr: cidr,
+		Options: map[string]string{
+			"lease_time": "3500",
+			"server_id":  kubevirt.ARPProxyIPv4,
+			"server_mac": kubevirt.ARPProxyMAC,
+			"hostname":   fmt.Sprintf("%q", pod.podName),
+			"mtu":        fmt.Sprintf("%d", mtu),
+		},
+		ExternalIDs: externalIDs,
+	}
+	if router != "" {
+		dhcpOptions.Options["router"] = router
+	}
+	return dhcpOptions
+}
+
 func newExpectedSwitchToRouterPort(lspUUID string, portName string, pod testPod, netInfo util.NetInfo, nad string) *nbdb.LogicalSwitchPort {
 	lrp := newExpectedSwitchPort(lspUUID, portName, "router", pod, netInfo, nad)
 	lrp.ExternalIDs = nil
@@ -0,0 +1,140 @@
+package ovn
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/ovn-org/libovsdb/ovsdb"
+
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+)
+
+func getNamespaceIsolationACLDbIDs(namespace, controller string) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.ACLNamespaceIsolation, controller,
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey: namespace,
+		})
+}
+
+// syncNamespaceIsolation drops the traffic sent to the pods of every namespace
+// of a network isolating its namespaces by the pods of the other namespaces
+// of the network:
+// action match                                                          priority tier
+// ------ -------------------------------------------------------------- -------- ----
+// drop   "outport == @<NS_PG> && ip4.src == {$<OTHER_NS_AS>, ...}"     1000     3
+//
+// The ACLs belong to the baseline tier so that the traffic between namespaces
+// can still be allowed with (multi) network policies or baseline admin network
+// policies. Stale ACLs of namespaces no longer attached to the network are
+// removed.
+func (bnc *BaseNetworkController) syncNamespaceIsolation() error {
+	var namespaces []string
+	if bnc.IsolatesNamespaces() {
+		namespaces = bnc.GetNADNamespaces()
+		slices.Sort(namespaces)
+	}
+
+	// the address sets of all the namespaces are needed up front to build
+	// the match of every namespace
+	v4AddressSets := make(map[string]string, len(namespaces))
+	v6AddressSets := make(map[string]string, len(namespaces))
+	for _, namespace := range namespaces {
+		as, err := bnc.addressSetFactory.EnsureAddressSet(getNamespaceAddrSetDbIDs(namespace, bnc.controllerName))
+		if err != nil {
+			return fmt.Errorf("failed to ensure address set for namespace %s: %w", namespace, err)
+		}
+		v4AddressSets[namespace], v6AddressSets[namespace] = as.GetASHashNames()
+	}
+
+	var ops []ovsdb.Operation
+	var err error
+	expectedNamespaces := sets.New[string]()
+	for _, namespace := range namespaces {
+		match := getNamespaceIsolationMatch(namespace, namespaces, v4AddressSets, v6AddressSets)
+		if match == "" {
+			continue
+		}
+		pgName, err := bnc.createNamespacePortGroup(namespace)
+		if err != nil {
+			return fmt.Errorf("failed to create port group for namespace %s: %w", namespace, err)
+		}
+		acl := libovsdbutil.BuildACL(
+			getNamespaceIsolationACLDbIDs(namespace, bnc.controllerName),
+			types.NamespaceIsolationDenyPriority,
+			libovsdbutil.GetACLMatch(pgName, match, libovsdbutil.ACLIngress),
+			nbdb.ACLActionDrop,
+			nil,
+			libovsdbutil.LportIngress)
+		acl.Tier = types.DefaultBANPACLTier
+		ops, err = libovsdbops.CreateOrUpdateACLsOps(bnc.nbClient, ops, bnc.GetSamplingConfig(), acl)
+		if err != nil {
+			return fmt.Errorf("failed to create or update namespace isolation ACL for namespace %s: %w", namespace, err)
+		}
+		ops, err = libovsdbops.AddACLsToPortGroupOps(bnc.nbClient, ops, pgName, acl)
+		if err != nil {
+			return fmt.Errorf("failed to add namespace isolation ACL to port group %s: %w", pgName, err)
+		}
+		expectedNamespaces.Insert(namespace)
+	}
+
+	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.ACLNamespaceIsolation, bnc.controllerName, nil)
+	p := libovsdbops.GetPredicate[*nbdb.ACL](predicateIDs, func(item *nbdb.ACL) bool {
+		return !expectedNamespaces.Has(item.ExternalIDs[libovsdbops.ObjectNameKey.String()])
+	})
+	staleACLs, err := libovsdbops.FindACLsWithPredicate(bnc.nbClient, p)
+	if err != nil {
+		return fmt.Errorf("failed to find stale namespace isolation ACLs: %w", err)
+	}
+	for _, acl := range staleACLs {
+		pgName := bnc.getNamespacePortGroupName(acl.ExternalIDs[libovsdbops.ObjectNameKey.String()])
+		ops, err = libovsdbops.DeleteACLsFromPortGroupOps(bnc.nbClient, ops, pgName, acl)
+		if err != nil {
+			return fmt.Errorf("failed to remove stale namespace isolation ACL from port group %s: %w", pgName, err)
+		}
+	}
+
+	if _, err = libovsdbops.TransactAndCheck(bnc.nbClient, ops); err != nil {
+		return fmt.Errorf("failed to configure namespace isolation ACLs for network %s: %w", bnc.GetNetworkName(), err)
+	}
+	return nil
+}
+
+// getNamespaceIsolationMatch returns the match of the traffic sourced from the
+// pods of the namespaces other than the provided one, or an empty string if
+// there are no other namespaces.
+func getNamespaceIsolationMatch(namespace string, namespaces []string, v4AddressSets, v6AddressSets map[string]string) string {
+	var v4Sources, v6Sources []string
+	for _, other := range namespaces {
+		if other == namespace {
+			continue
+		}
+		if v4AddressSets[other] != "" {
+			v4Sources = append(v4Sources, "$"+v4AddressSets[other])
+		}
+		if v6AddressSets[other] != "" {
+			v6Sources = append(v6Sources, "$"+v6AddressSets[other])
+		}
+	}
+
+	var matches []string
+	if len(v4Sources) > 0 {
+		matches = append(matches, fmt.Sprintf("ip4.src == {%s}", strings.Join(v4Sources, ", ")))
+	}
+	if len(v6Sources) > 0 {
+		matches = append(matches, fmt.Sprintf("ip6.src == {%s}", strings.Join(v6Sources, ", ")))
+	}
+	switch len(matches) {
+	case 0:
+		return ""
+	case 1:
+		return matches[0]
+	default:
+		return "(" + strings.Join(matches, " || ") + ")"
+	}
+}
@@ -0,0 +1,117 @@
+package ovn
+
+import (
+	"testing"
+
+	cnitypes "github.com/containernetworking/cni/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
+	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+func TestGetNamespaceIsolationMatch(t *testing.T) {
+	v4AddressSets := map[string]string{"ns1": "a1", "ns2": "a2", "ns3": "a3"}
+	v6AddressSets := map[string]string{"ns1": "b1", "ns2": "b2", "ns3": "b3"}
+	tests := []struct {
+		name          string
+		namespaces    []string
+		v6AddressSets map[string]string
+		expectedMatch string
+	}{
+		{
+			name:       "single namespace",
+			namespaces: []string{"ns1"},
+		},
+		{
+			name:          "single stack",
+			namespaces:    []string{"ns1", "ns2", "ns3"},
+			expectedMatch: "ip4.src == {$a2, $a3}",
+		},
+		{
+			name:          "dual stack",
+			namespaces:    []string{"ns1", "ns2"},
+			v6AddressSets: v6AddressSets,
+			expectedMatch: "(ip4.src == {$a2} || ip6.src == {$b2})",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match := getNamespaceIsolationMatch("ns1", tt.namespaces, v4AddressSets, tt.v6AddressSets)
+			assert.Equal(t, tt.expectedMatch, match)
+		})
+	}
+}
+
+func TestSyncNamespaceIsolation(t *testing.T) {
+	util.PrepareTestConfig()
+
+	netInfo, err := util.NewNetInfo(&ovncnitypes.NetConf{
+		NetConf:           cnitypes.NetConf{Name: "isolated"},
+		Topology:          types.Layer2Topology,
+		Role:              types.NetworkRoleSecondary,
+		Subnets:           "192.168.0.0/16",
+		IsolateNamespaces: true,
+	})
+	require.NoError(t, err)
+	mutableNetInfo := util.NewMutableNetInfo(netInfo)
+	mutableNetInfo.SetNADs("ns1/isolated", "ns2/isolated")
+
+	nbClient, cleanup, err := libovsdbtest.NewNBTestHarness(libovsdbtest.TestSetup{}, nil)
+	require.NoError(t, err)
+	t.Cleanup(cleanup.Cleanup)
+
+	controllerName := getNetworkControllerName(netInfo.GetNetworkName())
+	bnc := &BaseNetworkController{
+		CommonNetworkControllerInfo: CommonNetworkControllerInfo{nbClient: nbClient},
+		controllerName:              controllerName,
+		ReconcilableNetInfo:         util.NewReconcilableNetInfo(mutableNetInfo),
+		addressSetFactory:           addressset.NewOvnAddressSetFactory(nbClient, true, false),
+	}
+
+	getACLs := func() []*nbdb.ACL {
+		predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.ACLNamespaceIsolation, controllerName, nil)
+		p := libovsdbops.GetPredicate[*nbdb.ACL](predicateIDs, nil)
+		acls, err := libovsdbops.FindACLsWithPredicate(nbClient, p)
+		require.NoError(t, err)
+		return acls
+	}
+	getPortGroupACLs := func(namespace string) []string {
+		pg, err := libovsdbops.GetPortGroup(nbClient, &nbdb.PortGroup{Name: bnc.getNamespacePortGroupName(namespace)})
+		require.NoError(t, err)
+		return pg.ACLs
+	}
+
+	require.NoError(t, bnc.syncNamespaceIsolation())
+	acls := getACLs()
+	require.Len(t, acls, 2)
+	for _, acl := range acls {
+		namespace := acl.ExternalIDs[libovsdbops.ObjectNameKey.String()]
+		other := "ns2"
+		if namespace == "ns2" {
+			other = "ns1"
+		}
+		as, err := bnc.addressSetFactory.GetAddressSet(getNamespaceAddrSetDbIDs(other, controllerName))
+		require.NoError(t, err)
+		v4AS, _ := as.GetASHashNames()
+		assert.Equal(t, "outport == @"+bnc.getNamespacePortGroupName(namespace)+" && ip4.src == {$"+v4AS+"}", acl.Match)
+		assert.Equal(t, nbdb.ACLActionDrop, acl.Action)
+		assert.Equal(t, types.NamespaceIsolationDenyPriority, acl.Priority)
+		assert.Equal(t, types.DefaultBANPACLTier, acl.Tier)
+		assert.Equal(t, []string{acl.UUID}, getPortGroupACLs(namespace))
+	}
+
+	// ns2 leaves the network, ns1 is left alone
+	mutableNetInfo = util.NewMutableNetInfo(netInfo)
+	mutableNetInfo.SetNADs("ns1/isolated")
+	require.NoError(t, util.ReconcileNetInfo(bnc.ReconcilableNetInfo, mutableNetInfo))
+	require.NoError(t, bnc.syncNamespaceIsolation())
+	assert.Empty(t, getPortGroupACLs("ns1"))
+	assert.Empty(t, getPortGroupACLs("ns2"))
+}
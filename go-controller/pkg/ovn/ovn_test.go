@@ -92,8 +92,9 @@ type FakeOVN struct {
 	portCache      *PortCache
 
 	// information map of all secondary network controllers
-	secondaryControllers       map[string]secondaryControllerInfo
-	fullSecondaryL2Controllers map[string]*SecondaryLayer2NetworkController
+	secondaryControllers             map[string]secondaryControllerInfo
+	fullSecondaryL2Controllers       map[string]*SecondaryLayer2NetworkController
+	fullSecondaryLocalnetControllers map[string]*SecondaryLocalnetNetworkController
 }
 
 // NOTE: the FakeAddressSetFactory is no longer needed and should no longer be used. starting to phase out FakeAddressSetFactory
@@ -109,8 +110,9 @@ func NewFakeOVN(useFakeAddressSet bool) *FakeOVN {
 		egressSVCWg:  &sync.WaitGroup{},
 		anpWg:        &sync.WaitGroup{},
 
-		secondaryControllers:       map[string]secondaryControllerInfo{},
-		fullSecondaryL2Controllers: map[string]*SecondaryLayer2NetworkController{},
+		secondaryControllers:             map[string]secondaryControllerInfo{},
+		fullSecondaryL2Controllers:       map[string]*SecondaryLayer2NetworkController{},
+		fullSecondaryLocalnetControllers: map[string]*SecondaryLocalnetNetworkController{},
 	}
 }
 
@@ -442,7 +444,7 @@ func NewOvnController(
 }
 
 func (o *FakeOVN) InitAndRunANPController() {
-	err := o.controller.newANPController()
+	err := o.controller.newANPController(o.controller.dnsNameResolver)
 	gomega.Expect(err).NotTo(gomega.HaveOccurred())
 	o.anpWg.Add(1)
 	go func() {
@@ -451,6 +453,20 @@ func (o *FakeOVN) InitAndRunANPController() {
 	}()
 }
 
+// InitAndRunNetworkANPController runs the admin network policy controller of the
+// secondary network controller of the provided network, which stops it on shutdown
+func (o *FakeOVN) InitAndRunNetworkANPController(netName string) {
+	ocInfo, ok := o.secondaryControllers[netName]
+	gomega.Expect(ok).To(gomega.BeTrue())
+	err := ocInfo.bnc.newANPController(nil)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	ocInfo.bnc.wg.Add(1)
+	go func() {
+		defer ocInfo.bnc.wg.Done()
+		ocInfo.bnc.anpController.Run(1, ocInfo.bnc.stopChan)
+	}()
+}
+
 func createTestNBGlobal(nbClient libovsdbclient.Client, zone string) error {
 	nbGlobal := &nbdb.NBGlobal{Name: zone}
 	ops, err := nbClient.Create(nbGlobal)
@@ -527,6 +543,7 @@ func (o *FakeOVN) NewSecondaryNetworkController(netattachdef *nettypes.NetworkAt
 			o.fakeClient.KubeClient,
 			&kube.KubeOVN{
 				Kube:                 kube.Kube{KClient: o.fakeClient.KubeClient},
+				ANPClient:            o.fakeClient.ANPClient,
 				EIPClient:            o.fakeClient.EgressIPClient,
 				EgressFirewallClient: o.fakeClient.EgressFirewallClient,
 				IPAMClaimsClient:     o.fakeClient.IPAMClaimsClient,
@@ -568,6 +585,7 @@ func (o *FakeOVN) NewSecondaryNetworkController(netattachdef *nettypes.NetworkAt
 				localnetController.addressSetFactory = asf
 			}
 			secondaryController = &localnetController.BaseSecondaryNetworkController
+			o.fullSecondaryLocalnetControllers[netName] = localnetController
 		default:
 			return fmt.Errorf("topology type %s not supported", topoType)
 		}
@@ -219,17 +219,32 @@ func getMultinetNsAddrSetHashNames(ns, controllerName string) (string, string) {
 // have `Ports` filled, but no `To`/`From`.
 func getGressACLs(gressIdx int, peers []knet.NetworkPolicyPeer, policyType knet.PolicyType,
 	params *netpolDataParams) []*nbdb.ACL {
+	acls := getGressTrafficACLs(gressIdx, peers, policyType, params, false)
+	if params.statelessNetPol && params.statelessReturnACLs {
+		acls = append(acls, getGressTrafficACLs(gressIdx, peers, policyType, params, true)...)
+	}
+	return acls
+}
+
+// getGressTrafficACLs returns the ACLs of the gress policy, or of its return traffic if returnTraffic is set.
+func getGressTrafficACLs(gressIdx int, peers []knet.NetworkPolicyPeer, policyType knet.PolicyType,
+	params *netpolDataParams, returnTraffic bool) []*nbdb.ACL {
 	namespace := params.networkPolicy.Namespace
 	fakeController := getFakeBaseController(params.netInfo)
 	pgName := fakeController.getNetworkPolicyPGName(namespace, params.networkPolicy.Name)
 	controllerName := getNetworkControllerName(params.netInfo.GetNetworkName())
 	shouldBeLogged := params.allowLogSeverity != ""
+	meter := types.OvnACLLoggingMeter
+	if params.allowLogMeter != "" {
+		meter = params.allowLogMeter
+	}
 	var options map[string]string
 	var direction string
 	var portDir string
 	var ipDir string
+	l4Dir := "dst"
 	acls := []*nbdb.ACL{}
-	if policyType == knet.PolicyTypeEgress {
+	if (policyType == knet.PolicyTypeEgress) != returnTraffic {
 		options = map[string]string{
 			"apply-after-lb": "true",
 		}
@@ -270,21 +285,26 @@ func getGressACLs(gressIdx int, peers []knet.NetworkPolicyPeer, policyType knet.
 		idx:             gressIdx,
 		controllerName:  controllerName,
 	}
+	getACLDbIDs := gp.getNetpolACLDbIDs
+	if returnTraffic {
+		getACLDbIDs = gp.getNetpolReturnACLDbIDs
+		l4Dir = "src"
+	}
+	action := nbdb.ACLActionAllowRelated
+	if params.statelessNetPol {
+		action = nbdb.ACLActionAllowStateless
+	}
 	if len(hashedASNames) > 0 {
 		gressAsMatch := asMatch(hashedASNames)
 		match := fmt.Sprintf("ip4.%s == {%s} && %s == @%s", ipDir, gressAsMatch, portDir, pgName)
-		action := nbdb.ACLActionAllowRelated
-		if params.statelessNetPol {
-			action = nbdb.ACLActionAllowStateless
-		}
-		dbIDs := gp.getNetpolACLDbIDs(emptyIdx, libovsdbutil.UnspecifiedL4Protocol)
+		dbIDs := getACLDbIDs(emptyIdx, libovsdbutil.UnspecifiedL4Protocol)
 		acl := libovsdbops.BuildACL(
 			libovsdbutil.GetACLName(dbIDs),
 			direction,
 			types.DefaultAllowPriority,
 			match,
 			action,
-			types.OvnACLLoggingMeter,
+			meter,
 			params.allowLogSeverity,
 			shouldBeLogged,
 			dbIDs.GetExternalIDs(),
@@ -296,14 +316,14 @@ func getGressACLs(gressIdx int, peers []knet.NetworkPolicyPeer, policyType knet.
 	}
 	for i, ipBlock := range ipBlocks {
 		match := fmt.Sprintf("ip4.%s == %s && %s == @%s", ipDir, ipBlock, portDir, pgName)
-		dbIDs := gp.getNetpolACLDbIDs(i, libovsdbutil.UnspecifiedL4Protocol)
+		dbIDs := getACLDbIDs(i, libovsdbutil.UnspecifiedL4Protocol)
 		acl := libovsdbops.BuildACL(
 			libovsdbutil.GetACLName(dbIDs),
 			direction,
 			types.DefaultAllowPriority,
 			match,
-			nbdb.ACLActionAllowRelated,
-			types.OvnACLLoggingMeter,
+			action,
+			meter,
 			params.allowLogSeverity,
 			shouldBeLogged,
 			dbIDs.GetExternalIDs(),
@@ -314,14 +334,14 @@ func getGressACLs(gressIdx int, peers []knet.NetworkPolicyPeer, policyType knet.
 		acls = append(acls, acl)
 	}
 	for _, v := range params.tcpPeerPorts {
-		dbIDs := gp.getNetpolACLDbIDs(emptyIdx, "tcp")
+		dbIDs := getACLDbIDs(emptyIdx, "tcp")
 		acl := libovsdbops.BuildACL(
 			libovsdbutil.GetACLName(dbIDs),
 			direction,
 			types.DefaultAllowPriority,
-			fmt.Sprintf("ip4 && tcp && tcp.dst==%d && %s == @%s", v, portDir, pgName),
-			nbdb.ACLActionAllowRelated,
-			types.OvnACLLoggingMeter,
+			fmt.Sprintf("ip4 && tcp && tcp.%s==%d && %s == @%s", l4Dir, v, portDir, pgName),
+			action,
+			meter,
 			params.allowLogSeverity,
 			shouldBeLogged,
 			dbIDs.GetExternalIDs(),
@@ -341,8 +361,11 @@ type netpolDataParams struct {
 	tcpPeerPorts     []int32
 	allowLogSeverity nbdb.ACLSeverity
 	denyLogSeverity  nbdb.ACLSeverity
+	allowLogMeter    string
 	statelessNetPol  bool
-	netInfo          util.NetInfo
+	// statelessReturnACLs expects the return ACLs of stateless network policies
+	statelessReturnACLs bool
+	netInfo             util.NetInfo
 }
 
 func getPolicyData(params *netpolDataParams) []libovsdbtest.TestData {
@@ -410,6 +433,11 @@ func (p *netpolDataParams) withAllowLogSeverity(allowLogSeverity nbdb.ACLSeverit
 	return p
 }
 
+func (p *netpolDataParams) withAllowLogMeter(allowLogMeter string) *netpolDataParams {
+	p.allowLogMeter = allowLogMeter
+	return p
+}
+
 func (p *netpolDataParams) withDenyLogSeverity(denyLogSeverity nbdb.ACLSeverity) *netpolDataParams {
 	p.denyLogSeverity = denyLogSeverity
 	return p
@@ -420,6 +448,11 @@ func (p *netpolDataParams) withStateless(statelessNetPol bool) *netpolDataParams
 	return p
 }
 
+func (p *netpolDataParams) withStatelessReturnACLs(statelessReturnACLs bool) *netpolDataParams {
+	p.statelessReturnACLs = statelessReturnACLs
+	return p
+}
+
 func (p *netpolDataParams) withNetInfo(netInfo util.NetInfo) *netpolDataParams {
 	p.netInfo = netInfo
 	return p
@@ -2042,7 +2075,72 @@ var _ = ginkgo.Describe("OVN NetworkPolicy Operations", func() {
 			gomega.Expect(app.Run([]string{app.Name})).To(gomega.Succeed())
 		})
 
+		ginkgo.It("policies with their own ACL logging annotation override the namespace logging level and rate limit", func() {
+			app.Action = func(*cli.Context) error {
+				startOvn(initialDB, []corev1.Namespace{originalNamespace}, nil, nil, nil)
+
+				newPolicy := getMatchLabelsNetworkPolicy(netPolicyName1, namespaceName1, namespaceName2, "", true, false)
+				newPolicy.Annotations = map[string]string{
+					util.AclLoggingAnnotation: fmt.Sprintf(`{ "allow": "%s", "rateLimit": 5 }`, nbdb.ACLSeverityInfo),
+				}
+				ginkgo.By("Creating new network policy")
+				_, err := fakeOvn.fakeClient.KubeClient.NetworkingV1().NetworkPolicies(namespaceName1).
+					Create(context.TODO(), newPolicy, metav1.CreateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred(), "should have managed to create a new network policy")
+
+				fairness := true
+				expectedData := []libovsdbtest.TestData{
+					&nbdb.MeterBand{
+						UUID:   "5-pktps-rate-limiter-UUID",
+						Action: types.MeterAction,
+						Rate:   5,
+					},
+					&nbdb.Meter{
+						UUID:  "acl-logging-5-UUID",
+						Name:  "acl-logging-5",
+						Bands: []string{"5-pktps-rate-limiter-UUID"},
+						Unit:  types.PacketsPerSecond,
+						Fair:  &fairness,
+					},
+				}
+				expectedData = append(expectedData, initialDB.NBData...)
+				namespace1AddressSetv4, _ := buildNamespaceAddressSets(namespaceName1, nil)
+				expectedData = append(expectedData, namespace1AddressSetv4)
+				// default deny ACLs are shared by the namespace policies and keep the namespace logging level
+				expectedData = append(expectedData, getDefaultDenyData(newNetpolDataParams(newPolicy).
+					withDenyLogSeverity(nbdb.ACLSeverityAlert))...)
+				expectedData = append(expectedData, getPolicyData(newNetpolDataParams(newPolicy).
+					withAllowLogSeverity(nbdb.ACLSeverityInfo).
+					withAllowLogMeter("acl-logging-5"))...)
+				gomega.Eventually(fakeOvn.nbClient).Should(libovsdbtest.HaveData(expectedData...))
+
+				// namespace logging level updates don't apply to the policy ACLs
+				desiredLogSeverity := nbdb.ACLSeverityDebug
+				gomega.Expect(
+					updateNamespaceACLLogSeverity(&originalNamespace, desiredLogSeverity, desiredLogSeverity)).To(gomega.Succeed(),
+					"should have managed to update the ACL logging severity within the namespace")
+				expectedData = expectedData[:len(expectedData)-len(getDefaultDenyData(newNetpolDataParams(newPolicy)))-
+					len(getPolicyData(newNetpolDataParams(newPolicy)))]
+				expectedData = append(expectedData, getDefaultDenyData(newNetpolDataParams(newPolicy).
+					withDenyLogSeverity(desiredLogSeverity))...)
+				expectedData = append(expectedData, getPolicyData(newNetpolDataParams(newPolicy).
+					withAllowLogSeverity(nbdb.ACLSeverityInfo).
+					withAllowLogMeter("acl-logging-5"))...)
+				gomega.Eventually(fakeOvn.nbClient).Should(libovsdbtest.HaveData(expectedData...))
+
+				ginkgo.By("Deleting the network policy, its ACL logging meter isn't used anymore")
+				err = fakeOvn.fakeClient.KubeClient.NetworkingV1().NetworkPolicies(namespaceName1).
+					Delete(context.TODO(), newPolicy.Name, metav1.DeleteOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				expectedData = append(initialDB.NBData, namespace1AddressSetv4)
+				gomega.Eventually(fakeOvn.nbClient).Should(libovsdbtest.HaveData(expectedData...))
+				return nil
+			}
+			gomega.Expect(app.Run([]string{app.Name})).To(gomega.Succeed())
+		})
+
 		ginkgo.It("creates stateless OVN ACLs based off of the annotation", func() {
+			config.OVNKubernetesFeature.EnableStatelessNetPol = true
 			app.Action = func(*cli.Context) error {
 				namespace1 := *newNamespace(namespaceName1)
 				nPodTest := getTestPod(namespace1.Name, nodeName)
@@ -2073,6 +2171,71 @@ var _ = ginkgo.Describe("OVN NetworkPolicy Operations", func() {
 			gomega.Expect(app.Run([]string{app.Name})).To(gomega.Succeed())
 		})
 
+		ginkgo.It("creates the return OVN ACLs of stateless network policies", func() {
+			config.OVNKubernetesFeature.EnableStatelessNetPol = true
+			config.OVNKubernetesFeature.EnableStatelessNetPolReturnACLs = true
+			app.Action = func(*cli.Context) error {
+				namespace1 := *newNamespace(namespaceName1)
+				namespace2 := *newNamespace(namespaceName2)
+				nPodTest := getTestPod(namespace1.Name, nodeName)
+				networkPolicy := getMatchLabelsNetworkPolicy(netPolicyName1, namespace1.Name,
+					namespace2.Name, "", true, true)
+				networkPolicy.Spec.Egress[0].To = append(networkPolicy.Spec.Egress[0].To,
+					knet.NetworkPolicyPeer{IPBlock: &knet.IPBlock{CIDR: "1.1.1.0/24"}})
+				networkPolicy.Annotations = map[string]string{
+					ovnStatelessNetPolAnnotationName: "true",
+				}
+				startOvn(initialDB, []corev1.Namespace{namespace1, namespace2}, []knet.NetworkPolicy{*networkPolicy},
+					[]testPod{nPodTest}, nil)
+
+				expectedData := getNamespaceWithSinglePolicyExpectedData(
+					newNetpolDataParams(networkPolicy).
+						withLocalPortUUIDs(nPodTest.portUUID).
+						withPeerNamespaces(namespace2.Name).
+						withStateless(true).
+						withStatelessReturnACLs(true),
+					getUpdatedInitialDB([]testPod{nPodTest}))
+				namespace1AddressSetv4, _ := buildNamespaceAddressSets(namespaceName1, []string{nPodTest.podIP})
+				namespace2AddressSetv4, _ := buildNamespaceAddressSets(namespaceName2, nil)
+				expectedData = append(expectedData, namespace1AddressSetv4, namespace2AddressSetv4)
+				gomega.Eventually(fakeOvn.nbClient).Should(libovsdbtest.HaveData(expectedData...))
+
+				return nil
+			}
+
+			gomega.Expect(app.Run([]string{app.Name})).To(gomega.Succeed())
+		})
+
+		ginkgo.It("creates the return OVN ACLs of stateless network policies with ports", func() {
+			config.OVNKubernetesFeature.EnableStatelessNetPol = true
+			config.OVNKubernetesFeature.EnableStatelessNetPolReturnACLs = true
+			app.Action = func(*cli.Context) error {
+				namespace1 := *newNamespace(namespaceName1)
+				nPodTest := getTestPod(namespace1.Name, nodeName)
+				networkPolicy := getPortNetworkPolicy(netPolicyName1, namespace1.Name, labelName, labelVal, portNum)
+				networkPolicy.Annotations = map[string]string{
+					ovnStatelessNetPolAnnotationName: "true",
+				}
+				startOvn(initialDB, []corev1.Namespace{namespace1}, []knet.NetworkPolicy{*networkPolicy},
+					[]testPod{nPodTest}, map[string]string{labelName: labelVal})
+
+				expectedData := getNamespaceWithSinglePolicyExpectedData(
+					newNetpolDataParams(networkPolicy).
+						withLocalPortUUIDs(nPodTest.portUUID).
+						withTCPPeerPorts(portNum).
+						withStateless(true).
+						withStatelessReturnACLs(true),
+					getUpdatedInitialDB([]testPod{nPodTest}))
+				namespace1AddressSetv4, _ := buildNamespaceAddressSets(namespaceName1, []string{nPodTest.podIP})
+				expectedData = append(expectedData, namespace1AddressSetv4)
+				gomega.Eventually(fakeOvn.nbClient).Should(libovsdbtest.HaveData(expectedData...))
+
+				return nil
+			}
+
+			gomega.Expect(app.Run([]string{app.Name})).To(gomega.Succeed())
+		})
+
 	})
 })
 
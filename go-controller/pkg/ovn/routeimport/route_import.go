@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"maps"
 	"net"
+	"reflect"
+	"slices"
 	"sync"
 	"time"
 
@@ -20,6 +22,7 @@ import (
 
 	controllerutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
 	nbdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
@@ -33,6 +36,11 @@ const (
 	noTable                 = -1
 	controllerExternalIDKey = string(nbdbops.OwnerControllerKey)
 	controllerName          = "RouteImport"
+
+	// reasons for routes not being imported
+	rejectedByPrefix      = "prefix"
+	rejectedByNextHop     = "nexthop"
+	rejectedByMaxPrefixes = "maxprefixes"
 )
 
 type Manager interface {
@@ -145,6 +153,7 @@ func (c *controller) ForgetNetwork(name string) {
 	delete(c.networkIDs, network.GetNetworkID())
 	delete(c.networks, name)
 	c.setTableForNetworkUnlocked(network.GetNetworkID(), noTable)
+	metrics.DeleteRouteImportMetrics(name)
 
 	c.log.V(5).Info("Stopped tracking network", "name", name)
 }
@@ -153,12 +162,13 @@ func (c *controller) NeedsReconciliation(network util.NetInfo) bool {
 	c.RLock()
 	defer c.RUnlock()
 
-	if c.networks[network.GetNetworkName()] == nil {
+	known := c.networks[network.GetNetworkName()]
+	if known == nil {
 		return false
 	}
 
 	// TODO check if overlay mode changed
-	return false
+	return !reflect.DeepEqual(known.GetRouteImportPolicy(), network.GetRouteImportPolicy())
 }
 
 func (c *controller) ReconcileNetwork(name string) error {
@@ -350,7 +360,7 @@ func (c *controller) syncNetwork(network string) error {
 		ignoreSubnets[i] = subnet.CIDR
 	}
 
-	expected, err := c.getBGPRoutes(table, ignoreSubnets)
+	learned, err := c.getBGPRoutes(table, ignoreSubnets)
 	if err != nil {
 		return err
 	}
@@ -363,10 +373,20 @@ func (c *controller) syncNetwork(network string) error {
 		return fmt.Errorf("failed to get routes from OVN: %w", err)
 	}
 
+	expected, rejected, maxPrefixesExceeded := applyImportPolicy(info.GetRouteImportPolicy(), learned, actual)
+	if maxPrefixesExceeded {
+		c.log.Error(nil, "Routes to import exceed the maximum number of prefixes of the import policy", "network", network,
+			"maxPrefixes", info.GetRouteImportPolicy().MaxPrefixes, "withdrawAll", info.GetRouteImportPolicy().WithdrawOnMaxPrefixes)
+	}
+	if len(rejected) > 0 {
+		c.log.V(5).Info("Rejected routes by import policy", "network", network, "rejected", stringer{rejected})
+	}
+
 	deletes := actual.Difference(expected)
 	adds := expected.Difference(actual)
 	if len(deletes)+len(adds) == 0 {
 		c.log.V(5).Info("Found no updates for router", "router", router)
+		metrics.UpdateRouteImportMetrics(network, len(expected), rejected, maxPrefixesExceeded)
 		return nil
 	}
 	c.log.V(5).Info("Found updates for router", "router", router, "adds", stringer{adds}, "deletes", stringer{deletes})
@@ -413,6 +433,9 @@ func (c *controller) syncNetwork(network string) error {
 	}
 
 	err = errors.Join(errs...)
+	if err == nil {
+		metrics.UpdateRouteImportMetrics(network, len(expected), rejected, maxPrefixesExceeded)
+	}
 	c.log.V(5).Info("Reconciled network", "network", network, "took", time.Since(start), "ops", ops, "errors", err)
 	return err
 }
@@ -440,6 +463,49 @@ func (c *controller) getBGPRoutes(table int, ignoreSubnets []*net.IPNet) (sets.S
 	return routes, nil
 }
 
+// applyImportPolicy returns the learned routes that should be imported as per
+// the import policy, along with the number of rejected routes by reason and
+// whether the maximum number of prefixes to import was exceeded. If exceeded,
+// either all the routes are withdrawn or only those already imported are kept.
+func applyImportPolicy(policy *util.RouteImportPolicy, learned, imported sets.Set[route]) (sets.Set[route], map[string]int, bool) {
+	if policy == nil {
+		return learned, nil, false
+	}
+
+	rejected := map[string]int{}
+	allowed := sets.New[route]()
+	prefixes := sets.New[string]()
+	for r := range learned {
+		if len(policy.AllowedPrefixes) > 0 {
+			_, dst, err := net.ParseCIDR(r.dst)
+			if err != nil || !util.IsContainedInAnyCIDR(dst, policy.AllowedPrefixes...) {
+				rejected[rejectedByPrefix]++
+				continue
+			}
+		}
+		if len(policy.AllowedNextHops) > 0 {
+			gw := net.ParseIP(r.gw)
+			if gw == nil || !slices.ContainsFunc(policy.AllowedNextHops, func(ipNet *net.IPNet) bool { return ipNet.Contains(gw) }) {
+				rejected[rejectedByNextHop]++
+				continue
+			}
+		}
+		allowed.Insert(r)
+		prefixes.Insert(r.dst)
+	}
+
+	if policy.MaxPrefixes == 0 || prefixes.Len() <= policy.MaxPrefixes {
+		return allowed, rejected, false
+	}
+
+	kept := sets.New[route]()
+	if !policy.WithdrawOnMaxPrefixes {
+		kept = allowed.Intersection(imported)
+	}
+	rejected[rejectedByMaxPrefixes] = allowed.Len() - kept.Len()
+	return kept, rejected, true
+}
+
 func (c *controller) getOVNRoutes(router string) (sets.Set[route], map[route]string, error) {
 	start := time.Now()
 	lr := &nbdb.LogicalRouter{
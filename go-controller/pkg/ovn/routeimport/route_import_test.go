@@ -2,6 +2,7 @@ package routeimport
 
 import (
 	"errors"
+	"net"
 	"sync"
 	"testing"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/vishvananda/netlink"
 	"golang.org/x/sys/unix"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/util/workqueue"
 
 	controllerutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
@@ -36,6 +38,20 @@ func Test_controller_syncNetwork(t *testing.T) {
 	udn.On("GetNetworkID").Return(1)
 	udn.On("Subnets").Return(nil)
 	udn.On("GetNetworkScopedGWRouterName", node).Return("router")
+	udn.On("GetRouteImportPolicy").Return(nil)
+
+	restrictedUDN := &multinetworkmocks.NetInfo{}
+	restrictedUDN.On("IsDefault").Return(false)
+	restrictedUDN.On("GetNetworkName").Return("restricted")
+	restrictedUDN.On("GetNetworkID").Return(3)
+	restrictedUDN.On("Subnets").Return(nil)
+	restrictedUDN.On("GetNetworkScopedGWRouterName", node).Return("router")
+	restrictedUDN.On("GetRouteImportPolicy").Return(&util.RouteImportPolicy{
+		AllowedPrefixes: []*net.IPNet{ovntesting.MustParseIPNet("1.0.0.0/8"), ovntesting.MustParseIPNet("2.0.0.0/8")},
+		AllowedNextHops: []*net.IPNet{ovntesting.MustParseIPNet("1.1.1.0/24"), ovntesting.MustParseIPNet("2.2.2.0/24")},
+		MaxPrefixes:     1,
+	})
+	restrictedRouterPort := types.GWRouterToExtSwitchPrefix + "router"
 
 	cudn := &multinetworkmocks.NetInfo{}
 	cudn.On("IsDefault").Return(false)
@@ -43,6 +59,7 @@ func Test_controller_syncNetwork(t *testing.T) {
 	cudn.On("GetNetworkID").Return(2)
 	cudn.On("Subnets").Return(nil)
 	cudn.On("GetNetworkScopedGWRouterName", node).Return("router")
+	cudn.On("GetRouteImportPolicy").Return(nil)
 
 	type fields struct {
 		networkIDs map[int]string
@@ -168,6 +185,32 @@ func Test_controller_syncNetwork(t *testing.T) {
 				&nbdb.LogicalRouterStaticRoute{UUID: "untouched-1", IPPrefix: "3.3.3.0/24", Nexthop: "3.3.3.2", ExternalIDs: map[string]string{controllerExternalIDKey: controllerName}},
 			},
 		},
+		{
+			name: "imports routes as per import policy",
+			args: args{"restricted"},
+			link: &netlink.Vrf{Table: 1003},
+			fields: fields{
+				networkIDs: map[int]string{3: "restricted"},
+				networks:   map[string]util.NetInfo{"restricted": restrictedUDN},
+			},
+			initial: []libovsdb.TestData{
+				&nbdb.LogicalRouter{Name: "router", StaticRoutes: []string{"keep"}},
+				&nbdb.LogicalRouterStaticRoute{UUID: "keep", IPPrefix: "1.1.1.0/24", Nexthop: "1.1.1.1", OutputPort: &restrictedRouterPort, ExternalIDs: map[string]string{controllerExternalIDKey: controllerName}},
+			},
+			routes: []netlink.Route{
+				{Dst: ovntesting.MustParseIPNet("1.1.1.0/24"), Gw: ovntesting.MustParseIP("1.1.1.1")},
+				// exceeds max prefixes, not imported
+				{Dst: ovntesting.MustParseIPNet("2.2.2.0/24"), Gw: ovntesting.MustParseIP("2.2.2.1")},
+				// prefix not allowed
+				{Dst: ovntesting.MustParseIPNet("3.3.3.0/24"), Gw: ovntesting.MustParseIP("1.1.1.1")},
+				// next hop not allowed
+				{Dst: ovntesting.MustParseIPNet("1.1.2.0/24"), Gw: ovntesting.MustParseIP("3.3.3.1")},
+			},
+			expected: []libovsdb.TestData{
+				&nbdb.LogicalRouter{UUID: "router", Name: "router", StaticRoutes: []string{"keep"}},
+				&nbdb.LogicalRouterStaticRoute{UUID: "keep", IPPrefix: "1.1.1.0/24", Nexthop: "1.1.1.1", OutputPort: &restrictedRouterPort, ExternalIDs: map[string]string{controllerExternalIDKey: controllerName}},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -223,6 +266,73 @@ func Test_controller_syncNetwork(t *testing.T) {
 	}
 }
 
+func Test_applyImportPolicy(t *testing.T) {
+	learned := sets.New(
+		route{dst: "1.1.1.0/24", gw: "1.1.1.1"},
+		route{dst: "1.1.2.0/24", gw: "1.1.1.1"},
+		route{dst: "1.1.2.0/24", gw: "2.2.2.1"},
+		route{dst: "2.2.2.0/24", gw: "2.2.2.1"},
+		route{dst: "fd00::/64", gw: "fe80::1"},
+	)
+	imported := sets.New(
+		route{dst: "1.1.1.0/24", gw: "1.1.1.1"},
+		route{dst: "3.3.3.0/24", gw: "3.3.3.1"},
+	)
+	tests := []struct {
+		name                string
+		policy              *util.RouteImportPolicy
+		expected            sets.Set[route]
+		expectedRejected    map[string]int
+		expectedMaxExceeded bool
+	}{
+		{
+			name:     "imports all routes without policy",
+			expected: learned,
+		},
+		{
+			name:             "imports routes to allowed prefixes",
+			policy:           &util.RouteImportPolicy{AllowedPrefixes: []*net.IPNet{ovntesting.MustParseIPNet("1.1.0.0/16"), ovntesting.MustParseIPNet("fd00::/48")}},
+			expected:         sets.New(route{dst: "1.1.1.0/24", gw: "1.1.1.1"}, route{dst: "1.1.2.0/24", gw: "1.1.1.1"}, route{dst: "1.1.2.0/24", gw: "2.2.2.1"}, route{dst: "fd00::/64", gw: "fe80::1"}),
+			expectedRejected: map[string]int{rejectedByPrefix: 1},
+		},
+		{
+			name:             "imports routes through allowed next hops",
+			policy:           &util.RouteImportPolicy{AllowedNextHops: []*net.IPNet{ovntesting.MustParseIPNet("2.2.2.0/24")}},
+			expected:         sets.New(route{dst: "1.1.2.0/24", gw: "2.2.2.1"}, route{dst: "2.2.2.0/24", gw: "2.2.2.1"}),
+			expectedRejected: map[string]int{rejectedByNextHop: 3},
+		},
+		{
+			name:             "imports routes within the maximum number of prefixes",
+			policy:           &util.RouteImportPolicy{MaxPrefixes: 4},
+			expected:         learned,
+			expectedRejected: map[string]int{},
+		},
+		{
+			name:                "keeps imported routes when exceeding the maximum number of prefixes",
+			policy:              &util.RouteImportPolicy{MaxPrefixes: 3},
+			expected:            sets.New(route{dst: "1.1.1.0/24", gw: "1.1.1.1"}),
+			expectedRejected:    map[string]int{rejectedByMaxPrefixes: 4},
+			expectedMaxExceeded: true,
+		},
+		{
+			name:                "withdraws all routes when exceeding the maximum number of prefixes",
+			policy:              &util.RouteImportPolicy{MaxPrefixes: 3, WithdrawOnMaxPrefixes: true},
+			expected:            sets.New[route](),
+			expectedRejected:    map[string]int{rejectedByMaxPrefixes: 5},
+			expectedMaxExceeded: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			expected, rejected, maxExceeded := applyImportPolicy(tt.policy, learned, imported)
+			g.Expect(expected).To(gomega.Equal(tt.expected))
+			g.Expect(rejected).To(gomega.Equal(tt.expectedRejected))
+			g.Expect(maxExceeded).To(gomega.Equal(tt.expectedMaxExceeded))
+		})
+	}
+}
+
 func Test_controller_syncRouteUpdate(t *testing.T) {
 	defaultNetwork := &util.DefaultNetInfo{}
 	type fields struct {
@@ -494,6 +494,12 @@ func (oc *SecondaryLayer2NetworkController) init() error {
 		return err
 	}
 
+	if util.DoesNetworkRequireRARouter(oc.GetNetInfo()) {
+		if err := oc.ensureRARouter(oc.GetNetworkScopedSwitchName(types.OVNLayer2Switch)); err != nil {
+			return fmt.Errorf("failed to create the router advertisements router of network %q: %w", oc.GetNetworkName(), err)
+		}
+	}
+
 	// Configure cluster port groups and multicast default policies for user defined primary networks.
 	if oc.IsPrimaryNetwork() && util.IsNetworkSegmentationSupportEnabled() {
 		if err := oc.setupClusterPortGroups(); err != nil {
@@ -505,6 +511,12 @@ func (oc *SecondaryLayer2NetworkController) init() error {
 		}
 	}
 
+	if oc.IsolatesNamespaces() {
+		if err := oc.syncNamespaceIsolation(); err != nil {
+			return fmt.Errorf("failed to sync namespace isolation for network %q: %w", oc.GetNetworkName(), err)
+		}
+	}
+
 	return err
 }
 
@@ -514,10 +526,32 @@ func (oc *SecondaryLayer2NetworkController) Stop() {
 }
 
 func (oc *SecondaryLayer2NetworkController) Reconcile(netInfo util.NetInfo) error {
-	return oc.BaseNetworkController.reconcile(
+	reconcileSubnets := !reflect.DeepEqual(oc.Subnets(), netInfo.Subnets())
+	err := oc.BaseNetworkController.reconcile(
 		netInfo,
-		func(node string) { oc.gatewaysFailed.Store(node, true) },
+		func(node string) {
+			oc.gatewaysFailed.Store(node, true)
+			oc.mgmtPortFailed.Store(node, true)
+		},
 	)
+	if err != nil || !reconcileSubnets {
+		return err
+	}
+	// subnets were appended to the network, make them available for pod IP
+	// allocation
+	hostSubnets := make([]*net.IPNet, 0, len(oc.Subnets()))
+	for _, subnet := range oc.Subnets() {
+		hostSubnets = append(hostSubnets, subnet.CIDR)
+	}
+	if err := oc.lsManager.AppendSwitchSubnets(oc.GetNetworkScopedSwitchName(types.OVNLayer2Switch), hostSubnets, oc.ExcludeSubnets()...); err != nil {
+		return fmt.Errorf("failed to append subnets to the switch of network %s: %w", oc.GetNetworkName(), err)
+	}
+	if util.DoesNetworkRequireRARouter(oc.GetNetInfo()) {
+		if err := oc.ensureRARouter(oc.GetNetworkScopedSwitchName(types.OVNLayer2Switch)); err != nil {
+			return fmt.Errorf("failed to update the router advertisements router of network %q: %w", oc.GetNetworkName(), err)
+		}
+	}
+	return nil
 }
 
 func (oc *SecondaryLayer2NetworkController) initRetryFramework() {
@@ -541,6 +575,12 @@ func (oc *SecondaryLayer2NetworkController) initRetryFramework() {
 		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
 		oc.retryMultiNetworkPolicies = oc.newRetryFramework(factory.MultiNetworkPolicyType)
 	}
+
+	// Namespace isolation relies on the namespace port groups and address sets
+	// which are kept up to date by the namespace events.
+	if oc.IsolatesNamespaces() && oc.retryNamespaces == nil {
+		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
+	}
 }
 
 // newRetryFramework builds and returns a retry framework for the input resource type;
@@ -18,7 +18,10 @@ import (
 	knet "k8s.io/utils/net"
 	"k8s.io/utils/ptr"
 
+	libovsdbclient "github.com/ovn-org/libovsdb/client"
+
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
 	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
@@ -144,6 +147,18 @@ var _ = Describe("OVN Multi-Homed pod operations for layer 2 network", func() {
 			config.GatewayModeShared,
 		),
 
+		Entry("pod on a user defined secondary network serving DHCP",
+			dummySecondaryLayer2UserDefinedNetworkServingDHCP("100.200.0.0/16"),
+			nonICClusterTestConfiguration(),
+			config.GatewayModeShared,
+		),
+
+		Entry("pod on a user defined secondary network serving DHCP on an IC cluster",
+			dummySecondaryLayer2UserDefinedNetworkServingDHCP("100.200.0.0/16"),
+			icClusterTestConfiguration(),
+			config.GatewayModeShared,
+		),
+
 		Entry("pod on a user defined secondary network on an IC cluster",
 			dummySecondaryLayer2UserDefinedNetwork("100.200.0.0/16"),
 			icClusterTestConfiguration(),
@@ -162,6 +177,12 @@ var _ = Describe("OVN Multi-Homed pod operations for layer 2 network", func() {
 			config.GatewayModeLocal,
 		),
 
+		Entry("pod on a user defined primary network with an external IP on an IC cluster",
+			withNodeExternalIP(dummyPrimaryLayer2UserDefinedNetwork("100.200.0.0/16"), "192.168.126.100"),
+			icClusterTestConfiguration(),
+			config.GatewayModeShared,
+		),
+
 		Entry("pod on a user defined primary network on an IC cluster with per-pod SNATs enabled",
 			dummyPrimaryLayer2UserDefinedNetwork("100.200.0.0/16"),
 			icClusterTestConfiguration(func(testConfig *testConfiguration) {
@@ -178,6 +199,125 @@ var _ = Describe("OVN Multi-Homed pod operations for layer 2 network", func() {
 		*/
 	)
 
+	It("removes the DHCP options of a pod deleted from a secondary network serving DHCP", func() {
+		const podIdx = 0
+		netInfo := dummySecondaryLayer2UserDefinedNetworkServingDHCP("100.200.0.0/16")
+		podInfo := dummyL2TestPod(ns, netInfo, podIdx, podIdx)
+		setupConfig(netInfo, nonICClusterTestConfiguration(), config.GatewayModeShared)
+		app.Action = func(*cli.Context) error {
+			pod := newMultiHomedPod(podInfo, netInfo)
+
+			const nodeIPv4CIDR = "192.168.126.202/24"
+			testNode, err := newNodeWithSecondaryNets(nodeName, nodeIPv4CIDR)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(setupFakeOvnForLayer2Topology(fakeOvn, initialDB, netInfo, testNode, podInfo, pod)).To(Succeed())
+			defer fakeOvn.networkManager.Stop()
+
+			podDHCPOptions := func() ([]nbdb.DHCPOptions, error) {
+				var dhcpOptions []nbdb.DHCPOptions
+				err := fakeOvn.nbClient.List(context.Background(), &dhcpOptions)
+				return dhcpOptions, err
+			}
+
+			By("asserting the pod logical switch port is attached to its DHCP options")
+			portName := util.GetSecondaryNetworkLogicalPortName(pod.Namespace, pod.Name, netInfo.nadName)
+			Eventually(func() *string {
+				lsp, err := libovsdbops.GetLogicalSwitchPort(fakeOvn.nbClient, &nbdb.LogicalSwitchPort{Name: portName})
+				if err != nil {
+					return nil
+				}
+				return lsp.Dhcpv4Options
+			}).ShouldNot(BeNil())
+			Expect(podDHCPOptions()).To(HaveLen(1))
+
+			By("deleting the pod")
+			Expect(fakeOvn.fakeClient.KubeClient.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{})).To(Succeed())
+			Eventually(podDHCPOptions).Should(BeEmpty())
+
+			return nil
+		}
+
+		Expect(app.Run([]string{app.Name})).To(Succeed())
+	})
+
+	DescribeTable(
+		"sends the IPv6 router advertisements of a secondary network serving DHCP",
+		func(testConfig testConfiguration) {
+			netInfo := dummySecondaryLayer2UserDefinedNetworkServingDHCP("2001:db8:abcd:0012::/64")
+			setupConfig(netInfo, testConfig, config.GatewayModeShared)
+			app.Action = func(*cli.Context) error {
+				nad, err := newNetworkAttachmentDefinition(ns, nadName, *netInfo.netconf())
+				Expect(err).NotTo(HaveOccurred())
+				nad.Annotations = map[string]string{ovntypes.OvnNetworkIDAnnotation: secondaryNetworkID}
+
+				const nodeIPv4CIDR = "192.168.126.202/24"
+				testNode, err := newNodeWithSecondaryNets(nodeName, nodeIPv4CIDR)
+				Expect(err).NotTo(HaveOccurred())
+
+				fakeOvn.startWithDBSetup(
+					initialDB,
+					&corev1.NamespaceList{Items: []corev1.Namespace{*newNamespace(ns)}},
+					&corev1.NodeList{Items: []corev1.Node{*testNode}},
+					&nadapi.NetworkAttachmentDefinitionList{Items: []nadapi.NetworkAttachmentDefinition{*nad}},
+				)
+				Expect(fakeOvn.networkManager.Start()).To(Succeed())
+				defer fakeOvn.networkManager.Stop()
+
+				controller, ok := fakeOvn.fullSecondaryL2Controllers[secondaryNetworkName]
+				Expect(ok).To(BeTrue())
+				Expect(controller.init()).To(Succeed())
+
+				By("asserting the router advertisements router is attached to the network switch")
+				switchName := controller.GetNetworkScopedSwitchName(ovntypes.OVNLayer2Switch)
+				routerName := controller.GetNetworkScopedName(ovntypes.OVNLayer2RARouter)
+				router, err := libovsdbops.GetLogicalRouter(fakeOvn.nbClient, &nbdb.LogicalRouter{Name: routerName})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(router.Ports).To(HaveLen(1))
+
+				lrp, err := libovsdbops.GetLogicalRouterPort(fakeOvn.nbClient, &nbdb.LogicalRouterPort{Name: ovntypes.RouterToSwitchPrefix + switchName})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(router.Ports).To(ConsistOf(lrp.UUID))
+				Expect(lrp.Networks).To(ConsistOf("2001:db8:abcd:12::/64"))
+				Expect(lrp.Ipv6RaConfigs).To(Equal(map[string]string{
+					"address_mode":      "dhcpv6_stateful",
+					"mtu":               "1400",
+					"send_periodic":     "true",
+					"max_interval":      "900",
+					"min_interval":      "300",
+					"router_preference": "LOW",
+				}))
+
+				lsp, err := libovsdbops.GetLogicalSwitchPort(fakeOvn.nbClient, &nbdb.LogicalSwitchPort{Name: ovntypes.SwitchToRouterPrefix + switchName})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(lsp.Type).To(Equal("router"))
+				Expect(lsp.Addresses).To(ConsistOf("router"))
+				Expect(lsp.Options).To(HaveKeyWithValue("router-port", lrp.Name))
+				if config.OVNKubernetesFeature.EnableInterconnect {
+					Expect(lsp.Options).To(HaveKeyWithValue("requested-tnl-key", strconv.Itoa(ovntypes.Layer2RARouterPortTunnelKey)))
+				} else {
+					Expect(lsp.Options).NotTo(HaveKey("requested-tnl-key"))
+				}
+				logicalSwitch, err := libovsdbops.GetLogicalSwitch(fakeOvn.nbClient, &nbdb.LogicalSwitch{Name: switchName})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(logicalSwitch.Ports).To(ContainElement(lsp.UUID))
+
+				By("asserting the router advertisements router is removed along with the network")
+				Expect(controller.Cleanup()).To(Succeed())
+				_, err = libovsdbops.GetLogicalRouter(fakeOvn.nbClient, &nbdb.LogicalRouter{Name: routerName})
+				Expect(err).To(MatchError(libovsdbclient.ErrNotFound))
+				_, err = libovsdbops.GetLogicalRouterPort(fakeOvn.nbClient, &nbdb.LogicalRouterPort{Name: lrp.Name})
+				Expect(err).To(MatchError(libovsdbclient.ErrNotFound))
+
+				return nil
+			}
+
+			Expect(app.Run([]string{app.Name})).To(Succeed())
+		},
+		Entry("on a non-IC cluster", nonICClusterTestConfiguration()),
+		Entry("on an IC cluster", icClusterTestConfiguration()),
+	)
+
 	DescribeTable(
 		"reconciles a new kubevirt-related pod during its live-migration phases",
 		func(netInfo secondaryNetInfo, testConfig testConfiguration, migrationInfo *liveMigrationInfo) {
@@ -479,6 +619,12 @@ func dummySecondaryLayer2UserDefinedNetwork(subnets string) secondaryNetInfo {
 	}
 }
 
+func dummySecondaryLayer2UserDefinedNetworkServingDHCP(subnets string) secondaryNetInfo {
+	secondaryNet := dummySecondaryLayer2UserDefinedNetwork(subnets)
+	secondaryNet.enableDHCP = true
+	return secondaryNet
+}
+
 func dummyPrimaryLayer2UserDefinedNetwork(subnets string) secondaryNetInfo {
 	secondaryNet := dummySecondaryLayer2UserDefinedNetwork(subnets)
 	secondaryNet.isPrimary = true
@@ -599,7 +745,7 @@ func expectedLayer2EgressEntities(netInfo util.NetInfo, gwConfig util.L3GatewayC
 
 	expectedEntities = append(expectedEntities, expectedExternalSwitchAndLSPs(netInfo, gwConfig, nodeName)...)
 	expectedEntities = append(expectedEntities, newNATEntry(nat1, dummyMasqueradeIP().IP.String(), gwRouterJoinIPAddress().IP.String(), standardNonDefaultNetworkExtIDs(netInfo), ""))
-	expectedEntities = append(expectedEntities, newNATEntry(nat2, dummyMasqueradeIP().IP.String(), layer2Subnet().String(), standardNonDefaultNetworkExtIDs(netInfo), fmt.Sprintf("outport == %q", gwRouterToExtSwitchPortName)))
+	expectedEntities = append(expectedEntities, newNATEntry(nat2, clusterSubnetSNATIP(netInfo, nodeName), layer2Subnet().String(), standardNonDefaultNetworkExtIDs(netInfo), fmt.Sprintf("outport == %q", gwRouterToExtSwitchPortName)))
 	expectedEntities = append(expectedEntities, newNATEntry(nat3, dummyMasqueradeIP().IP.String(), layer2SubnetGWAddr().IP.String(), standardNonDefaultNetworkExtIDs(netInfo), ""))
 	return expectedEntities
 }
@@ -424,6 +424,12 @@ func (oc *SecondaryLayer3NetworkController) initRetryFramework() {
 		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
 		oc.retryMultiNetworkPolicies = oc.newRetryFramework(factory.MultiNetworkPolicyType)
 	}
+
+	// Namespace isolation relies on the namespace port groups and address sets
+	// which are kept up to date by the namespace events.
+	if oc.IsolatesNamespaces() && oc.retryNamespaces == nil {
+		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
+	}
 }
 
 // newRetryFramework builds and returns a retry framework for the input resource type;
@@ -610,6 +616,21 @@ func (oc *SecondaryLayer3NetworkController) run() error {
 		}
 	}
 
+	// start Admin Network Policy controller if feature is enabled, the policies always apply to
+	// the primary networks while secondary networks have to be selected by the policies
+	if config.OVNKubernetesFeature.EnableAdminNetworkPolicy && (oc.IsPrimaryNetwork() || util.IsMultiNetworkPoliciesSupportEnabled()) {
+		err := oc.newANPController(nil)
+		if err != nil {
+			return fmt.Errorf("unable to create admin network policy controller, err: %w", err)
+		}
+		oc.wg.Add(1)
+		go func() {
+			defer oc.wg.Done()
+			// Until we have scale issues in future let's spawn only one thread
+			oc.anpController.Run(1, oc.stopChan)
+		}()
+	}
+
 	// start NetworkQoS controller if feature is enabled
 	if config.OVNKubernetesFeature.EnableNetworkQoS {
 		err := oc.newNetworkQoSController()
@@ -697,6 +718,12 @@ func (oc *SecondaryLayer3NetworkController) init() error {
 		oc.switchLoadBalancerGroupUUID = switchLBGroupUUID
 		oc.routerLoadBalancerGroupUUID = routerLBGroupUUID
 	}
+
+	if oc.IsolatesNamespaces() {
+		if err := oc.syncNamespaceIsolation(); err != nil {
+			return fmt.Errorf("failed to sync namespace isolation for network %q: %w", oc.GetNetworkName(), err)
+		}
+	}
 	return nil
 }
 
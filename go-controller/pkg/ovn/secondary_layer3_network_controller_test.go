@@ -41,6 +41,8 @@ type secondaryNetInfo struct {
 	topology           string
 	isPrimary          bool
 	allowPersistentIPs bool
+	enableDHCP         bool
+	externalIPs        map[string]string
 	ipamClaimReference string
 }
 
@@ -275,6 +277,11 @@ var _ = Describe("OVN Multi-Homed pod operations for layer 3 network", func() {
 			icClusterTestConfiguration(),
 			config.GatewayModeLocal,
 		),
+		Entry("pod on a user defined primary network with an external IP on an IC cluster",
+			withNodeExternalIP(dummyPrimaryLayer3UserDefinedNetwork("192.168.0.0/16", "192.168.1.0/24"), "192.168.126.100"),
+			icClusterTestConfiguration(),
+			config.GatewayModeShared,
+		),
 		Entry("pod on a user defined primary network on an IC cluster with per-pod SNATs enabled",
 			dummyPrimaryLayer3UserDefinedNetwork("192.168.0.0/16", "192.168.1.0/24"),
 			icClusterTestConfiguration(func(testConfig *testConfiguration) {
@@ -553,6 +560,8 @@ func (sni *secondaryNetInfo) netconf() *ovncnitypes.NetConf {
 		Subnets:            sni.clustersubnets,
 		Role:               role,
 		AllowPersistentIPs: sni.allowPersistentIPs,
+		EnableDHCP:         sni.enableDHCP,
+		ExternalIPs:        sni.externalIPs,
 	}
 }
 
@@ -609,6 +618,11 @@ func dummyPrimaryLayer3UserDefinedNetwork(clustersubnets, hostsubnets string) se
 }
 
 // This util is returning a network-name/hostSubnet for the node's node-subnets annotation
+func withNodeExternalIP(netInfo secondaryNetInfo, externalIP string) secondaryNetInfo {
+	netInfo.externalIPs = map[string]string{nodeName: externalIP}
+	return netInfo
+}
+
 func (sni *secondaryNetInfo) String() string {
 	return fmt.Sprintf("%q: %q", sni.netName, sni.hostsubnets)
 }
@@ -736,10 +750,19 @@ func expectedGWRouterPlusNATAndStaticRoutes(
 		expectedGRStaticRoute(staticRoute3, masqSubnet, nextHopMasqIP, nil, &staticRouteOutputPort, netInfo),
 	}
 	expectedEntities = append(expectedEntities, newNATEntry(nat1, dummyMasqueradeIP().IP.String(), gwRouterJoinIPAddress().IP.String(), standardNonDefaultNetworkExtIDs(netInfo), ""))
-	expectedEntities = append(expectedEntities, newNATEntry(nat2, dummyMasqueradeIP().IP.String(), netInfo.Subnets()[0].CIDR.String(), standardNonDefaultNetworkExtIDs(netInfo), ""))
+	expectedEntities = append(expectedEntities, newNATEntry(nat2, clusterSubnetSNATIP(netInfo, nodeName), netInfo.Subnets()[0].CIDR.String(), standardNonDefaultNetworkExtIDs(netInfo), ""))
 	return expectedEntities
 }
 
+// clusterSubnetSNATIP returns the IP the traffic of the network pods egressing
+// the node is SNATed to: the network external IP of the node, if any
+func clusterSubnetSNATIP(netInfo util.NetInfo, nodeName string) string {
+	if externalIPs := netInfo.ExternalIPs()[nodeName]; len(externalIPs) > 0 {
+		return externalIPs[0].String()
+	}
+	return dummyMasqueradeIP().IP.String()
+}
+
 func expectedStaticMACBindings(gwRouterName string, ips []net.IP) []libovsdbtest.TestData {
 	lrpName := fmt.Sprintf("%s%s", types.GWRouterToExtSwitchPrefix, gwRouterName)
 	var bindings []libovsdbtest.TestData
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -15,6 +17,7 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/pod"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics/recorders"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
@@ -293,6 +296,8 @@ func (oc *SecondaryLocalnetNetworkController) init() error {
 		Type:      "localnet",
 		Options:   oc.localnetPortNetworkNameOptions(),
 	}
+	// in trunk mode the localnet port is left untagged so that tagged
+	// traffic is passed as is to the pods
 	intVlanID := int(oc.Vlan())
 	if intVlanID != 0 {
 		logicalSwitchPort.TagRequest = &intVlanID
@@ -304,6 +309,65 @@ func (oc *SecondaryLocalnetNetworkController) init() error {
 		return err
 	}
 
+	if len(oc.TrunkVlans()) > 0 {
+		if err := oc.addTrunkVLANACLs(switchName); err != nil {
+			return err
+		}
+	}
+
+	if oc.IsolatesNamespaces() {
+		if err := oc.syncNamespaceIsolation(); err != nil {
+			return fmt.Errorf("failed to sync namespace isolation for network %q: %w", oc.GetNetworkName(), err)
+		}
+	}
+
+	return nil
+}
+
+func getTrunkVLANACLdbIDs(controller, networkName string, aclDir libovsdbutil.ACLDirection) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.ACLTrunkVLAN, controller,
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey:      networkName,
+			libovsdbops.PolicyDirectionKey: string(aclDir),
+		})
+}
+
+// addTrunkVLANACLs drops the traffic, in both directions, that is not tagged
+// with one of the network trunk VLANs, untagged traffic included:
+// action match                                                priority
+// ------ ---------------------------------------------------- --------
+// drop   "!vlan.present || vlan.vid != {<TRUNK_VLANS>}"       1000
+func (oc *SecondaryLocalnetNetworkController) addTrunkVLANACLs(switchName string) error {
+	vlans := make([]string, 0, len(oc.TrunkVlans()))
+	for _, vlan := range oc.TrunkVlans() {
+		vlans = append(vlans, strconv.FormatUint(uint64(vlan), 10))
+	}
+	match := fmt.Sprintf("!vlan.present || vlan.vid != {%s}", strings.Join(vlans, ", "))
+
+	var acls []*nbdb.ACL
+	for _, aclDir := range []libovsdbutil.ACLDirection{libovsdbutil.ACLEgress, libovsdbutil.ACLIngress} {
+		acl := libovsdbutil.BuildACL(
+			getTrunkVLANACLdbIDs(oc.controllerName, oc.GetNetworkName(), aclDir),
+			types.TrunkVLANDenyPriority,
+			match,
+			nbdb.ACLActionDrop,
+			nil,
+			libovsdbutil.ACLDirectionToACLPipeline(aclDir))
+		acl.Tier = types.PrimaryACLTier
+		acls = append(acls, acl)
+	}
+
+	ops, err := libovsdbops.CreateOrUpdateACLsOps(oc.nbClient, nil, nil, acls...)
+	if err != nil {
+		return fmt.Errorf("failed to create or update trunk VLAN ACLs for network %s: %w", oc.GetNetworkName(), err)
+	}
+	ops, err = libovsdbops.AddACLsToLogicalSwitchOps(oc.nbClient, ops, switchName, acls...)
+	if err != nil {
+		return fmt.Errorf("failed to add trunk VLAN ACLs to switch %s: %w", switchName, err)
+	}
+	if _, err = libovsdbops.TransactAndCheck(oc.nbClient, ops); err != nil {
+		return fmt.Errorf("failed to configure trunk VLAN ACLs for network %s: %w", oc.GetNetworkName(), err)
+	}
 	return nil
 }
 
@@ -333,6 +397,12 @@ func (oc *SecondaryLocalnetNetworkController) initRetryFramework() {
 		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
 		oc.retryMultiNetworkPolicies = oc.newRetryFramework(factory.MultiNetworkPolicyType)
 	}
+
+	// Namespace isolation relies on the namespace port groups and address sets
+	// which are kept up to date by the namespace events.
+	if oc.IsolatesNamespaces() && oc.retryNamespaces == nil {
+		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
+	}
 }
 
 // newRetryFramework builds and returns a retry framework for the input resource type;
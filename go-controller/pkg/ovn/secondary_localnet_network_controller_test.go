@@ -0,0 +1,110 @@
+package ovn
+
+import (
+	cnitypes "github.com/containernetworking/cni/pkg/types"
+	nadapi "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	"github.com/urfave/cli/v2"
+
+	corev1 "k8s.io/api/core/v1"
+
+	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	ovntypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OVN localnet network controller", func() {
+	var (
+		app     *cli.App
+		fakeOvn *FakeOVN
+	)
+
+	BeforeEach(func() {
+		Expect(config.PrepareTestConfig()).To(Succeed()) // reset defaults
+
+		app = cli.NewApp()
+		app.Name = "test"
+		app.Flags = config.Flags
+
+		fakeOvn = NewFakeOVN(true)
+	})
+
+	AfterEach(func() {
+		fakeOvn.shutdown()
+	})
+
+	DescribeTable(
+		"passes the trunk VLANs traffic to the pods of a trunk network",
+		func(testConfig testConfiguration) {
+			config.OVNKubernetesFeature = *testConfig.configToOverride
+			app.Action = func(*cli.Context) error {
+				nad, err := newNetworkAttachmentDefinition(ns, nadName, ovncnitypes.NetConf{
+					NetConf:      cnitypes.NetConf{Name: secondaryNetworkName, Type: "ovn-k8s-cni-overlay"},
+					Topology:     ovntypes.LocalnetTopology,
+					NADName:      util.GetNADName(ns, nadName),
+					Role:         ovntypes.NetworkRoleSecondary,
+					TrunkVLANIDs: []int{20, 10},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				nad.Annotations = map[string]string{ovntypes.OvnNetworkIDAnnotation: secondaryNetworkID}
+
+				fakeOvn.startWithDBSetup(
+					libovsdbtest.TestSetup{},
+					&corev1.NamespaceList{Items: []corev1.Namespace{*newNamespace(ns)}},
+					&nadapi.NetworkAttachmentDefinitionList{Items: []nadapi.NetworkAttachmentDefinition{*nad}},
+				)
+				Expect(fakeOvn.networkManager.Start()).To(Succeed())
+				defer fakeOvn.networkManager.Stop()
+
+				controller, ok := fakeOvn.fullSecondaryLocalnetControllers[secondaryNetworkName]
+				Expect(ok).To(BeTrue())
+				Expect(controller.init()).To(Succeed())
+
+				By("asserting the network switch passes the VLAN tagged traffic through")
+				switchName := controller.GetNetworkScopedSwitchName(ovntypes.OVNLocalnetSwitch)
+				logicalSwitch, err := libovsdbops.GetLogicalSwitch(fakeOvn.nbClient, &nbdb.LogicalSwitch{Name: switchName})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(logicalSwitch.OtherConfig).To(HaveKeyWithValue("vlan-passthru", "true"))
+
+				By("asserting the localnet port is left untagged")
+				lsp, err := libovsdbops.GetLogicalSwitchPort(fakeOvn.nbClient,
+					&nbdb.LogicalSwitchPort{Name: controller.GetNetworkScopedName(ovntypes.OVNLocalnetPort)})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(lsp.Type).To(Equal("localnet"))
+				Expect(lsp.TagRequest).To(BeNil())
+				Expect(logicalSwitch.Ports).To(ContainElement(lsp.UUID))
+
+				By("asserting the traffic not tagged with a trunk VLAN is dropped in both directions")
+				for aclDir, direction := range map[libovsdbutil.ACLDirection]nbdb.ACLDirection{
+					libovsdbutil.ACLEgress:  nbdb.ACLDirectionFromLport,
+					libovsdbutil.ACLIngress: nbdb.ACLDirectionToLport,
+				} {
+					dbIDs := getTrunkVLANACLdbIDs(controller.controllerName, secondaryNetworkName, aclDir)
+					acls, err := libovsdbops.FindACLsWithPredicate(fakeOvn.nbClient, libovsdbops.GetPredicate[*nbdb.ACL](dbIDs, nil))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(acls).To(HaveLen(1))
+					acl := acls[0]
+					Expect(acl.Match).To(Equal("!vlan.present || vlan.vid != {10, 20}"))
+					Expect(acl.Action).To(Equal(nbdb.ACLActionDrop))
+					Expect(acl.Priority).To(Equal(ovntypes.TrunkVLANDenyPriority))
+					Expect(acl.Direction).To(Equal(direction))
+					Expect(acl.Tier).To(Equal(ovntypes.PrimaryACLTier))
+					Expect(logicalSwitch.ACLs).To(ContainElement(acl.UUID))
+				}
+
+				return nil
+			}
+
+			Expect(app.Run([]string{app.Name})).To(Succeed())
+		},
+		Entry("on a non-IC cluster", nonICClusterTestConfiguration()),
+		Entry("on an IC cluster", icClusterTestConfiguration()),
+	)
+})
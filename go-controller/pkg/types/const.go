@@ -65,6 +65,9 @@ const (
 
 	// types.OVNLayer2Switch is the name of layer2 topology switch
 	OVNLayer2Switch = "ovn_layer2_switch"
+	// types.OVNLayer2RARouter is the name of the router sending the IPv6 router
+	// advertisements of the layer2 secondary networks serving DHCP
+	OVNLayer2RARouter = "ovn_layer2_ra_router"
 	// types.OVNLocalnetSwitch is the name of localnet topology switch
 	OVNLocalnetSwitch = "ovn_localnet_switch"
 	// types.OVNLocalnetPort is the name of localnet topology localnet port
@@ -90,6 +93,18 @@ const (
 	AdvertisedNetworkPassPriority = 1100
 	// Deny priority for isolated advertised networks
 	AdvertisedNetworkDenyPriority = 1050
+	// Deny priority for VLAN tagged traffic not allowed on a localnet trunk
+	TrunkVLANDenyPriority = 1000
+	// Priorities of the observability sample points, lower than all other primary tier ACLs
+	LoadBalancerSamplePriority = 3
+	NATSamplePriority          = 2
+	RerouteSamplePriority      = 1
+
+	// ACL Baseline Tier Priorities
+
+	// Deny priority for the traffic between namespaces of an isolating network,
+	// lower than the BaselineAdminNetworkPolicy priorities
+	NamespaceIsolationDenyPriority = 1000
 
 	// ACL PlaceHolderACL Tier Priorities
 	PrimaryUDNAllowPriority = 1001
@@ -256,6 +271,11 @@ const (
 	// Logical Switch or Router Port
 	MaxLogicalPortTunnelKey = 32767
 
+	// Layer2RARouterPortTunnelKey is the tunnel key reserved, on every zone, for
+	// the switch port of the router sending the IPv6 router advertisements of
+	// the layer2 secondary networks serving DHCP
+	Layer2RARouterPortTunnelKey = MaxLogicalPortTunnelKey - 1
+
 	// InformerSyncTimeout is used when waiting for the initial informer cache sync
 	// (i.e. all existing objects should be listed by the informer).
 	// It allows ~5 list() retries with the default reflector exponential backoff config
@@ -31,14 +31,13 @@ func ValidateAndGetEgressFirewallDestination(egressFirewallDestination egressfir
 	err error) {
 	// Validate the egress firewall rule.
 	if egressFirewallDestination.DNSName != "" {
-		// Validate that DNS name is not wildcard when DNSNameResolver is not enabled.
-		if !config.OVNKubernetesFeature.EnableDNSNameResolver && IsWildcard(egressFirewallDestination.DNSName) {
+		// Validate that DNS name is not wildcard when neither DNSNameResolver nor DNS snooping is enabled.
+		if !IsWildcardDNSNameSupported() && IsWildcard(egressFirewallDestination.DNSName) {
 			return "", "", false, nil, fmt.Errorf("wildcard dns name is not supported as rule destination, %s", egressFirewallDestination.DNSName)
 		}
-		// Validate that DNS name if DNSNameResolver is enabled.
-		if config.OVNKubernetesFeature.EnableDNSNameResolver {
-			exp := regexp.MustCompile(dnsRegex)
-			if !exp.MatchString(egressFirewallDestination.DNSName) {
+		// Validate that DNS name if DNSNameResolver or DNS snooping is enabled.
+		if IsWildcardDNSNameSupported() {
+			if !IsValidDNSName(egressFirewallDestination.DNSName) {
 				return "", "", false, nil, fmt.Errorf("invalid dns name used as rule destination, %s", egressFirewallDestination.DNSName)
 			}
 		}
@@ -68,6 +67,12 @@ func ValidateAndGetEgressFirewallDestination(egressFirewallDestination egressfir
 	return
 }
 
+// IsValidDNSName checks if the domain name, which may be a wildcard domain
+// name, is valid.
+func IsValidDNSName(dnsName string) bool {
+	return regexp.MustCompile(dnsRegex).MatchString(dnsName)
+}
+
 // IsWildcard checks if the domain name is wildcard.
 func IsWildcard(dnsName string) bool {
 	return strings.HasPrefix(dnsName, "*.")
@@ -79,6 +84,13 @@ func IsDNSNameResolverEnabled() bool {
 	return config.OVNKubernetesFeature.EnableEgressFirewall && config.OVNKubernetesFeature.EnableDNSNameResolver
 }
 
+// IsWildcardDNSNameSupported returns true if either DNSNameResolver or DNS
+// snooping is enabled, which both support wildcard DNS names in the egress
+// firewall rules.
+func IsWildcardDNSNameSupported() bool {
+	return config.OVNKubernetesFeature.EnableDNSNameResolver || config.OVNKubernetesFeature.EnableDNSSnooping
+}
+
 // LowerCaseFQDN convert the DNS name to lower case fully qualified
 // domain name.
 func LowerCaseFQDN(dnsName string) string {
@@ -31,10 +31,14 @@ import (
 	egressqosfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1/apis/clientset/versioned/fake"
 	egressservice "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1"
 	egressservicefake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1/apis/clientset/versioned/fake"
+	externaloverlaypeer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1"
+	externaloverlaypeerfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1/apis/clientset/versioned/fake"
 	networkqos "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1"
 	networkqosfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1/apis/clientset/versioned/fake"
 	routeadvertisements "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1"
 	routeadvertisementsfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/clientset/versioned/fake"
+	samplingpolicy "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/samplingpolicy/v1"
+	samplingpolicyfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/samplingpolicy/v1/apis/clientset/versioned/fake"
 	udnv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
 	udnfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/clientset/versioned/fake"
 )
@@ -55,6 +59,8 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 	udnObjects := []runtime.Object{}
 	raObjects := []runtime.Object{}
 	frrObjects := []runtime.Object{}
+	eopObjects := []runtime.Object{}
+	samplingPolicyObjects := []runtime.Object{}
 	for _, object := range objects {
 		switch object.(type) {
 		case *egressip.EgressIP:
@@ -85,6 +91,10 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 			frrObjects = append(frrObjects, object)
 		case *networkqos.NetworkQoS:
 			networkQoSObjects = append(networkQoSObjects, object)
+		case *externaloverlaypeer.ExternalOverlayPeer:
+			eopObjects = append(eopObjects, object)
+		case *samplingpolicy.SamplingPolicy:
+			samplingPolicyObjects = append(samplingPolicyObjects, object)
 		default:
 			v1Objects = append(v1Objects, object)
 		}
@@ -113,6 +123,8 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 		RouteAdvertisementsClient: routeadvertisementsfake.NewSimpleClientset(raObjects...),
 		FRRClient:                 frrfake.NewSimpleClientset(frrObjects...),
 		NetworkQoSClient:          networkqosfake.NewSimpleClientset(networkQoSObjects...),
+		ExternalOverlayPeerClient: externaloverlaypeerfake.NewSimpleClientset(eopObjects...),
+		SamplingPolicyClient:      samplingpolicyfake.NewSimpleClientset(samplingPolicyObjects...),
 	}
 }
 
@@ -50,8 +50,10 @@ import (
 	egressipclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1/apis/clientset/versioned"
 	egressqosclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1/apis/clientset/versioned"
 	egressserviceclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1/apis/clientset/versioned"
+	externaloverlaypeerclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/externaloverlaypeer/v1/apis/clientset/versioned"
 	networkqosclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1/apis/clientset/versioned"
 	routeadvertisementsclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/clientset/versioned"
+	samplingpolicyclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/samplingpolicy/v1/apis/clientset/versioned"
 	userdefinednetworkclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/clientset/versioned"
 )
 
@@ -71,8 +73,10 @@ type OVNClientset struct {
 	IPAMClaimsClient          ipamclaimssclientset.Interface
 	UserDefinedNetworkClient  userdefinednetworkclientset.Interface
 	RouteAdvertisementsClient routeadvertisementsclientset.Interface
+	ExternalOverlayPeerClient externaloverlaypeerclientset.Interface
 	FRRClient                 frrclientset.Interface
 	NetworkQoSClient          networkqosclientset.Interface
+	SamplingPolicyClient      samplingpolicyclientset.Interface
 }
 
 // OVNMasterClientset
@@ -91,8 +95,10 @@ type OVNMasterClientset struct {
 	NetworkAttchDefClient     networkattchmentdefclientset.Interface
 	UserDefinedNetworkClient  userdefinednetworkclientset.Interface
 	RouteAdvertisementsClient routeadvertisementsclientset.Interface
+	ExternalOverlayPeerClient externaloverlaypeerclientset.Interface
 	FRRClient                 frrclientset.Interface
 	NetworkQoSClient          networkqosclientset.Interface
+	SamplingPolicyClient      samplingpolicyclientset.Interface
 }
 
 // OVNKubeControllerClientset
@@ -111,6 +117,7 @@ type OVNKubeControllerClientset struct {
 	UserDefinedNetworkClient  userdefinednetworkclientset.Interface
 	RouteAdvertisementsClient routeadvertisementsclientset.Interface
 	NetworkQoSClient          networkqosclientset.Interface
+	SamplingPolicyClient      samplingpolicyclientset.Interface
 }
 
 type OVNNodeClientset struct {
@@ -121,6 +128,7 @@ type OVNNodeClientset struct {
 	NetworkAttchDefClient     networkattchmentdefclientset.Interface
 	UserDefinedNetworkClient  userdefinednetworkclientset.Interface
 	RouteAdvertisementsClient routeadvertisementsclientset.Interface
+	ExternalOverlayPeerClient externaloverlaypeerclientset.Interface
 }
 
 type OVNClusterManagerClientset struct {
@@ -167,8 +175,10 @@ func (cs *OVNClientset) GetMasterClientset() *OVNMasterClientset {
 		NetworkAttchDefClient:     cs.NetworkAttchDefClient,
 		UserDefinedNetworkClient:  cs.UserDefinedNetworkClient,
 		RouteAdvertisementsClient: cs.RouteAdvertisementsClient,
+		ExternalOverlayPeerClient: cs.ExternalOverlayPeerClient,
 		FRRClient:                 cs.FRRClient,
 		NetworkQoSClient:          cs.NetworkQoSClient,
+		SamplingPolicyClient:      cs.SamplingPolicyClient,
 	}
 }
 
@@ -188,6 +198,7 @@ func (cs *OVNMasterClientset) GetOVNKubeControllerClientset() *OVNKubeController
 		UserDefinedNetworkClient:  cs.UserDefinedNetworkClient,
 		RouteAdvertisementsClient: cs.RouteAdvertisementsClient,
 		NetworkQoSClient:          cs.NetworkQoSClient,
+		SamplingPolicyClient:      cs.SamplingPolicyClient,
 	}
 }
 
@@ -207,6 +218,7 @@ func (cs *OVNClientset) GetOVNKubeControllerClientset() *OVNKubeControllerClient
 		UserDefinedNetworkClient:  cs.UserDefinedNetworkClient,
 		RouteAdvertisementsClient: cs.RouteAdvertisementsClient,
 		NetworkQoSClient:          cs.NetworkQoSClient,
+		SamplingPolicyClient:      cs.SamplingPolicyClient,
 	}
 }
 
@@ -239,6 +251,7 @@ func (cs *OVNClientset) GetNodeClientset() *OVNNodeClientset {
 		NetworkAttchDefClient:     cs.NetworkAttchDefClient,
 		UserDefinedNetworkClient:  cs.UserDefinedNetworkClient,
 		RouteAdvertisementsClient: cs.RouteAdvertisementsClient,
+		ExternalOverlayPeerClient: cs.ExternalOverlayPeerClient,
 	}
 }
 
@@ -249,6 +262,7 @@ func (cs *OVNMasterClientset) GetNodeClientset() *OVNNodeClientset {
 		EgressIPClient:            cs.EgressIPClient,
 		NetworkAttchDefClient:     cs.NetworkAttchDefClient,
 		RouteAdvertisementsClient: cs.RouteAdvertisementsClient,
+		ExternalOverlayPeerClient: cs.ExternalOverlayPeerClient,
 	}
 }
 
@@ -526,6 +540,11 @@ func NewOVNClientset(conf *config.KubernetesConfig) (*OVNClientset, error) {
 		return nil, err
 	}
 
+	externalOverlayPeerClientset, err := externaloverlaypeerclientset.NewForConfig(kconfig)
+	if err != nil {
+		return nil, err
+	}
+
 	frrClientset, err := frrclientset.NewForConfig(kconfig)
 	if err != nil {
 		return nil, err
@@ -536,6 +555,11 @@ func NewOVNClientset(conf *config.KubernetesConfig) (*OVNClientset, error) {
 		return nil, err
 	}
 
+	samplingPolicyClientset, err := samplingpolicyclientset.NewForConfig(kconfig)
+	if err != nil {
+		return nil, err
+	}
+
 	return &OVNClientset{
 		KubeClient:                kclientset,
 		ANPClient:                 anpClientset,
@@ -551,8 +575,10 @@ func NewOVNClientset(conf *config.KubernetesConfig) (*OVNClientset, error) {
 		IPAMClaimsClient:          ipamClaimsClientset,
 		UserDefinedNetworkClient:  userDefinedNetworkClientSet,
 		RouteAdvertisementsClient: routeAdvertisementsClientset,
+		ExternalOverlayPeerClient: externalOverlayPeerClientset,
 		FRRClient:                 frrClientset,
 		NetworkQoSClient:          networkqosClientset,
+		SamplingPolicyClient:      samplingPolicyClientset,
 	}, nil
 }
 
@@ -874,6 +900,25 @@ func GetLocalEligibleEndpointAddressesFromSlices(endpointSlices []*discovery.End
 	return sets.New(endpoints...)
 }
 
+// GetNodesWithLocalEligibleEndpointsFromSlices returns the set of nodes that
+// have local eligible endpoints in the given endpoint slices.
+func GetNodesWithLocalEligibleEndpointsFromSlices(endpointSlices []*discovery.EndpointSlice, service *corev1.Service) sets.Set[string] {
+	endpointsByNode := map[string][]discovery.Endpoint{}
+	for _, endpoint := range getEndpointsFromEndpointSlices(endpointSlices) {
+		if endpoint.NodeName == nil || *endpoint.NodeName == "" {
+			continue
+		}
+		endpointsByNode[*endpoint.NodeName] = append(endpointsByNode[*endpoint.NodeName], endpoint)
+	}
+	nodes := sets.New[string]()
+	for node, endpoints := range endpointsByNode {
+		if len(getEligibleEndpoints(endpoints, service)) > 0 {
+			nodes.Insert(node)
+		}
+	}
+	return nodes
+}
+
 // DoesEndpointSliceContainEndpoint returns true if the endpointslice
 // contains an endpoint with the given IP, port and Protocol and if this endpoint is considered eligible.
 func DoesEndpointSliceContainEligibleEndpoint(endpointSlice *discovery.EndpointSlice,
@@ -800,6 +800,57 @@ func TestGetEligibleEndpointAddresses(t *testing.T) {
 	}
 }
 
+func TestGetNodesWithLocalEligibleEndpointsFromSlices(t *testing.T) {
+	service := getSampleService(false)
+	var tests = []struct {
+		name      string
+		endpoints []discovery.Endpoint
+		want      sets.Set[string]
+	}{
+		{
+			"Get nodes from an endpointslice with ready endpoints on multiple nodes",
+			[]discovery.Endpoint{
+				kubetest.MakeReadyEndpoint(testNode, ep1Address),
+				kubetest.MakeReadyEndpoint(otherNode, ep2Address),
+			},
+			sets.New(testNode, otherNode),
+		},
+		{
+			"Get nodes from an endpointslice where the endpoints of a node are serving and terminating and the endpoints of another node are ready",
+			[]discovery.Endpoint{
+				kubetest.MakeTerminatingServingEndpoint(testNode, ep1Address),
+				kubetest.MakeReadyEndpoint(otherNode, ep2Address),
+			},
+			sets.New(testNode, otherNode), // fallback to serving&terminating applies per node
+		},
+		{
+			"Get nodes from an endpointslice where the endpoints of a node are terminating and not serving",
+			[]discovery.Endpoint{
+				kubetest.MakeTerminatingNonServingEndpoint(testNode, ep1Address),
+				kubetest.MakeReadyEndpoint(otherNode, ep2Address),
+			},
+			sets.New(otherNode),
+		},
+		{
+			"Get nodes from an endpointslice with endpoints not assigned to a node",
+			[]discovery.Endpoint{
+				{Addresses: []string{ep1Address}},
+			},
+			sets.New[string](),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slice := &discovery.EndpointSlice{Endpoints: tt.endpoints}
+			answer := GetNodesWithLocalEligibleEndpointsFromSlices([]*discovery.EndpointSlice{slice}, service)
+			if !answer.Equal(tt.want) {
+				t.Errorf("got %v, want %v", sets.List(answer), sets.List(tt.want))
+			}
+		})
+	}
+}
+
 func TestDoesEndpointSliceContainEligibleEndpoint(t *testing.T) {
 	service := getSampleService(false)
 	var tests = []struct {
@@ -78,6 +78,44 @@ func (_m *NetInfo) ExcludeSubnets() []*net.IPNet {
 	return r0
 }
 
+// ExternalIPs provides a mock function with given fields:
+func (_m *NetInfo) ExternalIPs() map[string][]net.IP {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExternalIPs")
+	}
+
+	var r0 map[string][]net.IP
+	if rf, ok := ret.Get(0).(func() map[string][]net.IP); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]net.IP)
+		}
+	}
+
+	return r0
+}
+
+// GetEVPNVNI provides a mock function with given fields:
+func (_m *NetInfo) GetEVPNVNI() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEVPNVNI")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
 // GetEgressIPAdvertisedNodes provides a mock function with given fields:
 func (_m *NetInfo) GetEgressIPAdvertisedNodes() []string {
 	ret := _m.Called()
@@ -490,6 +528,26 @@ func (_m *NetInfo) GetPodNetworkAdvertisedVRFs() map[string][]string {
 	return r0
 }
 
+// GetRouteImportPolicy provides a mock function with given fields:
+func (_m *NetInfo) GetRouteImportPolicy() *util.RouteImportPolicy {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRouteImportPolicy")
+	}
+
+	var r0 *util.RouteImportPolicy
+	if rf, ok := ret.Get(0).(func() *util.RouteImportPolicy); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*util.RouteImportPolicy)
+		}
+	}
+
+	return r0
+}
+
 // HasNAD provides a mock function with given fields: nadName
 func (_m *NetInfo) HasNAD(nadName string) bool {
 	ret := _m.Called(nadName)
@@ -590,6 +648,24 @@ func (_m *NetInfo) IsSecondary() bool {
 	return r0
 }
 
+// IsolatesNamespaces provides a mock function with given fields:
+func (_m *NetInfo) IsolatesNamespaces() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsolatesNamespaces")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // JoinSubnetV4 provides a mock function with given fields:
 func (_m *NetInfo) JoinSubnetV4() *net.IPNet {
 	ret := _m.Called()
@@ -704,6 +780,24 @@ func (_m *NetInfo) RemoveNetworkScopeFromName(name string) string {
 	return r0
 }
 
+// ServesDHCP provides a mock function with given fields:
+func (_m *NetInfo) ServesDHCP() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServesDHCP")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // Subnets provides a mock function with given fields:
 func (_m *NetInfo) Subnets() []config.CIDRNetworkEntry {
 	ret := _m.Called()
@@ -742,6 +836,26 @@ func (_m *NetInfo) TopologyType() string {
 	return r0
 }
 
+// TrunkVlans provides a mock function with given fields:
+func (_m *NetInfo) TrunkVlans() []uint {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrunkVlans")
+	}
+
+	var r0 []uint
+	if rf, ok := ret.Get(0).(func() []uint); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint)
+		}
+	}
+
+	return r0
+}
+
 // Vlan provides a mock function with given fields:
 func (_m *NetInfo) Vlan() uint {
 	ret := _m.Called()
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -37,17 +38,24 @@ type NetInfo interface {
 	IsPrimaryNetwork() bool
 	IsSecondary() bool
 	TopologyType() string
-	MTU() int
 	IPMode() (bool, bool)
-	Subnets() []config.CIDRNetworkEntry
 	ExcludeSubnets() []*net.IPNet
 	JoinSubnetV4() *net.IPNet
 	JoinSubnetV6() *net.IPNet
 	JoinSubnets() []*net.IPNet
 	Vlan() uint
+	TrunkVlans() []uint
 	AllowsPersistentIPs() bool
+	ServesDHCP() bool
+	IsolatesNamespaces() bool
+	ExternalIPs() map[string][]net.IP
 	PhysicalNetworkName() string
 
+	// configuration that can be updated in place: the MTU can change and
+	// subnets can be appended to a layer3 network.
+	MTU() int
+	Subnets() []config.CIDRNetworkEntry
+
 	// dynamic information, can change over time
 	GetNADs() []string
 	EqualNADs(nads ...string) bool
@@ -67,6 +75,12 @@ type NetInfo interface {
 	// GetEgressIPAdvertisedNodes return the nodes where egress IP are
 	// advertised.
 	GetEgressIPAdvertisedNodes() []string
+	// GetEVPNVNI returns the L3 VNI the pod network is advertised with as EVPN
+	// route type-5, 0 if not advertised through EVPN.
+	GetEVPNVNI() int
+	// GetRouteImportPolicy returns the policy restricting the BGP routes
+	// imported into the network, nil if not restricted.
+	GetRouteImportPolicy() *RouteImportPolicy
 
 	// derived information.
 	GetNADNamespaces() []string
@@ -114,6 +128,28 @@ type MutableNetInfo interface {
 
 	// Nodes advertising Egress IP
 	SetEgressIPAdvertisedVRFs(eipAdvertisements map[string][]string)
+
+	// L3 VNI the pod network is advertised with through EVPN
+	SetEVPNVNI(vni int)
+
+	// Policy restricting the BGP routes imported into the network
+	SetRouteImportPolicy(policy *RouteImportPolicy)
+}
+
+// RouteImportPolicy restricts the BGP routes imported into a network.
+type RouteImportPolicy struct {
+	// AllowedPrefixes limits the imported routes to those with a destination
+	// contained in any of these prefixes, any destination if empty.
+	AllowedPrefixes []*net.IPNet
+	// AllowedNextHops limits the imported routes to those with a next hop
+	// contained in any of these prefixes, any next hop if empty.
+	AllowedNextHops []*net.IPNet
+	// MaxPrefixes is the maximum number of prefixes imported, no maximum if 0.
+	MaxPrefixes int
+	// WithdrawOnMaxPrefixes determines that all the imported routes are
+	// withdrawn instead of just not importing new routes when the maximum
+	// number of prefixes is exceeded.
+	WithdrawOnMaxPrefixes bool
 }
 
 // NewMutableNetInfo builds a copy of netInfo as a MutableNetInfo
@@ -177,6 +213,23 @@ func DoesNetworkNeedReconciliation(l, r NetInfo) bool {
 	return reconcilable(l).needsReconcile(r)
 }
 
+// ReconcileNetworkConfig updates the network configuration that can be changed
+// in place, that is the MTU and the subnets, from a compatible network. Unlike
+// ReconcileNetInfo, dynamic information like the NADs referencing the network
+// are left untouched.
+func ReconcileNetworkConfig(to MutableNetInfo, from NetInfo) error {
+	if from == nil || to == nil {
+		return fmt.Errorf("can't reconcile a nil network")
+	}
+	if !AreNetworksCompatible(to, from) {
+		return fmt.Errorf("can't reconcile from incompatible network")
+	}
+	if t, ok := to.GetNetInfo().(*secondaryNetInfo); ok {
+		t.setConfig(from.MTU(), from.Subnets())
+	}
+	return nil
+}
+
 // ReconcileNetInfo reconciles the dynamic network configuration
 func ReconcileNetInfo(to ReconcilableNetInfo, from NetInfo) error {
 	if from == nil || to == nil {
@@ -222,6 +275,8 @@ type mutableNetInfo struct {
 	nads                     sets.Set[string]
 	podNetworkAdvertisements map[string][]string
 	eipAdvertisements        map[string][]string
+	evpnVNI                  int
+	routeImportPolicy        *RouteImportPolicy
 
 	// information generated from previous fields, not used in comparisons
 
@@ -262,7 +317,9 @@ func (l *mutableNetInfo) equals(r *mutableNetInfo) bool {
 	return reflect.DeepEqual(l.id, r.id) &&
 		reflect.DeepEqual(l.nads, r.nads) &&
 		reflect.DeepEqual(l.podNetworkAdvertisements, r.podNetworkAdvertisements) &&
-		reflect.DeepEqual(l.eipAdvertisements, r.eipAdvertisements)
+		reflect.DeepEqual(l.eipAdvertisements, r.eipAdvertisements) &&
+		l.evpnVNI == r.evpnVNI &&
+		reflect.DeepEqual(l.routeImportPolicy, r.routeImportPolicy)
 }
 
 func (l *mutableNetInfo) copyFrom(r *mutableNetInfo) {
@@ -275,6 +332,8 @@ func (l *mutableNetInfo) copyFrom(r *mutableNetInfo) {
 	aux.nads = r.nads.Clone()
 	aux.setPodNetworkAdvertisedOnVRFs(r.podNetworkAdvertisements)
 	aux.setEgressIPAdvertisedAtNodes(r.eipAdvertisements)
+	aux.evpnVNI = r.evpnVNI
+	aux.routeImportPolicy = r.routeImportPolicy
 	aux.namespaces = r.namespaces.Clone()
 	r.RUnlock()
 	l.Lock()
@@ -283,6 +342,8 @@ func (l *mutableNetInfo) copyFrom(r *mutableNetInfo) {
 	l.nads = aux.nads
 	l.podNetworkAdvertisements = aux.podNetworkAdvertisements
 	l.eipAdvertisements = aux.eipAdvertisements
+	l.evpnVNI = aux.evpnVNI
+	l.routeImportPolicy = aux.routeImportPolicy
 	l.namespaces = aux.namespaces
 }
 
@@ -368,6 +429,32 @@ func (nInfo *mutableNetInfo) GetEgressIPAdvertisedNodes() []string {
 	return maps.Keys(nInfo.eipAdvertisements)
 }
 
+func (nInfo *mutableNetInfo) SetEVPNVNI(vni int) {
+	nInfo.Lock()
+	defer nInfo.Unlock()
+	nInfo.evpnVNI = vni
+}
+
+func (nInfo *mutableNetInfo) GetEVPNVNI() int {
+	nInfo.RLock()
+	defer nInfo.RUnlock()
+	return nInfo.evpnVNI
+}
+
+// SetRouteImportPolicy sets the policy restricting the BGP routes imported
+// into the network. The policy is not expected to be modified afterwards.
+func (nInfo *mutableNetInfo) SetRouteImportPolicy(policy *RouteImportPolicy) {
+	nInfo.Lock()
+	defer nInfo.Unlock()
+	nInfo.routeImportPolicy = policy
+}
+
+func (nInfo *mutableNetInfo) GetRouteImportPolicy() *RouteImportPolicy {
+	nInfo.RLock()
+	defer nInfo.RUnlock()
+	return nInfo.routeImportPolicy
+}
+
 // GetNADs returns all the NADs associated with this network
 func (nInfo *mutableNetInfo) GetNADs() []string {
 	nInfo.RLock()
@@ -624,11 +711,31 @@ func (nInfo *DefaultNetInfo) Vlan() uint {
 	return config.Gateway.VLANID
 }
 
+// TrunkVlans has no impact on defaultNetConfInfo (localnet feature)
+func (nInfo *DefaultNetInfo) TrunkVlans() []uint {
+	return nil
+}
+
 // AllowsPersistentIPs returns the defaultNetConfInfo's AllowPersistentIPs value
 func (nInfo *DefaultNetInfo) AllowsPersistentIPs() bool {
 	return false
 }
 
+// ServesDHCP has no impact on defaultNetConfInfo (layer2 feature)
+func (nInfo *DefaultNetInfo) ServesDHCP() bool {
+	return false
+}
+
+// IsolatesNamespaces has no impact on defaultNetConfInfo (user defined network feature)
+func (nInfo *DefaultNetInfo) IsolatesNamespaces() bool {
+	return false
+}
+
+// ExternalIPs has no impact on defaultNetConfInfo (user defined network feature)
+func (nInfo *DefaultNetInfo) ExternalIPs() map[string][]net.IP {
+	return nil
+}
+
 // PhysicalNetworkName has no impact on defaultNetConfInfo (localnet feature)
 func (nInfo *DefaultNetInfo) PhysicalNetworkName() string {
 	return ""
@@ -645,7 +752,11 @@ type secondaryNetInfo struct {
 	topology           string
 	mtu                int
 	vlan               uint
+	trunkVlans         []uint
 	allowPersistentIPs bool
+	enableDHCP         bool
+	isolateNamespaces  bool
+	externalIPs        map[string][]net.IP
 
 	ipv4mode, ipv6mode bool
 	subnets            []config.CIDRNetworkEntry
@@ -757,6 +868,8 @@ func (nInfo *secondaryNetInfo) TopologyType() string {
 
 // MTU returns the layer3NetConfInfo's MTU value
 func (nInfo *secondaryNetInfo) MTU() int {
+	nInfo.RLock()
+	defer nInfo.RUnlock()
 	return nInfo.mtu
 }
 
@@ -765,11 +878,33 @@ func (nInfo *secondaryNetInfo) Vlan() uint {
 	return nInfo.vlan
 }
 
+// TrunkVlans returns the VLANs passed tagged to the pods
+func (nInfo *secondaryNetInfo) TrunkVlans() []uint {
+	return nInfo.trunkVlans
+}
+
 // AllowsPersistentIPs returns the defaultNetConfInfo's AllowPersistentIPs value
 func (nInfo *secondaryNetInfo) AllowsPersistentIPs() bool {
 	return nInfo.allowPersistentIPs
 }
 
+// ServesDHCP returns whether OVN serves DHCP to all the pods of the network
+func (nInfo *secondaryNetInfo) ServesDHCP() bool {
+	return nInfo.enableDHCP
+}
+
+// IsolatesNamespaces returns whether the traffic between pods of different
+// namespaces attached to the network is dropped
+func (nInfo *secondaryNetInfo) IsolatesNamespaces() bool {
+	return nInfo.isolateNamespaces
+}
+
+// ExternalIPs returns, per node, the IPs the traffic of the network egressing
+// the node is SNATed to
+func (nInfo *secondaryNetInfo) ExternalIPs() map[string][]net.IP {
+	return nInfo.externalIPs
+}
+
 // PhysicalNetworkName returns the user provided physical network name value
 func (nInfo *secondaryNetInfo) PhysicalNetworkName() string {
 	return nInfo.physicalNetworkName
@@ -782,9 +917,18 @@ func (nInfo *secondaryNetInfo) IPMode() (bool, bool) {
 
 // Subnets returns the Subnets value
 func (nInfo *secondaryNetInfo) Subnets() []config.CIDRNetworkEntry {
+	nInfo.RLock()
+	defer nInfo.RUnlock()
 	return nInfo.subnets
 }
 
+func (nInfo *secondaryNetInfo) setConfig(mtu int, subnets []config.CIDRNetworkEntry) {
+	nInfo.Lock()
+	defer nInfo.Unlock()
+	nInfo.mtu = mtu
+	nInfo.subnets = subnets
+}
+
 // ExcludeSubnets returns the ExcludeSubnets value
 func (nInfo *secondaryNetInfo) ExcludeSubnets() []*net.IPNet {
 	return nInfo.excludeSubnets
@@ -828,15 +972,24 @@ func (nInfo *secondaryNetInfo) canReconcile(other NetInfo) bool {
 	if nInfo.topology != other.TopologyType() {
 		return false
 	}
-	if nInfo.mtu != other.MTU() {
+	if nInfo.vlan != other.Vlan() {
 		return false
 	}
-	if nInfo.vlan != other.Vlan() {
+	if !reflect.DeepEqual(nInfo.trunkVlans, other.TrunkVlans()) {
 		return false
 	}
 	if nInfo.allowPersistentIPs != other.AllowsPersistentIPs() {
 		return false
 	}
+	if nInfo.enableDHCP != other.ServesDHCP() {
+		return false
+	}
+	if nInfo.isolateNamespaces != other.IsolatesNamespaces() {
+		return false
+	}
+	if !reflect.DeepEqual(nInfo.externalIPs, other.ExternalIPs()) {
+		return false
+	}
 	if nInfo.primaryNetwork != other.IsPrimaryNetwork() {
 		return false
 	}
@@ -844,8 +997,7 @@ func (nInfo *secondaryNetInfo) canReconcile(other NetInfo) bool {
 		return false
 	}
 
-	lessCIDRNetworkEntry := func(a, b config.CIDRNetworkEntry) bool { return a.String() < b.String() }
-	if !cmp.Equal(nInfo.subnets, other.Subnets(), cmpopts.SortSlices(lessCIDRNetworkEntry)) {
+	if !nInfo.canReconcileSubnets(other) {
 		return false
 	}
 
@@ -856,18 +1008,68 @@ func (nInfo *secondaryNetInfo) canReconcile(other NetInfo) bool {
 	return cmp.Equal(nInfo.joinSubnets, other.JoinSubnets(), cmpopts.SortSlices(lessIPNet))
 }
 
+// canReconcileSubnets checks that the other network subnets are the same or,
+// for layer3 and layer2 networks, that they append subnets of the IP families
+// already in use. Localnet subnets can't be appended to as they belong to the
+// physical network.
+func (nInfo *secondaryNetInfo) canReconcileSubnets(other NetInfo) bool {
+	subnets, otherSubnets := nInfo.Subnets(), other.Subnets()
+	lessCIDRNetworkEntry := func(a, b config.CIDRNetworkEntry) bool { return a.String() < b.String() }
+	if cmp.Equal(subnets, otherSubnets, cmpopts.SortSlices(lessCIDRNetworkEntry)) {
+		return true
+	}
+	if nInfo.topology != types.Layer3Topology && nInfo.topology != types.Layer2Topology {
+		return false
+	}
+	ipv4Mode, ipv6Mode := getIPMode(otherSubnets)
+	if ipv4Mode != nInfo.ipv4mode || ipv6Mode != nInfo.ipv6mode {
+		return false
+	}
+	otherSubnetSet := sets.New[string]()
+	for _, subnet := range otherSubnets {
+		otherSubnetSet.Insert(subnet.String())
+	}
+	for _, subnet := range subnets {
+		if !otherSubnetSet.Has(subnet.String()) {
+			return false
+		}
+	}
+	return true
+}
+
+// needsReconcile checks if the other network holds differences either on the
+// configuration that can be updated in place or on its dynamic information.
+func (nInfo *secondaryNetInfo) needsReconcile(other NetInfo) bool {
+	if nInfo.MTU() != other.MTU() || !reflect.DeepEqual(nInfo.Subnets(), other.Subnets()) {
+		return true
+	}
+	return nInfo.mutableNetInfo.needsReconcile(other)
+}
+
+// reconcile copies the configuration that can be updated in place as well as
+// the dynamic information from the other network.
+func (nInfo *secondaryNetInfo) reconcile(other NetInfo) {
+	nInfo.setConfig(other.MTU(), other.Subnets())
+	nInfo.mutableNetInfo.reconcile(other)
+}
+
 func (nInfo *secondaryNetInfo) copy() *secondaryNetInfo {
-	// everything here is immutable
+	// everything here is immutable except for the mtu and subnets which can
+	// be updated in place
 	c := &secondaryNetInfo{
 		netName:             nInfo.netName,
 		primaryNetwork:      nInfo.primaryNetwork,
 		topology:            nInfo.topology,
-		mtu:                 nInfo.mtu,
+		mtu:                 nInfo.MTU(),
 		vlan:                nInfo.vlan,
+		trunkVlans:          nInfo.trunkVlans,
 		allowPersistentIPs:  nInfo.allowPersistentIPs,
+		enableDHCP:          nInfo.enableDHCP,
+		isolateNamespaces:   nInfo.isolateNamespaces,
+		externalIPs:         nInfo.externalIPs,
 		ipv4mode:            nInfo.ipv4mode,
 		ipv6mode:            nInfo.ipv6mode,
-		subnets:             nInfo.subnets,
+		subnets:             nInfo.Subnets(),
 		excludeSubnets:      nInfo.excludeSubnets,
 		joinSubnets:         nInfo.joinSubnets,
 		physicalNetworkName: nInfo.physicalNetworkName,
@@ -888,18 +1090,23 @@ func newLayer3NetConfInfo(netconf *ovncnitypes.NetConf) (MutableNetInfo, error)
 		return nil, err
 	}
 	ni := &secondaryNetInfo{
-		netName:        netconf.Name,
-		primaryNetwork: netconf.Role == types.NetworkRolePrimary,
-		topology:       types.Layer3Topology,
-		subnets:        subnets,
-		joinSubnets:    joinSubnets,
-		mtu:            netconf.MTU,
+		netName:           netconf.Name,
+		primaryNetwork:    netconf.Role == types.NetworkRolePrimary,
+		topology:          types.Layer3Topology,
+		subnets:           subnets,
+		joinSubnets:       joinSubnets,
+		mtu:               netconf.MTU,
+		isolateNamespaces: netconf.IsolateNamespaces,
 		mutableNetInfo: mutableNetInfo{
 			id:   types.InvalidID,
 			nads: sets.Set[string]{},
 		},
 	}
 	ni.ipv4mode, ni.ipv6mode = getIPMode(subnets)
+	ni.externalIPs, err = parseExternalIPs(netconf.ExternalIPs, ni.ipv4mode, ni.ipv6mode)
+	if err != nil {
+		return nil, err
+	}
 	return ni, nil
 }
 
@@ -921,12 +1128,18 @@ func newLayer2NetConfInfo(netconf *ovncnitypes.NetConf) (MutableNetInfo, error)
 		excludeSubnets:     excludes,
 		mtu:                netconf.MTU,
 		allowPersistentIPs: netconf.AllowPersistentIPs,
+		enableDHCP:         netconf.EnableDHCP,
+		isolateNamespaces:  netconf.IsolateNamespaces,
 		mutableNetInfo: mutableNetInfo{
 			id:   types.InvalidID,
 			nads: sets.Set[string]{},
 		},
 	}
 	ni.ipv4mode, ni.ipv6mode = getIPMode(subnets)
+	ni.externalIPs, err = parseExternalIPs(netconf.ExternalIPs, ni.ipv4mode, ni.ipv6mode)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s netconf %s: %v", netconf.Topology, netconf.Name, err)
+	}
 	return ni, nil
 }
 
@@ -943,7 +1156,9 @@ func newLocalnetNetConfInfo(netconf *ovncnitypes.NetConf) (MutableNetInfo, error
 		excludeSubnets:      excludes,
 		mtu:                 netconf.MTU,
 		vlan:                uint(netconf.VLANID),
+		trunkVlans:          trunkVlans(netconf.TrunkVLANIDs),
 		allowPersistentIPs:  netconf.AllowPersistentIPs,
+		isolateNamespaces:   netconf.IsolateNamespaces,
 		physicalNetworkName: netconf.PhysicalNetworkName,
 		mutableNetInfo: mutableNetInfo{
 			id:   types.InvalidID,
@@ -954,6 +1169,19 @@ func newLocalnetNetConfInfo(netconf *ovncnitypes.NetConf) (MutableNetInfo, error
 	return ni, nil
 }
 
+// trunkVlans returns the sorted trunk VLAN IDs
+func trunkVlans(vlanIDs []int) []uint {
+	if len(vlanIDs) == 0 {
+		return nil
+	}
+	vlans := make([]uint, 0, len(vlanIDs))
+	for _, vlanID := range vlanIDs {
+		vlans = append(vlans, uint(vlanID))
+	}
+	slices.Sort(vlans)
+	return vlans
+}
+
 func parseSubnets(subnetsString, excludeSubnetsString, topology string) ([]config.CIDRNetworkEntry, []*net.IPNet, error) {
 	var parseSubnets func(clusterSubnetCmd string) ([]config.CIDRNetworkEntry, error)
 	switch topology {
@@ -1038,6 +1266,38 @@ func parseJoinSubnet(joinSubnet string) ([]*net.IPNet, error) {
 	return joinSubnets, nil
 }
 
+// parseExternalIPs parses the comma-separated external IPs of every node,
+// checking there is at most one IP per node of each of the IP families used by
+// the network.
+func parseExternalIPs(externalIPs map[string]string, ipv4Mode, ipv6Mode bool) (map[string][]net.IP, error) {
+	if len(externalIPs) == 0 {
+		return nil, nil
+	}
+	parsed := make(map[string][]net.IP, len(externalIPs))
+	for node, ipsStr := range externalIPs {
+		if node == "" {
+			return nil, fmt.Errorf("external IPs %q provided for an empty node name", ipsStr)
+		}
+		var hasV4, hasV6 bool
+		var ips []net.IP
+		for _, ipStr := range strings.Split(ipsStr, ",") {
+			ip := net.ParseIP(strings.TrimSpace(ipStr))
+			if ip == nil {
+				return nil, fmt.Errorf("invalid external IP %q for node %s", ipStr, node)
+			}
+			isV6 := knet.IsIPv6(ip)
+			if isV6 && (hasV6 || !ipv6Mode) || !isV6 && (hasV4 || !ipv4Mode) {
+				return nil, fmt.Errorf("unexpected external IP %s for node %s: expecting at most one IP of each "+
+					"IP family of the network", ip, node)
+			}
+			hasV4, hasV6 = hasV4 || !isV6, hasV6 || isV6
+			ips = append(ips, ip)
+		}
+		parsed[node] = ips
+	}
+	return parsed, nil
+}
+
 func getIPMode(subnets []config.CIDRNetworkEntry) (bool, bool) {
 	var ipv6Mode, ipv4Mode bool
 	for _, subnet := range subnets {
@@ -1177,6 +1437,45 @@ func ValidateNetConf(nadName string, netconf *ovncnitypes.NetConf) error {
 		return fmt.Errorf("layer3 topology does not allow persistent IPs")
 	}
 
+	if len(netconf.TrunkVLANIDs) > 0 {
+		if netconf.Topology != types.LocalnetTopology {
+			return fmt.Errorf("%s topology does not allow trunk VLANs", netconf.Topology)
+		}
+		if netconf.VLANID != 0 {
+			return fmt.Errorf("trunk VLANs and access VLAN %d are mutually exclusive", netconf.VLANID)
+		}
+		if netconf.Subnets != "" {
+			return fmt.Errorf("trunk VLANs and subnets are mutually exclusive")
+		}
+		for _, vlanID := range netconf.TrunkVLANIDs {
+			if vlanID < 1 || vlanID > 4094 {
+				return fmt.Errorf("invalid trunk VLAN ID %d, must be in range 1-4094", vlanID)
+			}
+		}
+	}
+
+	if netconf.EnableDHCP && netconf.Topology != types.Layer2Topology {
+		return fmt.Errorf("%s topology does not allow enabling DHCP", netconf.Topology)
+	}
+
+	if netconf.EnableDHCP && netconf.Subnets == "" {
+		return fmt.Errorf("enabling DHCP requires subnets to be set")
+	}
+
+	if netconf.IsolateNamespaces && netconf.Subnets == "" {
+		return fmt.Errorf("isolating namespaces requires subnets to be set")
+	}
+
+	if len(netconf.ExternalIPs) > 0 && (netconf.Role != types.NetworkRolePrimary || netconf.Topology == types.LocalnetTopology) {
+		return fmt.Errorf("external IPs are only supported for primary layer3 and layer2 networks")
+	}
+
+	// the egress traffic is routed through the host in local gateway mode,
+	// where the SNAT to the external IPs is not implemented
+	if len(netconf.ExternalIPs) > 0 && config.Gateway.Mode == config.GatewayModeLocal {
+		return fmt.Errorf("external IPs are not supported in local gateway mode")
+	}
+
 	if netconf.Role != "" && netconf.Role != types.NetworkRoleSecondary && netconf.Topology == types.LocalnetTopology {
 		return fmt.Errorf("unexpected network field \"role\" %s for \"localnet\" topology, "+
 			"localnet topology does not allow network roles to be set since its always a secondary network", netconf.Role)
@@ -1374,6 +1673,15 @@ func DoesNetworkRequireIPAM(netInfo NetInfo) bool {
 	return !((netInfo.TopologyType() == types.Layer2Topology || netInfo.TopologyType() == types.LocalnetTopology) && len(netInfo.Subnets()) == 0)
 }
 
+// DoesNetworkRequireRARouter returns true for the IPv6 layer2 secondary
+// networks serving DHCP: they have no gateway router, so a dedicated router
+// sends their IPv6 router advertisements
+func DoesNetworkRequireRARouter(netInfo NetInfo) bool {
+	_, ipv6Mode := netInfo.IPMode()
+	return netInfo.TopologyType() == types.Layer2Topology && !netInfo.IsPrimaryNetwork() &&
+		netInfo.ServesDHCP() && ipv6Mode
+}
+
 func DoesNetworkRequireTunnelIDs(netInfo NetInfo) bool {
 	// Layer2Topology with IC require that we allocate tunnel IDs for each pod
 	return netInfo.TopologyType() == types.Layer2Topology && config.OVNKubernetesFeature.EnableInterconnect
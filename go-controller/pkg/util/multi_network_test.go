@@ -137,6 +137,7 @@ func TestParseNetconf(t *testing.T) {
 		expectedNetConf             *ovncnitypes.NetConf
 		expectedError               error
 		unsupportedReason           string
+		gatewayMode                 config.GatewayMode
 	}
 
 	tests := []testConfig{
@@ -336,6 +337,215 @@ func TestParseNetconf(t *testing.T) {
 `,
 			expectedError: fmt.Errorf("layer3 topology does not allow persistent IPs"),
 		},
+		{
+			desc: "valid attachment definition for a localnet topology with trunk VLANs",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "localnet",
+			"trunkVLANIDs": [20, 10],
+			"netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedNetConf: &ovncnitypes.NetConf{
+				Topology:     "localnet",
+				NADName:      "ns1/nad1",
+				MTU:          1400,
+				TrunkVLANIDs: []int{20, 10},
+				NetConf:      cnitypes.NetConf{Name: "tenantred", Type: "ovn-k8s-cni-overlay"},
+			},
+		},
+		{
+			desc: "invalid attachment definition for a layer2 topology with trunk VLANs",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "layer2",
+			"subnets": "192.168.200.0/16",
+			"trunkVLANIDs": [10],
+			"netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedError: fmt.Errorf("layer2 topology does not allow trunk VLANs"),
+		},
+		{
+			desc: "invalid attachment definition for a localnet topology with both access and trunk VLANs",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "localnet",
+			"vlanID": 10,
+			"trunkVLANIDs": [20],
+			"netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedError: fmt.Errorf("trunk VLANs and access VLAN 10 are mutually exclusive"),
+		},
+		{
+			desc: "invalid attachment definition for a localnet topology with both subnets and trunk VLANs",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "localnet",
+			"subnets": "192.168.200.0/16",
+			"trunkVLANIDs": [20],
+			"netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedError: fmt.Errorf("trunk VLANs and subnets are mutually exclusive"),
+		},
+		{
+			desc: "invalid attachment definition for a localnet topology with an out of range trunk VLAN",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "localnet",
+			"trunkVLANIDs": [4095],
+			"netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedError: fmt.Errorf("invalid trunk VLAN ID 4095, must be in range 1-4094"),
+		},
+		{
+			desc: "valid attachment definition for a layer2 topology with DHCP enabled",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "layer2",
+			"subnets": "192.168.200.0/16",
+			"enableDHCP": true,
+			"netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedNetConf: &ovncnitypes.NetConf{
+				Topology:   "layer2",
+				NADName:    "ns1/nad1",
+				MTU:        1400,
+				EnableDHCP: true,
+				Subnets:    "192.168.200.0/16",
+				NetConf:    cnitypes.NetConf{Name: "tenantred", Type: "ovn-k8s-cni-overlay"},
+			},
+		},
+		{
+			desc: "invalid attachment definition for a layer3 topology with DHCP enabled",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "layer3",
+			"subnets": "192.168.200.0/16",
+			"enableDHCP": true,
+			"netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedError: fmt.Errorf("layer3 topology does not allow enabling DHCP"),
+		},
+		{
+			desc: "invalid attachment definition for a layer2 topology with DHCP enabled and no subnets",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "layer2",
+			"enableDHCP": true,
+			"netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedError: fmt.Errorf("enabling DHCP requires subnets to be set"),
+		},
+		{
+			desc: "valid attachment definition for a layer3 topology isolating namespaces",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "layer3",
+			"subnets": "192.168.200.0/16",
+			"isolateNamespaces": true,
+			"netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedNetConf: &ovncnitypes.NetConf{
+				Topology:          "layer3",
+				NADName:           "ns1/nad1",
+				MTU:               1400,
+				IsolateNamespaces: true,
+				Subnets:           "192.168.200.0/16",
+				NetConf:           cnitypes.NetConf{Name: "tenantred", Type: "ovn-k8s-cni-overlay"},
+			},
+		},
+		{
+			desc: "invalid attachment definition for a localnet topology isolating namespaces and no subnets",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "localnet",
+			"isolateNamespaces": true,
+			"netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedError: fmt.Errorf("isolating namespaces requires subnets to be set"),
+		},
+		{
+			desc: "valid attachment definition for a primary layer3 topology with external IPs",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "layer3",
+			"subnets": "192.168.200.0/16",
+			"role": "primary",
+			"externalIPs": {"node1": "172.18.0.100"},
+			"netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedNetConf: &ovncnitypes.NetConf{
+				Topology:    "layer3",
+				NADName:     "ns1/nad1",
+				MTU:         1400,
+				Role:        "primary",
+				ExternalIPs: map[string]string{"node1": "172.18.0.100"},
+				Subnets:     "192.168.200.0/16",
+				NetConf:     cnitypes.NetConf{Name: "tenantred", Type: "ovn-k8s-cni-overlay"},
+			},
+		},
+		{
+			desc: "invalid attachment definition for a secondary layer2 topology with external IPs",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "layer2",
+			"subnets": "192.168.200.0/16",
+			"externalIPs": {"node1": "172.18.0.100"},
+			"netAttachDefName": "ns1/nad1"
+    }
+`,
+			expectedError: fmt.Errorf("external IPs are only supported for primary layer3 and layer2 networks"),
+		},
+		{
+			desc: "invalid attachment definition for a primary layer3 topology with external IPs in local gateway mode",
+			inputNetAttachDefConfigSpec: `
+    {
+            "name": "tenantred",
+            "type": "ovn-k8s-cni-overlay",
+            "topology": "layer3",
+			"subnets": "192.168.200.0/16",
+			"role": "primary",
+			"externalIPs": {"node1": "172.18.0.100"},
+			"netAttachDefName": "ns1/nad1"
+    }
+`,
+			gatewayMode:   config.GatewayModeLocal,
+			expectedError: fmt.Errorf("external IPs are not supported in local gateway mode"),
+		},
 		{
 			desc: "valid attachment definition for a layer2 topology with role:primary",
 			inputNetAttachDefConfigSpec: `
@@ -464,6 +674,7 @@ func TestParseNetconf(t *testing.T) {
 		t.Run(test.desc, func(t *testing.T) {
 			config.IPv4Mode = true
 			config.IPv6Mode = true
+			config.Gateway.Mode = test.gatewayMode
 			if test.unsupportedReason != "" {
 				t.Skip(test.unsupportedReason)
 			}
@@ -1260,6 +1471,68 @@ func TestNewNetInfo(t *testing.T) {
 	}
 }
 
+func TestParseExternalIPs(t *testing.T) {
+	tests := []struct {
+		desc          string
+		externalIPs   map[string]string
+		ipv4Mode      bool
+		ipv6Mode      bool
+		expected      map[string][]net.IP
+		expectedError string
+	}{
+		{
+			desc:     "no external IPs",
+			ipv4Mode: true,
+		},
+		{
+			desc:        "dualstack external IPs",
+			externalIPs: map[string]string{"node1": "172.18.0.100, fc00::100", "node2": "172.18.0.101"},
+			ipv4Mode:    true,
+			ipv6Mode:    true,
+			expected: map[string][]net.IP{
+				"node1": {net.ParseIP("172.18.0.100"), net.ParseIP("fc00::100")},
+				"node2": {net.ParseIP("172.18.0.101")},
+			},
+		},
+		{
+			desc:          "invalid external IP",
+			externalIPs:   map[string]string{"node1": "172.18.0.300"},
+			ipv4Mode:      true,
+			expectedError: "invalid external IP \"172.18.0.300\" for node node1",
+		},
+		{
+			desc:          "multiple external IPs of the same IP family",
+			externalIPs:   map[string]string{"node1": "172.18.0.100,172.18.0.101"},
+			ipv4Mode:      true,
+			expectedError: "unexpected external IP 172.18.0.101 for node node1: expecting at most one IP of each IP family of the network",
+		},
+		{
+			desc:          "external IP of an IP family not used by the network",
+			externalIPs:   map[string]string{"node1": "fc00::100"},
+			ipv4Mode:      true,
+			expectedError: "unexpected external IP fc00::100 for node node1: expecting at most one IP of each IP family of the network",
+		},
+		{
+			desc:          "empty node name",
+			externalIPs:   map[string]string{"": "172.18.0.100"},
+			ipv4Mode:      true,
+			expectedError: "external IPs \"172.18.0.100\" provided for an empty node name",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			externalIPs, err := parseExternalIPs(tc.externalIPs, tc.ipv4Mode, tc.ipv6Mode)
+			if tc.expectedError != "" {
+				g.Expect(err).To(gomega.MatchError(tc.expectedError))
+				return
+			}
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(externalIPs).To(gomega.Equal(tc.expected))
+		})
+	}
+}
+
 func TestAreNetworksCompatible(t *testing.T) {
 	tests := []struct {
 		desc                   string
@@ -1275,6 +1548,147 @@ func TestAreNetworksCompatible(t *testing.T) {
 			expectedResult:         false,
 			expectationDescription: "we should reconcile on physical network name updates",
 		},
+		{
+			desc:                   "trunk VLANs update",
+			aNetwork:               &secondaryNetInfo{topology: ovntypes.LocalnetTopology, trunkVlans: []uint{10}},
+			anotherNetwork:         &secondaryNetInfo{topology: ovntypes.LocalnetTopology, trunkVlans: []uint{10, 20}},
+			expectedResult:         false,
+			expectationDescription: "we should reconcile on trunk VLANs updates",
+		},
+		{
+			desc:                   "DHCP update",
+			aNetwork:               &secondaryNetInfo{topology: ovntypes.Layer2Topology},
+			anotherNetwork:         &secondaryNetInfo{topology: ovntypes.Layer2Topology, enableDHCP: true},
+			expectedResult:         false,
+			expectationDescription: "we should reconcile on DHCP being enabled or disabled",
+		},
+		{
+			desc:                   "namespace isolation update",
+			aNetwork:               &secondaryNetInfo{topology: ovntypes.Layer3Topology},
+			anotherNetwork:         &secondaryNetInfo{topology: ovntypes.Layer3Topology, isolateNamespaces: true},
+			expectedResult:         false,
+			expectationDescription: "we should reconcile on namespace isolation being enabled or disabled",
+		},
+		{
+			desc:                   "external IPs update",
+			aNetwork:               &secondaryNetInfo{topology: ovntypes.Layer3Topology},
+			anotherNetwork:         &secondaryNetInfo{topology: ovntypes.Layer3Topology, externalIPs: map[string][]net.IP{"node1": {net.ParseIP("172.18.0.100")}}},
+			expectedResult:         false,
+			expectationDescription: "we should reconcile on external IPs updates",
+		},
+		{
+			desc:                   "MTU update",
+			aNetwork:               &secondaryNetInfo{topology: ovntypes.Layer3Topology, mtu: 1400},
+			anotherNetwork:         &secondaryNetInfo{topology: ovntypes.Layer3Topology, mtu: 1300},
+			expectedResult:         true,
+			expectationDescription: "the MTU can be updated in place",
+		},
+		{
+			desc: "layer3 subnet appended",
+			aNetwork: &secondaryNetInfo{
+				topology: ovntypes.Layer3Topology,
+				ipv4mode: true,
+				subnets:  []config.CIDRNetworkEntry{{CIDR: ovntest.MustParseIPNet("10.128.0.0/16"), HostSubnetLength: 24}},
+			},
+			anotherNetwork: &secondaryNetInfo{
+				topology: ovntypes.Layer3Topology,
+				ipv4mode: true,
+				subnets: []config.CIDRNetworkEntry{
+					{CIDR: ovntest.MustParseIPNet("10.128.0.0/16"), HostSubnetLength: 24},
+					{CIDR: ovntest.MustParseIPNet("10.129.0.0/16"), HostSubnetLength: 24},
+				},
+			},
+			expectedResult:         true,
+			expectationDescription: "subnets can be appended to layer3 networks in place",
+		},
+		{
+			desc: "layer3 subnet removed",
+			aNetwork: &secondaryNetInfo{
+				topology: ovntypes.Layer3Topology,
+				ipv4mode: true,
+				subnets: []config.CIDRNetworkEntry{
+					{CIDR: ovntest.MustParseIPNet("10.128.0.0/16"), HostSubnetLength: 24},
+					{CIDR: ovntest.MustParseIPNet("10.129.0.0/16"), HostSubnetLength: 24},
+				},
+			},
+			anotherNetwork: &secondaryNetInfo{
+				topology: ovntypes.Layer3Topology,
+				ipv4mode: true,
+				subnets:  []config.CIDRNetworkEntry{{CIDR: ovntest.MustParseIPNet("10.128.0.0/16"), HostSubnetLength: 24}},
+			},
+			expectedResult:         false,
+			expectationDescription: "subnets can't be removed from layer3 networks in place",
+		},
+		{
+			desc: "layer3 subnet of a new IP family appended",
+			aNetwork: &secondaryNetInfo{
+				topology: ovntypes.Layer3Topology,
+				ipv4mode: true,
+				subnets:  []config.CIDRNetworkEntry{{CIDR: ovntest.MustParseIPNet("10.128.0.0/16"), HostSubnetLength: 24}},
+			},
+			anotherNetwork: &secondaryNetInfo{
+				topology: ovntypes.Layer3Topology,
+				ipv4mode: true,
+				ipv6mode: true,
+				subnets: []config.CIDRNetworkEntry{
+					{CIDR: ovntest.MustParseIPNet("10.128.0.0/16"), HostSubnetLength: 24},
+					{CIDR: ovntest.MustParseIPNet("fd00:10:128::/48"), HostSubnetLength: 64},
+				},
+			},
+			expectedResult:         false,
+			expectationDescription: "the IP families of a layer3 network can't be changed in place",
+		},
+		{
+			desc: "layer2 subnet appended",
+			aNetwork: &secondaryNetInfo{
+				topology: ovntypes.Layer2Topology,
+				ipv4mode: true,
+				subnets:  []config.CIDRNetworkEntry{{CIDR: ovntest.MustParseIPNet("10.128.0.0/16")}},
+			},
+			anotherNetwork: &secondaryNetInfo{
+				topology: ovntypes.Layer2Topology,
+				ipv4mode: true,
+				subnets: []config.CIDRNetworkEntry{
+					{CIDR: ovntest.MustParseIPNet("10.128.0.0/16")},
+					{CIDR: ovntest.MustParseIPNet("10.129.0.0/16")},
+				},
+			},
+			expectedResult:         true,
+			expectationDescription: "subnets can be appended to layer2 networks in place",
+		},
+		{
+			desc: "layer2 subnet replaced",
+			aNetwork: &secondaryNetInfo{
+				topology: ovntypes.Layer2Topology,
+				ipv4mode: true,
+				subnets:  []config.CIDRNetworkEntry{{CIDR: ovntest.MustParseIPNet("10.128.0.0/16")}},
+			},
+			anotherNetwork: &secondaryNetInfo{
+				topology: ovntypes.Layer2Topology,
+				ipv4mode: true,
+				subnets:  []config.CIDRNetworkEntry{{CIDR: ovntest.MustParseIPNet("10.129.0.0/16")}},
+			},
+			expectedResult:         false,
+			expectationDescription: "subnets can't be replaced on layer2 networks in place",
+		},
+		{
+			desc: "localnet subnet appended",
+			aNetwork: &secondaryNetInfo{
+				topology: ovntypes.LocalnetTopology,
+				ipv4mode: true,
+				subnets:  []config.CIDRNetworkEntry{{CIDR: ovntest.MustParseIPNet("10.128.0.0/16")}},
+			},
+			anotherNetwork: &secondaryNetInfo{
+				topology: ovntypes.LocalnetTopology,
+				ipv4mode: true,
+				subnets: []config.CIDRNetworkEntry{
+					{CIDR: ovntest.MustParseIPNet("10.128.0.0/16")},
+					{CIDR: ovntest.MustParseIPNet("10.129.0.0/16")},
+				},
+			},
+			expectedResult:         false,
+			expectationDescription: "subnets can't be appended to localnet networks in place",
+		},
 	}
 
 	for _, test := range tests {
@@ -614,6 +614,16 @@ func GetOvsDBServerPID() (string, error) {
 	return strings.TrimSpace(string(pid)), nil
 }
 
+// GetOvnControllerPID retrieves the Process IDentifier for ovn-controller daemon.
+func GetOvnControllerPID() (string, error) {
+	pid, err := afero.ReadFile(AppFs, savedOVNRunDir+"ovn-controller.pid")
+	if err != nil {
+		return "", fmt.Errorf("failed to get ovn-controller pid : %v", err)
+	}
+
+	return strings.TrimSpace(string(pid)), nil
+}
+
 // RunIP runs a command via the iproute2 "ip" utility
 func RunIP(args ...string) (string, string, error) {
 	stdout, stderr, err := run(runner.ipPath, args...)
@@ -0,0 +1,40 @@
+package util
+
+import (
+	"net"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	udnv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+)
+
+// GetRouteImportConflicts returns the prefixes that the given route imports of a
+// ClusterUserDefinedNetwork import from more than one network, mapped to the
+// sorted names of those networks. Traffic towards such a prefix can't be routed
+// to one of the networks rather than the other, so these prefixes are not
+// imported at all.
+func GetRouteImportConflicts(routeImports []udnv1.RouteImport) map[string][]string {
+	networksByPrefix := map[string]sets.Set[string]{}
+	for _, routeImport := range routeImports {
+		for _, cidr := range routeImport.Prefixes {
+			_, prefix, err := net.ParseCIDR(string(cidr))
+			if err != nil {
+				continue
+			}
+			networks, ok := networksByPrefix[prefix.String()]
+			if !ok {
+				networks = sets.New[string]()
+				networksByPrefix[prefix.String()] = networks
+			}
+			networks.Insert(routeImport.Network)
+		}
+	}
+
+	conflicts := map[string][]string{}
+	for prefix, networks := range networksByPrefix {
+		if networks.Len() > 1 {
+			conflicts[prefix] = sets.List(networks)
+		}
+	}
+	return conflicts
+}
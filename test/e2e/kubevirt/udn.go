@@ -52,7 +52,7 @@ func GenerateCUDN(namespace, name string, topology udnv1.NetworkTopology, role u
 	if topology == udnv1.NetworkTopologyLayer2 {
 		cudn.Spec.Network.Layer2 = &udnv1.Layer2Config{
 			Role:    role,
-			Subnets: subnets,
+			Subnets: udnv1.Layer2Subnets(subnets),
 			IPAM:    ipam,
 		}
 	} else if topology == udnv1.NetworkTopologyLocalnet {
@@ -991,8 +991,8 @@ func generateL3Subnets(v4, v6 udnv1.Layer3Subnet) []udnv1.Layer3Subnet {
 	return subnets
 }
 
-func generateL2Subnets(v4, v6 string) udnv1.DualStackCIDRs {
-	var subnets udnv1.DualStackCIDRs
+func generateL2Subnets(v4, v6 string) udnv1.Layer2Subnets {
+	var subnets udnv1.Layer2Subnets
 	if isIPv4Supported() {
 		subnets = append(subnets, udnv1.CIDR(v4))
 	}